@@ -31,6 +31,10 @@ struct SearchInfo {
     MetricType metric_type_;
     knowhere::Json search_params_;
     std::optional<FieldId> group_by_field_id_;
+    // max number of hits in each group of group by
+    int64_t group_size_ = 1;
+    // keep iterating until every group has group_size hits
+    bool group_strict_size_ = false;
     tracer::TraceContext trace_ctx_;
 };
 
@@ -37,6 +37,8 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
             auto dataGetter = GetDataGetter<int8_t>(segment, group_by_field_id);
             GroupIteratorsByType<int8_t>(iterators,
                                          search_info.topk_,
+                                         search_info.group_size_,
+                                         search_info.group_strict_size_,
                                          *dataGetter,
                                          group_by_values,
                                          seg_offsets,
@@ -49,6 +51,8 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<int16_t>(segment, group_by_field_id);
             GroupIteratorsByType<int16_t>(iterators,
                                           search_info.topk_,
+                                          search_info.group_size_,
+                                          search_info.group_strict_size_,
                                           *dataGetter,
                                           group_by_values,
                                           seg_offsets,
@@ -61,6 +65,8 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<int32_t>(segment, group_by_field_id);
             GroupIteratorsByType<int32_t>(iterators,
                                           search_info.topk_,
+                                          search_info.group_size_,
+                                          search_info.group_strict_size_,
                                           *dataGetter,
                                           group_by_values,
                                           seg_offsets,
@@ -73,6 +79,8 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<int64_t>(segment, group_by_field_id);
             GroupIteratorsByType<int64_t>(iterators,
                                           search_info.topk_,
+                                          search_info.group_size_,
+                                          search_info.group_strict_size_,
                                           *dataGetter,
                                           group_by_values,
                                           seg_offsets,
@@ -84,6 +92,8 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
             auto dataGetter = GetDataGetter<bool>(segment, group_by_field_id);
             GroupIteratorsByType<bool>(iterators,
                                        search_info.topk_,
+                                       search_info.group_size_,
+                                       search_info.group_strict_size_,
                                        *dataGetter,
                                        group_by_values,
                                        seg_offsets,
@@ -96,6 +106,8 @@ GroupBy(const std::vector<std::shared_ptr<VectorIterator>>& iterators,
                 GetDataGetter<std::string>(segment, group_by_field_id);
             GroupIteratorsByType<std::string>(iterators,
                                               search_info.topk_,
+                                              search_info.group_size_,
+                                              search_info.group_strict_size_,
                                               *dataGetter,
                                               group_by_values,
                                               seg_offsets,
@@ -117,6 +129,8 @@ void
 GroupIteratorsByType(
     const std::vector<std::shared_ptr<VectorIterator>>& iterators,
     int64_t topK,
+    int64_t group_size,
+    bool group_strict_size,
     const DataGetter<T>& data_getter,
     std::vector<GroupByValueType>& group_by_values,
     std::vector<int64_t>& seg_offsets,
@@ -125,6 +139,8 @@ GroupIteratorsByType(
     for (auto& iterator : iterators) {
         GroupIteratorResult<T>(iterator,
                                topK,
+                               group_size,
+                               group_strict_size,
                                data_getter,
                                group_by_values,
                                seg_offsets,
@@ -137,15 +153,20 @@ template <typename T>
 void
 GroupIteratorResult(const std::shared_ptr<VectorIterator>& iterator,
                     int64_t topK,
+                    int64_t group_size,
+                    bool group_strict_size,
                     const DataGetter<T>& data_getter,
                     std::vector<GroupByValueType>& group_by_values,
                     std::vector<int64_t>& offsets,
                     std::vector<float>& distances,
                     const knowhere::MetricType& metrics_type) {
-    //1.
-    std::unordered_map<T, std::pair<int64_t, float>> groupMap;
+    //1. hits of each group, in the order returned by the iterator
+    using GroupHits = std::vector<std::pair<int64_t, float>>;
+    std::unordered_map<T, GroupHits> groupMap;
+    int64_t full_group_count = 0;
 
     //2. do iteration until fill the whole map or run out of all data
+    //in strict mode, keep iterating until all the groups are full
     //note it may enumerate all data inside a segment and can block following
     //query and search possibly
     auto dis_closer = [&](float l, float r) {
@@ -153,7 +174,13 @@ GroupIteratorResult(const std::shared_ptr<VectorIterator>& iterator,
             return l > r;
         return l < r;
     };
-    while (iterator->HasNext() && groupMap.size() < topK) {
+    auto enough = [&]() {
+        if (group_strict_size) {
+            return full_group_count >= topK;
+        }
+        return groupMap.size() >= topK;
+    };
+    while (iterator->HasNext() && !enough()) {
         auto offset_dis_pair = iterator->Next();
         AssertInfo(
             offset_dis_pair.has_value(),
@@ -164,19 +191,33 @@ GroupIteratorResult(const std::shared_ptr<VectorIterator>& iterator,
         T row_data = data_getter.Get(offset);
         auto it = groupMap.find(row_data);
         if (it == groupMap.end()) {
-            groupMap.emplace(row_data, std::make_pair(offset, dis));
-        } else if (dis_closer(dis, it->second.second)) {
-            it->second = {offset, dis};
+            if (groupMap.size() >= topK) {
+                continue;
+            }
+            it = groupMap.emplace(row_data, GroupHits()).first;
+        }
+        if (it->second.size() >= group_size) {
+            continue;
+        }
+        it->second.emplace_back(offset, dis);
+        if (it->second.size() == group_size) {
+            full_group_count++;
         }
     }
 
-    //3. sorted based on distances and metrics
-    std::vector<std::pair<T, std::pair<int64_t, float>>> sortedGroupVals(
-        groupMap.begin(), groupMap.end());
+    //3. sorted based on distances and metrics, the results are merged by
+    //distances when reducing
+    std::vector<std::pair<T, std::pair<int64_t, float>>> sortedGroupVals;
+    for (auto& [group_val, hits] : groupMap) {
+        for (auto& hit : hits) {
+            sortedGroupVals.emplace_back(group_val, hit);
+        }
+    }
     auto customComparator = [&](const auto& lhs, const auto& rhs) {
         return dis_closer(lhs.second.second, rhs.second.second);
     };
-    std::sort(sortedGroupVals.begin(), sortedGroupVals.end(), customComparator);
+    std::stable_sort(
+        sortedGroupVals.begin(), sortedGroupVals.end(), customComparator);
 
     //4. save groupBy results
     group_by_values.reserve(sortedGroupVals.size());
@@ -189,8 +230,10 @@ GroupIteratorResult(const std::shared_ptr<VectorIterator>& iterator,
         distances.push_back(iter->second.second);
     }
 
-    //5. padding topK results, extra memory consumed will be removed when reducing
-    for (std::size_t idx = groupMap.size(); idx < topK; idx++) {
+    //5. padding topK * group_size results, extra memory consumed will be
+    //removed when reducing
+    for (std::size_t idx = sortedGroupVals.size(); idx < topK * group_size;
+         idx++) {
         offsets.push_back(INVALID_SEG_OFFSET);
         distances.push_back(0.0);
         group_by_values.emplace_back(std::monostate{});
@@ -206,6 +206,9 @@ ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     if (query_info_proto.group_by_field_id() != 0) {
         auto group_by_field_id = FieldId(query_info_proto.group_by_field_id());
         search_info.group_by_field_id_ = group_by_field_id;
+        search_info.group_size_ = std::max<int64_t>(
+            query_info_proto.group_size(), 1);
+        search_info.group_strict_size_ = query_info_proto.group_strict_size();
     }
     auto plan_node = [&]() -> std::unique_ptr<VectorPlanNode> {
         if (anns_proto.vector_type() ==
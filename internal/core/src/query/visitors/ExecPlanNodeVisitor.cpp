@@ -203,6 +203,9 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
                    "equal to search_result.seg_offsets.size:{}",
                    search_result.group_by_values_.size(),
                    search_result.seg_offsets_.size());
+        // every group holds at most group_size hits
+        search_result.unity_topK_ =
+            node.search_info_.topk_ * node.search_info_.group_size_;
     }
     search_result_opt_ = std::move(search_result);
 }
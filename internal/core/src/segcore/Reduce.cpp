@@ -220,7 +220,7 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
     }
     pk_set_.clear();
     pairs_.clear();
-    group_by_val_set_.clear();
+    group_by_val_count_.clear();
 
     pairs_.reserve(num_segments_);
     bool need_handle_group_by_values = false;
@@ -228,6 +228,9 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
         need_handle_group_by_values =
             !search_results_[0]->group_by_values_.empty();
     }
+    // topk is the number of groups for group by, each holds group_size hits
+    auto group_size = plan_->plan_node_->search_info_.group_size_;
+    auto limit = need_handle_group_by_values ? topk * group_size : topk;
     for (int i = 0; i < num_segments_; i++) {
         auto search_result = search_results_[i];
         auto offset_beg = search_result->topk_per_nq_prefix_sum_[qi];
@@ -259,7 +262,7 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
 
     int64_t dup_cnt = 0;
     auto start = offset;
-    while (offset - start < topk && !heap_.empty()) {
+    while (offset - start < limit && !heap_.empty()) {
         auto pilot = heap_.top();
         heap_.pop();
 
@@ -274,9 +277,12 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
             bool skip_for_group_by = false;
             if (need_handle_group_by_values &&
                 pilot->group_by_value_.has_value()) {
-                if (group_by_val_set_.count(pilot->group_by_value_.value()) >
-                    0) {
-                    skip_for_group_by = true;
+                auto it =
+                    group_by_val_count_.find(pilot->group_by_value_.value());
+                if (it == group_by_val_count_.end()) {
+                    skip_for_group_by = group_by_val_count_.size() >= topk;
+                } else {
+                    skip_for_group_by = it->second >= group_size;
                 }
             }
             if (!skip_for_group_by) {
@@ -285,7 +291,7 @@ ReduceHelper::ReduceSearchResultForOneNQ(int64_t qi,
                 pk_set_.insert(pk);
                 if (need_handle_group_by_values &&
                     pilot->group_by_value_.has_value())
-                    group_by_val_set_.insert(pilot->group_by_value_.value());
+                    group_by_val_count_[pilot->group_by_value_.value()]++;
             }
         } else {
             // skip entity with same primary key
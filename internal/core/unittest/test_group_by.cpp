@@ -509,6 +509,85 @@ TEST(GroupBY, SealedData) {
     }
 }
 
+TEST(GroupBY, SealedDataGroupSize) {
+    using namespace milvus;
+    using namespace milvus::query;
+    using namespace milvus::segcore;
+
+    //0. prepare schema
+    int dim = 64;
+    auto schema = std::make_shared<Schema>();
+    auto vec_fid = schema->AddDebugField(
+        "fakevec", DataType::VECTOR_FLOAT, dim, knowhere::metric::L2);
+    auto int8_fid = schema->AddDebugField("int8", DataType::INT8);
+    auto str_fid = schema->AddDebugField("string1", DataType::VARCHAR);
+    schema->set_primary_field_id(str_fid);
+    auto segment = CreateSealedSegment(schema);
+    size_t N = 100;
+
+    //2. load raw data
+    auto raw_data = DataGen(schema, N);
+    auto fields = schema->get_fields();
+    for (auto field_data : raw_data.raw_->fields_data()) {
+        int64_t field_id = field_data.field_id();
+
+        auto info = FieldDataInfo(field_data.field_id(), N);
+        auto field_meta = fields.at(FieldId(field_id));
+        info.channel->push(
+            CreateFieldDataFromDataArray(N, &field_data, field_meta));
+        info.channel->close();
+
+        segment->LoadFieldData(FieldId(field_id), info);
+    }
+    prepareSegmentSystemFieldData(segment, N, raw_data);
+
+    //3. search group by int8 with 3 hits in each group
+    int topK = 10;
+    int group_size = 3;
+    const char* raw_plan = R"(vector_anns: <
+                                        field_id: 100
+                                        query_info: <
+                                          topk: 10
+                                          metric_type: "L2"
+                                          search_params: "{\"ef\": 10}"
+                                          group_by_field_id: 101
+                                          group_size: 3
+                                          group_strict_size: true
+                                        >
+                                        placeholder_tag: "$0"
+
+         >)";
+    auto plan_str = translate_text_plan_to_binary_plan(raw_plan);
+    auto plan =
+        CreateSearchPlanByExpr(*schema, plan_str.data(), plan_str.size());
+    auto num_queries = 1;
+    auto seed = 1024;
+    auto ph_group_raw = CreatePlaceholderGroup(num_queries, dim, seed);
+    auto ph_group =
+        ParsePlaceholderGroup(plan.get(), ph_group_raw.SerializeAsString());
+    auto search_result = segment->Search(plan.get(), ph_group.get(), 1L << 63);
+    ASSERT_EQ(search_result->unity_topK_, topK * group_size);
+    ASSERT_EQ(search_result->seg_offsets_.size(), topK * group_size);
+
+    auto& group_by_values = search_result->group_by_values_;
+    std::unordered_map<int8_t, int> group_counts;
+    float lastDistance = 0.0;
+    for (size_t i = 0; i < group_by_values.size(); i++) {
+        if (std::holds_alternative<int8_t>(group_by_values[i])) {
+            int8_t g_val = std::get<int8_t>(group_by_values[i]);
+            group_counts[g_val]++;
+            ASSERT_TRUE(group_counts[g_val] <= group_size);
+            auto distance = search_result->distances_.at(i);
+            ASSERT_TRUE(lastDistance <= distance);
+            lastDistance = distance;
+        } else {
+            //check padding
+            ASSERT_EQ(search_result->seg_offsets_[i], INVALID_SEG_OFFSET);
+        }
+    }
+    ASSERT_TRUE(group_counts.size() <= topK);
+}
+
 TEST(GroupBY, Reduce) {
     using namespace milvus;
     using namespace milvus::query;
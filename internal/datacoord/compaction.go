@@ -77,10 +77,12 @@ var (
 type CompactionMeta interface {
 	SelectSegments(selector SegmentInfoSelector) []*SegmentInfo
 	GetHealthySegment(segID UniqueID) *SegmentInfo
+	GetSegment(segID UniqueID) *SegmentInfo
 	UpdateSegmentsInfo(operators ...UpdateOperator) error
 	SetSegmentCompacting(segmentID int64, compacting bool)
 
 	CompleteCompactionMutation(plan *datapb.CompactionPlan, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error)
+	CompleteClusteringCompactionMutation(plan *datapb.CompactionPlan, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error)
 }
 
 var _ CompactionMeta = (*meta)(nil)
@@ -328,7 +330,7 @@ func (c *compactionPlanHandler) RefreshPlan(task *compactionTask) {
 		return
 	}
 
-	if plan.GetType() == datapb.CompactionType_MixCompaction {
+	if plan.GetType() == datapb.CompactionType_MixCompaction || plan.GetType() == datapb.CompactionType_ClusteringCompaction {
 		segIDMap := make(map[int64][]*datapb.FieldBinlog, len(plan.SegmentBinlogs))
 		for _, seg := range plan.GetSegmentBinlogs() {
 			if info := c.meta.GetHealthySegment(seg.GetSegmentID()); info != nil {
@@ -336,7 +338,7 @@ func (c *compactionPlanHandler) RefreshPlan(task *compactionTask) {
 				segIDMap[seg.SegmentID] = info.GetDeltalogs()
 			}
 		}
-		log.Info("Compaction handler refreshed mix compaction plan", zap.Any("segID2DeltaLogs", segIDMap))
+		log.Info("Compaction handler refreshed compaction plan", zap.String("type", plan.GetType().String()), zap.Any("segID2DeltaLogs", segIDMap))
 		return
 	}
 }
@@ -408,6 +410,10 @@ func (c *compactionPlanHandler) completeCompaction(result *datapb.CompactionPlan
 		if err := c.handleL0CompactionResult(plan, result); err != nil {
 			return err
 		}
+	case datapb.CompactionType_ClusteringCompaction:
+		if err := c.handleClusteringCompactionResult(plan, result); err != nil {
+			return err
+		}
 	default:
 		return errors.New("unknown compaction type")
 	}
@@ -482,6 +488,74 @@ func (c *compactionPlanHandler) handleMergeCompactionResult(plan *datapb.Compact
 	return nil
 }
 
+func (c *compactionPlanHandler) handleClusteringCompactionResult(plan *datapb.CompactionPlan, result *datapb.CompactionPlanResult) error {
+	log := log.With(zap.Int64("planID", plan.GetPlanID()))
+	if len(result.GetSegments()) == 0 {
+		// should never happen
+		log.Warn("illegal clustering compaction results")
+		return fmt.Errorf("Illegal clustering compaction results: %v", result)
+	}
+
+	// the result segments are saved in one batch, either all or none of them exist.
+	// the empty ones are saved as dropped, so check them regardless of the state.
+	existed := lo.CountBy(result.GetSegments(), func(seg *datapb.CompactionSegment) bool {
+		return c.meta.GetSegment(seg.GetSegmentID()) != nil
+	})
+	switch existed {
+	case 0:
+		_, metricMutation, err := c.meta.CompleteClusteringCompactionMutation(plan, result)
+		if err != nil {
+			return err
+		}
+		metricMutation.commit()
+	case len(result.GetSegments()):
+		log.Info("meta has already been changed, skip meta change and retry sync segments")
+	default:
+		log.Warn("part of the clustering compaction results are in meta", zap.Int("existed", existed), zap.Int("total", len(result.GetSegments())))
+		return fmt.Errorf("only %d of %d clustering compaction result segments are in meta", existed, len(result.GetSegments()))
+	}
+
+	newSegments := lo.FilterMap(result.GetSegments(), func(seg *datapb.CompactionSegment, _ int) (*SegmentInfo, bool) {
+		info := c.meta.GetHealthySegment(seg.GetSegmentID())
+		return info, info != nil
+	})
+
+	// sync all the result segments within one request, datanode adds them all to its meta cache
+	// and transfers the compacted segments to the first one.
+	req := &datapb.SyncSegmentsRequest{
+		PlanID:        plan.GetPlanID(),
+		CompactedTo:   result.GetSegments()[0].GetSegmentID(),
+		CompactedFrom: lo.Map(plan.GetSegmentBinlogs(), func(binlogs *datapb.CompactionSegmentBinlogs, _ int) int64 { return binlogs.GetSegmentID() }),
+		ChannelName:   plan.GetChannel(),
+		CompactedToSegments: lo.Map(newSegments, func(info *SegmentInfo, _ int) *datapb.SyncCompactedSegment {
+			return &datapb.SyncCompactedSegment{
+				SegmentID: info.GetID(),
+				NumOfRows: info.GetNumOfRows(),
+				StatsLogs: info.GetStatslogs(),
+			}
+		}),
+	}
+	if len(newSegments) > 0 {
+		req.CompactedTo = newSegments[0].GetID()
+		req.NumOfRows = newSegments[0].GetNumOfRows()
+		req.StatsLogs = newSegments[0].GetStatslogs()
+		req.PartitionId = newSegments[0].GetPartitionID()
+		req.CollectionId = newSegments[0].GetCollectionID()
+	}
+
+	nodeID := c.plans[plan.GetPlanID()].dataNodeID
+	log.Info("handleClusteringCompactionResult: syncing segments with node", zap.Int64("nodeID", nodeID),
+		zap.Int64s("segmentIDs", lo.Map(newSegments, func(info *SegmentInfo, _ int) int64 { return info.GetID() })))
+	if err := c.sessions.SyncSegments(nodeID, req); err != nil {
+		log.Warn("handleClusteringCompactionResult: fail to sync segments with node",
+			zap.Int64("nodeID", nodeID), zap.Error(err))
+		return err
+	}
+
+	log.Info("handleClusteringCompactionResult: success to handle clustering compaction result")
+	return nil
+}
+
 // getCompaction return compaction task. If planId does not exist, return nil.
 func (c *compactionPlanHandler) getCompaction(planID int64) *compactionTask {
 	c.mu.RLock()
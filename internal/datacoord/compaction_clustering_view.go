@@ -0,0 +1,170 @@
+package datacoord
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// ClusteringSegmentsView keeps the L1 and L2 segments of the min group
+// of a collection with clustering key
+type ClusteringSegmentsView struct {
+	label         *CompactionGroupLabel
+	segments      []*SegmentView
+	clusteringKey *schemapb.FieldSchema
+}
+
+var _ CompactionView = (*ClusteringSegmentsView)(nil)
+
+func (v *ClusteringSegmentsView) String() string {
+	strs := lo.Map(v.segments, func(v *SegmentView, _ int) string {
+		return v.ClusteringString()
+	})
+	return fmt.Sprintf("label=<%s>, clusteringKey=<%s>, segments=%v",
+		v.label.String(),
+		v.clusteringKey.GetName(),
+		strs)
+}
+
+func (v *ClusteringSegmentsView) Append(segments ...*SegmentView) {
+	if v.segments == nil {
+		v.segments = segments
+		return
+	}
+
+	v.segments = append(v.segments, segments...)
+}
+
+func (v *ClusteringSegmentsView) GetGroupLabel() *CompactionGroupLabel {
+	if v == nil {
+		return &CompactionGroupLabel{}
+	}
+	return v.label
+}
+
+func (v *ClusteringSegmentsView) GetSegmentsView() []*SegmentView {
+	if v == nil {
+		return nil
+	}
+
+	return v.segments
+}
+
+// Trigger triggers a clustering compaction when there are enough L1 segments,
+// which are not clustered yet. All the L2 segments of the group are picked as well,
+// so that the result segments cover disjoint ranges of the clustering key.
+func (v *ClusteringSegmentsView) Trigger() (CompactionView, string) {
+	var (
+		minL1SegmentNum = paramtable.Get().DataCoordCfg.ClusteringCompactionMinL1SegmentNum.GetAsInt()
+		maxInputSize    = paramtable.Get().DataCoordCfg.ClusteringCompactionMaxInputSize.GetAsFloat() * 1024 * 1024
+	)
+
+	l1Segments := lo.Filter(v.segments, func(view *SegmentView, _ int) bool {
+		return view.Level == datapb.SegmentLevel_L1 || view.Level == datapb.SegmentLevel_Legacy
+	})
+	if len(l1Segments) < minL1SegmentNum {
+		return nil, ""
+	}
+
+	totalSize := lo.SumBy(v.segments, func(view *SegmentView) float64 { return view.Size })
+	if totalSize > maxInputSize {
+		// too large to rewrite the whole group, cluster part of the L1 segments with the L2 segments they overlap
+		picked, curSize := v.pickOverlapped(l1Segments, maxInputSize)
+		if len(lo.Filter(picked, func(view *SegmentView, _ int) bool { return view.Level != datapb.SegmentLevel_L2 })) < minL1SegmentNum {
+			return nil, ""
+		}
+		return &ClusteringSegmentsView{
+			label:         v.label,
+			segments:      picked,
+			clusteringKey: v.clusteringKey,
+		}, fmt.Sprintf("clustering view size exceeds maxInputSize=%.2f, pick %d segments, curSize=%.2f", maxInputSize, len(picked), curSize)
+	}
+
+	return &ClusteringSegmentsView{
+		label:         v.label,
+		segments:      v.segments,
+		clusteringKey: v.clusteringKey,
+	}, fmt.Sprintf("L1 segments count reaches minL1SegmentNum=%d, curL1Count=%d, curSize=%.2f", minL1SegmentNum, len(l1Segments), totalSize)
+}
+
+// keyRange returns the value range of the clustering key in the segment, false if not recorded.
+func (v *ClusteringSegmentsView) keyRange(view *SegmentView) (*schemapb.ValueField, *schemapb.ValueField, bool) {
+	for _, stats := range view.FieldStats {
+		if stats.GetFieldID() == v.clusteringKey.GetFieldID() && stats.GetMin() != nil && stats.GetMax() != nil {
+			return stats.GetMin(), stats.GetMax(), true
+		}
+	}
+	return nil, nil, false
+}
+
+// pickOverlapped picks L1 segments until the total size exceeds maxSize, together with all the L2 segments
+// overlapping the key range which the picked L1 segments span. The result segments of the picked segments
+// may cover any key in the range, so the L2 segments left out must not overlap it.
+// The L2 segments are all picked once the key range of any picked L1 segment is unknown.
+func (v *ClusteringSegmentsView) pickOverlapped(l1Segments []*SegmentView, maxSize float64) ([]*SegmentView, float64) {
+	dataType := v.clusteringKey.GetDataType()
+	l2Segments := lo.Filter(v.segments, func(view *SegmentView, _ int) bool {
+		return view.Level == datapb.SegmentLevel_L2
+	})
+
+	// pick the L1 segments in the order of the key range, so that the spanned range grows slowly,
+	// the ones with unknown range are picked at last
+	sorted := make([]*SegmentView, len(l1Segments))
+	copy(sorted, l1Segments)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		minI, _, okI := v.keyRange(sorted[i])
+		minJ, _, okJ := v.keyRange(sorted[j])
+		if okI != okJ {
+			return okI
+		}
+		return okI && storage.CompareValueField(dataType, minI, minJ) < 0
+	})
+
+	var (
+		picked     []*SegmentView
+		pickedSize float64
+		spanMin    *schemapb.ValueField
+		spanMax    *schemapb.ValueField
+		unbounded  bool
+		overlapped []*SegmentView
+		curSize    float64
+	)
+	for _, view := range sorted {
+		newMin, newMax, newUnbounded := spanMin, spanMax, unbounded
+		if keyMin, keyMax, ok := v.keyRange(view); !ok {
+			newUnbounded = true
+		} else {
+			if newMin == nil || storage.CompareValueField(dataType, keyMin, newMin) < 0 {
+				newMin = keyMin
+			}
+			if newMax == nil || storage.CompareValueField(dataType, keyMax, newMax) > 0 {
+				newMax = keyMax
+			}
+		}
+
+		l2 := lo.Filter(l2Segments, func(l2 *SegmentView, _ int) bool {
+			if newUnbounded {
+				return true
+			}
+			l2Min, l2Max, ok := v.keyRange(l2)
+			return !ok || storage.CompareValueField(dataType, l2Min, newMax) <= 0 && storage.CompareValueField(dataType, newMin, l2Max) <= 0
+		})
+		size := pickedSize + view.Size + lo.SumBy(l2, func(view *SegmentView) float64 { return view.Size })
+		if size > maxSize {
+			continue
+		}
+
+		picked = append(picked, view)
+		pickedSize += view.Size
+		spanMin, spanMax, unbounded = newMin, newMax, newUnbounded
+		overlapped = l2
+		curSize = size
+	}
+	return append(picked, overlapped...), curSize
+}
@@ -0,0 +1,140 @@
+package datacoord
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestClusteringSegmentsViewSuite(t *testing.T) {
+	suite.Run(t, new(ClusteringSegmentsViewSuite))
+}
+
+type ClusteringSegmentsViewSuite struct {
+	suite.Suite
+	label *CompactionGroupLabel
+}
+
+func genTestClusteringSegmentView(ID UniqueID, label *CompactionGroupLabel, level datapb.SegmentLevel, size float64) *SegmentView {
+	return &SegmentView{
+		ID:    ID,
+		label: label,
+		Level: level,
+		State: commonpb.SegmentState_Flushed,
+		Size:  size,
+	}
+}
+
+func (s *ClusteringSegmentsViewSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (s *ClusteringSegmentsViewSuite) SetupTest() {
+	s.label = &CompactionGroupLabel{
+		CollectionID: 1,
+		PartitionID:  10,
+		Channel:      "ch-1",
+	}
+}
+
+func (s *ClusteringSegmentsViewSuite) newView(segments ...*SegmentView) *ClusteringSegmentsView {
+	return &ClusteringSegmentsView{
+		label:         s.label,
+		segments:      segments,
+		clusteringKey: &schemapb.FieldSchema{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64},
+	}
+}
+
+func (s *ClusteringSegmentsViewSuite) TestTrigger() {
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.ClusteringCompactionMinL1SegmentNum.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.ClusteringCompactionMinL1SegmentNum.Key)
+	paramtable.Get().Save(paramtable.Get().DataCoordCfg.ClusteringCompactionMaxInputSize.Key, "10")
+	defer paramtable.Get().Reset(paramtable.Get().DataCoordCfg.ClusteringCompactionMaxInputSize.Key)
+
+	s.Run("not enough L1 segments", func() {
+		v := s.newView(
+			genTestClusteringSegmentView(100, s.label, datapb.SegmentLevel_L1, 1024*1024),
+			genTestClusteringSegmentView(101, s.label, datapb.SegmentLevel_L2, 1024*1024),
+		)
+		got, reason := v.Trigger()
+		s.Nil(got)
+		s.Empty(reason)
+	})
+
+	s.Run("pick all segments", func() {
+		v := s.newView(
+			genTestClusteringSegmentView(100, s.label, datapb.SegmentLevel_L1, 1024*1024),
+			genTestClusteringSegmentView(101, s.label, datapb.SegmentLevel_L1, 1024*1024),
+			genTestClusteringSegmentView(102, s.label, datapb.SegmentLevel_L2, 1024*1024),
+		)
+		got, reason := v.Trigger()
+		s.NotNil(got)
+		s.NotEmpty(reason)
+		s.ElementsMatch([]int64{100, 101, 102}, segmentViewIDs(got.GetSegmentsView()))
+	})
+
+	s.Run("exceeds max input size", func() {
+		v := s.newView(
+			genTestClusteringSegmentViewWithRange(100, s.label, datapb.SegmentLevel_L1, 4*1024*1024, 0, 10),
+			genTestClusteringSegmentViewWithRange(101, s.label, datapb.SegmentLevel_L1, 4*1024*1024, 20, 30),
+			genTestClusteringSegmentViewWithRange(102, s.label, datapb.SegmentLevel_L1, 4*1024*1024, 100, 110),
+			genTestClusteringSegmentViewWithRange(103, s.label, datapb.SegmentLevel_L2, 8*1024*1024, 200, 300),
+			genTestClusteringSegmentViewWithRange(104, s.label, datapb.SegmentLevel_L2, 1*1024*1024, 5, 15),
+		)
+		got, reason := v.Trigger()
+		s.NotNil(got)
+		s.NotEmpty(reason)
+		// the L2 segment overlapping the picked L1 segments is picked as well
+		s.ElementsMatch([]int64{100, 101, 104}, segmentViewIDs(got.GetSegmentsView()))
+	})
+
+	s.Run("L2 segments in the spanned range", func() {
+		v := s.newView(
+			genTestClusteringSegmentViewWithRange(100, s.label, datapb.SegmentLevel_L1, 2*1024*1024, 0, 10),
+			genTestClusteringSegmentViewWithRange(101, s.label, datapb.SegmentLevel_L1, 2*1024*1024, 50, 60),
+			genTestClusteringSegmentViewWithRange(102, s.label, datapb.SegmentLevel_L2, 2*1024*1024, 20, 30),
+			genTestClusteringSegmentViewWithRange(103, s.label, datapb.SegmentLevel_L2, 8*1024*1024, 100, 200),
+		)
+		got, _ := v.Trigger()
+		s.NotNil(got)
+		// the L2 segment between the picked L1 segments must be rewritten too
+		s.ElementsMatch([]int64{100, 101, 102}, segmentViewIDs(got.GetSegmentsView()))
+	})
+
+	s.Run("L2 segments with unknown range", func() {
+		v := s.newView(
+			genTestClusteringSegmentViewWithRange(100, s.label, datapb.SegmentLevel_L1, 4*1024*1024, 0, 10),
+			genTestClusteringSegmentViewWithRange(101, s.label, datapb.SegmentLevel_L1, 4*1024*1024, 20, 30),
+			genTestClusteringSegmentView(102, s.label, datapb.SegmentLevel_L2, 8*1024*1024),
+		)
+		got, reason := v.Trigger()
+		s.Nil(got)
+		s.Empty(reason)
+	})
+}
+
+func genTestClusteringSegmentViewWithRange(ID UniqueID, label *CompactionGroupLabel, level datapb.SegmentLevel, size float64, min, max int64) *SegmentView {
+	view := genTestClusteringSegmentView(ID, label, level, size)
+	view.FieldStats = []*datapb.FieldStats{
+		{
+			FieldID: 101,
+			Type:    schemapb.DataType_Int64,
+			Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: min}},
+			Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: max}},
+		},
+	}
+	return view
+}
+
+func segmentViewIDs(views []*SegmentView) []int64 {
+	ids := make([]int64, 0, len(views))
+	for _, view := range views {
+		ids = append(ids, view.ID)
+	}
+	return ids
+}
@@ -384,6 +384,123 @@ func (s *CompactionPlanHandlerSuite) TestHandleMergeCompactionResult() {
 	})
 }
 
+func (s *CompactionPlanHandlerSuite) TestHandleClusteringCompactionResult() {
+	plan := &datapb.CompactionPlan{
+		PlanID: 1,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: 1},
+			{SegmentID: 2},
+		},
+		Channel: "ch-1",
+		Type:    datapb.CompactionType_ClusteringCompaction,
+	}
+	compactionResult := &datapb.CompactionPlanResult{
+		PlanID: plan.PlanID,
+		Segments: []*datapb.CompactionSegment{
+			{SegmentID: 3, NumOfRows: 0},
+			{SegmentID: 4, NumOfRows: 15},
+			{SegmentID: 5, NumOfRows: 20},
+		},
+	}
+	segments := map[int64]*SegmentInfo{
+		3: NewSegmentInfo(&datapb.SegmentInfo{ID: 3, State: commonpb.SegmentState_Dropped}),
+		4: NewSegmentInfo(&datapb.SegmentInfo{ID: 4, CollectionID: 100, PartitionID: 10, NumOfRows: 15, State: commonpb.SegmentState_Flushed}),
+		5: NewSegmentInfo(&datapb.SegmentInfo{ID: 5, CollectionID: 100, PartitionID: 10, NumOfRows: 20, State: commonpb.SegmentState_Flushed}),
+	}
+	getHealthySegment := func(segID int64) *SegmentInfo {
+		if info := segments[segID]; isSegmentHealthy(info) {
+			return info
+		}
+		return nil
+	}
+	checkSyncRequest := func(nodeID int64, req *datapb.SyncSegmentsRequest) error {
+		s.EqualValues(111, nodeID)
+		s.ElementsMatch([]int64{1, 2}, req.GetCompactedFrom())
+		s.EqualValues(4, req.GetCompactedTo())
+		s.EqualValues(15, req.GetNumOfRows())
+		s.EqualValues(100, req.GetCollectionId())
+		s.EqualValues(10, req.GetPartitionId())
+		s.Equal([]int64{4, 5}, lo.Map(req.GetCompactedToSegments(), func(seg *datapb.SyncCompactedSegment, _ int) int64 { return seg.GetSegmentID() }))
+		return nil
+	}
+
+	s.Run("illegal empty result", func() {
+		s.SetupTest()
+		handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+		err := handler.handleClusteringCompactionResult(plan, &datapb.CompactionPlanResult{PlanID: plan.PlanID})
+		s.Error(err)
+	})
+
+	s.Run("complete mutation and sync all segments at once", func() {
+		s.SetupTest()
+		s.mockMeta.EXPECT().GetSegment(mock.Anything).Return(nil).Times(3)
+		s.mockMeta.EXPECT().CompleteClusteringCompactionMutation(mock.Anything, mock.Anything).Return(
+			lo.Values(segments), &segMetricMutation{}, nil).Once()
+		s.mockMeta.EXPECT().GetHealthySegment(mock.Anything).RunAndReturn(getHealthySegment).Times(3)
+		s.mockSessMgr.EXPECT().SyncSegments(mock.Anything, mock.Anything).RunAndReturn(checkSyncRequest).Once()
+
+		handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+		handler.plans[plan.PlanID] = &compactionTask{dataNodeID: 111, plan: plan}
+		err := handler.handleClusteringCompactionResult(plan, compactionResult)
+		s.NoError(err)
+	})
+
+	s.Run("meta already changed", func() {
+		s.SetupTest()
+		s.mockMeta.EXPECT().GetSegment(mock.Anything).RunAndReturn(func(segID int64) *SegmentInfo {
+			return segments[segID]
+		}).Times(3)
+		s.mockMeta.EXPECT().GetHealthySegment(mock.Anything).RunAndReturn(getHealthySegment).Times(3)
+		s.mockSessMgr.EXPECT().SyncSegments(mock.Anything, mock.Anything).RunAndReturn(checkSyncRequest).Once()
+
+		handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+		handler.plans[plan.PlanID] = &compactionTask{dataNodeID: 111, plan: plan}
+		err := handler.handleClusteringCompactionResult(plan, compactionResult)
+		s.NoError(err)
+	})
+
+	s.Run("part of the results in meta", func() {
+		s.SetupTest()
+		s.mockMeta.EXPECT().GetSegment(mock.Anything).RunAndReturn(func(segID int64) *SegmentInfo {
+			if segID == 5 {
+				return segments[segID]
+			}
+			return nil
+		}).Times(3)
+
+		handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+		handler.plans[plan.PlanID] = &compactionTask{dataNodeID: 111, plan: plan}
+		err := handler.handleClusteringCompactionResult(plan, compactionResult)
+		s.Error(err)
+	})
+
+	s.Run("complete mutation error", func() {
+		s.SetupTest()
+		s.mockMeta.EXPECT().GetSegment(mock.Anything).Return(nil).Times(3)
+		s.mockMeta.EXPECT().CompleteClusteringCompactionMutation(mock.Anything, mock.Anything).Return(
+			nil, nil, errors.New("mock error")).Once()
+
+		handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+		handler.plans[plan.PlanID] = &compactionTask{dataNodeID: 111, plan: plan}
+		err := handler.handleClusteringCompactionResult(plan, compactionResult)
+		s.Error(err)
+	})
+
+	s.Run("sync segments error", func() {
+		s.SetupTest()
+		s.mockMeta.EXPECT().GetSegment(mock.Anything).Return(nil).Times(3)
+		s.mockMeta.EXPECT().CompleteClusteringCompactionMutation(mock.Anything, mock.Anything).Return(
+			lo.Values(segments), &segMetricMutation{}, nil).Once()
+		s.mockMeta.EXPECT().GetHealthySegment(mock.Anything).RunAndReturn(getHealthySegment).Times(3)
+		s.mockSessMgr.EXPECT().SyncSegments(mock.Anything, mock.Anything).Return(errors.New("mock error")).Once()
+
+		handler := newCompactionPlanHandler(s.mockSessMgr, s.mockCm, s.mockMeta, s.mockAlloc)
+		handler.plans[plan.PlanID] = &compactionTask{dataNodeID: 111, plan: plan}
+		err := handler.handleClusteringCompactionResult(plan, compactionResult)
+		s.Error(err)
+	})
+}
+
 func (s *CompactionPlanHandlerSuite) TestCompleteCompaction() {
 	s.Run("test not exists compaction task", func() {
 		handler := newCompactionPlanHandler(nil, nil, nil, nil)
@@ -15,6 +15,7 @@ type CompactionTriggerType int8
 const (
 	TriggerTypeLevelZeroView CompactionTriggerType = iota + 1
 	TriggerTypeSegmentSizeView
+	TriggerTypeClusteringView
 )
 
 type TriggerManager interface {
@@ -28,6 +29,7 @@ type TriggerManager interface {
 // 1. Change of Views
 //   - LevelZeroViewTrigger
 //   - SegmentSizeViewTrigger
+//   - ClusteringViewTrigger
 //
 // 2. SystemIDLE & schedulerIDLE
 // 3. Manual Compaction
@@ -83,6 +85,34 @@ func (m *CompactionTriggerManager) Notify(taskID UniqueID, eventType CompactionT
 				zap.String("type", plan.GetType().String()),
 				zap.String("reason", reason),
 				zap.String("output view", outView.String()))
+
+		case TriggerTypeClusteringView:
+			log.Debug("Start to trigger a clustering compaction")
+			outView, reason := view.Trigger()
+			if outView == nil {
+				continue
+			}
+
+			plan := m.BuildClusteringCompactionPlan(outView)
+			if plan == nil {
+				continue
+			}
+
+			label := outView.GetGroupLabel()
+			signal := &compactionSignal{
+				id:           taskID,
+				isForce:      false,
+				isGlobal:     true,
+				collectionID: label.CollectionID,
+				partitionID:  label.PartitionID,
+			}
+
+			m.handler.execCompactionPlan(signal, plan)
+			log.Info("Finish to trigger a ClusteringCompaction plan",
+				zap.Int64("planID", plan.GetPlanID()),
+				zap.String("type", plan.GetType().String()),
+				zap.String("reason", reason),
+				zap.String("output view", outView.String()))
 		}
 	}
 }
@@ -114,6 +144,56 @@ func (m *CompactionTriggerManager) BuildLevelZeroCompactionPlan(view CompactionV
 	return plan
 }
 
+func (m *CompactionTriggerManager) BuildClusteringCompactionPlan(view CompactionView) *datapb.CompactionPlan {
+	clusteringView, ok := view.(*ClusteringSegmentsView)
+	if !ok {
+		return nil
+	}
+
+	plan := &datapb.CompactionPlan{
+		Type:               datapb.CompactionType_ClusteringCompaction,
+		Channel:            view.GetGroupLabel().Channel,
+		ClusteringKeyField: clusteringView.clusteringKey.GetFieldID(),
+	}
+
+	for _, segView := range view.GetSegmentsView() {
+		s := m.meta.GetHealthySegment(segView.ID)
+		if s == nil {
+			log.Warn("segment of clustering view not found, skip building plan", zap.Int64("segmentID", segView.ID))
+			return nil
+		}
+		plan.SegmentBinlogs = append(plan.SegmentBinlogs, &datapb.CompactionSegmentBinlogs{
+			SegmentID:           s.GetID(),
+			FieldBinlogs:        s.GetBinlogs(),
+			Field2StatslogPaths: s.GetStatslogs(),
+			Deltalogs:           s.GetDeltalogs(),
+			Level:               s.GetLevel(),
+			CollectionID:        s.GetCollectionID(),
+			PartitionID:         s.GetPartitionID(),
+		})
+		plan.TotalRows += s.GetNumOfRows()
+		if s.GetMaxRowNum() > plan.MaxSegmentRows {
+			plan.MaxSegmentRows = s.GetMaxRowNum()
+		}
+	}
+
+	collection := m.meta.GetCollection(view.GetGroupLabel().CollectionID)
+	if collection != nil {
+		ttl, err := getCollectionTTL(collection.Properties)
+		if err != nil {
+			log.Warn("failed to get collection ttl, skip building plan", zap.Error(err))
+			return nil
+		}
+		plan.CollectionTtl = ttl.Nanoseconds()
+	}
+
+	if err := fillOriginPlan(m.allocator, plan); err != nil {
+		return nil
+	}
+
+	return plan
+}
+
 // chanPartSegments is an internal result struct, which is aggregates of SegmentInfos with same collectionID, partitionID and channelName
 type chanPartSegments struct {
 	collectionID UniqueID
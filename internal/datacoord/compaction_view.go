@@ -89,6 +89,9 @@ type SegmentView struct {
 	BinlogCount   int
 	StatslogCount int
 	DeltalogCount int
+
+	NumOfRows  int64
+	FieldStats []*datapb.FieldStats // value range of the scalar fields, empty if not recorded
 }
 
 func (s *SegmentView) Clone() *SegmentView {
@@ -105,6 +108,8 @@ func (s *SegmentView) Clone() *SegmentView {
 		BinlogCount:   s.BinlogCount,
 		StatslogCount: s.StatslogCount,
 		DeltalogCount: s.DeltalogCount,
+		NumOfRows:     s.NumOfRows,
+		FieldStats:    s.FieldStats,
 	}
 }
 
@@ -131,6 +136,8 @@ func GetViewsByInfo(segments ...*SegmentInfo) []*SegmentView {
 			Size:          GetBinlogSizeAsBytes(segment.GetBinlogs()),
 			BinlogCount:   GetBinlogCount(segment.GetBinlogs()),
 			StatslogCount: GetBinlogCount(segment.GetStatslogs()),
+			NumOfRows:     segment.GetNumOfRows(),
+			FieldStats:    segment.GetFieldStats(),
 
 			// TODO: set the following
 			// ExpireSize float64
@@ -157,6 +164,11 @@ func (v *SegmentView) LevelZeroString() string {
 		v.ID, v.Level.String(), v.DeltaSize, v.DeltalogCount)
 }
 
+func (v *SegmentView) ClusteringString() string {
+	return fmt.Sprintf("<ID=%d, level=%s, binlogSize=%.2f, numRows=%d>",
+		v.ID, v.Level.String(), v.Size, v.NumOfRows)
+}
+
 func GetBinlogCount(fieldBinlogs []*datapb.FieldBinlog) int {
 	var num int
 	for _, binlog := range fieldBinlogs {
@@ -11,6 +11,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/logutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type CompactionViewManager struct {
@@ -73,7 +74,8 @@ func (m *CompactionViewManager) checkLoop() {
 
 // Global check could take some time, we need to record the time.
 func (m *CompactionViewManager) Check() {
-	// Only process L0 compaction now, so just return if its not enabled
+	// Only process L0 and clustering compaction now, so just return if L0 is not enabled.
+	// Clustering compaction relies on L0 segments to handle deletes as well.
 	if !Params.DataCoordCfg.EnableLevelZeroSegment.GetAsBool() {
 		return
 	}
@@ -124,6 +126,16 @@ func (m *CompactionViewManager) Check() {
 		events[TriggerTypeLevelZeroView] = changedL0Views
 	}
 
+	if Params.DataCoordCfg.ClusteringCompactionEnable.GetAsBool() {
+		for collID, segments := range latestCollSegs {
+			clusteringViews := m.getClusteringViews(collID, segments)
+			if len(clusteringViews) == 0 {
+				continue
+			}
+			events[TriggerTypeClusteringView] = append(events[TriggerTypeClusteringView], clusteringViews...)
+		}
+	}
+
 	for eType, views := range events {
 		m.trigger.Notify(taskID, eType, views)
 	}
@@ -165,3 +177,39 @@ func (m *CompactionViewManager) groupL0ViewsByPartChan(collectionID UniqueID, le
 
 	return partChanView
 }
+
+// getClusteringViews groups the L1 and L2 segments of a collection with clustering key by partition-channel.
+func (m *CompactionViewManager) getClusteringViews(collID UniqueID, segments []*SegmentInfo) []CompactionView {
+	collection := m.meta.GetCollection(collID)
+	if collection == nil {
+		return nil
+	}
+	clusteringKey := typeutil.GetClusteringKeyField(collection.Schema)
+	// only the scalar types with total order could be clustered
+	if clusteringKey == nil || !(typeutil.IsIntegerType(clusteringKey.GetDataType()) ||
+		typeutil.IsFloatingType(clusteringKey.GetDataType()) || typeutil.IsStringType(clusteringKey.GetDataType())) {
+		return nil
+	}
+
+	segments = lo.Filter(segments, func(info *SegmentInfo, _ int) bool {
+		return info.GetLevel() != datapb.SegmentLevel_L0
+	})
+
+	partChanView := make(map[string]*ClusteringSegmentsView) // "part-chan" as key
+	for _, view := range GetViewsByInfo(segments...) {
+		key := view.label.Key()
+		if _, ok := partChanView[key]; !ok {
+			partChanView[key] = &ClusteringSegmentsView{
+				label:         view.label,
+				segments:      []*SegmentView{view},
+				clusteringKey: clusteringKey,
+			}
+		} else {
+			partChanView[key].Append(view)
+		}
+	}
+
+	return lo.Map(lo.Values(partChanView), func(view *ClusteringSegmentsView, _ int) CompactionView {
+		return view
+	})
+}
@@ -265,11 +265,13 @@ func (m *meta) GetNumRowsOfCollection(collectionID UniqueID) int64 {
 	return ret
 }
 
-// GetCollectionBinlogSize returns the total binlog size and binlog size of collections.
-func (m *meta) GetCollectionBinlogSize() (int64, map[UniqueID]int64) {
+// GetCollectionBinlogSize returns the total binlog size, binlog size of collections
+// and binlog size of partitions grouped by collection.
+func (m *meta) GetCollectionBinlogSize() (int64, map[UniqueID]int64, map[UniqueID]map[UniqueID]int64) {
 	m.RLock()
 	defer m.RUnlock()
 	collectionBinlogSize := make(map[UniqueID]int64)
+	partitionBinlogSize := make(map[UniqueID]map[UniqueID]int64)
 	collectionRowsNum := make(map[UniqueID]map[commonpb.SegmentState]int64)
 	segments := m.segments.GetSegments()
 	var total int64
@@ -278,6 +280,10 @@ func (m *meta) GetCollectionBinlogSize() (int64, map[UniqueID]int64) {
 		if isSegmentHealthy(segment) {
 			total += segmentSize
 			collectionBinlogSize[segment.GetCollectionID()] += segmentSize
+			if _, ok := partitionBinlogSize[segment.GetCollectionID()]; !ok {
+				partitionBinlogSize[segment.GetCollectionID()] = make(map[UniqueID]int64)
+			}
+			partitionBinlogSize[segment.GetCollectionID()][segment.GetPartitionID()] += segmentSize
 			metrics.DataCoordStoredBinlogSize.WithLabelValues(
 				fmt.Sprint(segment.GetCollectionID()), fmt.Sprint(segment.GetID())).Set(float64(segmentSize))
 			if _, ok := collectionRowsNum[segment.GetCollectionID()]; !ok {
@@ -291,7 +297,7 @@ func (m *meta) GetCollectionBinlogSize() (int64, map[UniqueID]int64) {
 			metrics.DataCoordNumStoredRows.WithLabelValues(fmt.Sprint(collection), state.String()).Set(float64(rows))
 		}
 	}
-	return total, collectionBinlogSize
+	return total, collectionBinlogSize, partitionBinlogSize
 }
 
 // AddSegment records segment info, persisting info into kv store
@@ -598,6 +604,33 @@ func UpdateBinlogsOperator(segmentID int64, binlogs, statslogs, deltalogs []*dat
 	}
 }
 
+// update the scalar field stats of segment with the stats of newly synced insert data,
+// must be applied before the binlogs are updated.
+func UpdateFieldStatsOperator(segmentID int64, fieldStats []*datapb.FieldStats) UpdateOperator {
+	return func(modPack *updateSegmentPack) bool {
+		if len(fieldStats) == 0 {
+			return true
+		}
+		segment := modPack.Get(segmentID)
+		if segment == nil {
+			log.Warn("meta update: update field stats failed - segment not found",
+				zap.Int64("segmentID", segmentID))
+			return false
+		}
+
+		switch {
+		case len(segment.GetBinlogs()) == 0:
+			// first batch of insert data
+			segment.FieldStats = fieldStats
+		case len(segment.GetFieldStats()) > 0:
+			segment.FieldStats = storage.MergeFieldStats(segment.GetFieldStats(), fieldStats)
+		default:
+			// data synced without stats before, the value range of segment is unknown
+		}
+		return true
+	}
+}
+
 // update startPosition
 func UpdateStartPosition(startPositions []*datapb.SegmentStartPosition) UpdateOperator {
 	return func(modPack *updateSegmentPack) bool {
@@ -1087,6 +1120,7 @@ func (m *meta) CompleteCompactionMutation(plan *datapb.CompactionPlan, result *d
 			Binlogs:       compactToSegment.GetInsertLogs(),
 			Statslogs:     compactToSegment.GetField2StatslogPaths(),
 			Deltalogs:     compactToSegment.GetDeltalogs(),
+			FieldStats:    compactToSegment.GetFieldStats(),
 
 			CreatedByCompaction: true,
 			CompactionFrom:      compactFromSegIDs,
@@ -1143,6 +1177,137 @@ func (m *meta) CompleteCompactionMutation(plan *datapb.CompactionPlan, result *d
 	return []*SegmentInfo{compactToSegmentInfo}, metricMutation, nil
 }
 
+// CompleteClusteringCompactionMutation marks the compacted segments as dropped and adds the
+// result L2 segments with the value range of the clustering key.
+// The deltalogs added to the compacted segments after the plan was made are copied to
+// every non-empty result segment, since the rows they delete may be in any of them.
+func (m *meta) CompleteClusteringCompactionMutation(plan *datapb.CompactionPlan, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	log := log.With(zap.Int64("planID", plan.GetPlanID()), zap.String("type", plan.GetType().String()))
+
+	metricMutation := &segMetricMutation{stateChange: make(map[string]map[string]int)}
+	var compactFromSegIDs []int64
+	var compactFromSegInfos []*SegmentInfo
+	for _, segmentBinlogs := range plan.GetSegmentBinlogs() {
+		segment := m.segments.GetSegment(segmentBinlogs.GetSegmentID())
+		if segment == nil {
+			return nil, nil, merr.WrapErrSegmentNotFound(segmentBinlogs.GetSegmentID())
+		}
+
+		cloned := segment.Clone()
+		cloned.DroppedAt = uint64(time.Now().UnixNano())
+		cloned.Compacted = true
+
+		compactFromSegInfos = append(compactFromSegInfos, cloned)
+		compactFromSegIDs = append(compactFromSegIDs, cloned.GetID())
+
+		// metrics mutation for compaction from segments
+		updateSegStateAndPrepareMetrics(cloned, commonpb.SegmentState_Dropped, metricMutation)
+	}
+
+	getMinPosition := func(getter func(info *SegmentInfo) *msgpb.MsgPosition) *msgpb.MsgPosition {
+		var minPos *msgpb.MsgPosition
+		for _, info := range compactFromSegInfos {
+			pos := getter(info)
+			if minPos == nil ||
+				pos != nil && pos.GetTimestamp() < minPos.GetTimestamp() {
+				minPos = pos
+			}
+		}
+		return minPos
+	}
+	startPosition := getMinPosition(func(info *SegmentInfo) *msgpb.MsgPosition { return info.GetStartPosition() })
+	dmlPosition := getMinPosition(func(info *SegmentInfo) *msgpb.MsgPosition { return info.GetDmlPosition() })
+
+	logIDsFromPlan := make(map[int64]struct{})
+	for _, segBinlogs := range plan.GetSegmentBinlogs() {
+		for _, fieldBinlog := range segBinlogs.GetDeltalogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				logIDsFromPlan[binlog.GetLogID()] = struct{}{}
+			}
+		}
+	}
+
+	var compactToSegInfos []*SegmentInfo
+	for _, seg := range result.GetSegments() {
+		// copy new deltalogs in compactFrom segments to compactTo segments.
+		if seg.GetNumOfRows() > 0 {
+			newDeltalogs, err := m.copyNewDeltalogs(compactFromSegInfos, logIDsFromPlan, seg.GetSegmentID())
+			if err != nil {
+				return nil, nil, err
+			}
+			if len(newDeltalogs) > 0 {
+				newDeltalogs = lo.Map(newDeltalogs, func(binlog *datapb.Binlog, _ int) *datapb.Binlog {
+					return proto.Clone(binlog).(*datapb.Binlog)
+				})
+				seg.Deltalogs = append(seg.GetDeltalogs(), &datapb.FieldBinlog{Binlogs: newDeltalogs})
+			}
+		}
+
+		segmentInfo := NewSegmentInfo(&datapb.SegmentInfo{
+			ID:            seg.GetSegmentID(),
+			CollectionID:  compactFromSegInfos[0].GetCollectionID(),
+			PartitionID:   compactFromSegInfos[0].GetPartitionID(),
+			InsertChannel: plan.GetChannel(),
+			NumOfRows:     seg.GetNumOfRows(),
+			State:         commonpb.SegmentState_Flushed,
+			MaxRowNum:     compactFromSegInfos[0].GetMaxRowNum(),
+			Binlogs:       seg.GetInsertLogs(),
+			Statslogs:     seg.GetField2StatslogPaths(),
+			Deltalogs:     seg.GetDeltalogs(),
+			FieldStats:    seg.GetFieldStats(),
+
+			CreatedByCompaction: true,
+			CompactionFrom:      compactFromSegIDs,
+			LastExpireTime:      plan.GetStartTime(),
+			Level:               datapb.SegmentLevel_L2,
+
+			StartPosition: startPosition,
+			DmlPosition:   dmlPosition,
+		})
+
+		if segmentInfo.GetNumOfRows() > 0 {
+			metricMutation.addNewSeg(segmentInfo.GetState(), segmentInfo.GetLevel(), segmentInfo.GetNumOfRows())
+		} else {
+			segmentInfo.State = commonpb.SegmentState_Dropped
+		}
+		compactToSegInfos = append(compactToSegInfos, segmentInfo)
+	}
+
+	log = log.With(
+		zap.String("channel", plan.GetChannel()),
+		zap.Int64s("compactTo segmentIDs", lo.Map(compactToSegInfos, func(info *SegmentInfo, _ int) int64 { return info.GetID() })),
+		zap.Int64s("compactFrom segments(to be updated as dropped)", compactFromSegIDs),
+	)
+
+	log.Debug("meta update: prepare for clustering compaction mutation - complete")
+	segmentInfos := make([]*datapb.SegmentInfo, 0, len(compactFromSegInfos)+len(compactToSegInfos))
+	binlogsIncrements := make([]metastore.BinlogsIncrement, 0, len(compactToSegInfos))
+	for _, info := range compactFromSegInfos {
+		segmentInfos = append(segmentInfos, info.SegmentInfo)
+	}
+	for _, info := range compactToSegInfos {
+		segmentInfos = append(segmentInfos, info.SegmentInfo)
+		binlogsIncrements = append(binlogsIncrements, metastore.BinlogsIncrement{Segment: info.SegmentInfo})
+	}
+	if err := m.catalog.AlterSegments(m.ctx, segmentInfos, binlogsIncrements...); err != nil {
+		log.Warn("fail to alter segments and new segments", zap.Error(err))
+		return nil, nil, err
+	}
+
+	lo.ForEach(compactFromSegInfos, func(info *SegmentInfo, _ int) {
+		m.segments.SetSegment(info.GetID(), info)
+	})
+	lo.ForEach(compactToSegInfos, func(info *SegmentInfo, _ int) {
+		m.segments.SetSegment(info.GetID(), info)
+	})
+
+	log.Info("meta update: alter in memory meta after clustering compaction - complete")
+	return compactToSegInfos, metricMutation, nil
+}
+
 func (m *meta) copyNewDeltalogs(latestCompactFromInfos []*SegmentInfo, logIDsInPlan map[int64]struct{}, toSegment int64) ([]*datapb.Binlog, error) {
 	newBinlogs := []*datapb.Binlog{}
 	for _, seg := range latestCompactFromInfos {
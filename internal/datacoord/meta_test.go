@@ -28,6 +28,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/kv"
 	mockkv "github.com/milvus-io/milvus/internal/kv/mocks"
 	"github.com/milvus-io/milvus/internal/metastore/kv/datacoord"
@@ -322,6 +323,98 @@ func (suite *MetaBasicSuite) TestCompleteCompactionMutation() {
 	suite.EqualValues(2, mutation.rowCountAccChange)
 }
 
+func (suite *MetaBasicSuite) TestCompleteClusteringCompactionMutation() {
+	latestSegments := &SegmentsInfo{
+		map[UniqueID]*SegmentInfo{
+			1: {SegmentInfo: &datapb.SegmentInfo{
+				ID:           1,
+				CollectionID: 100,
+				PartitionID:  10,
+				State:        commonpb.SegmentState_Flushed,
+				Level:        datapb.SegmentLevel_L1,
+				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogIDs(0, 10000)},
+				Statslogs:    []*datapb.FieldBinlog{getFieldBinlogIDs(0, 20000)},
+				// latest segment has 2 deltalogs, one submit for compaction, one is appended before compaction done
+				Deltalogs: []*datapb.FieldBinlog{getFieldBinlogIDs(0, 30000), getFieldBinlogIDs(0, 30001)},
+				NumOfRows: 2,
+			}},
+			2: {SegmentInfo: &datapb.SegmentInfo{
+				ID:           2,
+				CollectionID: 100,
+				PartitionID:  10,
+				State:        commonpb.SegmentState_Flushed,
+				Level:        datapb.SegmentLevel_L1,
+				Binlogs:      []*datapb.FieldBinlog{getFieldBinlogIDs(0, 11000)},
+				Statslogs:    []*datapb.FieldBinlog{getFieldBinlogIDs(0, 21000)},
+				Deltalogs:    []*datapb.FieldBinlog{getFieldBinlogIDs(0, 31000)},
+				NumOfRows:    2,
+			}},
+		},
+	}
+
+	// the new deltalog is copied to both of the non-empty result segments
+	mockChMgr := mocks.NewChunkManager(suite.T())
+	mockChMgr.EXPECT().RootPath().Return("mockroot").Times(4)
+	mockChMgr.EXPECT().Read(mock.Anything, mock.Anything).Return(nil, nil).Twice()
+	mockChMgr.EXPECT().Write(mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+
+	m := &meta{
+		catalog:      &datacoord.Catalog{MetaKv: NewMetaMemoryKV()},
+		segments:     latestSegments,
+		chunkManager: mockChMgr,
+	}
+
+	plan := &datapb.CompactionPlan{
+		Type: datapb.CompactionType_ClusteringCompaction,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{
+				SegmentID:           1,
+				FieldBinlogs:        m.GetSegment(1).GetBinlogs(),
+				Field2StatslogPaths: m.GetSegment(1).GetStatslogs(),
+				Deltalogs:           m.GetSegment(1).GetDeltalogs()[:1], // compaction plan use only 1 deltalog
+			},
+			{
+				SegmentID:           2,
+				FieldBinlogs:        m.GetSegment(2).GetBinlogs(),
+				Field2StatslogPaths: m.GetSegment(2).GetStatslogs(),
+				Deltalogs:           m.GetSegment(2).GetDeltalogs(),
+			},
+		},
+	}
+
+	result := &datapb.CompactionPlanResult{
+		Segments: []*datapb.CompactionSegment{
+			{SegmentID: 3, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogIDs(0, 50000)}, NumOfRows: 2},
+			{SegmentID: 4, InsertLogs: []*datapb.FieldBinlog{getFieldBinlogIDs(0, 51000)}, NumOfRows: 2},
+			{SegmentID: 5},
+		},
+	}
+
+	infos, _, err := m.CompleteClusteringCompactionMutation(plan, result)
+	suite.NoError(err)
+	suite.Len(infos, 3)
+	for _, info := range infos {
+		suite.Equal(datapb.SegmentLevel_L2, info.GetLevel())
+		deltalogIDs := []int64{}
+		for _, fbinlog := range info.GetDeltalogs() {
+			for _, blog := range fbinlog.GetBinlogs() {
+				deltalogIDs = append(deltalogIDs, blog.GetLogID())
+			}
+		}
+		if info.GetNumOfRows() > 0 {
+			suite.Equal(commonpb.SegmentState_Flushed, info.GetState())
+			suite.ElementsMatch([]int64{30001}, deltalogIDs)
+		} else {
+			suite.Equal(commonpb.SegmentState_Dropped, info.GetState())
+			suite.Empty(deltalogIDs)
+		}
+	}
+
+	for _, segID := range []int64{1, 2} {
+		suite.Equal(commonpb.SegmentState_Dropped, m.GetSegment(segID).GetState())
+	}
+}
+
 func (suite *MetaBasicSuite) TestSetSegment() {
 	meta := suite.meta
 	catalog := mocks.NewDataCoordCatalog(suite.T())
@@ -655,9 +748,10 @@ func TestMeta_Basic(t *testing.T) {
 		assert.NoError(t, err)
 
 		// check TotalBinlogSize
-		total, collectionBinlogSize := meta.GetCollectionBinlogSize()
+		total, collectionBinlogSize, partitionBinlogSize := meta.GetCollectionBinlogSize()
 		assert.Len(t, collectionBinlogSize, 1)
 		assert.Equal(t, int64(size0+size1), collectionBinlogSize[collID])
+		assert.Equal(t, int64(size0+size1), partitionBinlogSize[collID][partID0])
 		assert.Equal(t, int64(size0+size1), total)
 	})
 
@@ -769,6 +863,55 @@ func TestUpdateSegmentsInfo(t *testing.T) {
 		)
 		assert.NoError(t, err)
 	})
+	t.Run("update field stats", func(t *testing.T) {
+		meta, err := newMemoryMeta()
+		assert.NoError(t, err)
+
+		genStats := func(min, max int64) []*datapb.FieldStats {
+			return []*datapb.FieldStats{{
+				FieldID: 100,
+				Type:    schemapb.DataType_Int64,
+				Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: min}},
+				Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: max}},
+			}}
+		}
+
+		segment1 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{ID: 1, State: commonpb.SegmentState_Growing}}
+		err = meta.AddSegment(context.TODO(), segment1)
+		assert.NoError(t, err)
+
+		// first sync
+		err = meta.UpdateSegmentsInfo(
+			UpdateFieldStatsOperator(1, genStats(10, 20)),
+			UpdateBinlogsOperator(1, []*datapb.FieldBinlog{getFieldBinlogIDs(1, 0)}, nil, nil),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), meta.GetHealthySegment(1).GetFieldStats()[0].GetMin().GetLongData())
+		assert.Equal(t, int64(20), meta.GetHealthySegment(1).GetFieldStats()[0].GetMax().GetLongData())
+
+		// stats are merged
+		err = meta.UpdateSegmentsInfo(
+			UpdateFieldStatsOperator(1, genStats(5, 15)),
+			UpdateBinlogsOperator(1, []*datapb.FieldBinlog{getFieldBinlogIDs(1, 1)}, nil, nil),
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), meta.GetHealthySegment(1).GetFieldStats()[0].GetMin().GetLongData())
+		assert.Equal(t, int64(20), meta.GetHealthySegment(1).GetFieldStats()[0].GetMax().GetLongData())
+
+		// segment synced without stats before
+		segment2 := &SegmentInfo{SegmentInfo: &datapb.SegmentInfo{
+			ID: 2, State: commonpb.SegmentState_Growing,
+			Binlogs: []*datapb.FieldBinlog{getFieldBinlogIDs(1, 0)},
+		}}
+		err = meta.AddSegment(context.TODO(), segment2)
+		assert.NoError(t, err)
+		err = meta.UpdateSegmentsInfo(
+			UpdateFieldStatsOperator(2, genStats(5, 15)),
+		)
+		assert.NoError(t, err)
+		assert.Empty(t, meta.GetHealthySegment(2).GetFieldStats())
+	})
+
 	t.Run("update non-existed segment", func(t *testing.T) {
 		meta, err := newMemoryMeta()
 		assert.NoError(t, err)
@@ -20,6 +20,70 @@ func (_m *MockCompactionMeta) EXPECT() *MockCompactionMeta_Expecter {
 	return &MockCompactionMeta_Expecter{mock: &_m.Mock}
 }
 
+// CompleteClusteringCompactionMutation provides a mock function with given fields: plan, result
+func (_m *MockCompactionMeta) CompleteClusteringCompactionMutation(plan *datapb.CompactionPlan, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error) {
+	ret := _m.Called(plan, result)
+
+	var r0 []*SegmentInfo
+	var r1 *segMetricMutation
+	var r2 error
+	if rf, ok := ret.Get(0).(func(*datapb.CompactionPlan, *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error)); ok {
+		return rf(plan, result)
+	}
+	if rf, ok := ret.Get(0).(func(*datapb.CompactionPlan, *datapb.CompactionPlanResult) []*SegmentInfo); ok {
+		r0 = rf(plan, result)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*SegmentInfo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*datapb.CompactionPlan, *datapb.CompactionPlanResult) *segMetricMutation); ok {
+		r1 = rf(plan, result)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*segMetricMutation)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(*datapb.CompactionPlan, *datapb.CompactionPlanResult) error); ok {
+		r2 = rf(plan, result)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockCompactionMeta_CompleteClusteringCompactionMutation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteClusteringCompactionMutation'
+type MockCompactionMeta_CompleteClusteringCompactionMutation_Call struct {
+	*mock.Call
+}
+
+// CompleteClusteringCompactionMutation is a helper method to define mock.On call
+//   - plan *datapb.CompactionPlan
+//   - result *datapb.CompactionPlanResult
+func (_e *MockCompactionMeta_Expecter) CompleteClusteringCompactionMutation(plan interface{}, result interface{}) *MockCompactionMeta_CompleteClusteringCompactionMutation_Call {
+	return &MockCompactionMeta_CompleteClusteringCompactionMutation_Call{Call: _e.mock.On("CompleteClusteringCompactionMutation", plan, result)}
+}
+
+func (_c *MockCompactionMeta_CompleteClusteringCompactionMutation_Call) Run(run func(plan *datapb.CompactionPlan, result *datapb.CompactionPlanResult)) *MockCompactionMeta_CompleteClusteringCompactionMutation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*datapb.CompactionPlan), args[1].(*datapb.CompactionPlanResult))
+	})
+	return _c
+}
+
+func (_c *MockCompactionMeta_CompleteClusteringCompactionMutation_Call) Return(_a0 []*SegmentInfo, _a1 *segMetricMutation, _a2 error) *MockCompactionMeta_CompleteClusteringCompactionMutation_Call {
+	_c.Call.Return(_a0, _a1, _a2)
+	return _c
+}
+
+func (_c *MockCompactionMeta_CompleteClusteringCompactionMutation_Call) RunAndReturn(run func(*datapb.CompactionPlan, *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error)) *MockCompactionMeta_CompleteClusteringCompactionMutation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CompleteCompactionMutation provides a mock function with given fields: plan, result
 func (_m *MockCompactionMeta) CompleteCompactionMutation(plan *datapb.CompactionPlan, result *datapb.CompactionPlanResult) ([]*SegmentInfo, *segMetricMutation, error) {
 	ret := _m.Called(plan, result)
@@ -128,6 +192,50 @@ func (_c *MockCompactionMeta_GetHealthySegment_Call) RunAndReturn(run func(int64
 	return _c
 }
 
+// GetSegment provides a mock function with given fields: segID
+func (_m *MockCompactionMeta) GetSegment(segID int64) *SegmentInfo {
+	ret := _m.Called(segID)
+
+	var r0 *SegmentInfo
+	if rf, ok := ret.Get(0).(func(int64) *SegmentInfo); ok {
+		r0 = rf(segID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SegmentInfo)
+		}
+	}
+
+	return r0
+}
+
+// MockCompactionMeta_GetSegment_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSegment'
+type MockCompactionMeta_GetSegment_Call struct {
+	*mock.Call
+}
+
+// GetSegment is a helper method to define mock.On call
+//   - segID int64
+func (_e *MockCompactionMeta_Expecter) GetSegment(segID interface{}) *MockCompactionMeta_GetSegment_Call {
+	return &MockCompactionMeta_GetSegment_Call{Call: _e.mock.On("GetSegment", segID)}
+}
+
+func (_c *MockCompactionMeta_GetSegment_Call) Run(run func(segID int64)) *MockCompactionMeta_GetSegment_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockCompactionMeta_GetSegment_Call) Return(_a0 *SegmentInfo) *MockCompactionMeta_GetSegment_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCompactionMeta_GetSegment_Call) RunAndReturn(run func(int64) *SegmentInfo) *MockCompactionMeta_GetSegment_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectSegments provides a mock function with given fields: selector
 func (_m *MockCompactionMeta) SelectSegments(selector SegmentInfoSelector) []*SegmentInfo {
 	ret := _m.Called(selector)
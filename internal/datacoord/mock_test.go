@@ -447,6 +447,14 @@ func (m *mockRootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.Li
 	panic("not implemented") // TODO: Implement
 }
 
+func (m *mockRootCoordClient) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	panic("not implemented") // TODO: Implement
+}
+
+func (m *mockRootCoordClient) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func (m *mockRootCoordClient) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	panic("not implemented") // TODO: Implement
 }
@@ -706,6 +714,14 @@ func (m *mockRootCoordClient) ListPolicy(ctx context.Context, in *internalpb.Lis
 	return &internalpb.ListPolicyResponse{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, nil
 }
 
+func (m *mockRootCoordClient) AlterRateLimits(ctx context.Context, in *internalpb.AlterRateLimitsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *mockRootCoordClient) DescribeRateLimits(ctx context.Context, in *internalpb.DescribeRateLimitsRequest, opts ...grpc.CallOption) (*internalpb.DescribeRateLimitsResponse, error) {
+	return &internalpb.DescribeRateLimitsResponse{Status: merr.Success()}, nil
+}
+
 type mockHandler struct {
 	meta *meta
 }
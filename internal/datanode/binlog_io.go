@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/io"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/metautil"
@@ -170,6 +171,48 @@ func uploadStatsLog(
 	return statPaths, nil
 }
 
+// uploadBM25StatsLog uploads the term frequency indexes of the analyzer enabled fields, one statslog per field.
+func uploadBM25StatsLog(
+	ctx context.Context,
+	b io.BinlogIO,
+	allocator allocator.Allocator,
+	collectionID UniqueID,
+	partID UniqueID,
+	segID UniqueID,
+	indexes map[UniqueID]*bm25.Index,
+) (map[UniqueID]*datapb.FieldBinlog, error) {
+	statPaths := make(map[UniqueID]*datapb.FieldBinlog)
+	if len(indexes) == 0 {
+		return statPaths, nil
+	}
+	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, "UploadBM25Statslog")
+	defer span.End()
+
+	start, _, err := allocator.Alloc(uint32(len(indexes)))
+	if err != nil {
+		return nil, err
+	}
+	kvs := make(map[string][]byte)
+	for fieldID, index := range indexes {
+		blob, err := storage.SerializeBM25Index(fieldID, index)
+		if err != nil {
+			return nil, err
+		}
+		key := b.JoinFullPath(common.SegmentStatslogPath, metautil.JoinIDPath(collectionID, partID, segID, fieldID, start))
+		start++
+		kvs[key] = blob.GetValue()
+		statPaths[fieldID] = &datapb.FieldBinlog{
+			FieldID: fieldID,
+			Binlogs: []*datapb.Binlog{{LogSize: int64(len(blob.GetValue())), LogPath: key, EntriesNum: blob.RowNum}},
+		}
+	}
+
+	if err := b.Upload(ctx, kvs); err != nil {
+		return nil, err
+	}
+	return statPaths, nil
+}
+
 func uploadInsertLog(
 	ctx context.Context,
 	b io.BinlogIO,
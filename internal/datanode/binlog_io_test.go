@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/datanode/allocator"
 	"github.com/milvus-io/milvus/internal/datanode/io"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 )
@@ -120,6 +121,35 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		})
 	})
 
+	t.Run("Test upload bm25 stats log", func(t *testing.T) {
+		binlogIO := io.NewBinlogIO(cm, getOrCreateIOPool())
+		analyzer, err := bm25.NewAnalyzer("")
+		assert.NoError(t, err)
+		index := bm25.NewIndex(analyzer)
+		index.Append(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}}, nil, []string{"hello milvus"})
+
+		alloc := allocator.NewMockAllocator(t)
+		alloc.EXPECT().Alloc(uint32(1)).Call.Return(int64(100), int64(101), nil).Once()
+		paths, err := uploadBM25StatsLog(ctx, binlogIO, alloc, 10001, 10, 1, map[UniqueID]*bm25.Index{101: index})
+		assert.NoError(t, err)
+		assert.Len(t, paths, 1)
+		assert.EqualValues(t, 1, paths[101].GetBinlogs()[0].GetEntriesNum())
+
+		blobs, err := downloadBlobs(ctx, binlogIO, []string{paths[101].GetBinlogs()[0].GetLogPath()})
+		assert.NoError(t, err)
+		loaded, err := storage.DeserializeBM25Index(analyzer, blobs)
+		assert.NoError(t, err)
+		assert.Equal(t, index.Stats(), loaded.Stats())
+
+		paths, err = uploadBM25StatsLog(ctx, binlogIO, alloc, 10001, 10, 1, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, paths)
+
+		alloc.EXPECT().Alloc(uint32(1)).Call.Return(int64(0), int64(0), fmt.Errorf("mock Alloc error")).Once()
+		_, err = uploadBM25StatsLog(ctx, binlogIO, alloc, 10001, 10, 1, map[UniqueID]*bm25.Index{101: index})
+		assert.Error(t, err)
+	})
+
 	t.Run("Test upload insert log err", func(t *testing.T) {
 		f := &MetaFactory{}
 		meta := f.GetCollectionMeta(UniqueID(10001), "test_gen_blobs", schemapb.DataType_Int64)
@@ -0,0 +1,606 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
+	"go.opentelemetry.io/otel"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
+	"github.com/milvus-io/milvus/internal/datanode/io"
+	"github.com/milvus-io/milvus/internal/datanode/metacache"
+	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
+	"github.com/milvus-io/milvus/internal/metastore/kv/binlog"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bm25"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// make sure clusteringCompactionTask implements compactor interface
+var _ compactor = (*clusteringCompactionTask)(nil)
+
+// clusteringCompactionTask splits the rows of the plan segments by the clustering key
+// into L2 segments with disjoint key ranges, writing the segments bucket by bucket.
+type clusteringCompactionTask struct {
+	*compactionTask
+}
+
+func newClusteringCompactionTask(
+	ctx context.Context,
+	binlogIO io.BinlogIO,
+	metaCache metacache.MetaCache,
+	syncMgr syncmgr.SyncManager,
+	alloc allocator.Allocator,
+	plan *datapb.CompactionPlan,
+) *clusteringCompactionTask {
+	task := newCompactionTask(ctx, binlogIO, metaCache, syncMgr, alloc, plan)
+	task.tr = timerecord.NewTimeRecorder("clustering compaction")
+	return &clusteringCompactionTask{compactionTask: task}
+}
+
+func (t *clusteringCompactionTask) compact() (*datapb.CompactionPlanResult, error) {
+	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(t.ctx, fmt.Sprintf("ClusteringCompact-%d", t.getPlanID()))
+	defer span.End()
+
+	log := log.Ctx(ctx).With(zap.Int64("planID", t.plan.GetPlanID()),
+		zap.Int64("clusteringKeyField", t.plan.GetClusteringKeyField()),
+		zap.Int64("maxSegmentRows", t.plan.GetMaxSegmentRows()),
+		zap.Int32("timeout in seconds", t.plan.GetTimeoutInSeconds()))
+	if ok := funcutil.CheckCtxValid(ctx); !ok {
+		log.Warn("clustering compact wrong, task context done or timeout")
+		return nil, errContext
+	}
+
+	ctxTimeout, cancelAll := context.WithTimeout(ctx, time.Duration(t.plan.GetTimeoutInSeconds())*time.Second)
+	defer cancelAll()
+
+	compactStart := time.Now()
+	durInQueue := t.tr.RecordSpan()
+	log.Info("clustering compact start")
+	if len(t.plan.GetSegmentBinlogs()) < 1 {
+		log.Warn("clustering compact wrong, there's no segments in segment binlogs")
+		return nil, errIllegalCompactionPlan
+	}
+	if t.plan.GetMaxSegmentRows() <= 0 {
+		log.Warn("clustering compact wrong, illegal max segment rows")
+		return nil, errIllegalCompactionPlan
+	}
+
+	meta := &etcdpb.CollectionMeta{ID: t.metaCache.Collection(), Schema: t.metaCache.Schema()}
+	var pkField, clusteringKeyField *schemapb.FieldSchema
+	for _, fs := range meta.GetSchema().GetFields() {
+		if fs.GetIsPrimaryKey() && fs.GetFieldID() >= 100 && typeutil.IsPrimaryFieldType(fs.GetDataType()) {
+			pkField = fs
+		}
+		if fs.GetFieldID() == t.plan.GetClusteringKeyField() {
+			clusteringKeyField = fs
+		}
+	}
+	if pkField == nil {
+		log.Warn("failed to get pk field from schema")
+		return nil, fmt.Errorf("no pk field in schema")
+	}
+	if clusteringKeyField == nil || !isSupportedClusteringKeyType(clusteringKeyField.GetDataType()) {
+		log.Warn("clustering compact wrong, illegal clustering key field")
+		return nil, merr.WrapErrParameterInvalidMsg("illegal clustering key field %d", t.plan.GetClusteringKeyField())
+	}
+
+	segIDs := lo.Map(t.plan.GetSegmentBinlogs(), func(binlogs *datapb.CompactionSegmentBinlogs, _ int) int64 {
+		return binlogs.GetSegmentID()
+	})
+
+	// Inject to stop flush
+	// when compaction failed, these segments need to be Unblocked by injectDone in compaction_executor
+	// when compaction succeeded, these segments will be Unblocked by SyncSegments from DataCoord.
+	for _, segID := range segIDs {
+		t.syncMgr.Block(segID)
+	}
+	log.Info("clustering compact finish injection", zap.Duration("elapse", t.tr.RecordSpan()))
+
+	if err := binlog.DecompressCompactionBinlogs(t.plan.GetSegmentBinlogs()); err != nil {
+		log.Warn("clustering compact wrong, fail to decompress compaction binlogs", zap.Error(err))
+		return nil, err
+	}
+
+	dblobs := make(map[UniqueID][]*Blob)
+	allPath := make([][]string, 0)
+	for _, s := range t.plan.GetSegmentBinlogs() {
+		var binlogNum int
+		for _, b := range s.GetFieldBinlogs() {
+			if b != nil {
+				binlogNum = len(b.GetBinlogs())
+				break
+			}
+		}
+
+		for idx := 0; idx < binlogNum; idx++ {
+			var ps []string
+			for _, f := range s.GetFieldBinlogs() {
+				ps = append(ps, f.GetBinlogs()[idx].GetLogPath())
+			}
+			allPath = append(allPath, ps)
+		}
+
+		segID := s.GetSegmentID()
+		paths := make([]string, 0)
+		for _, d := range s.GetDeltalogs() {
+			for _, l := range d.GetBinlogs() {
+				paths = append(paths, l.GetLogPath())
+			}
+		}
+
+		if len(paths) != 0 {
+			bs, err := downloadBlobs(ctxTimeout, t.binlogIO, paths)
+			if err != nil {
+				log.Warn("clustering compact wrong, fail to download deltalogs", zap.Int64("segment", segID), zap.Strings("path", paths), zap.Error(err))
+				return nil, err
+			}
+			dblobs[segID] = append(dblobs[segID], bs...)
+		}
+	}
+	log.Info("clustering compact download deltalogs done", zap.Duration("elapse", t.tr.RecordSpan()))
+
+	deltaPk2Ts, err := t.mergeDeltalogs(dblobs)
+	if err != nil {
+		log.Warn("clustering compact wrong, fail to merge deltalogs", zap.Error(err))
+		return nil, err
+	}
+
+	// the rows are not buffered in memory, the insert logs are read twice instead.
+	// the first pass collects the clustering keys to decide the key range of each result segment,
+	// and the second pass dispatches each row to the writer of its segment.
+	currentTs := t.GetCurrentTime()
+	keys := make([]interface{}, 0)
+	numRows, err := t.iterateValues(ctxTimeout, allPath, pkField, deltaPk2Ts, currentTs, func(v *storage.Value) error {
+		keys = append(keys, v.Value.(map[UniqueID]interface{})[clusteringKeyField.GetFieldID()])
+		return nil
+	})
+	if err != nil {
+		log.Warn("clustering compact wrong, fail to read clustering keys", zap.Error(err))
+		return nil, err
+	}
+	bounds, bucketRows := splitClusteringBounds(keys, clusteringKeyField.GetDataType(), t.plan.GetMaxSegmentRows())
+	keys = nil
+	log.Info("clustering compact split key ranges done", zap.Int("num of segments", len(bucketRows)), zap.Duration("elapse", t.tr.RecordSpan()))
+
+	// the buffers of all the writers share the memory limit, the largest of them are flushed once it is exceeded
+	memoryLimit := int(float64(hardware.GetMemoryCount()) * paramtable.Get().DataNodeCfg.ClusteringCompactionMemoryBufferRatio.GetAsFloat())
+	totalBufferSize := 0
+	partID := t.plan.GetSegmentBinlogs()[0].GetPartitionID()
+	writers := make([]*clusteringSegmentWriter, len(bucketRows))
+	_, err = t.iterateValues(ctxTimeout, allPath, pkField, deltaPk2Ts, currentTs, func(v *storage.Value) error {
+		key := v.Value.(map[UniqueID]interface{})[clusteringKeyField.GetFieldID()]
+		idx := sort.Search(len(bounds), func(i int) bool {
+			return compareClusteringKey(clusteringKeyField.GetDataType(), key, bounds[i]) <= 0
+		})
+		if writers[idx] == nil {
+			writer, err := t.newSegmentWriter(partID, meta, pkField, bucketRows[idx])
+			if err != nil {
+				return err
+			}
+			writers[idx] = writer
+		}
+		bufferSize := writers[idx].bufferSize
+		if err := writers[idx].write(ctxTimeout, v); err != nil {
+			return err
+		}
+		totalBufferSize += writers[idx].bufferSize - bufferSize
+		if totalBufferSize <= memoryLimit {
+			return nil
+		}
+		size, err := flushLargestBuffers(ctxTimeout, writers, totalBufferSize, memoryLimit)
+		if err != nil {
+			return err
+		}
+		totalBufferSize = size
+		return nil
+	})
+	if err != nil {
+		log.Warn("clustering compact wrong, fail to write segments", zap.Error(err))
+		return nil, err
+	}
+
+	segments := make([]*datapb.CompactionSegment, 0, len(writers))
+	for _, writer := range writers {
+		if writer == nil {
+			continue
+		}
+		segment, err := writer.finish(ctxTimeout)
+		if err != nil {
+			log.Warn("clustering compact wrong, fail to write segment", zap.Error(err))
+			return nil, err
+		}
+		segments = append(segments, segment)
+	}
+
+	// all the rows are deleted or expired, an empty segment is returned
+	// so that datacoord could drop the compacted segments
+	if len(segments) == 0 {
+		targetSegID, err := t.AllocOne()
+		if err != nil {
+			log.Warn("clustering compact wrong, unable to allocate segmentID", zap.Error(err))
+			return nil, err
+		}
+		segments = append(segments, &datapb.CompactionSegment{
+			SegmentID: targetSegID,
+			Channel:   t.plan.GetChannel(),
+		})
+	}
+
+	log.Info("clustering compact done",
+		zap.Int64s("compactedFrom", segIDs),
+		zap.Int64s("compactedTo", lo.Map(segments, func(segment *datapb.CompactionSegment, _ int) int64 { return segment.GetSegmentID() })),
+		zap.Int64("num of rows", numRows),
+		zap.Duration("elapse", time.Since(compactStart)),
+	)
+
+	metrics.DataNodeCompactionLatency.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), t.plan.GetType().String()).Observe(float64(t.tr.ElapseSpan().Milliseconds()))
+	metrics.DataNodeCompactionLatencyInQueue.WithLabelValues(fmt.Sprint(paramtable.GetNodeID())).Observe(float64(durInQueue.Milliseconds()))
+
+	planResult := &datapb.CompactionPlanResult{
+		State:    commonpb.CompactionState_Completed,
+		PlanID:   t.getPlanID(),
+		Channel:  t.plan.GetChannel(),
+		Segments: segments,
+		Type:     t.plan.GetType(),
+	}
+
+	return planResult, nil
+}
+
+// iterateValues calls fn for each row of the plan, deleted and expired rows are filtered.
+// It returns the number of the remaining rows.
+func (t *clusteringCompactionTask) iterateValues(
+	ctx context.Context,
+	unMergedInsertlogs [][]string,
+	pkField *schemapb.FieldSchema,
+	delta map[interface{}]Timestamp,
+	currentTs Timestamp,
+	fn func(v *storage.Value) error,
+) (int64, error) {
+	log := log.With(zap.Int64("planID", t.getPlanID()))
+	var (
+		remaining int64
+		expired   int64
+		deleted   int64
+		readTime  = time.Now()
+	)
+
+	for _, path := range unMergedInsertlogs {
+		data, err := downloadBlobs(ctx, t.binlogIO, path)
+		if err != nil {
+			log.Warn("download insertlogs wrong", zap.Strings("path", path), zap.Error(err))
+			return 0, err
+		}
+
+		iter, err := storage.NewInsertBinlogIterator(data, pkField.GetFieldID(), pkField.GetDataType())
+		if err != nil {
+			log.Warn("new insert binlogs Itr wrong", zap.Strings("path", path), zap.Error(err))
+			return 0, err
+		}
+
+		for iter.HasNext() {
+			vInter, _ := iter.Next()
+			v, ok := vInter.(*storage.Value)
+			if !ok {
+				log.Warn("transfer interface to Value wrong", zap.Strings("path", path))
+				return 0, errors.New("unexpected error")
+			}
+
+			// insert task and delete task has the same ts when upsert
+			// here should be < instead of <=
+			// to avoid the upsert data to be deleted after compact
+			if ts, ok := delta[v.PK.GetValue()]; ok && uint64(v.Timestamp) < ts {
+				deleted++
+				continue
+			}
+
+			if t.isExpiredEntity(Timestamp(v.Timestamp), currentTs) {
+				expired++
+				continue
+			}
+
+			if _, ok := v.Value.(map[UniqueID]interface{}); !ok {
+				log.Warn("transfer interface to map wrong", zap.Strings("path", path))
+				return 0, errors.New("unexpected error")
+			}
+			if err := fn(v); err != nil {
+				return 0, err
+			}
+			remaining++
+		}
+	}
+
+	log.Info("clustering compact iterate insert logs done",
+		zap.Int64("remaining rows", remaining),
+		zap.Int64("deleted entities", deleted),
+		zap.Int64("expired entities", expired),
+		zap.Duration("elapse", time.Since(readTime)))
+	return remaining, nil
+}
+
+// flushLargestBuffers flushes the writers in descending order of buffer size, until the total
+// buffer size drops to half of the memory limit. It returns the total buffer size after flush.
+func flushLargestBuffers(ctx context.Context, writers []*clusteringSegmentWriter, totalBufferSize int, memoryLimit int) (int, error) {
+	buffered := lo.Filter(writers, func(w *clusteringSegmentWriter, _ int) bool {
+		return w != nil && w.bufferedRows > 0
+	})
+	sort.Slice(buffered, func(i, j int) bool {
+		return buffered[i].bufferSize > buffered[j].bufferSize
+	})
+	for _, w := range buffered {
+		if totalBufferSize <= memoryLimit/2 {
+			break
+		}
+		totalBufferSize -= w.bufferSize
+		if err := w.flush(ctx); err != nil {
+			return 0, err
+		}
+	}
+	log.Info("clustering compact flush largest buffers done", zap.Int("totalBufferSize", totalBufferSize), zap.Int("memoryLimit", memoryLimit))
+	return totalBufferSize, nil
+}
+
+// clusteringSegmentWriter writes the rows of one result segment, the buffered rows
+// are uploaded as insert logs once the buffer exceeds the max binlog size,
+// or it is one of the largest buffers when the memory limit of the task is exceeded.
+type clusteringSegmentWriter struct {
+	task   *clusteringCompactionTask
+	segID  UniqueID
+	partID UniqueID
+	meta   *etcdpb.CollectionMeta
+
+	buffer       *storage.InsertData
+	bufferedRows int
+	// bufferSize is the memory size of buffer, it is refreshed every 100 rows
+	bufferSize       int
+	stats            *storage.PrimaryKeyStats
+	fieldStats       []*datapb.FieldStats
+	bm25Indexes      map[UniqueID]*bm25.Index
+	insertField2Path map[UniqueID]*datapb.FieldBinlog
+	numRows          int64
+	// initial timestampFrom, timestampTo = -1, -1 is an illegal value, only to mark initial state
+	timestampFrom int64
+	timestampTo   int64
+}
+
+func (t *clusteringCompactionTask) newSegmentWriter(partID UniqueID, meta *etcdpb.CollectionMeta, pkField *schemapb.FieldSchema, expectedRows int64) (*clusteringSegmentWriter, error) {
+	segID, err := t.AllocOne()
+	if err != nil {
+		return nil, err
+	}
+	stats, err := storage.NewPrimaryKeyStats(pkField.GetFieldID(), int64(pkField.GetDataType()), expectedRows)
+	if err != nil {
+		return nil, err
+	}
+	buffer, err := storage.NewInsertData(meta.GetSchema())
+	if err != nil {
+		return nil, err
+	}
+	return &clusteringSegmentWriter{
+		task:             t,
+		segID:            segID,
+		partID:           partID,
+		meta:             meta,
+		buffer:           buffer,
+		stats:            stats,
+		insertField2Path: make(map[UniqueID]*datapb.FieldBinlog),
+		timestampFrom:    -1,
+		timestampTo:      -1,
+	}, nil
+}
+
+func (w *clusteringSegmentWriter) write(ctx context.Context, v *storage.Value) error {
+	if v.Timestamp < w.timestampFrom || w.timestampFrom == -1 {
+		w.timestampFrom = v.Timestamp
+	}
+	if v.Timestamp > w.timestampTo || w.timestampTo == -1 {
+		w.timestampTo = v.Timestamp
+	}
+
+	if err := w.buffer.Append(v.Value.(map[UniqueID]interface{})); err != nil {
+		return err
+	}
+	w.stats.Update(v.PK)
+	w.bufferedRows++
+
+	// check size every 100 rows in case of too many `GetMemorySize` call
+	if w.bufferedRows%100 == 0 {
+		w.bufferSize = w.buffer.GetMemorySize()
+		if w.bufferSize > paramtable.Get().DataNodeCfg.BinLogMaxSize.GetAsInt() {
+			return w.flush(ctx)
+		}
+	}
+	return nil
+}
+
+// flush uploads the buffered rows as insert logs and resets the buffer.
+func (w *clusteringSegmentWriter) flush(ctx context.Context) error {
+	if err := w.mergeBufferStats(); err != nil {
+		return err
+	}
+	inPaths, err := w.task.uploadSingleInsertLog(ctx, w.segID, w.partID, w.meta, w.buffer)
+	if err != nil {
+		return err
+	}
+	w.addInsertFieldPath(inPaths)
+
+	w.buffer, _ = storage.NewInsertData(w.meta.GetSchema())
+	w.bufferedRows = 0
+	w.bufferSize = 0
+	return nil
+}
+
+// finish uploads the remaining rows and the stats logs of the segment.
+func (w *clusteringSegmentWriter) finish(ctx context.Context) (*datapb.CompactionSegment, error) {
+	if err := w.mergeBufferStats(); err != nil {
+		return nil, err
+	}
+	inPaths, statsPaths, err := w.task.uploadRemainLog(ctx, w.segID, w.partID, w.meta, w.stats, w.bm25Indexes, w.numRows, w.buffer)
+	if err != nil {
+		return nil, err
+	}
+	w.addInsertFieldPath(inPaths)
+
+	return &datapb.CompactionSegment{
+		SegmentID:           w.segID,
+		InsertLogs:          lo.Values(w.insertField2Path),
+		Field2StatslogPaths: lo.Values(statsPaths),
+		NumOfRows:           w.numRows,
+		Channel:             w.task.plan.GetChannel(),
+		FieldStats:          w.fieldStats,
+	}, nil
+}
+
+func (w *clusteringSegmentWriter) mergeBufferStats() error {
+	var err error
+	w.numRows += int64(w.buffer.GetRowNum())
+	w.fieldStats = mergeBufferFieldStats(w.fieldStats, w.meta.GetSchema(), w.buffer)
+	w.bm25Indexes, err = mergeBufferBM25Index(w.bm25Indexes, w.meta.GetSchema(), w.buffer)
+	return err
+}
+
+func (w *clusteringSegmentWriter) addInsertFieldPath(inPaths map[UniqueID]*datapb.FieldBinlog) {
+	for fID, path := range inPaths {
+		for _, binlog := range path.GetBinlogs() {
+			binlog.TimestampTo = uint64(w.timestampTo)
+			binlog.TimestampFrom = uint64(w.timestampFrom)
+		}
+		tmpBinlog, ok := w.insertField2Path[fID]
+		if !ok {
+			tmpBinlog = path
+		} else {
+			tmpBinlog.Binlogs = append(tmpBinlog.Binlogs, path.GetBinlogs()...)
+		}
+		w.insertField2Path[fID] = tmpBinlog
+	}
+	w.timestampFrom = -1
+	w.timestampTo = -1
+}
+
+// mergeBufferFieldStats merges the field stats of the write buffer into the stats of the segment.
+func mergeBufferFieldStats(fieldStats []*datapb.FieldStats, schema *schemapb.CollectionSchema, buffer *storage.InsertData) []*datapb.FieldStats {
+	stats := storage.NewFieldStatsFromInsertData(schema, buffer)
+	if fieldStats == nil {
+		return stats
+	}
+	return storage.MergeFieldStats(fieldStats, stats)
+}
+
+// mergeBufferBM25Index appends the rows of the write buffer to the term frequency indexes of the segment.
+func mergeBufferBM25Index(bm25Indexes map[int64]*bm25.Index, schema *schemapb.CollectionSchema, buffer *storage.InsertData) (map[int64]*bm25.Index, error) {
+	indexes, err := storage.NewBM25IndexFromInsertData(schema, buffer)
+	if err != nil {
+		return nil, err
+	}
+	if bm25Indexes == nil {
+		return indexes, nil
+	}
+	for fieldID, index := range indexes {
+		if merged, ok := bm25Indexes[fieldID]; ok {
+			merged.Merge(index)
+		} else {
+			bm25Indexes[fieldID] = index
+		}
+	}
+	return bm25Indexes, nil
+}
+
+func isSupportedClusteringKeyType(dataType schemapb.DataType) bool {
+	return typeutil.IsIntegerType(dataType) || typeutil.IsFloatingType(dataType) || typeutil.IsStringType(dataType)
+}
+
+// compareClusteringKey returns -1, 0 or 1 if a is less than, equal to or greater than b.
+func compareClusteringKey(dataType schemapb.DataType, a, b interface{}) int {
+	compare := func(less, greater bool) int {
+		switch {
+		case less:
+			return -1
+		case greater:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	switch dataType {
+	case schemapb.DataType_Int8:
+		return compare(a.(int8) < b.(int8), a.(int8) > b.(int8))
+	case schemapb.DataType_Int16:
+		return compare(a.(int16) < b.(int16), a.(int16) > b.(int16))
+	case schemapb.DataType_Int32:
+		return compare(a.(int32) < b.(int32), a.(int32) > b.(int32))
+	case schemapb.DataType_Int64:
+		return compare(a.(int64) < b.(int64), a.(int64) > b.(int64))
+	case schemapb.DataType_Float:
+		return compare(a.(float32) < b.(float32), a.(float32) > b.(float32))
+	case schemapb.DataType_Double:
+		return compare(a.(float64) < b.(float64), a.(float64) > b.(float64))
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		return strings.Compare(a.(string), b.(string))
+	default:
+		return 0
+	}
+}
+
+// splitClusteringBounds sorts the clustering keys, and splits them into buckets
+// with at most maxRows rows. Rows with the same key are always kept in the same bucket,
+// so that the key ranges of the buckets are disjoint.
+// It returns the max key of each bucket except the last one, and the row count of each bucket.
+// A key belongs to the first bucket whose bound is not less than it.
+func splitClusteringBounds(keys []interface{}, dataType schemapb.DataType, maxRows int64) ([]interface{}, []int64) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return compareClusteringKey(dataType, keys[i], keys[j]) < 0
+	})
+
+	var (
+		bounds     []interface{}
+		bucketRows []int64
+		start      int
+	)
+	for i := 1; i < len(keys); i++ {
+		if int64(i-start) >= maxRows && compareClusteringKey(dataType, keys[i-1], keys[i]) != 0 {
+			bounds = append(bounds, keys[i-1])
+			bucketRows = append(bucketRows, int64(i-start))
+			start = i
+		}
+	}
+	return bounds, append(bucketRows, int64(len(keys)-start))
+}
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/datanode/allocator"
+	"github.com/milvus-io/milvus/internal/datanode/io"
+	"github.com/milvus-io/milvus/internal/datanode/metacache"
+	"github.com/milvus-io/milvus/internal/datanode/syncmgr"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+func TestClusteringCompact(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cm := storage.NewLocalChunkManager(storage.RootPath(compactTestDir))
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	var collID, partID, segID1, segID2 UniqueID = 1, 10, 200, 201
+	var nextID int64 = 19530
+	alloc := allocator.NewMockAllocator(t)
+	alloc.EXPECT().AllocOne().RunAndReturn(func() (int64, error) {
+		nextID++
+		return nextID, nil
+	})
+	alloc.EXPECT().GetGenerator(mock.Anything, mock.Anything).Call.Return(validGeneratorFn, nil)
+
+	meta := NewMetaFactory().GetCollectionMeta(collID, "test_clustering_compact_coll_name", schemapb.DataType_Int64)
+	mockbIO := io.NewBinlogIO(cm, getOrCreateIOPool())
+	iCodec := storage.NewInsertCodecWithSchema(meta)
+
+	metaCache := metacache.NewMockMetaCache(t)
+	metaCache.EXPECT().Collection().Return(collID)
+	metaCache.EXPECT().Schema().Return(meta.GetSchema())
+	syncMgr := syncmgr.NewMockSyncManager(t)
+	syncMgr.EXPECT().Block(mock.Anything).Return()
+
+	// the clustering keys of both segments are interleaved, pk field 106 is the clustering key
+	iData1 := genInsertDataWithPKs([2]storage.PrimaryKey{storage.NewInt64PrimaryKey(3), storage.NewInt64PrimaryKey(1)}, schemapb.DataType_Int64)
+	iData2 := genInsertDataWithPKs([2]storage.PrimaryKey{storage.NewInt64PrimaryKey(2), storage.NewInt64PrimaryKey(4)}, schemapb.DataType_Int64)
+	iPaths1, err := uploadInsertLog(ctx, mockbIO, alloc, meta.GetID(), partID, segID1, iData1, iCodec)
+	require.NoError(t, err)
+	iPaths2, err := uploadInsertLog(ctx, mockbIO, alloc, meta.GetID(), partID, segID2, iData2, iCodec)
+	require.NoError(t, err)
+
+	plan := &datapb.CompactionPlan{
+		PlanID: 20080,
+		SegmentBinlogs: []*datapb.CompactionSegmentBinlogs{
+			{SegmentID: segID1, PartitionID: partID, FieldBinlogs: lo.Values(iPaths1)},
+			{SegmentID: segID2, PartitionID: partID, FieldBinlogs: lo.Values(iPaths2)},
+		},
+		TimeoutInSeconds:   10,
+		Type:               datapb.CompactionType_ClusteringCompaction,
+		Channel:            "channelname",
+		ClusteringKeyField: 106,
+		MaxSegmentRows:     2,
+	}
+
+	task := newClusteringCompactionTask(ctx, mockbIO, metaCache, syncMgr, alloc, plan)
+	result, err := task.compact()
+	require.NoError(t, err)
+	assert.Equal(t, plan.GetPlanID(), result.GetPlanID())
+	require.Equal(t, 2, len(result.GetSegments()))
+
+	ranges := make([][2]int64, 0)
+	for _, segment := range result.GetSegments() {
+		assert.EqualValues(t, 2, segment.GetNumOfRows())
+		assert.NotEmpty(t, segment.GetInsertLogs())
+		assert.NotEmpty(t, segment.GetField2StatslogPaths())
+		stats, ok := lo.Find(segment.GetFieldStats(), func(stats *datapb.FieldStats) bool { return stats.GetFieldID() == 106 })
+		require.True(t, ok)
+		ranges = append(ranges, [2]int64{stats.GetMin().GetLongData(), stats.GetMax().GetLongData()})
+	}
+	assert.ElementsMatch(t, [][2]int64{{1, 2}, {3, 4}}, ranges)
+}
+
+func TestFlushLargestBuffers(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cm := storage.NewLocalChunkManager(storage.RootPath(compactTestDir))
+	defer cm.RemoveWithPrefix(ctx, cm.RootPath())
+
+	var nextID int64 = 19530
+	alloc := allocator.NewMockAllocator(t)
+	alloc.EXPECT().AllocOne().RunAndReturn(func() (int64, error) {
+		nextID++
+		return nextID, nil
+	})
+	alloc.EXPECT().GetGenerator(mock.Anything, mock.Anything).Call.Return(validGeneratorFn, nil)
+
+	meta := NewMetaFactory().GetCollectionMeta(1, "test_flush_largest_buffers_coll_name", schemapb.DataType_Int64)
+	pkField, err := typeutil.GetPrimaryFieldSchema(meta.GetSchema())
+	require.NoError(t, err)
+	plan := &datapb.CompactionPlan{PlanID: 20080, Channel: "channelname"}
+	task := newClusteringCompactionTask(ctx, io.NewBinlogIO(cm, getOrCreateIOPool()), nil, nil, alloc, plan)
+
+	iData := genInsertDataWithPKs([2]storage.PrimaryKey{storage.NewInt64PrimaryKey(1), storage.NewInt64PrimaryKey(2)}, schemapb.DataType_Int64)
+	writeRows := func(w *clusteringSegmentWriter, rows int) {
+		for i := 0; i < rows; i++ {
+			row := iData.GetRow(i)
+			err := w.write(ctx, &storage.Value{
+				PK:        storage.NewInt64PrimaryKey(row[pkField.GetFieldID()].(int64)),
+				Timestamp: row[common.TimeStampField].(int64),
+				Value:     row,
+			})
+			require.NoError(t, err)
+		}
+	}
+	small, err := task.newSegmentWriter(10, meta, pkField, 1)
+	require.NoError(t, err)
+	writeRows(small, 1)
+	large, err := task.newSegmentWriter(10, meta, pkField, 2)
+	require.NoError(t, err)
+	writeRows(large, 2)
+	// the buffer sizes are refreshed every 100 rows, set them directly
+	small.bufferSize, large.bufferSize = 10, 100
+
+	// only the largest buffer is flushed, then the total size drops to half of the limit
+	totalBufferSize, err := flushLargestBuffers(ctx, []*clusteringSegmentWriter{small, nil, large}, 110, 100)
+	require.NoError(t, err)
+	assert.Equal(t, 10, totalBufferSize)
+	assert.Equal(t, 0, large.bufferedRows)
+	assert.EqualValues(t, 2, large.numRows)
+	assert.NotEmpty(t, large.insertField2Path)
+	assert.Equal(t, 1, small.bufferedRows)
+	assert.Empty(t, small.insertField2Path)
+}
+
+func TestSplitClusteringBounds(t *testing.T) {
+	genKeys := func(keys ...int64) []interface{} {
+		return lo.Map(keys, func(key int64, _ int) interface{} { return key })
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		bounds, rows := splitClusteringBounds(nil, schemapb.DataType_Int64, 2)
+		assert.Empty(t, bounds)
+		assert.Empty(t, rows)
+	})
+
+	t.Run("sorted and split", func(t *testing.T) {
+		bounds, rows := splitClusteringBounds(genKeys(5, 1, 4, 2, 3), schemapb.DataType_Int64, 2)
+		assert.Equal(t, genKeys(2, 4), bounds)
+		assert.Equal(t, []int64{2, 2, 1}, rows)
+	})
+
+	t.Run("same keys kept together", func(t *testing.T) {
+		bounds, rows := splitClusteringBounds(genKeys(2, 1, 1, 1, 3, 2), schemapb.DataType_Int64, 2)
+		assert.Equal(t, genKeys(1, 2), bounds)
+		assert.Equal(t, []int64{3, 2, 1}, rows)
+	})
+}
+
+func TestCompareClusteringKey(t *testing.T) {
+	assert.Equal(t, -1, compareClusteringKey(schemapb.DataType_Int8, int8(1), int8(2)))
+	assert.Equal(t, 1, compareClusteringKey(schemapb.DataType_Int32, int32(3), int32(2)))
+	assert.Equal(t, 0, compareClusteringKey(schemapb.DataType_Double, 1.5, 1.5))
+	assert.Equal(t, -1, compareClusteringKey(schemapb.DataType_VarChar, "a", "b"))
+}
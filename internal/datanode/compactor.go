@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
@@ -179,6 +180,7 @@ func (t *compactionTask) uploadRemainLog(
 	partID UniqueID,
 	meta *etcdpb.CollectionMeta,
 	stats *storage.PrimaryKeyStats,
+	bm25Indexes map[UniqueID]*bm25.Index,
 	totRows int64,
 	writeBuffer *storage.InsertData,
 ) (map[UniqueID]*datapb.FieldBinlog, map[UniqueID]*datapb.FieldBinlog, error) {
@@ -197,6 +199,14 @@ func (t *compactionTask) uploadRemainLog(
 		return nil, nil, err
 	}
 
+	bm25StatPaths, err := uploadBM25StatsLog(ctxTimeout, t.binlogIO, t.Allocator, meta.GetID(), partID, targetSegID, bm25Indexes)
+	if err != nil {
+		return nil, nil, err
+	}
+	for fieldID, path := range bm25StatPaths {
+		statPaths[fieldID] = path
+	}
+
 	return inPaths, statPaths, nil
 }
 
@@ -224,7 +234,7 @@ func (t *compactionTask) merge(
 	partID UniqueID,
 	meta *etcdpb.CollectionMeta,
 	delta map[interface{}]Timestamp,
-) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, int64, error) {
+) ([]*datapb.FieldBinlog, []*datapb.FieldBinlog, []*datapb.FieldStats, int64, error) {
 	ctx, span := otel.Tracer(typeutil.DataNodeRole).Start(ctx, fmt.Sprintf("CompactMerge-%d", t.getPlanID()))
 	defer span.End()
 	log := log.With(zap.Int64("planID", t.getPlanID()))
@@ -240,10 +250,13 @@ func (t *compactionTask) merge(
 
 		statField2Path = make(map[UniqueID]*datapb.FieldBinlog)
 		statPaths      = make([]*datapb.FieldBinlog, 0)
+
+		fieldStats  []*datapb.FieldStats
+		bm25Indexes map[UniqueID]*bm25.Index
 	)
 	writeBuffer, err := storage.NewInsertData(meta.GetSchema())
 	if err != nil {
-		return nil, nil, -1, err
+		return nil, nil, nil, -1, err
 	}
 
 	isDeletedValue := func(v *storage.Value) bool {
@@ -295,7 +308,7 @@ func (t *compactionTask) merge(
 
 	if pkField == nil {
 		log.Warn("failed to get pk field from schema")
-		return nil, nil, 0, fmt.Errorf("no pk field in schema")
+		return nil, nil, nil, 0, fmt.Errorf("no pk field in schema")
 	}
 
 	pkID := pkField.GetFieldID()
@@ -311,12 +324,12 @@ func (t *compactionTask) merge(
 
 	oldRowNums, err := t.getNumRows()
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 
 	stats, err := storage.NewPrimaryKeyStats(pkID, int64(pkType), oldRowNums)
 	if err != nil {
-		return nil, nil, 0, err
+		return nil, nil, nil, 0, err
 	}
 	// initial timestampFrom, timestampTo = -1, -1 is an illegal value, only to mark initial state
 	var (
@@ -329,14 +342,14 @@ func (t *compactionTask) merge(
 		data, err := downloadBlobs(ctx, t.binlogIO, path)
 		if err != nil {
 			log.Warn("download insertlogs wrong", zap.Strings("path", path), zap.Error(err))
-			return nil, nil, 0, err
+			return nil, nil, nil, 0, err
 		}
 		downloadTimeCost += time.Since(downloadStart)
 
 		iter, err := storage.NewInsertBinlogIterator(data, pkID, pkType)
 		if err != nil {
 			log.Warn("new insert binlogs Itr wrong", zap.Strings("path", path), zap.Error(err))
-			return nil, nil, 0, err
+			return nil, nil, nil, 0, err
 		}
 
 		for iter.HasNext() {
@@ -344,7 +357,7 @@ func (t *compactionTask) merge(
 			v, ok := vInter.(*storage.Value)
 			if !ok {
 				log.Warn("transfer interface to Value wrong", zap.Strings("path", path))
-				return nil, nil, 0, errors.New("unexpected error")
+				return nil, nil, nil, 0, errors.New("unexpected error")
 			}
 
 			if isDeletedValue(v) {
@@ -369,12 +382,12 @@ func (t *compactionTask) merge(
 			row, ok := v.Value.(map[UniqueID]interface{})
 			if !ok {
 				log.Warn("transfer interface to map wrong", zap.Strings("path", path))
-				return nil, nil, 0, errors.New("unexpected error")
+				return nil, nil, nil, 0, errors.New("unexpected error")
 			}
 
 			err = writeBuffer.Append(row)
 			if err != nil {
-				return nil, nil, 0, err
+				return nil, nil, nil, 0, err
 			}
 
 			currentRows++
@@ -383,11 +396,16 @@ func (t *compactionTask) merge(
 			// check size every 100 rows in case of too many `GetMemorySize` call
 			if (currentRows+1)%100 == 0 && writeBuffer.GetMemorySize() > paramtable.Get().DataNodeCfg.BinLogMaxSize.GetAsInt() {
 				numRows += int64(writeBuffer.GetRowNum())
+				fieldStats = mergeBufferFieldStats(fieldStats, meta.GetSchema(), writeBuffer)
+				bm25Indexes, err = mergeBufferBM25Index(bm25Indexes, meta.GetSchema(), writeBuffer)
+				if err != nil {
+					return nil, nil, nil, 0, err
+				}
 				uploadInsertStart := time.Now()
 				inPaths, err := t.uploadSingleInsertLog(ctx, targetSegID, partID, meta, writeBuffer)
 				if err != nil {
 					log.Warn("failed to upload single insert log", zap.Error(err))
-					return nil, nil, 0, err
+					return nil, nil, nil, 0, err
 				}
 				uploadInsertTimeCost += time.Since(uploadInsertStart)
 				addInsertFieldPath(inPaths, timestampFrom, timestampTo)
@@ -404,11 +422,16 @@ func (t *compactionTask) merge(
 	// upload stats log and remain insert rows
 	if writeBuffer.GetRowNum() > 0 || numRows > 0 {
 		numRows += int64(writeBuffer.GetRowNum())
+		fieldStats = mergeBufferFieldStats(fieldStats, meta.GetSchema(), writeBuffer)
+		bm25Indexes, err = mergeBufferBM25Index(bm25Indexes, meta.GetSchema(), writeBuffer)
+		if err != nil {
+			return nil, nil, nil, 0, err
+		}
 		uploadStart := time.Now()
 		inPaths, statsPaths, err := t.uploadRemainLog(ctx, targetSegID, partID, meta,
-			stats, numRows+int64(currentRows), writeBuffer)
+			stats, bm25Indexes, numRows+int64(currentRows), writeBuffer)
 		if err != nil {
-			return nil, nil, 0, err
+			return nil, nil, nil, 0, err
 		}
 
 		uploadInsertTimeCost += time.Since(uploadStart)
@@ -433,7 +456,7 @@ func (t *compactionTask) merge(
 		zap.Duration("upload insert log elapse", uploadInsertTimeCost),
 		zap.Duration("merge elapse", time.Since(mergeStart)))
 
-	return insertPaths, statPaths, numRows, nil
+	return insertPaths, statPaths, fieldStats, numRows, nil
 }
 
 func (t *compactionTask) compact() (*datapb.CompactionPlanResult, error) {
@@ -535,7 +558,7 @@ func (t *compactionTask) compact() (*datapb.CompactionPlanResult, error) {
 	partID := segmentBinlog.GetPartitionID()
 	meta := &etcdpb.CollectionMeta{ID: t.metaCache.Collection(), Schema: t.metaCache.Schema()}
 
-	inPaths, statsPaths, numRows, err := t.merge(ctxTimeout, allPath, targetSegID, partID, meta, deltaPk2Ts)
+	inPaths, statsPaths, fieldStats, numRows, err := t.merge(ctxTimeout, allPath, targetSegID, partID, meta, deltaPk2Ts)
 	if err != nil {
 		log.Warn("compact wrong, fail to merge", zap.Error(err))
 		return nil, err
@@ -547,6 +570,7 @@ func (t *compactionTask) compact() (*datapb.CompactionPlanResult, error) {
 		Field2StatslogPaths: statsPaths,
 		NumOfRows:           numRows,
 		Channel:             t.plan.GetChannel(),
+		FieldStats:          fieldStats,
 	}
 
 	log.Info("compact done",
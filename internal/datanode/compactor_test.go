@@ -327,7 +327,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(2), numOfRow)
 			assert.Equal(t, 1, len(inPaths[0].GetBinlogs()))
@@ -375,7 +375,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(2), numOfRow)
 			assert.Equal(t, 1, len(inPaths[0].GetBinlogs()))
@@ -426,7 +426,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(101), numOfRow)
 			assert.Equal(t, 2, len(inPaths[0].GetBinlogs()))
@@ -475,7 +475,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 				},
 				done: make(chan struct{}, 1),
 			}
-			inPaths, statsPaths, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
+			inPaths, statsPaths, _, numOfRow, err := ct.merge(context.Background(), allPaths, 2, 0, meta, dm)
 			assert.NoError(t, err)
 			assert.Equal(t, int64(0), numOfRow)
 			assert.Equal(t, 0, len(inPaths))
@@ -529,7 +529,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			_, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
+			_, _, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
 				Schema: meta.GetSchema(),
 			}, dm)
 			assert.Error(t, err)
@@ -573,7 +573,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					},
 				},
 			}
-			_, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
+			_, _, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
 				Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
 					{DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
 						{Key: common.DimKey, Value: "64"},
@@ -616,7 +616,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 				done:      make(chan struct{}, 1),
 			}
 
-			_, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
+			_, _, _, _, err = ct.merge(context.Background(), allPaths, 2, 0, &etcdpb.CollectionMeta{
 				Schema: &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
 					{DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{
 						{Key: common.DimKey, Value: "bad_dim"},
@@ -738,7 +738,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 				done:      make(chan struct{}, 1),
 			}
 
-			_, _, err = ct.uploadRemainLog(ctx, 1, 2, meta, stats, 10, nil)
+			_, _, err = ct.uploadRemainLog(ctx, 1, 2, meta, stats, nil, 10, nil)
 			assert.Error(t, err)
 		})
 	})
@@ -294,6 +294,16 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 			node.allocator,
 			req,
 		)
+	case datapb.CompactionType_ClusteringCompaction:
+		binlogIO := io.NewBinlogIO(node.chunkManager, getOrCreateIOPool())
+		task = newClusteringCompactionTask(
+			taskCtx,
+			binlogIO,
+			ds.metacache,
+			node.syncMgr,
+			node.allocator,
+			req,
+		)
 	default:
 		log.Warn("Unknown compaction type", zap.String("type", req.GetType().String()))
 		return merr.Status(merr.WrapErrParameterInvalidMsg("Unknown compaction type: %v", req.GetType().String())), nil
@@ -350,22 +360,53 @@ func (node *DataNode) SyncSegments(ctx context.Context, req *datapb.SyncSegments
 		log.Warn("failed to sync segments", zap.Error(err))
 		return merr.Status(err), nil
 	}
-	err := binlog.DecompressBinLog(storage.StatsBinlog, req.GetCollectionId(), req.GetPartitionId(), req.GetCompactedTo(), req.GetStatsLogs())
-	if err != nil {
-		log.Warn("failed to DecompressBinLog", zap.Error(err))
-		return merr.Status(err), nil
+	// a clustering compaction produces several segments, add the others before transferring the compacted ones.
+	// the compacted segments only track the first one as compactTo, which is fine since their deletes are all
+	// carried by the L0 segments.
+	for _, segment := range req.GetCompactedToSegments() {
+		if segment.GetSegmentID() == req.GetCompactedTo() || segment.GetNumOfRows() <= 0 {
+			continue
+		}
+		if _, ok := ds.metacache.GetSegmentByID(segment.GetSegmentID()); ok {
+			continue
+		}
+		bfs, err := node.loadCompactedBloomFilter(ctx, ds.metacache.Schema(), req.GetCollectionId(), req.GetPartitionId(), segment.GetSegmentID(), segment.GetStatsLogs())
+		if err != nil {
+			log.Warn("failed to load compacted segment statslog", zap.Int64("segmentID", segment.GetSegmentID()), zap.Error(err))
+			return merr.Status(err), nil
+		}
+		ds.metacache.AddSegment(&datapb.SegmentInfo{
+			ID:           segment.GetSegmentID(),
+			CollectionID: req.GetCollectionId(),
+			PartitionID:  req.GetPartitionId(),
+			State:        commonpb.SegmentState_Flushed,
+			Level:        datapb.SegmentLevel_L2,
+			NumOfRows:    segment.GetNumOfRows(),
+		}, func(*datapb.SegmentInfo) *metacache.BloomFilterSet { return bfs })
 	}
-	pks, err := loadStats(ctx, node.chunkManager, ds.metacache.Schema(), req.GetCompactedTo(), req.GetStatsLogs())
+
+	bfs, err := node.loadCompactedBloomFilter(ctx, ds.metacache.Schema(), req.GetCollectionId(), req.GetPartitionId(), req.GetCompactedTo(), req.GetStatsLogs())
 	if err != nil {
 		log.Warn("failed to load segment statslog", zap.Error(err))
 		return merr.Status(err), nil
 	}
-	bfs := metacache.NewBloomFilterSet(pks...)
 	ds.metacache.CompactSegments(req.GetCompactedTo(), req.GetPartitionId(), req.GetNumOfRows(), bfs, req.GetCompactedFrom()...)
 	node.compactionExecutor.injectDone(req.GetPlanID())
 	return merr.Success(), nil
 }
 
+// loadCompactedBloomFilter loads the pk bloom filter of a compacted segment from its statslogs.
+func (node *DataNode) loadCompactedBloomFilter(ctx context.Context, schema *schemapb.CollectionSchema, collectionID, partitionID, segmentID int64, statsLogs []*datapb.FieldBinlog) (*metacache.BloomFilterSet, error) {
+	if err := binlog.DecompressBinLog(storage.StatsBinlog, collectionID, partitionID, segmentID, statsLogs); err != nil {
+		return nil, err
+	}
+	pks, err := loadStats(ctx, node.chunkManager, schema, segmentID, statsLogs)
+	if err != nil {
+		return nil, err
+	}
+	return metacache.NewBloomFilterSet(pks...), nil
+}
+
 func (node *DataNode) NotifyChannelOperation(ctx context.Context, req *datapb.ChannelOperationsRequest) (*commonpb.Status, error) {
 	log.Ctx(ctx).Info("DataNode receives NotifyChannelOperation",
 		zap.Int("operation count", len(req.GetInfos())))
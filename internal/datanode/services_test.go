@@ -852,6 +852,39 @@ func (s *DataNodeServicesSuite) TestSyncSegments() {
 		s.Assert().True(merr.Ok(status))
 	})
 
+	s.Run("valid request with multiple compactTo segments", func() {
+		fg.metacache.AddSegment(&datapb.SegmentInfo{ID: 500, CollectionID: 1, State: commonpb.SegmentState_Flushed}, EmptyBfsFactory)
+		fg.metacache.AddSegment(&datapb.SegmentInfo{ID: 501, CollectionID: 1, State: commonpb.SegmentState_Flushed}, EmptyBfsFactory)
+
+		req := &datapb.SyncSegmentsRequest{
+			CompactedFrom: []UniqueID{500, 501},
+			CompactedTo:   502,
+			NumOfRows:     100,
+			ChannelName:   chanName,
+			CollectionId:  1,
+			CompactedToSegments: []*datapb.SyncCompactedSegment{
+				{SegmentID: 502, NumOfRows: 100},
+				{SegmentID: 503, NumOfRows: 50},
+				{SegmentID: 504, NumOfRows: 0},
+			},
+		}
+		status, err := s.node.SyncSegments(s.ctx, req)
+		s.Assert().NoError(err)
+		s.Assert().True(merr.Ok(status))
+
+		for _, segmentID := range []int64{502, 503} {
+			_, result := fg.metacache.GetSegmentByID(segmentID, metacache.WithSegmentState(commonpb.SegmentState_Flushed))
+			s.True(result)
+		}
+		_, result := fg.metacache.GetSegmentByID(504)
+		s.False(result)
+		for _, compactFrom := range req.GetCompactedFrom() {
+			seg, result := fg.metacache.GetSegmentByID(compactFrom, metacache.WithSegmentState(commonpb.SegmentState_Flushed))
+			s.True(result)
+			s.Equal(req.CompactedTo, seg.CompactTo())
+		}
+	})
+
 	s.Run("without_channel_meta", func() {
 		fg.metacache.UpdateSegments(metacache.UpdateState(commonpb.SegmentState_Flushed),
 			metacache.WithSegmentIDs(100, 200, 300))
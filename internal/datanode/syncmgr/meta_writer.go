@@ -91,6 +91,7 @@ func (b *brokerMetaWriter) UpdateSync(pack *SyncTask) error {
 		Field2BinlogPaths:   insertFieldBinlogs,
 		Field2StatslogPaths: statsFieldBinlogs,
 		Deltalogs:           deltaFieldBinlogs,
+		FieldStats:          pack.fieldStats,
 
 		CheckPoints: checkPoints,
 
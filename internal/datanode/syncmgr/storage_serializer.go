@@ -103,6 +103,14 @@ func (s *storageV1Serializer) EncodeBuffer(ctx context.Context, pack *SyncPack)
 		}
 
 		task.batchStatsBlob = batchStatsBlob
+		task.fieldStats = storage.NewFieldStatsFromInsertData(s.schema, pack.insertData)
+
+		bm25StatsBlobs, err := s.serializeBM25Stats(pack)
+		if err != nil {
+			log.Warn("failed to serialize bm25 stats log", zap.Error(err))
+			return nil, err
+		}
+		task.bm25StatsBlobs = bm25StatsBlobs
 		s.metacache.UpdateSegments(metacache.RollStats(singlePKStats), metacache.WithSegmentIDs(pack.segmentID))
 	}
 
@@ -193,6 +201,24 @@ func (s *storageV1Serializer) serializeStatslog(pack *SyncPack) (*storage.Primar
 	return stats, blob, nil
 }
 
+// serializeBM25Stats serializes the term frequency indexes of the analyzer enabled fields in this batch.
+func (s *storageV1Serializer) serializeBM25Stats(pack *SyncPack) (map[int64]*storage.Blob, error) {
+	indexes, err := storage.NewBM25IndexFromInsertData(s.schema, pack.insertData)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[int64]*storage.Blob, len(indexes))
+	for fieldID, index := range indexes {
+		blob, err := storage.SerializeBM25Index(fieldID, index)
+		if err != nil {
+			return nil, err
+		}
+		result[fieldID] = blob
+	}
+	return result, nil
+}
+
 func (s *storageV1Serializer) serializeMergedPkStats(pack *SyncPack) (*storage.Blob, error) {
 	segment, ok := s.metacache.GetSegmentByID(pack.segmentID)
 	if !ok {
@@ -77,6 +77,15 @@ func (s *StorageV1SerializerSuite) SetupSuite() {
 					{Key: common.DimKey, Value: "128"},
 				},
 			},
+			{
+				FieldID:  102,
+				Name:     "text",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.MaxLengthKey, Value: "256"},
+					{Key: common.EnableAnalyzerKey, Value: "true"},
+				},
+			},
 		},
 	}
 
@@ -113,6 +122,7 @@ func (s *StorageV1SerializerSuite) getInsertBuffer() *storage.InsertData {
 			return rand.Float32()
 		})
 		data[101] = vector
+		data[102] = fmt.Sprintf("text of row %d", i+1)
 		err := buf.Append(data)
 		s.Require().NoError(err)
 	}
@@ -227,8 +237,10 @@ func (s *StorageV1SerializerSuite) TestSerializeInsert() {
 		}, taskV1.checkpoint)
 		s.EqualValues(50, taskV1.tsFrom)
 		s.EqualValues(100, taskV1.tsTo)
-		s.Len(taskV1.binlogBlobs, 4)
+		s.Len(taskV1.binlogBlobs, 5)
 		s.NotNil(taskV1.batchStatsBlob)
+		s.Len(taskV1.bm25StatsBlobs, 1)
+		s.EqualValues(10, taskV1.bm25StatsBlobs[102].RowNum)
 	})
 
 	s.Run("with_flush_segment_not_found", func() {
@@ -269,7 +281,7 @@ func (s *StorageV1SerializerSuite) TestSerializeInsert() {
 		}, taskV1.checkpoint)
 		s.EqualValues(50, taskV1.tsFrom)
 		s.EqualValues(100, taskV1.tsTo)
-		s.Len(taskV1.binlogBlobs, 4)
+		s.Len(taskV1.binlogBlobs, 5)
 		s.NotNil(taskV1.batchStatsBlob)
 		s.NotNil(taskV1.mergedStatsBlob)
 	})
@@ -79,11 +79,14 @@ type SyncTask struct {
 	insertBinlogs map[int64]*datapb.FieldBinlog // map[int64]*datapb.Binlog
 	statsBinlogs  map[int64]*datapb.FieldBinlog // map[int64]*datapb.Binlog
 	deltaBinlog   *datapb.FieldBinlog
+	// fieldStats is the min/max stats of the scalar fields of this batch
+	fieldStats []*datapb.FieldStats
 
 	binlogBlobs     map[int64]*storage.Blob // fieldID => blob
 	binlogMemsize   map[int64]int64         // memory size
 	batchStatsBlob  *storage.Blob
 	mergedStatsBlob *storage.Blob
+	bm25StatsBlobs  map[int64]*storage.Blob // fieldID => term frequency index of analyzer enabled field
 	deltaBlob       *storage.Blob
 	deltaRowCount   int64
 
@@ -221,7 +224,7 @@ func (t *SyncTask) Run() (err error) {
 
 // prefetchIDs pre-allcates ids depending on the number of blobs current task contains.
 func (t *SyncTask) prefetchIDs() error {
-	totalIDCount := len(t.binlogBlobs)
+	totalIDCount := len(t.binlogBlobs) + len(t.bm25StatsBlobs)
 	if t.batchStatsBlob != nil {
 		totalIDCount++
 	}
@@ -268,6 +271,9 @@ func (t *SyncTask) processStatsBlob() {
 		totalRowNum := t.segment.NumOfRows()
 		t.convertBlob2StatsBinlog(t.mergedStatsBlob, t.pkField.GetFieldID(), int64(storage.CompoundStatsType), totalRowNum)
 	}
+	for fieldID, blob := range t.bm25StatsBlobs {
+		t.convertBlob2StatsBinlog(blob, fieldID, t.nextID(), blob.RowNum)
+	}
 }
 
 func (t *SyncTask) processDeltaBlob() {
@@ -5,14 +5,16 @@ import "time"
 // v2
 const (
 	// --- category ---
-	CollectionCategory = "/collections/"
-	EntityCategory     = "/entities/"
-	PartitionCategory  = "/partitions/"
-	UserCategory       = "/users/"
-	RoleCategory       = "/roles/"
-	IndexCategory      = "/indexes/"
-	AliasCategory      = "/aliases/"
-	ImportJobCategory  = "/jobs/import/"
+	CollectionCategory    = "/collections/"
+	EntityCategory        = "/entities/"
+	PartitionCategory     = "/partitions/"
+	UserCategory          = "/users/"
+	RoleCategory          = "/roles/"
+	IndexCategory         = "/indexes/"
+	AliasCategory         = "/aliases/"
+	ImportJobCategory     = "/jobs/import/"
+	DatabaseCategory      = "/databases/"
+	ResourceGroupCategory = "/resource_groups/"
 
 	ListAction         = "list"
 	HasAction          = "has"
@@ -39,6 +41,8 @@ const (
 	RevokePrivilegeAction = "revoke_privilege"
 	AlterAction           = "alter"
 	GetProgressAction     = "get_progress"
+
+	DescribeRateLimitsAction = "describe_rate_limits"
 )
 
 const (
@@ -63,27 +67,31 @@ const (
 	EnableAutoID  = true
 	DisableAutoID = false
 
-	HTTPCollectionName       = "collectionName"
-	HTTPDbName               = "dbName"
-	HTTPPartitionName        = "partitionName"
-	HTTPPartitionNames       = "partitionNames"
-	HTTPUserName             = "userName"
-	HTTPRoleName             = "roleName"
-	HTTPIndexName            = "indexName"
-	HTTPIndexField           = "fieldName"
-	HTTPAliasName            = "aliasName"
-	DefaultDbName            = "default"
-	DefaultIndexName         = "vector_idx"
-	DefaultAliasName         = "the_alias"
-	DefaultOutputFields      = "*"
-	HTTPHeaderAllowInt64     = "Accept-Type-Allow-Int64"
-	HTTPHeaderRequestTimeout = "Request-Timeout"
-	HTTPDefaultTimeout       = 30 * time.Second
-	HTTPReturnCode           = "code"
-	HTTPReturnMessage        = "message"
-	HTTPReturnData           = "data"
-	HTTPReturnLoadState      = "loadState"
-	HTTPReturnLoadProgress   = "loadProgress"
+	HTTPCollectionName         = "collectionName"
+	HTTPDbName                 = "dbName"
+	HTTPPartitionName          = "partitionName"
+	HTTPPartitionNames         = "partitionNames"
+	HTTPUserName               = "userName"
+	HTTPRoleName               = "roleName"
+	HTTPIndexName              = "indexName"
+	HTTPIndexField             = "fieldName"
+	HTTPAliasName              = "aliasName"
+	HTTPResourceGroupName      = "resourceGroupName"
+	DefaultDbName              = "default"
+	DefaultIndexName           = "vector_idx"
+	DefaultAliasName           = "the_alias"
+	DefaultOutputFields        = "*"
+	HTTPHeaderAllowInt64       = "Accept-Type-Allow-Int64"
+	HTTPHeaderRequestTimeout   = "Request-Timeout"
+	HTTPDefaultTimeout         = 30 * time.Second
+	HTTPReturnCode             = "code"
+	HTTPReturnMessage          = "message"
+	HTTPReturnData             = "data"
+	HTTPReturnCursor           = "cursor"
+	HTTPReturnMissingShards    = "missingShards"
+	HTTPReturnSearchedFraction = "searchedFraction"
+	HTTPReturnLoadState        = "loadState"
+	HTTPReturnLoadProgress     = "loadProgress"
 
 	HTTPReturnHas = "has"
 
@@ -112,6 +120,25 @@ const (
 	HTTPReturnGrantor    = "grantor"
 	HTTPReturnDbName     = "dbName"
 
+	HTTPReturnDbID       = "dbID"
+	HTTPReturnProperties = "properties"
+
+	HTTPReturnCapacity         = "capacity"
+	HTTPReturnNumAvailableNode = "numAvailableNode"
+	HTTPReturnNumLoadedReplica = "numLoadedReplica"
+	HTTPReturnNumOutgoingNode  = "numOutgoingNode"
+	HTTPReturnNumIncomingNode  = "numIncomingNode"
+	HTTPReturnConfig           = "config"
+	HTTPReturnNodes            = "nodes"
+
+	// the rate limits of user and role, DML rates are in MB/s, search rate is in vectors/s, query rate is in requests/s
+	HTTPRateLimitInsert   = "insertRate"
+	HTTPRateLimitUpsert   = "upsertRate"
+	HTTPRateLimitDelete   = "deleteRate"
+	HTTPRateLimitBulkLoad = "bulkLoadRate"
+	HTTPRateLimitSearch   = "searchRate"
+	HTTPRateLimitQuery    = "queryRate"
+
 	DefaultMetricType       = "L2"
 	DefaultPrimaryFieldName = "id"
 	DefaultVectorFieldName  = "vector"
@@ -128,5 +155,8 @@ const (
 	ParamRadius       = "radius"
 	ParamRangeFilter  = "range_filter"
 	ParamGroupByField = "group_by_field"
+	ParamPkIterator   = "pk_iterator"
+	ParamPkCursor     = "pk_iterator_cursor"
+	ParamAllowPartial = "allow_partial_results"
 	BoundedTimestamp  = 2
 )
@@ -20,7 +20,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -104,6 +106,8 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(UserCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &UserReq{} }, wrapperTraceLog(h.dropUser))))
 	router.POST(UserCategory+GrantRoleAction, timeoutMiddleware(wrapperPost(func() any { return &UserRoleReq{} }, wrapperTraceLog(h.addRoleToUser))))
 	router.POST(UserCategory+RevokeRoleAction, timeoutMiddleware(wrapperPost(func() any { return &UserRoleReq{} }, wrapperTraceLog(h.removeRoleFromUser))))
+	router.POST(UserCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &UserRateLimitsReq{} }, wrapperTraceLog(h.alterUserRateLimits))))
+	router.POST(UserCategory+DescribeRateLimitsAction, timeoutMiddleware(wrapperPost(func() any { return &UserReq{} }, wrapperTraceLog(h.describeUserRateLimits))))
 
 	router.POST(RoleCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listRoles))))
 	router.POST(RoleCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.describeRole))))
@@ -112,6 +116,8 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(RoleCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.dropRole))))
 	router.POST(RoleCategory+GrantPrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.addPrivilegeToRole))))
 	router.POST(RoleCategory+RevokePrivilegeAction, timeoutMiddleware(wrapperPost(func() any { return &GrantReq{} }, wrapperTraceLog(h.removePrivilegeFromRole))))
+	router.POST(RoleCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &RoleRateLimitsReq{} }, wrapperTraceLog(h.alterRoleRateLimits))))
+	router.POST(RoleCategory+DescribeRateLimitsAction, timeoutMiddleware(wrapperPost(func() any { return &RoleReq{} }, wrapperTraceLog(h.describeRoleRateLimits))))
 
 	router.POST(IndexCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listIndexes)))))
 	router.POST(IndexCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &IndexReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.describeIndex)))))
@@ -127,6 +133,20 @@ func (h *HandlersV2) RegisterRoutesToV2(router gin.IRouter) {
 	router.POST(AliasCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &AliasReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.dropAlias)))))
 	router.POST(AliasCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &AliasCollectionReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.alterAlias)))))
 
+	router.POST(DatabaseCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listDatabases))))
+	router.POST(DatabaseCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseNameReq{} }, wrapperTraceLog(h.describeDatabase))))
+
+	router.POST(DatabaseCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &DatabasePropertiesReq{} }, wrapperTraceLog(h.createDatabase))))
+	router.POST(DatabaseCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseNameReq{} }, wrapperTraceLog(h.dropDatabase))))
+	router.POST(DatabaseCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &DatabasePropertiesReq{} }, wrapperTraceLog(h.alterDatabase))))
+
+	router.POST(ResourceGroupCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &DatabaseReq{} }, wrapperTraceLog(h.listResourceGroups))))
+	router.POST(ResourceGroupCategory+DescribeAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.describeResourceGroup))))
+
+	router.POST(ResourceGroupCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupConfigReq{} }, wrapperTraceLog(h.createResourceGroup))))
+	router.POST(ResourceGroupCategory+DropAction, timeoutMiddleware(wrapperPost(func() any { return &ResourceGroupReq{} }, wrapperTraceLog(h.dropResourceGroup))))
+	router.POST(ResourceGroupCategory+AlterAction, timeoutMiddleware(wrapperPost(func() any { return &UpdateResourceGroupsReq{} }, wrapperTraceLog(h.updateResourceGroups))))
+
 	router.POST(ImportJobCategory+ListAction, timeoutMiddleware(wrapperPost(func() any { return &CollectionNameReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.listImportJob)))))
 	router.POST(ImportJobCategory+CreateAction, timeoutMiddleware(wrapperPost(func() any { return &DataFilesReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.createImportJob)))))
 	router.POST(ImportJobCategory+GetProgressAction, timeoutMiddleware(wrapperPost(func() any { return &TaskIDReq{} }, wrapperTraceLog(h.wrapperCheckDatabase(h.getImportJobProcess)))))
@@ -510,8 +530,15 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 	if httpReq.Limit > 0 {
 		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)})
 	}
+	if httpReq.Iterator {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamPkIterator, Value: strconv.FormatBool(true)})
+	}
+	if httpReq.Cursor != "" {
+		req.QueryParams = append(req.QueryParams, &commonpb.KeyValuePair{Key: ParamPkCursor, Value: httpReq.Cursor})
+	}
+	headers := &headerRecorder{method: "Query"}
 	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
-		return h.proxy.Query(reqCtx, req.(*milvuspb.QueryRequest))
+		return h.proxy.Query(grpc.NewContextWithServerTransportStream(reqCtx, headers), req.(*milvuspb.QueryRequest))
 	})
 	if err == nil {
 		queryResp := resp.(*milvuspb.QueryResults)
@@ -524,7 +551,11 @@ func (h *HandlersV2) query(ctx context.Context, c *gin.Context, anyReq any, dbNa
 				HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
 			})
 		} else {
-			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData})
+			ret := gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData}
+			if cursor := headers.header.Get(proxy.QueryIteratorCursorHeader); len(cursor) > 0 {
+				ret[HTTPReturnCursor] = cursor[0]
+			}
+			c.JSON(http.StatusOK, ret)
 		}
 	}
 	return resp, err
@@ -764,6 +795,15 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamOffset, Value: strconv.FormatInt(int64(httpReq.Offset), 10)})
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamGroupByField, Value: httpReq.GroupByField})
 	searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamRoundDecimal, Value: "-1"})
+	if httpReq.AllowPartial {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: ParamAllowPartial, Value: strconv.FormatBool(true)})
+	}
+	if httpReq.Iterator {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.SearchIteratorKey, Value: strconv.FormatBool(true)})
+	}
+	if httpReq.Cursor != "" {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: proxy.SearchIteratorCursorKey, Value: httpReq.Cursor})
+	}
 	req := &milvuspb.SearchRequest{
 		DbName:             dbName,
 		CollectionName:     httpReq.CollectionName,
@@ -776,13 +816,20 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 		GuaranteeTimestamp: BoundedTimestamp,
 		Nq:                 int64(1),
 	}
+	headers := &headerRecorder{method: "Search"}
 	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
-		return h.proxy.Search(reqCtx, req.(*milvuspb.SearchRequest))
+		return h.proxy.Search(grpc.NewContextWithServerTransportStream(reqCtx, headers), req.(*milvuspb.SearchRequest))
 	})
 	if err == nil {
 		searchResp := resp.(*milvuspb.SearchResults)
+		withCursor := func(ret gin.H) gin.H {
+			if cursor := headers.header.Get(proxy.SearchIteratorCursorHeader); len(cursor) > 0 {
+				ret[HTTPReturnCursor] = cursor[0]
+			}
+			return ret
+		}
 		if searchResp.Results.TopK == int64(0) {
-			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}})
+			c.JSON(http.StatusOK, withCursor(withPartialResults(gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}}, searchResp.GetStatus())))
 		} else {
 			allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
 			outputData, err := buildQueryResp(searchResp.Results.TopK, searchResp.Results.OutputFields, searchResp.Results.FieldsData, searchResp.Results.Ids, searchResp.Results.Scores, allowJS)
@@ -793,7 +840,7 @@ func (h *HandlersV2) search(ctx context.Context, c *gin.Context, anyReq any, dbN
 					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
 				})
 			} else {
-				c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData})
+				c.JSON(http.StatusOK, withCursor(withPartialResults(gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData}, searchResp.GetStatus())))
 			}
 		}
 	}
@@ -838,13 +885,16 @@ func (h *HandlersV2) hybridSearch(ctx context.Context, c *gin.Context, anyReq an
 		{Key: ParamLimit, Value: strconv.FormatInt(int64(httpReq.Limit), 10)},
 		{Key: ParamRoundDecimal, Value: "-1"},
 	}
+	if httpReq.AllowPartial {
+		req.RankParams = append(req.RankParams, &commonpb.KeyValuePair{Key: ParamAllowPartial, Value: strconv.FormatBool(true)})
+	}
 	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
 		return h.proxy.HybridSearch(reqCtx, req.(*milvuspb.HybridSearchRequest))
 	})
 	if err == nil {
 		searchResp := resp.(*milvuspb.SearchResults)
 		if searchResp.Results.TopK == int64(0) {
-			c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}})
+			c.JSON(http.StatusOK, withPartialResults(gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: []interface{}{}}, searchResp.GetStatus()))
 		} else {
 			allowJS, _ := strconv.ParseBool(c.Request.Header.Get(HTTPHeaderAllowInt64))
 			outputData, err := buildQueryResp(0, searchResp.Results.OutputFields, searchResp.Results.FieldsData, searchResp.Results.Ids, searchResp.Results.Scores, allowJS)
@@ -855,7 +905,7 @@ func (h *HandlersV2) hybridSearch(ctx context.Context, c *gin.Context, anyReq an
 					HTTPReturnMessage: merr.ErrInvalidSearchResult.Error() + ", error: " + err.Error(),
 				})
 			} else {
-				c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData})
+				c.JSON(http.StatusOK, withPartialResults(gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: outputData}, searchResp.GetStatus()))
 			}
 		}
 	}
@@ -1240,6 +1290,65 @@ func (h *HandlersV2) removeRoleFromUser(ctx context.Context, c *gin.Context, any
 	return h.operateRoleToUser(ctx, c, anyReq.(*UserRoleReq).UserName, anyReq.(*UserRoleReq).RoleName, milvuspb.OperateUserRoleType_RemoveUserFromRole)
 }
 
+func (h *HandlersV2) alterRateLimits(ctx context.Context, c *gin.Context, userName, roleName string, rateLimits map[string]float64) (interface{}, error) {
+	rates, err := convertToRates(rateLimits)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, invalid rate limits", zap.Any("rateLimits", rateLimits), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	req := &internalpb.AlterRateLimitsRequest{
+		UserName: userName,
+		RoleName: roleName,
+		Rates:    rates,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.AlterRateLimits(reqCtx, req.(*internalpb.AlterRateLimitsRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) describeRateLimits(ctx context.Context, c *gin.Context, userName, roleName string) (interface{}, error) {
+	req := &internalpb.DescribeRateLimitsRequest{
+		UserName: userName,
+		RoleName: roleName,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DescribeRateLimits(reqCtx, req.(*internalpb.DescribeRateLimitsRequest))
+	})
+	if err == nil {
+		rateLimits := convertFromRates(resp.(*internalpb.DescribeRateLimitsResponse).GetRates())
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: rateLimits})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) alterUserRateLimits(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*UserRateLimitsReq)
+	return h.alterRateLimits(ctx, c, httpReq.UserName, "", httpReq.RateLimits)
+}
+
+func (h *HandlersV2) describeUserRateLimits(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(UserNameGetter)
+	return h.describeRateLimits(ctx, c, getter.GetUserName(), "")
+}
+
+func (h *HandlersV2) alterRoleRateLimits(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*RoleRateLimitsReq)
+	return h.alterRateLimits(ctx, c, "", httpReq.RoleName, httpReq.RateLimits)
+}
+
+func (h *HandlersV2) describeRoleRateLimits(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(RoleNameGetter)
+	return h.describeRateLimits(ctx, c, "", getter.GetRoleName())
+}
+
 func (h *HandlersV2) listRoles(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	req := &milvuspb.SelectRoleRequest{}
 	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
@@ -1535,6 +1644,222 @@ func (h *HandlersV2) alterAlias(ctx context.Context, c *gin.Context, anyReq any,
 	return resp, err
 }
 
+func (h *HandlersV2) listDatabases(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &milvuspb.ListDatabasesRequest{}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListDatabases(reqCtx, req.(*milvuspb.ListDatabasesRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnList(resp.(*milvuspb.ListDatabasesResponse).DbNames))
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) describeDatabase(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &internalpb.DescribeDatabaseRequest{
+		DbName: dbName,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DescribeDatabase(reqCtx, req.(*internalpb.DescribeDatabaseRequest))
+	})
+	if err == nil {
+		response := resp.(*internalpb.DescribeDatabaseResponse)
+		properties := make(map[string]string, len(response.GetProperties()))
+		for _, kv := range response.GetProperties() {
+			properties[kv.GetKey()] = kv.GetValue()
+		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{
+			HTTPReturnDbName:     response.GetDbName(),
+			HTTPReturnDbID:       response.GetDbID(),
+			HTTPReturnProperties: properties,
+		}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createDatabase(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*DatabasePropertiesReq)
+	properties, err := convertToKeyValuePairs(httpReq.Properties)
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, invalid database properties", zap.Any("properties", httpReq.Properties), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	req := &milvuspb.CreateDatabaseRequest{
+		DbName: dbName,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateDatabase(reqCtx, req.(*milvuspb.CreateDatabaseRequest))
+	})
+	if err != nil {
+		return resp, err
+	}
+	// the properties can't be set on creation, alter the database right after it is created,
+	// and drop it if the properties could not be set, so that the creation is all or nothing
+	if len(properties) > 0 {
+		alterReq := &internalpb.AlterDatabaseRequest{
+			DbName:     dbName,
+			Properties: properties,
+		}
+		resp, err = wrapperProxy(ctx, c, alterReq, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+			return h.proxy.AlterDatabase(reqCtx, req.(*internalpb.AlterDatabaseRequest))
+		})
+		if err != nil {
+			dropReq := &milvuspb.DropDatabaseRequest{
+				DbName: dbName,
+			}
+			_, dropErr := wrapperProxy(ctx, c, dropReq, false, true, func(reqCtx context.Context, req any) (interface{}, error) {
+				return h.proxy.DropDatabase(reqCtx, req.(*milvuspb.DropDatabaseRequest))
+			})
+			if dropErr != nil {
+				log.Ctx(ctx).Warn("high level restful api, failed to drop the database after setting properties failed",
+					zap.String("database", dbName), zap.Error(dropErr))
+			}
+			return resp, err
+		}
+	}
+	c.JSON(http.StatusOK, wrapperReturnDefault())
+	return resp, err
+}
+
+func (h *HandlersV2) dropDatabase(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &milvuspb.DropDatabaseRequest{
+		DbName: dbName,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropDatabase(reqCtx, req.(*milvuspb.DropDatabaseRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) alterDatabase(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*DatabasePropertiesReq)
+	properties, err := convertToKeyValuePairs(httpReq.Properties)
+	if err == nil && len(properties) == 0 {
+		err = errors.New("database properties are required")
+	}
+	if err != nil {
+		log.Ctx(ctx).Warn("high level restful api, invalid database properties", zap.Any("properties", httpReq.Properties), zap.Error(err))
+		c.AbortWithStatusJSON(http.StatusOK, gin.H{
+			HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+			HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+		})
+		return nil, err
+	}
+	req := &internalpb.AlterDatabaseRequest{
+		DbName:     dbName,
+		Properties: properties,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.AlterDatabase(reqCtx, req.(*internalpb.AlterDatabaseRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) listResourceGroups(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	req := &milvuspb.ListResourceGroupsRequest{}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.ListResourceGroups(reqCtx, req.(*milvuspb.ListResourceGroupsRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnList(resp.(*milvuspb.ListResourceGroupsResponse).ResourceGroups))
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) describeResourceGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(ResourceGroupNameGetter)
+	req := &milvuspb.DescribeResourceGroupRequest{
+		ResourceGroup: getter.GetResourceGroupName(),
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DescribeResourceGroup(reqCtx, req.(*milvuspb.DescribeResourceGroupRequest))
+	})
+	if err == nil {
+		rg := resp.(*milvuspb.DescribeResourceGroupResponse).GetResourceGroup()
+		nodes := make([]int64, 0, len(rg.GetNodes()))
+		for _, node := range rg.GetNodes() {
+			nodes = append(nodes, node.GetNodeID())
+		}
+		c.JSON(http.StatusOK, gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{
+			HTTPResourceGroupName:      rg.GetName(),
+			HTTPReturnCapacity:         rg.GetCapacity(),
+			HTTPReturnNumAvailableNode: rg.GetNumAvailableNode(),
+			HTTPReturnNumLoadedReplica: rg.GetNumLoadedReplica(),
+			HTTPReturnNumOutgoingNode:  rg.GetNumOutgoingNode(),
+			HTTPReturnNumIncomingNode:  rg.GetNumIncomingNode(),
+			HTTPReturnConfig:           convertFromResourceGroupConfig(rg.GetConfig()),
+			HTTPReturnNodes:            nodes,
+		}})
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) createResourceGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*ResourceGroupConfigReq)
+	req := &milvuspb.CreateResourceGroupRequest{
+		ResourceGroup: httpReq.GetResourceGroupName(),
+		Config:        convertToResourceGroupConfig(httpReq.Config),
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.CreateResourceGroup(reqCtx, req.(*milvuspb.CreateResourceGroupRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) dropResourceGroup(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	getter, _ := anyReq.(ResourceGroupNameGetter)
+	req := &milvuspb.DropResourceGroupRequest{
+		ResourceGroup: getter.GetResourceGroupName(),
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.DropResourceGroup(reqCtx, req.(*milvuspb.DropResourceGroupRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
+func (h *HandlersV2) updateResourceGroups(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
+	httpReq := anyReq.(*UpdateResourceGroupsReq)
+	resourceGroups := make(map[string]*rgpb.ResourceGroupConfig, len(httpReq.ResourceGroups))
+	for name, config := range httpReq.ResourceGroups {
+		if config == nil {
+			err := errors.Newf("config of resource group %s is required", name)
+			log.Ctx(ctx).Warn("high level restful api, invalid resource group config", zap.Error(err))
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{
+				HTTPReturnCode:    merr.Code(merr.ErrIncorrectParameterFormat),
+				HTTPReturnMessage: merr.ErrIncorrectParameterFormat.Error() + ", error: " + err.Error(),
+			})
+			return nil, err
+		}
+		resourceGroups[name] = convertToResourceGroupConfig(config)
+	}
+	req := &milvuspb.UpdateResourceGroupsRequest{
+		ResourceGroups: resourceGroups,
+	}
+	resp, err := wrapperProxy(ctx, c, req, h.checkAuth, false, func(reqCtx context.Context, req any) (interface{}, error) {
+		return h.proxy.UpdateResourceGroups(reqCtx, req.(*milvuspb.UpdateResourceGroupsRequest))
+	})
+	if err == nil {
+		c.JSON(http.StatusOK, wrapperReturnDefault())
+	}
+	return resp, err
+}
+
 func (h *HandlersV2) listImportJob(ctx context.Context, c *gin.Context, anyReq any, dbName string) (interface{}, error) {
 	collectionGetter, _ := anyReq.(requestutil.CollectionNameGetter)
 	limitGetter, _ := anyReq.(LimitGetter)
@@ -13,13 +13,18 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -670,10 +675,47 @@ func TestMethodGet(t *testing.T) {
 			},
 		},
 	}, nil).Once()
+	mp.EXPECT().DescribeRateLimits(mock.Anything, mock.Anything).Return(&internalpb.DescribeRateLimitsResponse{
+		Status: &StatusSuccess,
+		Rates: []*internalpb.Rate{
+			{Rt: internalpb.RateType_DMLInsert, R: 1024 * 1024},
+			{Rt: internalpb.RateType_DQLSearch, R: 100},
+		},
+	}, nil).Twice()
 	mp.EXPECT().ListAliases(mock.Anything, mock.Anything).Return(&milvuspb.ListAliasesResponse{
 		Status:  &StatusSuccess,
 		Aliases: []string{DefaultAliasName},
 	}, nil).Once()
+	mp.EXPECT().ListDatabases(mock.Anything, mock.Anything).Return(&milvuspb.ListDatabasesResponse{
+		Status:  &StatusSuccess,
+		DbNames: []string{DefaultDbName},
+	}, nil).Once()
+	mp.EXPECT().DescribeDatabase(mock.Anything, mock.Anything).Return(&internalpb.DescribeDatabaseResponse{
+		Status: &StatusSuccess,
+		DbName: DefaultDbName,
+		DbID:   1,
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.DatabaseReplicaNumber, Value: "2"},
+			{Key: common.DatabaseResourceGroups, Value: "rg1,rg2"},
+		},
+	}, nil).Once()
+	mp.EXPECT().ListResourceGroups(mock.Anything, mock.Anything).Return(&milvuspb.ListResourceGroupsResponse{
+		Status:         &StatusSuccess,
+		ResourceGroups: []string{"__default_resource_group", "rg1"},
+	}, nil).Once()
+	mp.EXPECT().DescribeResourceGroup(mock.Anything, mock.Anything).Return(&milvuspb.DescribeResourceGroupResponse{
+		Status: &StatusSuccess,
+		ResourceGroup: &milvuspb.ResourceGroup{
+			Name:             "rg1",
+			Capacity:         1,
+			NumAvailableNode: 1,
+			Config: &rgpb.ResourceGroupConfig{
+				Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+				Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+			},
+			Nodes: []*commonpb.NodeInfo{{NodeID: 1}},
+		},
+	}, nil).Once()
 	mp.EXPECT().DescribeAlias(mock.Anything, mock.Anything).Return(&milvuspb.DescribeAliasResponse{
 		Status: &StatusSuccess,
 		Alias:  DefaultAliasName,
@@ -773,6 +815,12 @@ func TestMethodGet(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(RoleCategory, DescribeAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(UserCategory, DescribeRateLimitsAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(RoleCategory, DescribeRateLimitsAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(IndexCategory, ListAction),
 	})
@@ -791,6 +839,18 @@ func TestMethodGet(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(AliasCategory, DescribeAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(DatabaseCategory, ListAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(DatabaseCategory, DescribeAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ResourceGroupCategory, ListAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ResourceGroupCategory, DescribeAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(ImportJobCategory, ListAction),
 	})
@@ -807,6 +867,8 @@ func TestMethodGet(t *testing.T) {
 				`"userName": "` + util.UserRoot + `",` +
 				`"roleName": "` + util.RoleAdmin + `",` +
 				`"aliasName": "` + DefaultAliasName + `",` +
+				`"dbName": "` + DefaultDbName + `",` +
+				`"resourceGroupName": "rg1",` +
 				`"taskID": 1234567890` +
 				`}`))
 			req := httptest.NewRequest(http.MethodPost, testcase.path, bodyReader)
@@ -846,6 +908,8 @@ func TestMethodDelete(t *testing.T) {
 	mp.EXPECT().DropRole(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().DropIndex(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().DropAlias(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().DropDatabase(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().DropResourceGroup(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	testEngine := initHTTPServerV2(mp, false)
 	queryTestCases := []rawTestCase{}
 	queryTestCases = append(queryTestCases, rawTestCase{
@@ -866,10 +930,16 @@ func TestMethodDelete(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(AliasCategory, DropAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(DatabaseCategory, DropAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ResourceGroupCategory, DropAction),
+	})
 	for _, testcase := range queryTestCases {
 		t.Run("query", func(t *testing.T) {
 			bodyReader := bytes.NewReader([]byte(`{"collectionName": "` + DefaultCollectionName + `", "partitionName": "` + DefaultPartitionName +
-				`", "userName": "` + util.UserRoot + `", "roleName": "` + util.RoleAdmin + `", "indexName": "` + DefaultIndexName + `", "aliasName": "` + DefaultAliasName + `"}`))
+				`", "userName": "` + util.UserRoot + `", "roleName": "` + util.RoleAdmin + `", "indexName": "` + DefaultIndexName + `", "aliasName": "` + DefaultAliasName + `", "dbName": "` + DefaultDbName + `", "resourceGroupName": "rg1"}`))
 			req := httptest.NewRequest(http.MethodPost, testcase.path, bodyReader)
 			w := httptest.NewRecorder()
 			testEngine.ServeHTTP(w, req)
@@ -902,12 +972,17 @@ func TestMethodPost(t *testing.T) {
 	mp.EXPECT().UpdateCredential(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().OperateUserRole(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
 	mp.EXPECT().CreateRole(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().AlterRateLimits(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
 	mp.EXPECT().OperatePrivilege(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
 	mp.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
 	mp.EXPECT().CreateIndex(mock.Anything, mock.Anything).Return(commonErrorStatus, nil).Once()
 	mp.EXPECT().CreateAlias(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().AlterAlias(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	mp.EXPECT().Import(mock.Anything, mock.Anything).Return(&milvuspb.ImportResponse{Status: commonSuccessStatus, Tasks: []int64{int64(1234567890)}}, nil).Once()
+	mp.EXPECT().CreateDatabase(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().AlterDatabase(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Twice()
+	mp.EXPECT().CreateResourceGroup(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().UpdateResourceGroups(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
 	testEngine := initHTTPServerV2(mp, false)
 	queryTestCases := []rawTestCase{}
 	queryTestCases = append(queryTestCases, rawTestCase{
@@ -952,6 +1027,12 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(RoleCategory, RevokePrivilegeAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(UserCategory, AlterAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(RoleCategory, AlterAction),
+	})
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(IndexCategory, CreateAction),
 	})
@@ -969,6 +1050,18 @@ func TestMethodPost(t *testing.T) {
 	queryTestCases = append(queryTestCases, rawTestCase{
 		path: versionalV2(ImportJobCategory, CreateAction),
 	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(DatabaseCategory, CreateAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(DatabaseCategory, AlterAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ResourceGroupCategory, CreateAction),
+	})
+	queryTestCases = append(queryTestCases, rawTestCase{
+		path: versionalV2(ResourceGroupCategory, AlterAction),
+	})
 
 	for _, testcase := range queryTestCases {
 		t.Run("query", func(t *testing.T) {
@@ -980,6 +1073,10 @@ func TestMethodPost(t *testing.T) {
 				`"userName": "` + util.UserRoot + `", "password": "Milvus", "newPassword": "milvus", "roleName": "` + util.RoleAdmin + `",` +
 				`"roleName": "` + util.RoleAdmin + `", "objectType": "Global", "objectName": "*", "privilege": "*",` +
 				`"aliasName": "` + DefaultAliasName + `",` +
+				`"rateLimits": {"insertRate": 1, "searchRate": 100},` +
+				`"dbName": "` + DefaultDbName + `", "properties": {"database.replica.number": 2, "database.resource_groups": ["rg1", "rg2"]},` +
+				`"resourceGroupName": "rg1", "config": {"requests": {"nodeNum": 1}, "limits": {"nodeNum": 2}, "transferFrom": ["rg2"]},` +
+				`"resourceGroups": {"rg1": {"requests": {"nodeNum": 2}, "limits": {"nodeNum": 3}, "transferTo": ["rg2"]}},` +
 				`"files": ["book.json"]` +
 				`}`))
 			req := httptest.NewRequest(http.MethodPost, testcase.path, bodyReader)
@@ -1000,6 +1097,28 @@ func TestMethodPost(t *testing.T) {
 	}
 }
 
+func TestCreateDatabaseWithProperties(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().CreateDatabase(mock.Anything, mock.Anything).Return(commonSuccessStatus, nil).Once()
+	mp.EXPECT().AlterDatabase(mock.Anything, mock.Anything).Return(commonErrorStatus, nil).Once()
+	mp.EXPECT().DropDatabase(mock.Anything, mock.MatchedBy(func(req *milvuspb.DropDatabaseRequest) bool {
+		return req.GetDbName() == "db1"
+	})).Return(commonSuccessStatus, nil).Once()
+	testEngine := initHTTPServerV2(mp, false)
+
+	// the database is dropped if its properties could not be set
+	bodyReader := bytes.NewReader([]byte(`{"dbName": "db1", "properties": {"database.replica.number": 2}}`))
+	req := httptest.NewRequest(http.MethodPost, versionalV2(DatabaseCategory, CreateAction), bodyReader)
+	w := httptest.NewRecorder()
+	testEngine.ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+	returnBody := &ReturnErrMsg{}
+	err := json.Unmarshal(w.Body.Bytes(), returnBody)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(65535), returnBody.Code)
+}
+
 func TestDML(t *testing.T) {
 	paramtable.Init()
 	mp := mocks.NewMockProxy(t)
@@ -1135,3 +1254,77 @@ func TestDML(t *testing.T) {
 		})
 	}
 }
+
+func TestQueryIterator(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().Query(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.QueryRequest) (*milvuspb.QueryResults, error) {
+		params := make(map[string]string)
+		for _, kv := range req.GetQueryParams() {
+			params[kv.GetKey()] = kv.GetValue()
+		}
+		cursor := "first"
+		if params[ParamPkCursor] != "" {
+			cursor = params[ParamPkCursor] + "-next"
+		} else {
+			assert.Equal(t, "true", params[ParamPkIterator])
+		}
+		assert.NoError(t, grpc.SetHeader(ctx, metadata.Pairs(proxy.QueryIteratorCursorHeader, cursor)))
+		return &milvuspb.QueryResults{Status: commonSuccessStatus, OutputFields: []string{}, FieldsData: []*schemapb.FieldData{}}, nil
+	}).Twice()
+	testEngine := initHTTPServerV2(mp, false)
+
+	query := func(body string) map[string]any {
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, QueryAction), bytes.NewReader([]byte(body)))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		ret := make(map[string]any)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &ret))
+		return ret
+	}
+	ret := query(`{"collectionName": "book", "filter": "word_count > 0", "limit": 10, "iterator": true}`)
+	assert.EqualValues(t, http.StatusOK, ret[HTTPReturnCode])
+	assert.Equal(t, "first", ret[HTTPReturnCursor])
+
+	ret = query(`{"collectionName": "book", "filter": "word_count > 0", "limit": 10, "cursor": "first"}`)
+	assert.EqualValues(t, http.StatusOK, ret[HTTPReturnCode])
+	assert.Equal(t, "first-next", ret[HTTPReturnCursor])
+}
+
+func TestSearchIterator(t *testing.T) {
+	paramtable.Init()
+	mp := mocks.NewMockProxy(t)
+	mp.EXPECT().Search(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+		params := make(map[string]string)
+		for _, kv := range req.GetSearchParams() {
+			params[kv.GetKey()] = kv.GetValue()
+		}
+		cursor := "first"
+		if params[proxy.SearchIteratorCursorKey] != "" {
+			cursor = params[proxy.SearchIteratorCursorKey] + "-next"
+		} else {
+			assert.Equal(t, "true", params[proxy.SearchIteratorKey])
+		}
+		assert.NoError(t, grpc.SetHeader(ctx, metadata.Pairs(proxy.SearchIteratorCursorHeader, cursor)))
+		return &milvuspb.SearchResults{Status: commonSuccessStatus, Results: &schemapb.SearchResultData{TopK: int64(0)}}, nil
+	}).Twice()
+	testEngine := initHTTPServerV2(mp, false)
+
+	search := func(body string) map[string]any {
+		req := httptest.NewRequest(http.MethodPost, versionalV2(EntityCategory, SearchAction), bytes.NewReader([]byte(body)))
+		w := httptest.NewRecorder()
+		testEngine.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+		ret := make(map[string]any)
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &ret))
+		return ret
+	}
+	ret := search(`{"collectionName": "book", "vector": [[0.1, 0.2]], "limit": 10, "iterator": true}`)
+	assert.EqualValues(t, http.StatusOK, ret[HTTPReturnCode])
+	assert.Equal(t, "first", ret[HTTPReturnCursor])
+
+	ret = search(`{"collectionName": "book", "vector": [[0.1, 0.2]], "limit": 10, "cursor": "first"}`)
+	assert.EqualValues(t, http.StatusOK, ret[HTTPReturnCode])
+	assert.Equal(t, "first-next", ret[HTTPReturnCursor])
+}
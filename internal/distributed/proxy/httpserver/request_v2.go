@@ -15,6 +15,19 @@ type DatabaseReq struct {
 
 func (req *DatabaseReq) GetDbName() string { return req.DbName }
 
+type DatabaseNameReq struct {
+	DbName string `json:"dbName" binding:"required"`
+}
+
+func (req *DatabaseNameReq) GetDbName() string { return req.DbName }
+
+type DatabasePropertiesReq struct {
+	DbName     string                 `json:"dbName" binding:"required"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+func (req *DatabasePropertiesReq) GetDbName() string { return req.DbName }
+
 type CollectionNameReq struct {
 	DbName         string   `json:"dbName"`
 	CollectionName string   `json:"collectionName" binding:"required"`
@@ -90,6 +103,8 @@ type QueryReqV2 struct {
 	Filter         string   `json:"filter" binding:"required"`
 	Limit          int32    `json:"limit"`
 	Offset         int32    `json:"offset"`
+	Iterator       bool     `json:"iterator"`
+	Cursor         string   `json:"cursor"`
 }
 
 func (req *QueryReqV2) GetDbName() string { return req.DbName }
@@ -135,6 +150,9 @@ type SearchReqV2 struct {
 	Offset         int32              `json:"offset"`
 	OutputFields   []string           `json:"outputFields"`
 	Params         map[string]float64 `json:"params"`
+	AllowPartial   bool               `json:"allowPartialResults"`
+	Iterator       bool               `json:"iterator"`
+	Cursor         string             `json:"cursor"`
 }
 
 func (req *SearchReqV2) GetDbName() string { return req.DbName }
@@ -164,6 +182,7 @@ type HybridSearchReq struct {
 	Rerank         Rand           `json:"rerank"`
 	Limit          int32          `json:"limit"`
 	OutputFields   []string       `json:"outputFields"`
+	AllowPartial   bool           `json:"allowPartialResults"`
 }
 
 func (req *HybridSearchReq) GetDbName() string { return req.DbName }
@@ -203,6 +222,9 @@ type IndexNameGetter interface {
 type AliasNameGetter interface {
 	GetAliasName() string
 }
+type ResourceGroupNameGetter interface {
+	GetResourceGroupName() string
+}
 type LimitGetter interface {
 	GetLimit() int32
 }
@@ -237,6 +259,20 @@ func (req *RoleReq) GetRoleName() string {
 	return req.RoleName
 }
 
+type UserRateLimitsReq struct {
+	UserName   string             `json:"userName" binding:"required"`
+	RateLimits map[string]float64 `json:"rateLimits"`
+}
+
+func (req *UserRateLimitsReq) GetUserName() string { return req.UserName }
+
+type RoleRateLimitsReq struct {
+	RoleName   string             `json:"roleName" binding:"required"`
+	RateLimits map[string]float64 `json:"rateLimits"`
+}
+
+func (req *RoleRateLimitsReq) GetRoleName() string { return req.RoleName }
+
 type GrantReq struct {
 	RoleName   string `json:"roleName" binding:"required"`
 	ObjectType string `json:"objectType" binding:"required"`
@@ -326,6 +362,41 @@ func (req *AliasCollectionReq) GetAliasName() string {
 	return req.AliasName
 }
 
+// ResourceGroupLimit is the json form of rgpb.ResourceGroupLimit.
+type ResourceGroupLimit struct {
+	NodeNum int32 `json:"nodeNum"`
+}
+
+// ResourceGroupConfig is the json form of rgpb.ResourceGroupConfig,
+// the transfer groups are flattened into names.
+type ResourceGroupConfig struct {
+	Requests     *ResourceGroupLimit `json:"requests"`
+	Limits       *ResourceGroupLimit `json:"limits"`
+	TransferFrom []string            `json:"transferFrom"`
+	TransferTo   []string            `json:"transferTo"`
+}
+
+type ResourceGroupReq struct {
+	ResourceGroupName string `json:"resourceGroupName" binding:"required"`
+}
+
+func (req *ResourceGroupReq) GetResourceGroupName() string {
+	return req.ResourceGroupName
+}
+
+type ResourceGroupConfigReq struct {
+	ResourceGroupName string               `json:"resourceGroupName" binding:"required"`
+	Config            *ResourceGroupConfig `json:"config"`
+}
+
+func (req *ResourceGroupConfigReq) GetResourceGroupName() string {
+	return req.ResourceGroupName
+}
+
+type UpdateResourceGroupsReq struct {
+	ResourceGroups map[string]*ResourceGroupConfig `json:"resourceGroups" binding:"required"`
+}
+
 func wrapperReturnHas(has bool) gin.H {
 	return gin.H{HTTPReturnCode: http.StatusOK, HTTPReturnData: gin.H{HTTPReturnHas: has}}
 }
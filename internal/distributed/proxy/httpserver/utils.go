@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"math"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -15,10 +16,14 @@ import (
 	"github.com/spf13/cast"
 	"github.com/tidwall/gjson"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util"
@@ -1104,3 +1109,184 @@ func formatInt64(intArray []int64) []string {
 	}
 	return stringArray
 }
+
+// rateLimitTypes maps the rate limit names of RESTful api to rate types.
+var rateLimitTypes = map[string]internalpb.RateType{
+	HTTPRateLimitInsert:   internalpb.RateType_DMLInsert,
+	HTTPRateLimitUpsert:   internalpb.RateType_DMLUpsert,
+	HTTPRateLimitDelete:   internalpb.RateType_DMLDelete,
+	HTTPRateLimitBulkLoad: internalpb.RateType_DMLBulkLoad,
+	HTTPRateLimitSearch:   internalpb.RateType_DQLSearch,
+	HTTPRateLimitQuery:    internalpb.RateType_DQLQuery,
+}
+
+func isDMLRateType(rt internalpb.RateType) bool {
+	switch rt {
+	case internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete, internalpb.RateType_DMLBulkLoad:
+		return true
+	default:
+		return false
+	}
+}
+
+// convertToRates converts the rate limits of user or role to rates, DML rates are converted from MB/s to bytes/s.
+func convertToRates(rateLimits map[string]float64) ([]*internalpb.Rate, error) {
+	rates := make([]*internalpb.Rate, 0, len(rateLimits))
+	for name, limit := range rateLimits {
+		rt, ok := rateLimitTypes[name]
+		if !ok {
+			return nil, merr.WrapErrParameterInvalidMsg("unknown rate limit %s", name)
+		}
+		if isDMLRateType(rt) {
+			limit = limit * 1024 * 1024
+		}
+		rates = append(rates, &internalpb.Rate{Rt: rt, R: limit})
+	}
+	return rates, nil
+}
+
+// convertFromRates converts the rates to the rate limits of user or role, DML rates are converted from bytes/s to MB/s.
+func convertFromRates(rates []*internalpb.Rate) map[string]float64 {
+	rateLimits := make(map[string]float64, len(rates))
+	for name, rt := range rateLimitTypes {
+		for _, rate := range rates {
+			if rate.GetRt() != rt {
+				continue
+			}
+			limit := rate.GetR()
+			if isDMLRateType(rt) {
+				limit = limit / 1024 / 1024
+			}
+			rateLimits[name] = limit
+		}
+	}
+	return rateLimits
+}
+
+// convertToKeyValuePairs converts the properties of restful request into key value pairs,
+// the elements of an array value are joined by comma, e.g. the resource groups of a database.
+func convertToKeyValuePairs(properties map[string]interface{}) ([]*commonpb.KeyValuePair, error) {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]*commonpb.KeyValuePair, 0, len(keys))
+	for _, key := range keys {
+		value, err := convertPropertyValue(properties[key])
+		if err != nil {
+			return nil, fmt.Errorf("invalid value of property %s: %w", key, err)
+		}
+		pairs = append(pairs, &commonpb.KeyValuePair{Key: key, Value: value})
+	}
+	return pairs, nil
+}
+
+func convertPropertyValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return v, nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case []interface{}:
+		values := make([]string, 0, len(v))
+		for _, elem := range v {
+			str, err := convertPropertyValue(elem)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, str)
+		}
+		return strings.Join(values, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported type %T", value)
+	}
+}
+
+// convertToResourceGroupConfig converts the resource group config of restful request into rgpb.ResourceGroupConfig,
+// nil is returned if no config is given so that the resource group keeps the legacy capacity semantics.
+func convertToResourceGroupConfig(config *ResourceGroupConfig) *rgpb.ResourceGroupConfig {
+	if config == nil {
+		return nil
+	}
+	toTransfers := func(names []string) []*rgpb.ResourceGroupTransfer {
+		transfers := make([]*rgpb.ResourceGroupTransfer, 0, len(names))
+		for _, name := range names {
+			transfers = append(transfers, &rgpb.ResourceGroupTransfer{ResourceGroup: name})
+		}
+		return transfers
+	}
+	ret := &rgpb.ResourceGroupConfig{
+		From: toTransfers(config.TransferFrom),
+		To:   toTransfers(config.TransferTo),
+	}
+	if config.Requests != nil {
+		ret.Requests = &rgpb.ResourceGroupLimit{NodeNum: config.Requests.NodeNum}
+	}
+	if config.Limits != nil {
+		ret.Limits = &rgpb.ResourceGroupLimit{NodeNum: config.Limits.NodeNum}
+	}
+	return ret
+}
+
+// convertFromResourceGroupConfig is the reverse of convertToResourceGroupConfig.
+func convertFromResourceGroupConfig(config *rgpb.ResourceGroupConfig) *ResourceGroupConfig {
+	if config == nil {
+		return nil
+	}
+	fromTransfers := func(transfers []*rgpb.ResourceGroupTransfer) []string {
+		names := make([]string, 0, len(transfers))
+		for _, transfer := range transfers {
+			names = append(names, transfer.GetResourceGroup())
+		}
+		return names
+	}
+	ret := &ResourceGroupConfig{
+		TransferFrom: fromTransfers(config.GetFrom()),
+		TransferTo:   fromTransfers(config.GetTo()),
+	}
+	if config.GetRequests() != nil {
+		ret.Requests = &ResourceGroupLimit{NodeNum: config.GetRequests().GetNodeNum()}
+	}
+	if config.GetLimits() != nil {
+		ret.Limits = &ResourceGroupLimit{NodeNum: config.GetLimits().GetNodeNum()}
+	}
+	return ret
+}
+
+// headerRecorder records the grpc headers set by proxy,
+// the restful apis have no grpc stream to send them so they are returned in the response body.
+type headerRecorder struct {
+	method string
+	header metadata.MD
+}
+
+func (r *headerRecorder) Method() string {
+	return r.method
+}
+
+func (r *headerRecorder) SetHeader(md metadata.MD) error {
+	r.header = metadata.Join(r.header, md)
+	return nil
+}
+
+func (r *headerRecorder) SendHeader(md metadata.MD) error {
+	return r.SetHeader(md)
+}
+
+func (r *headerRecorder) SetTrailer(md metadata.MD) error {
+	return nil
+}
+
+// withPartialResults adds the missing shards and the searched fraction of the partial results to the response.
+func withPartialResults(ret gin.H, status *commonpb.Status) gin.H {
+	if info, ok := proxy.GetPartialResultsInfo(status); ok {
+		ret[HTTPReturnMissingShards] = info.MissingChannels
+		ret[HTTPReturnSearchedFraction] = info.SearchedFraction
+	}
+	return ret
+}
@@ -14,7 +14,10 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 const (
@@ -1235,3 +1238,85 @@ func TestBuildQueryResps(t *testing.T) {
 	_, err = buildQueryResp(int64(0), outputFields, newFieldData(generateFieldData(), schemapb.DataType_None), generateIds(schemapb.DataType_Int64, 3), []float32{0.01, 0.04}, true)
 	assert.Equal(t, nil, err)
 }
+
+func TestConvertRates(t *testing.T) {
+	rates, err := convertToRates(map[string]float64{
+		HTTPRateLimitInsert: 2,
+		HTTPRateLimitSearch: 100,
+	})
+	assert.NoError(t, err)
+	assert.Len(t, rates, 2)
+	for _, rate := range rates {
+		switch rate.GetRt() {
+		case internalpb.RateType_DMLInsert:
+			assert.Equal(t, float64(2*1024*1024), rate.GetR())
+		case internalpb.RateType_DQLSearch:
+			assert.Equal(t, float64(100), rate.GetR())
+		default:
+			t.FailNow()
+		}
+	}
+
+	rateLimits := convertFromRates(rates)
+	assert.Equal(t, map[string]float64{HTTPRateLimitInsert: 2, HTTPRateLimitSearch: 100}, rateLimits)
+
+	_, err = convertToRates(map[string]float64{"unknownRate": 1})
+	assert.Error(t, err)
+}
+
+func TestConvertToKeyValuePairs(t *testing.T) {
+	pairs, err := convertToKeyValuePairs(map[string]interface{}{
+		common.DatabaseReplicaNumber:    float64(2),
+		common.DatabaseResourceGroups:   []interface{}{"rg1", "rg2"},
+		common.DatabaseQueryRateMaxKey:  1.5,
+		common.DatabaseInsertRateMaxKey: nil,
+		common.MmapEnabledKey:           true,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []*commonpb.KeyValuePair{
+		{Key: common.DatabaseInsertRateMaxKey, Value: ""},
+		{Key: common.DatabaseQueryRateMaxKey, Value: "1.5"},
+		{Key: common.DatabaseReplicaNumber, Value: "2"},
+		{Key: common.DatabaseResourceGroups, Value: "rg1,rg2"},
+		{Key: common.MmapEnabledKey, Value: "true"},
+	}, pairs)
+
+	_, err = convertToKeyValuePairs(map[string]interface{}{
+		common.DatabaseReplicaNumber: map[string]interface{}{"num": 2},
+	})
+	assert.Error(t, err)
+}
+
+func TestConvertResourceGroupConfig(t *testing.T) {
+	assert.Nil(t, convertToResourceGroupConfig(nil))
+	assert.Nil(t, convertFromResourceGroupConfig(nil))
+
+	config := &ResourceGroupConfig{
+		Requests:     &ResourceGroupLimit{NodeNum: 1},
+		Limits:       &ResourceGroupLimit{NodeNum: 2},
+		TransferFrom: []string{"rg1"},
+		TransferTo:   []string{"rg2", "rg3"},
+	}
+	pbConfig := convertToResourceGroupConfig(config)
+	assert.Equal(t, int32(1), pbConfig.GetRequests().GetNodeNum())
+	assert.Equal(t, int32(2), pbConfig.GetLimits().GetNodeNum())
+	assert.Equal(t, "rg1", pbConfig.GetFrom()[0].GetResourceGroup())
+	assert.Len(t, pbConfig.GetTo(), 2)
+	assert.Equal(t, config, convertFromResourceGroupConfig(pbConfig))
+
+	pbConfig = convertToResourceGroupConfig(&ResourceGroupConfig{Limits: &ResourceGroupLimit{NodeNum: 2}})
+	assert.Nil(t, pbConfig.GetRequests())
+	assert.Equal(t, int32(2), pbConfig.GetLimits().GetNodeNum())
+}
+
+func TestWithPartialResults(t *testing.T) {
+	ret := withPartialResults(gin.H{HTTPReturnCode: 200}, merr.Success())
+	assert.NotContains(t, ret, HTTPReturnMissingShards)
+	assert.NotContains(t, ret, HTTPReturnSearchedFraction)
+
+	status := merr.Success(proxy.PartialResultsReason)
+	status.Detail = `{"missing_channels":["ch1","ch2"],"searched_fraction":0.5}`
+	ret = withPartialResults(gin.H{HTTPReturnCode: 200}, status)
+	assert.Equal(t, []string{"ch1", "ch2"}, ret[HTTPReturnMissingShards])
+	assert.Equal(t, 0.5, ret[HTTPReturnSearchedFraction])
+}
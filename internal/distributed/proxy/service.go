@@ -267,7 +267,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 
 	opts := tracer.GetInterceptorOpts()
 
-	var unaryServerOption grpc.ServerOption
+	var unaryServerOption, streamServerOption grpc.ServerOption
 	if enableCustomInterceptor {
 		unaryServerOption = grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
 			accesslog.UnaryAccessLogInterceptor,
@@ -282,8 +282,17 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 			proxy.TraceLogInterceptor,
 			connection.KeepActiveInterceptor,
 		))
+		streamServerOption = grpc.StreamInterceptor(grpc_middleware.ChainStreamServer(
+			accesslog.StreamAccessLogInterceptor,
+			otelgrpc.StreamServerInterceptor(opts...),
+			grpc_auth.StreamServerInterceptor(proxy.AuthenticationInterceptor),
+			logutil.StreamTraceLoggerInterceptor,
+			proxy.StreamServerInterceptor(limiter),
+			accesslog.StreamUpdateAccessInfoInterceptor,
+		))
 	} else {
 		unaryServerOption = grpc.EmptyServerOption{}
+		streamServerOption = grpc.EmptyServerOption{}
 	}
 
 	grpcOpts := []grpc.ServerOption{
@@ -292,6 +301,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize.GetAsInt()),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize.GetAsInt()),
 		unaryServerOption,
+		streamServerOption,
 	}
 
 	if Params.TLSMode.GetAsInt() == 1 {
@@ -339,6 +349,7 @@ func (s *Server) startExternalGrpc(grpcPort int, errChan chan error) {
 	}
 
 	milvuspb.RegisterMilvusServiceServer(s.grpcExternalServer, s)
+	proxypb.RegisterProxyStreamServer(s.grpcExternalServer, s)
 	grpc_health_v1.RegisterHealthServer(s.grpcExternalServer, s)
 	errChan <- nil
 
@@ -920,6 +931,16 @@ func (s *Server) Query(ctx context.Context, request *milvuspb.QueryRequest) (*mi
 	return s.proxy.Query(ctx, request)
 }
 
+// QueryStream streams the results of query request in batches.
+func (s *Server) QueryStream(request *milvuspb.QueryRequest, srv proxypb.ProxyStream_QueryStreamServer) error {
+	return s.proxy.QueryStream(request, srv)
+}
+
+// SearchStream streams the results of search request in batches.
+func (s *Server) SearchStream(request *milvuspb.SearchRequest, srv proxypb.ProxyStream_SearchStreamServer) error {
+	return s.proxy.SearchStream(request, srv)
+}
+
 func (s *Server) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	return s.proxy.CalcDistance(ctx, request)
 }
@@ -1123,6 +1144,10 @@ func (s *Server) SetRates(ctx context.Context, request *proxypb.SetRatesRequest)
 	return s.proxy.SetRates(ctx, request)
 }
 
+func (s *Server) InvalidateQueryCache(ctx context.Context, request *proxypb.InvalidateQueryCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateQueryCache(ctx, request)
+}
+
 // GetProxyMetrics gets the metrics of proxy.
 func (s *Server) GetProxyMetrics(ctx context.Context, request *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.proxy.GetProxyMetrics(ctx, request)
@@ -1156,6 +1181,10 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *milvuspb.Descri
 	return s.proxy.DescribeResourceGroup(ctx, req)
 }
 
+func (s *Server) UpdateResourceGroups(ctx context.Context, req *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	return s.proxy.UpdateResourceGroups(ctx, req)
+}
+
 func (s *Server) TransferNode(ctx context.Context, req *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
 	return s.proxy.TransferNode(ctx, req)
 }
@@ -1188,6 +1217,22 @@ func (s *Server) ListClientInfos(ctx context.Context, req *proxypb.ListClientInf
 	return s.proxy.ListClientInfos(ctx, req)
 }
 
+func (s *Server) AlterRateLimits(ctx context.Context, req *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterRateLimits(ctx, req)
+}
+
+func (s *Server) DescribeRateLimits(ctx context.Context, req *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error) {
+	return s.proxy.DescribeRateLimits(ctx, req)
+}
+
+func (s *Server) AlterDatabase(ctx context.Context, req *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterDatabase(ctx, req)
+}
+
+func (s *Server) DescribeDatabase(ctx context.Context, req *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	return s.proxy.DescribeDatabase(ctx, req)
+}
+
 func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateDatabase(ctx, request)
 }
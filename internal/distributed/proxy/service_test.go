@@ -603,6 +603,12 @@ func Test_NewServer(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("UpdateResourceGroups", func(t *testing.T) {
+		mockProxy.EXPECT().UpdateResourceGroups(mock.Anything, mock.Anything).Return(nil, nil)
+		_, err := server.UpdateResourceGroups(ctx, nil)
+		assert.NoError(t, err)
+	})
+
 	t.Run("TransferNode", func(t *testing.T) {
 		mockProxy.EXPECT().TransferNode(mock.Anything, mock.Anything).Return(nil, nil)
 		_, err := server.TransferNode(ctx, nil)
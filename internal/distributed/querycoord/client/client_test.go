@@ -158,6 +158,9 @@ func Test_NewClient(t *testing.T) {
 
 		r30, err := client.DeactivateChecker(ctx, nil)
 		retCheck(retNotNil, r30, err)
+
+		r31, err := client.UpdateResourceGroups(ctx, nil)
+		retCheck(retNotNil, r31, err)
 	}
 
 	client.(*Client).grpcClient = &mock.GRPCClientBase[querypb.QueryCoordClient]{
@@ -424,6 +424,10 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.Describ
 	return s.queryCoord.DescribeResourceGroup(ctx, req)
 }
 
+func (s *Server) UpdateResourceGroups(ctx context.Context, req *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	return s.queryCoord.UpdateResourceGroups(ctx, req)
+}
+
 func (s *Server) ActivateChecker(ctx context.Context, req *querypb.ActivateCheckerRequest) (*commonpb.Status, error) {
 	return s.queryCoord.ActivateChecker(ctx, req)
 }
@@ -259,6 +259,14 @@ func Test_NewServer(t *testing.T) {
 			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
 		})
 
+		t.Run("UpdateResourceGroups", func(t *testing.T) {
+			req := &milvuspb.UpdateResourceGroupsRequest{}
+			mqc.EXPECT().UpdateResourceGroups(mock.Anything, req).Return(successStatus, nil)
+			resp, err := server.UpdateResourceGroups(ctx, req)
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+		})
+
 		t.Run("ListCheckers", func(t *testing.T) {
 			req := &querypb.ListCheckersRequest{}
 			mqc.EXPECT().ListCheckers(mock.Anything, req).Return(&querypb.ListCheckersResponse{Status: successStatus}, nil)
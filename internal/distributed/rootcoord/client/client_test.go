@@ -228,6 +228,14 @@ func Test_NewClient(t *testing.T) {
 			r, err := client.ListPolicy(ctx, nil)
 			retCheck(retNotNil, r, err)
 		}
+		{
+			r, err := client.AlterRateLimits(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
+		{
+			r, err := client.DescribeRateLimits(ctx, nil)
+			retCheck(retNotNil, r, err)
+		}
 		{
 			r, err := client.ShowConfigurations(ctx, nil)
 			retCheck(retNotNil, r, err)
@@ -454,6 +462,14 @@ func Test_NewClient(t *testing.T) {
 		rTimeout, err := client.ListPolicy(shortCtx, nil)
 		retCheck(rTimeout, err)
 	}
+	{
+		rTimeout, err := client.AlterRateLimits(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
+	{
+		rTimeout, err := client.DescribeRateLimits(shortCtx, nil)
+		retCheck(rTimeout, err)
+	}
 	{
 		rTimeout, err := client.CheckHealth(shortCtx, nil)
 		retCheck(rTimeout, err)
@@ -89,6 +89,14 @@ func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDataba
 	return s.rootCoord.ListDatabases(ctx, request)
 }
 
+func (s *Server) DescribeDatabase(ctx context.Context, request *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	return s.rootCoord.DescribeDatabase(ctx, request)
+}
+
+func (s *Server) AlterDatabase(ctx context.Context, request *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterDatabase(ctx, request)
+}
+
 func (s *Server) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	return s.rootCoord.CheckHealth(ctx, request)
 }
@@ -531,6 +539,14 @@ func (s *Server) ListPolicy(ctx context.Context, request *internalpb.ListPolicyR
 	return s.rootCoord.ListPolicy(ctx, request)
 }
 
+func (s *Server) AlterRateLimits(ctx context.Context, request *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterRateLimits(ctx, request)
+}
+
+func (s *Server) DescribeRateLimits(ctx context.Context, request *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error) {
+	return s.rootCoord.DescribeRateLimits(ctx, request)
+}
+
 func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
 	return s.rootCoord.AlterCollection(ctx, request)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -16,6 +17,7 @@ type RootCoordCatalog interface {
 	CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error
 	DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error
 	ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error)
+	AlterDatabase(ctx context.Context, newDB *model.Database, ts typeutil.Timestamp) error
 
 	CreateCollection(ctx context.Context, collectionInfo *model.Collection, ts typeutil.Timestamp) error
 	GetCollectionByID(ctx context.Context, dbID int64, ts typeutil.Timestamp, collectionID typeutil.UniqueID) (*model.Collection, error)
@@ -44,6 +46,8 @@ type RootCoordCatalog interface {
 	DropCredential(ctx context.Context, username string) error
 	// ListCredentials gets all usernames.
 	ListCredentials(ctx context.Context) ([]string, error)
+	// ListUserRateLimits gets the rate limits of all users, users without rate limits are not included.
+	ListUserRateLimits(ctx context.Context) (map[string][]*internalpb.Rate, error)
 
 	// CreateRole creates role by the entity for the tenant. Please make sure the tenent and entity.Name aren't empty. Empty entity.Name may end up with deleting all roles
 	// Returns common.IgnorableError if the role already existes
@@ -75,6 +79,10 @@ type RootCoordCatalog interface {
 	// Please make sure entity valid before calling this API
 	ListGrant(ctx context.Context, tenant string, entity *milvuspb.GrantEntity) ([]*milvuspb.GrantEntity, error)
 	ListPolicy(ctx context.Context, tenant string) ([]string, error)
+	// AlterRoleRateLimits replaces the rate limits of the role, the rate limits are removed if rates is empty.
+	AlterRoleRateLimits(ctx context.Context, tenant string, roleName string, rates []*internalpb.Rate) error
+	// ListRoleRateLimits gets the rate limits of all roles for the tenant, roles without rate limits are not included.
+	ListRoleRateLimits(ctx context.Context, tenant string) (map[string][]*internalpb.Rate, error)
 	// List all user role pair in string for the tenant
 	// For example []string{"user1/role1"}
 	ListUserRole(ctx context.Context, tenant string) ([]string, error)
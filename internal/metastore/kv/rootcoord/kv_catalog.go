@@ -110,6 +110,16 @@ func (kc *Catalog) CreateDatabase(ctx context.Context, db *model.Database, ts ty
 	return kc.Snapshot.Save(key, string(v), ts)
 }
 
+func (kc *Catalog) AlterDatabase(ctx context.Context, newDB *model.Database, ts typeutil.Timestamp) error {
+	key := BuildDatabaseKey(newDB.ID)
+	dbInfo := model.MarshalDatabaseModel(newDB)
+	v, err := proto.Marshal(dbInfo)
+	if err != nil {
+		return err
+	}
+	return kc.Snapshot.Save(key, string(v), ts)
+}
+
 func (kc *Catalog) DropDatabase(ctx context.Context, dbID int64, ts typeutil.Timestamp) error {
 	key := BuildDatabaseKey(dbID)
 	return kc.Snapshot.MultiSaveAndRemoveWithPrefix(nil, []string{key}, ts)
@@ -283,7 +293,7 @@ func (kc *Catalog) CreateAlias(ctx context.Context, alias *model.Alias, ts typeu
 
 func (kc *Catalog) CreateCredential(ctx context.Context, credential *model.Credential) error {
 	k := fmt.Sprintf("%s/%s", CredentialPrefix, credential.Username)
-	v, err := json.Marshal(&internalpb.CredentialInfo{EncryptedPassword: credential.EncryptedPassword})
+	v, err := json.Marshal(&internalpb.CredentialInfo{EncryptedPassword: credential.EncryptedPassword, RateLimits: credential.RateLimits})
 	if err != nil {
 		log.Error("create credential marshal fail", zap.String("key", k), zap.Error(err))
 		return err
@@ -398,7 +408,7 @@ func (kc *Catalog) GetCredential(ctx context.Context, username string) (*model.C
 		return nil, fmt.Errorf("unmarshal credential info err:%w", err)
 	}
 
-	return &model.Credential{Username: username, EncryptedPassword: credentialInfo.EncryptedPassword}, nil
+	return &model.Credential{Username: username, EncryptedPassword: credentialInfo.EncryptedPassword, RateLimits: credentialInfo.RateLimits}, nil
 }
 
 func (kc *Catalog) AlterAlias(ctx context.Context, alias *model.Alias, ts typeutil.Timestamp) error {
@@ -727,6 +737,79 @@ func (kc *Catalog) ListCredentials(ctx context.Context) ([]string, error) {
 	return usernames, nil
 }
 
+func (kc *Catalog) ListUserRateLimits(ctx context.Context) (map[string][]*internalpb.Rate, error) {
+	keys, values, err := kc.Txn.LoadWithPrefix(CredentialPrefix)
+	if err != nil {
+		log.Error("list all credentials fail", zap.String("prefix", CredentialPrefix), zap.Error(err))
+		return nil, err
+	}
+
+	rateLimits := make(map[string][]*internalpb.Rate)
+	for i, path := range keys {
+		username := typeutil.After(path, UserSubPrefix+"/")
+		if len(username) == 0 {
+			log.Warn("no username extract from path:", zap.String("path", path))
+			continue
+		}
+		credentialInfo := internalpb.CredentialInfo{}
+		err = json.Unmarshal([]byte(values[i]), &credentialInfo)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal credential info err:%w", err)
+		}
+		if len(credentialInfo.GetRateLimits()) > 0 {
+			rateLimits[username] = credentialInfo.GetRateLimits()
+		}
+	}
+	return rateLimits, nil
+}
+
+func (kc *Catalog) AlterRoleRateLimits(ctx context.Context, tenant string, roleName string, rates []*internalpb.Rate) error {
+	k := funcutil.HandleTenantForEtcdKey(RoleRateLimitPrefix, tenant, roleName)
+	if len(rates) == 0 {
+		err := kc.Txn.Remove(k)
+		if err != nil {
+			log.Warn("fail to remove the role rate limits", zap.String("key", k), zap.Error(err))
+		}
+		return err
+	}
+
+	v, err := json.Marshal(rates)
+	if err != nil {
+		log.Error("marshal role rate limits fail", zap.String("key", k), zap.Error(err))
+		return err
+	}
+	err = kc.Txn.Save(k, string(v))
+	if err != nil {
+		log.Warn("fail to save the role rate limits", zap.String("key", k), zap.Error(err))
+	}
+	return err
+}
+
+func (kc *Catalog) ListRoleRateLimits(ctx context.Context, tenant string) (map[string][]*internalpb.Rate, error) {
+	prefix := funcutil.HandleTenantForEtcdKey(RoleRateLimitPrefix, tenant, "")
+	keys, values, err := kc.Txn.LoadWithPrefix(prefix)
+	if err != nil {
+		log.Error("fail to load role rate limits", zap.String("prefix", prefix), zap.Error(err))
+		return nil, err
+	}
+
+	rateLimits := make(map[string][]*internalpb.Rate, len(keys))
+	for i, key := range keys {
+		roleName := typeutil.After(key, prefix+"/")
+		if len(roleName) == 0 {
+			log.Warn("no role name extract from key", zap.String("key", key))
+			continue
+		}
+		rates := make([]*internalpb.Rate, 0)
+		err = json.Unmarshal([]byte(values[i]), &rates)
+		if err != nil {
+			return nil, fmt.Errorf("unmarshal role rate limits err:%w", err)
+		}
+		rateLimits[roleName] = rates
+	}
+	return rateLimits, nil
+}
+
 func (kc *Catalog) save(k string) error {
 	var err error
 	if _, err = kc.Txn.Load(k); err != nil && !errors.Is(err, merr.ErrIoKeyNotFound) {
@@ -767,8 +850,8 @@ func (kc *Catalog) DropRole(ctx context.Context, tenant string, roleName string)
 		return err
 	}
 
-	deleteKeys := make([]string, 0, len(roleResults)+1)
-	deleteKeys = append(deleteKeys, k)
+	deleteKeys := make([]string, 0, len(roleResults)+2)
+	deleteKeys = append(deleteKeys, k, funcutil.HandleTenantForEtcdKey(RoleRateLimitPrefix, tenant, roleName))
 	for _, roleResult := range roleResults {
 		if roleResult.Role.Name == roleName {
 			for _, userInfo := range roleResult.Users {
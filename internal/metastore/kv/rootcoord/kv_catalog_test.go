@@ -1488,6 +1488,42 @@ func TestRBAC_Credential(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("test ListUserRateLimits", func(t *testing.T) {
+		var (
+			kvmock = mocks.NewTxnKV(t)
+			c      = &Catalog{Txn: kvmock}
+		)
+
+		rates := []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 1024}}
+		limited, err := json.Marshal(&internalpb.CredentialInfo{EncryptedPassword: "pwd", RateLimits: rates})
+		require.NoError(t, err)
+		kvmock.EXPECT().LoadWithPrefix(CredentialPrefix).Return(
+			[]string{
+				fmt.Sprintf("%s/%s", CredentialPrefix, "user1"),
+				fmt.Sprintf("%s/%s", CredentialPrefix, "user2"),
+			},
+			[]string{string(limited), getUserInfoMetaString("user2")},
+			nil,
+		).Once()
+
+		rateLimits, err := c.ListUserRateLimits(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, rateLimits, 1)
+		assert.Equal(t, rates[0].GetR(), rateLimits["user1"][0].GetR())
+
+		kvmock.EXPECT().LoadWithPrefix(CredentialPrefix).Return(
+			[]string{fmt.Sprintf("%s/%s", CredentialPrefix, "user1")},
+			[]string{"random"},
+			nil,
+		).Once()
+		_, err = c.ListUserRateLimits(ctx)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().LoadWithPrefix(CredentialPrefix).Return(nil, nil, errors.New("mock load error")).Once()
+		_, err = c.ListUserRateLimits(ctx)
+		assert.Error(t, err)
+	})
 }
 
 func TestRBAC_Role(t *testing.T) {
@@ -1636,9 +1672,13 @@ func TestRBAC_Role(t *testing.T) {
 			getFailName = "get-fail"
 		)
 
-		kvmock.EXPECT().MultiRemove([]string{funcutil.HandleTenantForEtcdKey(RolePrefix, tenant, errorName)}).Return(errors.New("remove error"))
+		kvmock.EXPECT().MultiRemove([]string{
+			funcutil.HandleTenantForEtcdKey(RolePrefix, tenant, errorName),
+			funcutil.HandleTenantForEtcdKey(RoleRateLimitPrefix, tenant, errorName),
+		}).Return(errors.New("remove error"))
 		kvmock.EXPECT().MultiRemove([]string{
 			funcutil.HandleTenantForEtcdKey(RolePrefix, tenant, validName),
+			funcutil.HandleTenantForEtcdKey(RoleRateLimitPrefix, tenant, validName),
 			funcutil.HandleTenantForEtcdKey(RoleMappingPrefix, tenant, fmt.Sprintf("%s/%s", "user1", validName)),
 			funcutil.HandleTenantForEtcdKey(RoleMappingPrefix, tenant, fmt.Sprintf("%s/%s", "user2", validName)),
 		}).Return(nil)
@@ -2065,6 +2105,60 @@ func TestRBAC_Role(t *testing.T) {
 	})
 }
 
+func TestRBAC_RoleRateLimits(t *testing.T) {
+	ctx := context.TODO()
+	tenant := "default"
+
+	t.Run("test AlterRoleRateLimits", func(t *testing.T) {
+		var (
+			kvmock = mocks.NewTxnKV(t)
+			c      = &Catalog{Txn: kvmock}
+		)
+
+		rates := []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 10}}
+		key := funcutil.HandleTenantForEtcdKey(RoleRateLimitPrefix, tenant, "role1")
+		kvmock.EXPECT().Save(key, mock.Anything).Return(nil).Once()
+		err := c.AlterRoleRateLimits(ctx, tenant, "role1", rates)
+		assert.NoError(t, err)
+
+		kvmock.EXPECT().Remove(key).Return(nil).Once()
+		err = c.AlterRoleRateLimits(ctx, tenant, "role1", nil)
+		assert.NoError(t, err)
+
+		kvmock.EXPECT().Save(key, mock.Anything).Return(errors.New("mock save error")).Once()
+		err = c.AlterRoleRateLimits(ctx, tenant, "role1", rates)
+		assert.Error(t, err)
+	})
+
+	t.Run("test ListRoleRateLimits", func(t *testing.T) {
+		var (
+			kvmock = mocks.NewTxnKV(t)
+			c      = &Catalog{Txn: kvmock}
+		)
+
+		prefix := funcutil.HandleTenantForEtcdKey(RoleRateLimitPrefix, tenant, "")
+		rates, err := json.Marshal([]*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 10}})
+		require.NoError(t, err)
+		kvmock.EXPECT().LoadWithPrefix(prefix).Return(
+			[]string{prefix + "/role1", prefix + "/"},
+			[]string{string(rates), ""},
+			nil,
+		).Once()
+		rateLimits, err := c.ListRoleRateLimits(ctx, tenant)
+		assert.NoError(t, err)
+		assert.Len(t, rateLimits, 1)
+		assert.Equal(t, internalpb.RateType_DQLSearch, rateLimits["role1"][0].GetRt())
+
+		kvmock.EXPECT().LoadWithPrefix(prefix).Return([]string{prefix + "/role1"}, []string{"random"}, nil).Once()
+		_, err = c.ListRoleRateLimits(ctx, tenant)
+		assert.Error(t, err)
+
+		kvmock.EXPECT().LoadWithPrefix(prefix).Return(nil, nil, errors.New("mock load error")).Once()
+		_, err = c.ListRoleRateLimits(ctx, tenant)
+		assert.Error(t, err)
+	})
+}
+
 func TestRBAC_Grant(t *testing.T) {
 	var (
 		tenant = "default"
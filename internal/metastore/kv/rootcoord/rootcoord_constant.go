@@ -49,6 +49,9 @@ const (
 
 	// GranteeIDPrefix prefix for mapping among privilege and grantor
 	GranteeIDPrefix = ComponentPrefix + CommonCredentialPrefix + "/grantee-id"
+
+	// RoleRateLimitPrefix prefix for the rate limits of role
+	RoleRateLimitPrefix = ComponentPrefix + CommonCredentialPrefix + "/role-rate-limits"
 )
 
 func BuildDatabasePrefixWithDBID(dbID int64) string {
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	internalpb "github.com/milvus-io/milvus/internal/proto/internalpb"
+
 	milvuspb "github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	metastore "github.com/milvus-io/milvus/internal/metastore"
 
@@ -159,6 +161,50 @@ func (_c *RootCoordCatalog_AlterCredential_Call) RunAndReturn(run func(context.C
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: ctx, newDB, ts
+func (_m *RootCoordCatalog) AlterDatabase(ctx context.Context, newDB *model.Database, ts uint64) error {
+	ret := _m.Called(ctx, newDB, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Database, uint64) error); ok {
+		r0 = rf(ctx, newDB, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type RootCoordCatalog_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - newDB *model.Database
+//   - ts uint64
+func (_e *RootCoordCatalog_Expecter) AlterDatabase(ctx interface{}, newDB interface{}, ts interface{}) *RootCoordCatalog_AlterDatabase_Call {
+	return &RootCoordCatalog_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", ctx, newDB, ts)}
+}
+
+func (_c *RootCoordCatalog_AlterDatabase_Call) Run(run func(ctx context.Context, newDB *model.Database, ts uint64)) *RootCoordCatalog_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Database), args[2].(uint64))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterDatabase_Call) Return(_a0 error) *RootCoordCatalog_AlterDatabase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterDatabase_Call) RunAndReturn(run func(context.Context, *model.Database, uint64) error) *RootCoordCatalog_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterGrant provides a mock function with given fields: ctx, tenant, entity, operateType
 func (_m *RootCoordCatalog) AlterGrant(ctx context.Context, tenant string, entity *milvuspb.GrantEntity, operateType milvuspb.OperatePrivilegeType) error {
 	ret := _m.Called(ctx, tenant, entity, operateType)
@@ -251,6 +297,51 @@ func (_c *RootCoordCatalog_AlterPartition_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// AlterRoleRateLimits provides a mock function with given fields: ctx, tenant, roleName, rates
+func (_m *RootCoordCatalog) AlterRoleRateLimits(ctx context.Context, tenant string, roleName string, rates []*internalpb.Rate) error {
+	ret := _m.Called(ctx, tenant, roleName, rates)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []*internalpb.Rate) error); ok {
+		r0 = rf(ctx, tenant, roleName, rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RootCoordCatalog_AlterRoleRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterRoleRateLimits'
+type RootCoordCatalog_AlterRoleRateLimits_Call struct {
+	*mock.Call
+}
+
+// AlterRoleRateLimits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+//   - roleName string
+//   - rates []*internalpb.Rate
+func (_e *RootCoordCatalog_Expecter) AlterRoleRateLimits(ctx interface{}, tenant interface{}, roleName interface{}, rates interface{}) *RootCoordCatalog_AlterRoleRateLimits_Call {
+	return &RootCoordCatalog_AlterRoleRateLimits_Call{Call: _e.mock.On("AlterRoleRateLimits", ctx, tenant, roleName, rates)}
+}
+
+func (_c *RootCoordCatalog_AlterRoleRateLimits_Call) Run(run func(ctx context.Context, tenant string, roleName string, rates []*internalpb.Rate)) *RootCoordCatalog_AlterRoleRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].([]*internalpb.Rate))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterRoleRateLimits_Call) Return(_a0 error) *RootCoordCatalog_AlterRoleRateLimits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *RootCoordCatalog_AlterRoleRateLimits_Call) RunAndReturn(run func(context.Context, string, string, []*internalpb.Rate) error) *RootCoordCatalog_AlterRoleRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterUserRole provides a mock function with given fields: ctx, tenant, userEntity, roleEntity, operateType
 func (_m *RootCoordCatalog) AlterUserRole(ctx context.Context, tenant string, userEntity *milvuspb.UserEntity, roleEntity *milvuspb.RoleEntity, operateType milvuspb.OperateUserRoleType) error {
 	ret := _m.Called(ctx, tenant, userEntity, roleEntity, operateType)
@@ -1506,6 +1597,61 @@ func (_c *RootCoordCatalog_ListRole_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListRoleRateLimits provides a mock function with given fields: ctx, tenant
+func (_m *RootCoordCatalog) ListRoleRateLimits(ctx context.Context, tenant string) (map[string][]*internalpb.Rate, error) {
+	ret := _m.Called(ctx, tenant)
+
+	var r0 map[string][]*internalpb.Rate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (map[string][]*internalpb.Rate, error)); ok {
+		return rf(ctx, tenant)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) map[string][]*internalpb.Rate); ok {
+		r0 = rf(ctx, tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*internalpb.Rate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListRoleRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleRateLimits'
+type RootCoordCatalog_ListRoleRateLimits_Call struct {
+	*mock.Call
+}
+
+// ListRoleRateLimits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - tenant string
+func (_e *RootCoordCatalog_Expecter) ListRoleRateLimits(ctx interface{}, tenant interface{}) *RootCoordCatalog_ListRoleRateLimits_Call {
+	return &RootCoordCatalog_ListRoleRateLimits_Call{Call: _e.mock.On("ListRoleRateLimits", ctx, tenant)}
+}
+
+func (_c *RootCoordCatalog_ListRoleRateLimits_Call) Run(run func(ctx context.Context, tenant string)) *RootCoordCatalog_ListRoleRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRoleRateLimits_Call) Return(_a0 map[string][]*internalpb.Rate, _a1 error) *RootCoordCatalog_ListRoleRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListRoleRateLimits_Call) RunAndReturn(run func(context.Context, string) (map[string][]*internalpb.Rate, error)) *RootCoordCatalog_ListRoleRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUser provides a mock function with given fields: ctx, tenant, entity, includeRoleInfo
 func (_m *RootCoordCatalog) ListUser(ctx context.Context, tenant string, entity *milvuspb.UserEntity, includeRoleInfo bool) ([]*milvuspb.UserResult, error) {
 	ret := _m.Called(ctx, tenant, entity, includeRoleInfo)
@@ -1563,6 +1709,60 @@ func (_c *RootCoordCatalog_ListUser_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// ListUserRateLimits provides a mock function with given fields: ctx
+func (_m *RootCoordCatalog) ListUserRateLimits(ctx context.Context) (map[string][]*internalpb.Rate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[string][]*internalpb.Rate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) (map[string][]*internalpb.Rate, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) map[string][]*internalpb.Rate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*internalpb.Rate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoordCatalog_ListUserRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRateLimits'
+type RootCoordCatalog_ListUserRateLimits_Call struct {
+	*mock.Call
+}
+
+// ListUserRateLimits is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *RootCoordCatalog_Expecter) ListUserRateLimits(ctx interface{}) *RootCoordCatalog_ListUserRateLimits_Call {
+	return &RootCoordCatalog_ListUserRateLimits_Call{Call: _e.mock.On("ListUserRateLimits", ctx)}
+}
+
+func (_c *RootCoordCatalog_ListUserRateLimits_Call) Run(run func(ctx context.Context)) *RootCoordCatalog_ListUserRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListUserRateLimits_Call) Return(_a0 map[string][]*internalpb.Rate, _a1 error) *RootCoordCatalog_ListUserRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoordCatalog_ListUserRateLimits_Call) RunAndReturn(run func(context.Context) (map[string][]*internalpb.Rate, error)) *RootCoordCatalog_ListUserRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserRole provides a mock function with given fields: ctx, tenant
 func (_m *RootCoordCatalog) ListUserRole(ctx context.Context, tenant string) ([]string, error) {
 	ret := _m.Called(ctx, tenant)
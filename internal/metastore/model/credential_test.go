@@ -15,6 +15,7 @@ var (
 		Tenant:            "tenant-1",
 		IsSuper:           true,
 		Sha256Password:    "xxxx",
+		RateLimits:        []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 10}},
 	}
 
 	credentialPb = &internalpb.CredentialInfo{
@@ -23,6 +24,7 @@ var (
 		Tenant:            "tenant-1",
 		IsSuper:           true,
 		Sha256Password:    "xxxx",
+		RateLimits:        []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 10}},
 	}
 )
 
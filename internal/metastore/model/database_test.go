@@ -5,6 +5,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 )
 
@@ -15,6 +16,9 @@ var (
 		Id:          1,
 		CreatedTime: 1,
 		State:       etcdpb.DatabaseState_DatabaseCreated,
+		Properties: []*commonpb.KeyValuePair{
+			{Key: "database.insertRate.max.mb", Value: "10"},
+		},
 	}
 
 	dbModel = &Database{
@@ -23,6 +27,9 @@ var (
 		ID:          1,
 		CreatedTime: 1,
 		State:       etcdpb.DatabaseState_DatabaseCreated,
+		Properties: []*commonpb.KeyValuePair{
+			{Key: "database.insertRate.max.mb", Value: "10"},
+		},
 	}
 )
 
@@ -41,6 +48,10 @@ func TestUnmarshalDatabaseModel(t *testing.T) {
 func TestDatabaseCloneAndEqual(t *testing.T) {
 	clone := dbModel.Clone()
 	assert.Equal(t, dbModel, clone)
+	assert.True(t, dbModel.Equal(*clone))
+
+	clone.Properties[0].Value = "20"
+	assert.False(t, dbModel.Equal(*clone))
 }
 
 func TestDatabaseAvailable(t *testing.T) {
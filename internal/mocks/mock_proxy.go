@@ -199,6 +199,61 @@ func (_c *MockProxy_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterDatabase(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockProxy_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterDatabaseRequest
+func (_e *MockProxy_Expecter) AlterDatabase(_a0 interface{}, _a1 interface{}) *MockProxy_AlterDatabase_Call {
+	return &MockProxy_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)) *MockProxy_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AlterIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) AlterIndex(_a0 context.Context, _a1 *milvuspb.AlterIndexRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -254,6 +309,61 @@ func (_c *MockProxy_AlterIndex_Call) RunAndReturn(run func(context.Context, *mil
 	return _c
 }
 
+// AlterRateLimits provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) AlterRateLimits(_a0 context.Context, _a1 *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterRateLimitsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterRateLimitsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_AlterRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterRateLimits'
+type MockProxy_AlterRateLimits_Call struct {
+	*mock.Call
+}
+
+// AlterRateLimits is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterRateLimitsRequest
+func (_e *MockProxy_Expecter) AlterRateLimits(_a0 interface{}, _a1 interface{}) *MockProxy_AlterRateLimits_Call {
+	return &MockProxy_AlterRateLimits_Call{Call: _e.mock.On("AlterRateLimits", _a0, _a1)}
+}
+
+func (_c *MockProxy_AlterRateLimits_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterRateLimitsRequest)) *MockProxy_AlterRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterRateLimitsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_AlterRateLimits_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_AlterRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_AlterRateLimits_Call) RunAndReturn(run func(context.Context, *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error)) *MockProxy_AlterRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CalcDistance provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) CalcDistance(_a0 context.Context, _a1 *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1079,6 +1189,61 @@ func (_c *MockProxy_DescribeCollection_Call) RunAndReturn(run func(context.Conte
 	return _c
 }
 
+// DescribeDatabase provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DescribeDatabase(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.DescribeDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) *internalpb.DescribeDatabaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DescribeDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDatabase'
+type MockProxy_DescribeDatabase_Call struct {
+	*mock.Call
+}
+
+// DescribeDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DescribeDatabaseRequest
+func (_e *MockProxy_Expecter) DescribeDatabase(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeDatabase_Call {
+	return &MockProxy_DescribeDatabase_Call{Call: _e.mock.On("DescribeDatabase", _a0, _a1)}
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest)) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) Return(_a0 *internalpb.DescribeDatabaseResponse, _a1 error) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DescribeDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)) *MockProxy_DescribeDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeIndex provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DescribeIndex(_a0 context.Context, _a1 *milvuspb.DescribeIndexRequest) (*milvuspb.DescribeIndexResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -1134,6 +1299,61 @@ func (_c *MockProxy_DescribeIndex_Call) RunAndReturn(run func(context.Context, *
 	return _c
 }
 
+// DescribeRateLimits provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) DescribeRateLimits(_a0 context.Context, _a1 *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.DescribeRateLimitsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeRateLimitsRequest) *internalpb.DescribeRateLimitsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeRateLimitsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeRateLimitsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_DescribeRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeRateLimits'
+type MockProxy_DescribeRateLimits_Call struct {
+	*mock.Call
+}
+
+// DescribeRateLimits is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DescribeRateLimitsRequest
+func (_e *MockProxy_Expecter) DescribeRateLimits(_a0 interface{}, _a1 interface{}) *MockProxy_DescribeRateLimits_Call {
+	return &MockProxy_DescribeRateLimits_Call{Call: _e.mock.On("DescribeRateLimits", _a0, _a1)}
+}
+
+func (_c *MockProxy_DescribeRateLimits_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DescribeRateLimitsRequest)) *MockProxy_DescribeRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeRateLimitsRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_DescribeRateLimits_Call) Return(_a0 *internalpb.DescribeRateLimitsResponse, _a1 error) *MockProxy_DescribeRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_DescribeRateLimits_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error)) *MockProxy_DescribeRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DescribeResourceGroup provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) DescribeResourceGroup(_a0 context.Context, _a1 *milvuspb.DescribeResourceGroupRequest) (*milvuspb.DescribeResourceGroupResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -3469,6 +3689,61 @@ func (_c *MockProxy_InvalidateCredentialCache_Call) RunAndReturn(run func(contex
 	return _c
 }
 
+// InvalidateQueryCache provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) InvalidateQueryCache(_a0 context.Context, _a1 *proxypb.InvalidateQueryCacheRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateQueryCacheRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateQueryCacheRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateQueryCacheRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxy_InvalidateQueryCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateQueryCache'
+type MockProxy_InvalidateQueryCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateQueryCache is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *proxypb.InvalidateQueryCacheRequest
+func (_e *MockProxy_Expecter) InvalidateQueryCache(_a0 interface{}, _a1 interface{}) *MockProxy_InvalidateQueryCache_Call {
+	return &MockProxy_InvalidateQueryCache_Call{Call: _e.mock.On("InvalidateQueryCache", _a0, _a1)}
+}
+
+func (_c *MockProxy_InvalidateQueryCache_Call) Run(run func(_a0 context.Context, _a1 *proxypb.InvalidateQueryCacheRequest)) *MockProxy_InvalidateQueryCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateQueryCacheRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxy_InvalidateQueryCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxy_InvalidateQueryCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxy_InvalidateQueryCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateQueryCacheRequest) (*commonpb.Status, error)) *MockProxy_InvalidateQueryCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListAliases provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) ListAliases(_a0 context.Context, _a1 *milvuspb.ListAliasesRequest) (*milvuspb.ListAliasesResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4239,6 +4514,49 @@ func (_c *MockProxy_Query_Call) RunAndReturn(run func(context.Context, *milvuspb
 	return _c
 }
 
+// QueryStream provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) QueryStream(_a0 *milvuspb.QueryRequest, _a1 proxypb.ProxyStream_QueryStreamServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*milvuspb.QueryRequest, proxypb.ProxyStream_QueryStreamServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_QueryStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'QueryStream'
+type MockProxy_QueryStream_Call struct {
+	*mock.Call
+}
+
+// QueryStream is a helper method to define mock.On call
+//   - _a0 *milvuspb.QueryRequest
+//   - _a1 proxypb.ProxyStream_QueryStreamServer
+func (_e *MockProxy_Expecter) QueryStream(_a0 interface{}, _a1 interface{}) *MockProxy_QueryStream_Call {
+	return &MockProxy_QueryStream_Call{Call: _e.mock.On("QueryStream", _a0, _a1)}
+}
+
+func (_c *MockProxy_QueryStream_Call) Run(run func(_a0 *milvuspb.QueryRequest, _a1 proxypb.ProxyStream_QueryStreamServer)) *MockProxy_QueryStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*milvuspb.QueryRequest), args[1].(proxypb.ProxyStream_QueryStreamServer))
+	})
+	return _c
+}
+
+func (_c *MockProxy_QueryStream_Call) Return(_a0 error) *MockProxy_QueryStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_QueryStream_Call) RunAndReturn(run func(*milvuspb.QueryRequest, proxypb.ProxyStream_QueryStreamServer) error) *MockProxy_QueryStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfoCache provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) RefreshPolicyInfoCache(_a0 context.Context, _a1 *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -4665,6 +4983,49 @@ func (_c *MockProxy_Search_Call) RunAndReturn(run func(context.Context, *milvusp
 	return _c
 }
 
+// SearchStream provides a mock function with given fields: _a0, _a1
+func (_m *MockProxy) SearchStream(_a0 *milvuspb.SearchRequest, _a1 proxypb.ProxyStream_SearchStreamServer) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*milvuspb.SearchRequest, proxypb.ProxyStream_SearchStreamServer) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxy_SearchStream_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SearchStream'
+type MockProxy_SearchStream_Call struct {
+	*mock.Call
+}
+
+// SearchStream is a helper method to define mock.On call
+//   - _a0 *milvuspb.SearchRequest
+//   - _a1 proxypb.ProxyStream_SearchStreamServer
+func (_e *MockProxy_Expecter) SearchStream(_a0 interface{}, _a1 interface{}) *MockProxy_SearchStream_Call {
+	return &MockProxy_SearchStream_Call{Call: _e.mock.On("SearchStream", _a0, _a1)}
+}
+
+func (_c *MockProxy_SearchStream_Call) Run(run func(_a0 *milvuspb.SearchRequest, _a1 proxypb.ProxyStream_SearchStreamServer)) *MockProxy_SearchStream_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*milvuspb.SearchRequest), args[1].(proxypb.ProxyStream_SearchStreamServer))
+	})
+	return _c
+}
+
+func (_c *MockProxy_SearchStream_Call) Return(_a0 error) *MockProxy_SearchStream_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxy_SearchStream_Call) RunAndReturn(run func(*milvuspb.SearchRequest, proxypb.ProxyStream_SearchStreamServer) error) *MockProxy_SearchStream_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SelectGrant provides a mock function with given fields: _a0, _a1
 func (_m *MockProxy) SelectGrant(_a0 context.Context, _a1 *milvuspb.SelectGrantRequest) (*milvuspb.SelectGrantResponse, error) {
 	ret := _m.Called(_a0, _a1)
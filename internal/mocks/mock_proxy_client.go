@@ -31,6 +31,146 @@ func (_m *MockProxyClient) EXPECT() *MockProxyClient_Expecter {
 	return &MockProxyClient_Expecter{mock: &_m.Mock}
 }
 
+// AlterDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type MockProxyClient_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.AlterDatabaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AlterDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AlterDatabase_Call {
+	return &MockProxyClient_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AlterDatabase_Call) Run(run func(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption)) *MockProxyClient_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.AlterDatabaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AlterDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.AlterDatabaseRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterRateLimits provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) AlterRateLimits(ctx context.Context, in *internalpb.AlterRateLimitsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterRateLimitsRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterRateLimitsRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterRateLimitsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_AlterRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterRateLimits'
+type MockProxyClient_AlterRateLimits_Call struct {
+	*mock.Call
+}
+
+// AlterRateLimits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.AlterRateLimitsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) AlterRateLimits(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_AlterRateLimits_Call {
+	return &MockProxyClient_AlterRateLimits_Call{Call: _e.mock.On("AlterRateLimits",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_AlterRateLimits_Call) Run(run func(ctx context.Context, in *internalpb.AlterRateLimitsRequest, opts ...grpc.CallOption)) *MockProxyClient_AlterRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.AlterRateLimitsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_AlterRateLimits_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_AlterRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_AlterRateLimits_Call) RunAndReturn(run func(context.Context, *internalpb.AlterRateLimitsRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_AlterRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Close provides a mock function with given fields:
 func (_m *MockProxyClient) Close() error {
 	ret := _m.Called()
@@ -72,6 +212,146 @@ func (_c *MockProxyClient_Close_Call) RunAndReturn(run func() error) *MockProxyC
 	return _c
 }
 
+// DescribeDatabase provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.DescribeDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) *internalpb.DescribeDatabaseResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DescribeDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDatabase'
+type MockProxyClient_DescribeDatabase_Call struct {
+	*mock.Call
+}
+
+// DescribeDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DescribeDatabaseRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DescribeDatabase(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DescribeDatabase_Call {
+	return &MockProxyClient_DescribeDatabase_Call{Call: _e.mock.On("DescribeDatabase",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DescribeDatabase_Call) Run(run func(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption)) *MockProxyClient_DescribeDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeDatabaseRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeDatabase_Call) Return(_a0 *internalpb.DescribeDatabaseResponse, _a1 error) *MockProxyClient_DescribeDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeDatabaseRequest, ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error)) *MockProxyClient_DescribeDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeRateLimits provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) DescribeRateLimits(ctx context.Context, in *internalpb.DescribeRateLimitsRequest, opts ...grpc.CallOption) (*internalpb.DescribeRateLimitsResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *internalpb.DescribeRateLimitsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeRateLimitsRequest, ...grpc.CallOption) (*internalpb.DescribeRateLimitsResponse, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeRateLimitsRequest, ...grpc.CallOption) *internalpb.DescribeRateLimitsResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeRateLimitsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeRateLimitsRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_DescribeRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeRateLimits'
+type MockProxyClient_DescribeRateLimits_Call struct {
+	*mock.Call
+}
+
+// DescribeRateLimits is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *internalpb.DescribeRateLimitsRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) DescribeRateLimits(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_DescribeRateLimits_Call {
+	return &MockProxyClient_DescribeRateLimits_Call{Call: _e.mock.On("DescribeRateLimits",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_DescribeRateLimits_Call) Run(run func(ctx context.Context, in *internalpb.DescribeRateLimitsRequest, opts ...grpc.CallOption)) *MockProxyClient_DescribeRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeRateLimitsRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeRateLimits_Call) Return(_a0 *internalpb.DescribeRateLimitsResponse, _a1 error) *MockProxyClient_DescribeRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_DescribeRateLimits_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeRateLimitsRequest, ...grpc.CallOption) (*internalpb.DescribeRateLimitsResponse, error)) *MockProxyClient_DescribeRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetComponentStates provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) GetComponentStates(ctx context.Context, in *milvuspb.GetComponentStatesRequest, opts ...grpc.CallOption) (*milvuspb.ComponentStates, error) {
 	_va := make([]interface{}, len(opts))
@@ -492,6 +772,76 @@ func (_c *MockProxyClient_InvalidateCredentialCache_Call) RunAndReturn(run func(
 	return _c
 }
 
+// InvalidateQueryCache provides a mock function with given fields: ctx, in, opts
+func (_m *MockProxyClient) InvalidateQueryCache(ctx context.Context, in *proxypb.InvalidateQueryCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateQueryCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)); ok {
+		return rf(ctx, in, opts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateQueryCacheRequest, ...grpc.CallOption) *commonpb.Status); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *proxypb.InvalidateQueryCacheRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockProxyClient_InvalidateQueryCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateQueryCache'
+type MockProxyClient_InvalidateQueryCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateQueryCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *proxypb.InvalidateQueryCacheRequest
+//   - opts ...grpc.CallOption
+func (_e *MockProxyClient_Expecter) InvalidateQueryCache(ctx interface{}, in interface{}, opts ...interface{}) *MockProxyClient_InvalidateQueryCache_Call {
+	return &MockProxyClient_InvalidateQueryCache_Call{Call: _e.mock.On("InvalidateQueryCache",
+		append([]interface{}{ctx, in}, opts...)...)}
+}
+
+func (_c *MockProxyClient_InvalidateQueryCache_Call) Run(run func(ctx context.Context, in *proxypb.InvalidateQueryCacheRequest, opts ...grpc.CallOption)) *MockProxyClient_InvalidateQueryCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateQueryCacheRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockProxyClient_InvalidateQueryCache_Call) Return(_a0 *commonpb.Status, _a1 error) *MockProxyClient_InvalidateQueryCache_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockProxyClient_InvalidateQueryCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateQueryCacheRequest, ...grpc.CallOption) (*commonpb.Status, error)) *MockProxyClient_InvalidateQueryCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListClientInfos provides a mock function with given fields: ctx, in, opts
 func (_m *MockProxyClient) ListClientInfos(ctx context.Context, in *proxypb.ListClientInfosRequest, opts ...grpc.CallOption) (*proxypb.ListClientInfosResponse, error) {
 	_va := make([]interface{}, len(opts))
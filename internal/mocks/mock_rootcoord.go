@@ -256,6 +256,116 @@ func (_c *RootCoord_AlterCollection_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AlterDatabase(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterDatabaseRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type RootCoord_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterDatabaseRequest
+func (_e *RootCoord_Expecter) AlterDatabase(_a0 interface{}, _a1 interface{}) *RootCoord_AlterDatabase_Call {
+	return &RootCoord_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", _a0, _a1)}
+}
+
+func (_c *RootCoord_AlterDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterDatabaseRequest)) *RootCoord_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AlterDatabase_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AlterDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AlterDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.AlterDatabaseRequest) (*commonpb.Status, error)) *RootCoord_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterRateLimits provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) AlterRateLimits(_a0 context.Context, _a1 *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *commonpb.Status
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.AlterRateLimitsRequest) *commonpb.Status); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*commonpb.Status)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.AlterRateLimitsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_AlterRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterRateLimits'
+type RootCoord_AlterRateLimits_Call struct {
+	*mock.Call
+}
+
+// AlterRateLimits is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.AlterRateLimitsRequest
+func (_e *RootCoord_Expecter) AlterRateLimits(_a0 interface{}, _a1 interface{}) *RootCoord_AlterRateLimits_Call {
+	return &RootCoord_AlterRateLimits_Call{Call: _e.mock.On("AlterRateLimits", _a0, _a1)}
+}
+
+func (_c *RootCoord_AlterRateLimits_Call) Run(run func(_a0 context.Context, _a1 *internalpb.AlterRateLimitsRequest)) *RootCoord_AlterRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.AlterRateLimitsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_AlterRateLimits_Call) Return(_a0 *commonpb.Status, _a1 error) *RootCoord_AlterRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_AlterRateLimits_Call) RunAndReturn(run func(context.Context, *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error)) *RootCoord_AlterRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CheckHealth provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) CheckHealth(_a0 context.Context, _a1 *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	ret := _m.Called(_a0, _a1)
@@ -861,6 +971,116 @@ func (_c *RootCoord_DescribeCollectionInternal_Call) RunAndReturn(run func(conte
 	return _c
 }
 
+// DescribeDatabase provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DescribeDatabase(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.DescribeDatabaseResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeDatabaseRequest) *internalpb.DescribeDatabaseResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeDatabaseResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeDatabaseRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DescribeDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeDatabase'
+type RootCoord_DescribeDatabase_Call struct {
+	*mock.Call
+}
+
+// DescribeDatabase is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DescribeDatabaseRequest
+func (_e *RootCoord_Expecter) DescribeDatabase(_a0 interface{}, _a1 interface{}) *RootCoord_DescribeDatabase_Call {
+	return &RootCoord_DescribeDatabase_Call{Call: _e.mock.On("DescribeDatabase", _a0, _a1)}
+}
+
+func (_c *RootCoord_DescribeDatabase_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DescribeDatabaseRequest)) *RootCoord_DescribeDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeDatabaseRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DescribeDatabase_Call) Return(_a0 *internalpb.DescribeDatabaseResponse, _a1 error) *RootCoord_DescribeDatabase_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DescribeDatabase_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error)) *RootCoord_DescribeDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DescribeRateLimits provides a mock function with given fields: _a0, _a1
+func (_m *RootCoord) DescribeRateLimits(_a0 context.Context, _a1 *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error) {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 *internalpb.DescribeRateLimitsResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error)); ok {
+		return rf(_a0, _a1)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *internalpb.DescribeRateLimitsRequest) *internalpb.DescribeRateLimitsResponse); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*internalpb.DescribeRateLimitsResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *internalpb.DescribeRateLimitsRequest) error); ok {
+		r1 = rf(_a0, _a1)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RootCoord_DescribeRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeRateLimits'
+type RootCoord_DescribeRateLimits_Call struct {
+	*mock.Call
+}
+
+// DescribeRateLimits is a helper method to define mock.On call
+//   - _a0 context.Context
+//   - _a1 *internalpb.DescribeRateLimitsRequest
+func (_e *RootCoord_Expecter) DescribeRateLimits(_a0 interface{}, _a1 interface{}) *RootCoord_DescribeRateLimits_Call {
+	return &RootCoord_DescribeRateLimits_Call{Call: _e.mock.On("DescribeRateLimits", _a0, _a1)}
+}
+
+func (_c *RootCoord_DescribeRateLimits_Call) Run(run func(_a0 context.Context, _a1 *internalpb.DescribeRateLimitsRequest)) *RootCoord_DescribeRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*internalpb.DescribeRateLimitsRequest))
+	})
+	return _c
+}
+
+func (_c *RootCoord_DescribeRateLimits_Call) Return(_a0 *internalpb.DescribeRateLimitsResponse, _a1 error) *RootCoord_DescribeRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *RootCoord_DescribeRateLimits_Call) RunAndReturn(run func(context.Context, *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error)) *RootCoord_DescribeRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DropAlias provides a mock function with given fields: _a0, _a1
 func (_m *RootCoord) DropAlias(_a0 context.Context, _a1 *milvuspb.DropAliasRequest) (*commonpb.Status, error) {
 	ret := _m.Called(_a0, _a1)
@@ -0,0 +1,285 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bufio"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+)
+
+const (
+	// TextMode writes the access log by the formatters.
+	TextMode = "text"
+	// JSONMode writes the access log as NDJSON audit records chained by hmac.
+	JSONMode = "json"
+)
+
+// AuditRecord is the fixed schema of the access log in json mode, one record per line.
+// Hash is the HMAC-SHA256 keyed by proxy.accessLog.auditSecret of the record marshaled without hash,
+// and PrevHash is the hash of the previous record, so removing, reordering or modifying any record
+// breaks the chain, across the rotated files as well, and the chain can't be rebuilt without the secret.
+type AuditRecord struct {
+	Time         string   `json:"time"`
+	TraceID      string   `json:"trace_id"`
+	User         string   `json:"user"`
+	Roles        []string `json:"roles"`
+	ClientAddr   string   `json:"client_addr"`
+	SdkVersion   string   `json:"sdk_version"`
+	Method       string   `json:"method"`
+	Database     string   `json:"database"`
+	Collection   string   `json:"collection"`
+	Partitions   []string `json:"partitions"`
+	Expr         string   `json:"expr"`
+	Status       string   `json:"status"`
+	ResultCode   string   `json:"result_code"`
+	ErrorMsg     string   `json:"error_msg"`
+	RequestRows  int64    `json:"request_rows"`
+	AffectedRows int64    `json:"affected_rows"`
+	ReturnedRows int64    `json:"returned_rows"`
+	LatencyMs    float64  `json:"latency_ms"`
+	PrevHash     string   `json:"prev_hash"`
+	Hash         string   `json:"hash,omitempty"`
+}
+
+func newAuditRecord(i *GrpcAccessInfo) *AuditRecord {
+	record := &AuditRecord{
+		Time:         getTimeNow(i),
+		TraceID:      getTraceID(i),
+		User:         getUserName(i),
+		Roles:        i.roles,
+		ClientAddr:   getAddr(i),
+		SdkVersion:   getSdkVersion(i),
+		Method:       getMethodName(i),
+		Database:     getDbName(i),
+		Collection:   getCollectionName(i),
+		Partitions:   getPartitionNames(i),
+		Expr:         getExpr(i),
+		Status:       getMethodStatus(i),
+		ResultCode:   getErrorCode(i),
+		ErrorMsg:     getErrorMsg(i),
+		RequestRows:  getRequestRows(i),
+		AffectedRows: getAffectedRows(i),
+		ReturnedRows: getReturnedRows(i),
+	}
+	if record.Roles == nil {
+		record.Roles = []string{}
+	}
+	if !i.end.IsZero() {
+		record.LatencyMs = float64(i.end.Sub(i.start)) / float64(time.Millisecond)
+	}
+	return record
+}
+
+// auditChain chains the audit records by hmac, not concurrent safe, guarded by auditWriter.
+type auditChain struct {
+	secret   []byte
+	prevHash string
+}
+
+// signAuditRecord returns the hmac of the record marshaled without hash.
+func signAuditRecord(secret []byte, record *AuditRecord) (string, error) {
+	hash := record.Hash
+	record.Hash = ""
+	data, err := json.Marshal(record)
+	record.Hash = hash
+	if err != nil {
+		return "", err
+	}
+	mac := hmac.New(sha256.New, secret)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// seal fills the hash of the record and returns the line to write.
+func (c *auditChain) seal(record *AuditRecord) ([]byte, error) {
+	record.PrevHash = c.prevHash
+	hash, err := signAuditRecord(c.secret, record)
+	if err != nil {
+		return nil, err
+	}
+	record.Hash = hash
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+	c.prevHash = record.Hash
+	return append(line, '\n'), nil
+}
+
+// auditWriter writes the audit records in the order of the hash chain.
+type auditWriter struct {
+	mu     sync.Mutex
+	chain  auditChain
+	writer io.Writer
+}
+
+func newAuditWriter(writer io.Writer, secret []byte, prevHash string) *auditWriter {
+	return &auditWriter{
+		chain:  auditChain{secret: secret, prevHash: prevHash},
+		writer: writer,
+	}
+}
+
+func (w *auditWriter) Write(record *AuditRecord) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	line, err := w.chain.seal(record)
+	if err != nil {
+		return err
+	}
+	_, err = w.writer.Write(line)
+	return err
+}
+
+// VerifyAuditLog verifies the hmac chain of an audit log file by the secret starting from prevHash,
+// which is the last hash of the previous file or empty for the first file,
+// and returns the last hash of the file to verify the next one.
+func VerifyAuditLog(r io.Reader, secret []byte, prevHash string) (string, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), megabyte)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		record := &AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return "", fmt.Errorf("invalid audit record at line %d: %w", lineNum, err)
+		}
+		if record.PrevHash != prevHash {
+			return "", fmt.Errorf("audit record at line %d not chained to the previous record", lineNum)
+		}
+		hash, err := signAuditRecord(secret, record)
+		if err != nil {
+			return "", err
+		}
+		if !hmac.Equal([]byte(hash), []byte(record.Hash)) {
+			return "", fmt.Errorf("audit record at line %d was modified", lineNum)
+		}
+		prevHash = hash
+	}
+	return prevHash, scanner.Err()
+}
+
+// lastAuditHash returns the hash of the last audit record in the file to continue the chain after restart,
+// empty if the file not exists or has no audit record.
+func lastAuditHash(filename string) (string, error) {
+	file, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	lastHash := ""
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 0, 64*1024), megabyte)
+	for scanner.Scan() {
+		record := &AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err == nil && record.Hash != "" {
+			lastHash = record.Hash
+		}
+	}
+	return lastHash, scanner.Err()
+}
+
+// lastAuditHash returns the hash of the last audit record written by the logger before restart,
+// which is in the current file, or the latest rotated file if the current one is empty.
+func (l *RotateLogger) lastAuditHash() (string, error) {
+	hash, err := lastAuditHash(l.filename())
+	if err != nil || hash != "" {
+		return hash, err
+	}
+	if _, err := os.Stat(l.dir()); os.IsNotExist(err) {
+		return "", nil
+	}
+	files, err := l.oldLogFiles()
+	if err != nil || len(files) == 0 {
+		return "", err
+	}
+	sort.Slice(files, func(i, j int) bool { return files[i].timestamp.Before(files[j].timestamp) })
+	return lastAuditHash(path.Join(l.dir(), files[len(files)-1].fileName))
+}
+
+func getPartitionNames(i *GrpcAccessInfo) []string {
+	if name, ok := i.req.(interface{ GetPartitionName() string }); ok && name.GetPartitionName() != "" {
+		return []string{name.GetPartitionName()}
+	}
+	if names, ok := i.req.(interface{ GetPartitionNames() []string }); ok && names.GetPartitionNames() != nil {
+		return names.GetPartitionNames()
+	}
+	return []string{}
+}
+
+// getRequestRows returns the rows to write by insert and upsert, or the nq of search.
+func getRequestRows(i *GrpcAccessInfo) int64 {
+	switch req := i.req.(type) {
+	case interface{ GetNumRows() uint32 }:
+		return int64(req.GetNumRows())
+	case interface{ GetNq() int64 }:
+		return req.GetNq()
+	}
+	return 0
+}
+
+// getAffectedRows returns the rows written by insert, upsert and delete.
+func getAffectedRows(i *GrpcAccessInfo) int64 {
+	result, ok := i.resp.(*milvuspb.MutationResult)
+	if !ok {
+		return 0
+	}
+	if result.GetUpsertCnt() > 0 {
+		return result.GetUpsertCnt()
+	}
+	return result.GetInsertCnt() + result.GetDeleteCnt()
+}
+
+// getReturnedRows returns the rows returned by search and query.
+func getReturnedRows(i *GrpcAccessInfo) int64 {
+	switch resp := i.resp.(type) {
+	case *milvuspb.SearchResults:
+		rows := int64(0)
+		for _, topk := range resp.GetResults().GetTopks() {
+			rows += topk
+		}
+		return rows
+	case *milvuspb.QueryResults:
+		if len(resp.GetFieldsData()) == 0 {
+			return 0
+		}
+		rows, err := funcutil.GetNumRowOfFieldData(resp.GetFieldsData()[0])
+		if err != nil {
+			return 0
+		}
+		return int64(rows)
+	}
+	return 0
+}
@@ -0,0 +1,248 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package accesslog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestAuditWriter(t *testing.T) {
+	secret := []byte("secret")
+	buf := &bytes.Buffer{}
+	w := newAuditWriter(buf, secret, "")
+	for _, method := range []string{"CreateCollection", "Insert", "Search"} {
+		assert.NoError(t, w.Write(&AuditRecord{Method: method, Roles: []string{}, Partitions: []string{}}))
+	}
+	lines := strings.SplitAfter(buf.String(), "\n")
+	lines = lines[:len(lines)-1]
+	assert.Equal(t, 3, len(lines))
+
+	lastHash, err := VerifyAuditLog(strings.NewReader(buf.String()), secret, "")
+	assert.NoError(t, err)
+	assert.Equal(t, w.chain.prevHash, lastHash)
+
+	t.Run("chained across files", func(t *testing.T) {
+		next := &bytes.Buffer{}
+		w.writer = next
+		assert.NoError(t, w.Write(&AuditRecord{Method: "Query"}))
+		_, err := VerifyAuditLog(strings.NewReader(next.String()), secret, lastHash)
+		assert.NoError(t, err)
+		_, err = VerifyAuditLog(strings.NewReader(next.String()), secret, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("modified", func(t *testing.T) {
+		modified := strings.Replace(buf.String(), "Insert", "Upsert", 1)
+		_, err := VerifyAuditLog(strings.NewReader(modified), secret, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		removed := lines[0] + lines[2]
+		_, err := VerifyAuditLog(strings.NewReader(removed), secret, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("rebuilt without secret", func(t *testing.T) {
+		rebuilt := &bytes.Buffer{}
+		forged := newAuditWriter(rebuilt, []byte("guess"), "")
+		for _, method := range []string{"CreateCollection", "Upsert", "Search"} {
+			assert.NoError(t, forged.Write(&AuditRecord{Method: method, Roles: []string{}, Partitions: []string{}}))
+		}
+		_, err := VerifyAuditLog(strings.NewReader(rebuilt.String()), []byte("guess"), "")
+		assert.NoError(t, err)
+		_, err = VerifyAuditLog(strings.NewReader(rebuilt.String()), secret, "")
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := VerifyAuditLog(strings.NewReader(lines[0]+"invalid\n"), secret, "")
+		assert.Error(t, err)
+	})
+}
+
+func TestAuditRecord(t *testing.T) {
+	ctx := peer.NewContext(
+		context.Background(),
+		&peer.Peer{
+			Addr: &net.IPAddr{
+				IP:   net.IPv4(0, 0, 0, 0),
+				Zone: "test",
+			},
+		})
+	ctx = metadata.AppendToOutgoingContext(ctx, clientRequestIDKey, "test")
+	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "/milvus.proto.milvus.MilvusService/Insert"}
+
+	accessInfo := NewGrpcAccessInfo(ctx, rpcInfo, &milvuspb.InsertRequest{
+		DbName:         "test-db",
+		CollectionName: "test-collection",
+		PartitionName:  "test-partition",
+		NumRows:        10,
+	})
+	ctx = context.WithValue(ctx, AccessKey{}, accessInfo)
+	SetRoles(ctx, []string{"admin", "public"})
+	accessInfo.SetResult(&milvuspb.MutationResult{Status: merr.Success(), InsertCnt: 10}, nil)
+
+	record := newAuditRecord(accessInfo)
+	assert.Equal(t, "Insert", record.Method)
+	assert.Equal(t, "test", record.TraceID)
+	assert.Equal(t, []string{"admin", "public"}, record.Roles)
+	assert.Equal(t, "test-db", record.Database)
+	assert.Equal(t, "test-collection", record.Collection)
+	assert.Equal(t, []string{"test-partition"}, record.Partitions)
+	assert.Equal(t, "Successful", record.Status)
+	assert.Equal(t, "0", record.ResultCode)
+	assert.EqualValues(t, 10, record.RequestRows)
+	assert.EqualValues(t, 10, record.AffectedRows)
+	assert.EqualValues(t, 0, record.ReturnedRows)
+
+	accessInfo = NewGrpcAccessInfo(ctx, rpcInfo, &milvuspb.UpsertRequest{NumRows: 5})
+	accessInfo.SetResult(&milvuspb.MutationResult{Status: merr.Success(), InsertCnt: 5, DeleteCnt: 5, UpsertCnt: 5}, nil)
+	record = newAuditRecord(accessInfo)
+	assert.EqualValues(t, 5, record.RequestRows)
+	assert.EqualValues(t, 5, record.AffectedRows)
+	assert.Empty(t, record.Roles)
+	assert.Empty(t, record.Partitions)
+
+	accessInfo = NewGrpcAccessInfo(ctx, rpcInfo, &milvuspb.SearchRequest{Nq: 2, PartitionNames: []string{"p1", "p2"}})
+	accessInfo.SetResult(&milvuspb.SearchResults{Status: merr.Success(), Results: &schemapb.SearchResultData{Topks: []int64{3, 4}}}, nil)
+	record = newAuditRecord(accessInfo)
+	assert.EqualValues(t, 2, record.RequestRows)
+	assert.EqualValues(t, 7, record.ReturnedRows)
+	assert.Equal(t, []string{"p1", "p2"}, record.Partitions)
+
+	accessInfo = NewGrpcAccessInfo(ctx, rpcInfo, &milvuspb.QueryRequest{Expr: "pk > 0"})
+	accessInfo.SetResult(&milvuspb.QueryResults{Status: merr.Success(), FieldsData: []*schemapb.FieldData{{
+		Type: schemapb.DataType_Int64,
+		Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 2, 3}}},
+		}},
+	}}}, nil)
+	record = newAuditRecord(accessInfo)
+	assert.Equal(t, "pk > 0", record.Expr)
+	assert.EqualValues(t, 3, record.ReturnedRows)
+
+	// the fixed schema has all the fields even if empty
+	data, err := json.Marshal(record)
+	assert.NoError(t, err)
+	fields := map[string]any{}
+	assert.NoError(t, json.Unmarshal(data, &fields))
+	for _, key := range []string{"user", "roles", "client_addr", "method", "database", "collection", "partitions", "expr", "result_code", "request_rows", "affected_rows", "returned_rows", "latency_ms", "prev_hash"} {
+		assert.Contains(t, fields, key)
+	}
+}
+
+func TestAccessLogger_JSONMode(t *testing.T) {
+	var Params paramtable.ComponentParam
+
+	Params.Init(paramtable.NewBaseTable(paramtable.SkipRemote(true)))
+	testPath := "/tmp/accesstest_json"
+	Params.Save(Params.ProxyCfg.AccessLog.Enable.Key, "true")
+	Params.Save(Params.ProxyCfg.AccessLog.Mode.Key, JSONMode)
+	Params.Save(Params.ProxyCfg.AccessLog.AuditSecret.Key, "secret")
+	Params.Save(Params.ProxyCfg.AccessLog.Filename.Key, "audit.log")
+	Params.Save(Params.ProxyCfg.AccessLog.LocalPath.Key, testPath)
+	defer os.RemoveAll(testPath)
+	defer func() { _globalA = nil }()
+
+	rpcInfo := &grpc.UnaryServerInfo{Server: nil, FullMethod: "testMethod"}
+	ctx := metadata.AppendToOutgoingContext(context.Background(), clientRequestIDKey, "test")
+	write := func() {
+		accessInfo := NewGrpcAccessInfo(ctx, rpcInfo, &milvuspb.QueryRequest{CollectionName: "test-collection"})
+		accessInfo.SetResult(&milvuspb.QueryResults{Status: merr.Success()}, nil)
+		assert.True(t, accessInfo.Write())
+	}
+
+	err := initAccessLogger(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
+	assert.NoError(t, err)
+	write()
+	assert.NoError(t, Rotate())
+	write()
+	_globalR.Close()
+
+	// the chain continues after restart
+	err = initAccessLogger(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
+	assert.NoError(t, err)
+	write()
+	_globalR.Close()
+
+	files, err := _globalR.oldLogFiles()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+	rotated, err := os.ReadFile(path.Join(testPath, files[0].fileName))
+	assert.NoError(t, err)
+	current, err := os.ReadFile(path.Join(testPath, "audit.log"))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, strings.Count(string(current), "\n"))
+
+	lastHash, err := VerifyAuditLog(bytes.NewReader(rotated), []byte("secret"), "")
+	assert.NoError(t, err)
+	_, err = VerifyAuditLog(bytes.NewReader(current), []byte("secret"), lastHash)
+	assert.NoError(t, err)
+
+	t.Run("missing secret", func(t *testing.T) {
+		Params.Save(Params.ProxyCfg.AccessLog.AuditSecret.Key, "")
+		defer Params.Save(Params.ProxyCfg.AccessLog.AuditSecret.Key, "secret")
+		err := initAccessLogger(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
+		assert.ErrorIs(t, err, merr.ErrParameterMissing)
+	})
+
+	t.Run("invalid mode", func(t *testing.T) {
+		Params.Save(Params.ProxyCfg.AccessLog.Mode.Key, "xml")
+		err := initAccessLogger(&Params.ProxyCfg.AccessLog, &Params.MinioCfg)
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
+
+type failedWriter struct{}
+
+func (w *failedWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("mock error")
+}
+
+func TestAuditWriteFailure(t *testing.T) {
+	paramtable.Init()
+	_globalA = newAuditWriter(&failedWriter{}, []byte("secret"), "")
+	defer func() { _globalA = nil }()
+
+	counter := metrics.ProxyAccessLogWriteFailureCount.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10))
+	before := testutil.ToFloat64(counter)
+	accessInfo := NewGrpcAccessInfo(context.Background(), &grpc.UnaryServerInfo{FullMethod: "testMethod"}, &milvuspb.QueryRequest{})
+	assert.False(t, accessInfo.Write())
+	assert.Equal(t, before+1, testutil.ToFloat64(counter))
+}
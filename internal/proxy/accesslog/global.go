@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap/zapcore"
 
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -35,6 +36,7 @@ var (
 	_globalW io.Writer
 	_globalR *RotateLogger
 	_globalF *FormatterManger
+	_globalA *auditWriter
 	once     sync.Once
 )
 
@@ -80,13 +82,24 @@ func initFormatter(logCfg *paramtable.AccessLogConfig) error {
 func initAccessLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.MinioConfig) error {
 	var lg *RotateLogger
 	var err error
+	_globalA = nil
 	if !logCfg.Enable.GetAsBool() {
 		return nil
 	}
 
-	err = initFormatter(logCfg)
-	if err != nil {
-		return err
+	mode := logCfg.Mode.GetValue()
+	switch mode {
+	case TextMode:
+		err = initFormatter(logCfg)
+		if err != nil {
+			return err
+		}
+	case JSONMode:
+		if logCfg.AuditSecret.GetValue() == "" {
+			return merr.WrapErrParameterMissing("proxy.accessLog.auditSecret", "required by json mode of access log")
+		}
+	default:
+		return merr.WrapErrParameterInvalid("text or json", mode, "invalid access log mode")
 	}
 
 	if len(logCfg.Filename.GetValue()) > 0 {
@@ -95,7 +108,15 @@ func initAccessLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.M
 			return err
 		}
 
-		if logCfg.CacheSize.GetAsInt() > 0 {
+		if mode == JSONMode {
+			// the audit records are written without cache,
+			// so they are neither lost on crash nor split across the rotated files
+			prevHash, err := lg.lastAuditHash()
+			if err != nil {
+				return err
+			}
+			_globalA = newAuditWriter(lg, []byte(logCfg.AuditSecret.GetValue()), prevHash)
+		} else if logCfg.CacheSize.GetAsInt() > 0 {
 			blg := NewCacheLogger(lg, logCfg.CacheSize.GetAsInt())
 			_globalW = zapcore.AddSync(blg)
 		} else {
@@ -108,6 +129,9 @@ func initAccessLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.M
 		}
 
 		_globalW = stdout
+		if mode == JSONMode {
+			_globalA = newAuditWriter(stdout, []byte(logCfg.AuditSecret.GetValue()), "")
+		}
 	}
 	_globalR = lg
 	return nil
@@ -20,9 +20,11 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"strconv"
 	"time"
 
 	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -32,6 +34,8 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -52,6 +56,7 @@ type GrpcAccessInfo struct {
 	grpcInfo *grpc.UnaryServerInfo
 	start    time.Time
 	end      time.Time
+	roles    []string
 }
 
 func NewGrpcAccessInfo(ctx context.Context, grpcInfo *grpc.UnaryServerInfo, req interface{}) *GrpcAccessInfo {
@@ -70,6 +75,13 @@ func (i *GrpcAccessInfo) UpdateCtx(ctx context.Context) {
 	i.ctx = ctx
 }
 
+// SetRoles records the roles of the user in the access info of the request.
+func SetRoles(ctx context.Context, roles []string) {
+	if accessInfo, ok := ctx.Value(AccessKey{}).(*GrpcAccessInfo); ok {
+		accessInfo.roles = roles
+	}
+}
+
 func (i *GrpcAccessInfo) SetResult(resp interface{}, err error) {
 	i.resp = resp
 	i.err = err
@@ -100,6 +112,14 @@ func (i *GrpcAccessInfo) Get(keys ...string) []any {
 }
 
 func (i *GrpcAccessInfo) Write() bool {
+	if _globalA != nil {
+		if err := _globalA.Write(newAuditRecord(i)); err != nil {
+			writeFailed(i, err)
+			return false
+		}
+		return true
+	}
+
 	if _globalW == nil {
 		return false
 	}
@@ -109,8 +129,17 @@ func (i *GrpcAccessInfo) Write() bool {
 		return false
 	}
 
-	_, err := _globalW.Write([]byte(formatter.Format(i)))
-	return err == nil
+	if _, err := _globalW.Write([]byte(formatter.Format(i))); err != nil {
+		writeFailed(i, err)
+		return false
+	}
+	return true
+}
+
+// writeFailed logs and counts the access log record failed to write.
+func writeFailed(i *GrpcAccessInfo, err error) {
+	log.RatedWarn(10, "failed to write access log", zap.String("method", getMethodName(i)), zap.Error(err))
+	metrics.ProxyAccessLogWriteFailureCount.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Inc()
 }
 
 func getTimeCost(i *GrpcAccessInfo) string {
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -31,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type config struct {
@@ -45,10 +47,11 @@ type config struct {
 }
 
 // minIO client for upload access log
-// TODO file retention on minio
 type (
 	RetentionFunc func(object minio.ObjectInfo) bool
-	task          struct {
+	// BackupsRetentionFunc returns the objects to remove of all the uploaded objects
+	BackupsRetentionFunc func(objects []minio.ObjectInfo) []minio.ObjectInfo
+	task                 struct {
 		objectName string
 		filePath   string
 	}
@@ -58,8 +61,9 @@ type minioHandler struct {
 	bucketName string
 	rootPath   string
 
-	retentionPolicy RetentionFunc
-	client          *minio.Client
+	retentionPolicy        RetentionFunc
+	backupsRetentionPolicy BackupsRetentionFunc
+	client                 *minio.Client
 
 	taskCh    chan task
 	closeCh   chan struct{}
@@ -187,20 +191,38 @@ func (c *minioHandler) update(objectName string, filePath string) error {
 }
 
 func (c *minioHandler) Retention() error {
-	if c.retentionPolicy == nil {
+	if c.retentionPolicy == nil && c.backupsRetentionPolicy == nil {
 		return nil
 	}
 
-	objects := c.client.ListObjects(context.Background(), c.bucketName, minio.ListObjectsOptions{Prefix: c.rootPath, Recursive: false})
-	removeObjects := make(chan minio.ObjectInfo)
-	go func() {
-		defer close(removeObjects)
-		for object := range objects {
+	objects := []minio.ObjectInfo{}
+	for object := range c.client.ListObjects(context.Background(), c.bucketName, minio.ListObjectsOptions{Prefix: c.rootPath, Recursive: false}) {
+		if object.Err != nil {
+			log.Warn("failed to list with rootpath", zap.String("rootpath", c.rootPath), zap.Error(object.Err))
+			return object.Err
+		}
+		objects = append(objects, object)
+	}
+
+	removeObjects := make(chan minio.ObjectInfo, len(objects))
+	removed := typeutil.NewSet[string]()
+	if c.retentionPolicy != nil {
+		for _, object := range objects {
 			if c.retentionPolicy(object) {
+				removed.Insert(object.Key)
 				removeObjects <- object
 			}
 		}
-	}()
+	}
+	if c.backupsRetentionPolicy != nil {
+		for _, object := range c.backupsRetentionPolicy(objects) {
+			if !removed.Contain(object.Key) {
+				removed.Insert(object.Key)
+				removeObjects <- object
+			}
+		}
+	}
+	close(removeObjects)
 
 	for rErr := range c.client.RemoveObjects(context.Background(), c.bucketName, removeObjects, minio.RemoveObjectsOptions{GovernanceBypass: false}) {
 		if rErr.Err != nil {
@@ -271,3 +293,34 @@ func getTimeRetentionFunc(retentionTime int, prefix, ext string) RetentionFunc {
 		return intervalTime > (time.Duration(retentionTime) * time.Hour)
 	}
 }
+
+// getBackupsRetentionFunc retains the latest maxBackups log files in minIO.
+func getBackupsRetentionFunc(maxBackups int, prefix, ext string) BackupsRetentionFunc {
+	if maxBackups <= 0 {
+		return nil
+	}
+
+	return func(objects []minio.ObjectInfo) []minio.ObjectInfo {
+		logObjects := []logInfo{}
+		objectMap := make(map[string]minio.ObjectInfo)
+		for _, object := range objects {
+			name := path.Base(object.Key)
+			fileTime, err := timeFromName(name, prefix, ext)
+			if err != nil {
+				continue
+			}
+			logObjects = append(logObjects, logInfo{timestamp: fileTime, fileName: object.Key})
+			objectMap[object.Key] = object
+		}
+		if len(logObjects) <= maxBackups {
+			return nil
+		}
+
+		sort.Slice(logObjects, func(i, j int) bool { return logObjects[i].timestamp.After(logObjects[j].timestamp) })
+		removeObjects := make([]minio.ObjectInfo, 0, len(logObjects)-maxBackups)
+		for _, info := range logObjects[maxBackups:] {
+			removeObjects = append(removeObjects, objectMap[info.fileName])
+		}
+		return removeObjects
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/minio/minio-go/v7"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -153,3 +154,22 @@ func createAndUpdateFile(handler *minioHandler, t time.Time, rootPath, prefix, e
 	handler.update(oldFilePath, oldFileName)
 	return nil
 }
+
+func TestGetBackupsRetentionFunc(t *testing.T) {
+	prefix, ext := "accesslog", ".log"
+	assert.Nil(t, getBackupsRetentionFunc(0, prefix, ext))
+
+	now := time.Now()
+	objects := []minio.ObjectInfo{}
+	for i := 0; i < 5; i++ {
+		objects = append(objects, minio.ObjectInfo{Key: "access_log/" + prefix + now.Add(time.Duration(-i)*time.Hour).Format(timeNameFormat) + ext})
+	}
+	objects = append(objects, minio.ObjectInfo{Key: "access_log/irrelevant" + now.Add(-time.Hour*24).Format(timeNameFormat) + ext})
+
+	retention := getBackupsRetentionFunc(3, prefix, ext)
+	removed := retention(objects)
+	assert.ElementsMatch(t, objects[3:5], removed)
+
+	retention = getBackupsRetentionFunc(5, prefix, ext)
+	assert.Empty(t, retention(objects))
+}
@@ -47,6 +47,67 @@ func UnaryUpdateAccessInfoInterceptor(ctx context.Context, req any, info *grpc.U
 	return handler(ctx, req)
 }
 
+// StreamAccessLogInterceptor writes the access log of a streaming request when the stream ends,
+// the request is the first message received and the response is the last message sent.
+func StreamAccessLogInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	accessInfo := NewGrpcAccessInfo(ss.Context(), &grpc.UnaryServerInfo{Server: srv, FullMethod: info.FullMethod}, nil)
+	stream := &accessLogServerStream{
+		ServerStream: ss,
+		ctx:          context.WithValue(ss.Context(), AccessKey{}, accessInfo),
+		accessInfo:   accessInfo,
+	}
+	err := handler(srv, stream)
+	accessInfo.SetResult(stream.resp, err)
+	accessInfo.Write()
+	return err
+}
+
+// StreamUpdateAccessInfoInterceptor updates the context of the access info by the context of
+// the stream after the request is received.
+func StreamUpdateAccessInfoInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &updateAccessInfoServerStream{ServerStream: ss})
+}
+
+type accessLogServerStream struct {
+	grpc.ServerStream
+	ctx        context.Context
+	accessInfo *GrpcAccessInfo
+	resp       any
+}
+
+func (s *accessLogServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *accessLogServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.accessInfo.req == nil {
+		s.accessInfo.req = m
+	}
+	return nil
+}
+
+func (s *accessLogServerStream) SendMsg(m any) error {
+	s.resp = m
+	return s.ServerStream.SendMsg(m)
+}
+
+type updateAccessInfoServerStream struct {
+	grpc.ServerStream
+}
+
+func (s *updateAccessInfoServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if accessInfo, ok := s.Context().Value(AccessKey{}).(*GrpcAccessInfo); ok {
+		accessInfo.UpdateCtx(s.Context())
+	}
+	return nil
+}
+
 func join(path1, path2 string) string {
 	if strings.HasSuffix(path1, "/") {
 		return path1 + path2
@@ -17,9 +17,14 @@
 package accesslog
 
 import (
+	"context"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 )
 
 func TestJoin(t *testing.T) {
@@ -50,3 +55,40 @@ func TestGetSdkTypeByUserAgent(t *testing.T) {
 	_, ok = getSdkTypeByUserAgent([]string{"invalid_type"})
 	assert.False(t, ok)
 }
+
+type mockServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *mockServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockServerStream) RecvMsg(m any) error {
+	m.(*milvuspb.SearchRequest).CollectionName = "test"
+	return nil
+}
+
+func (s *mockServerStream) SendMsg(m any) error {
+	return nil
+}
+
+func TestStreamAccessLogInterceptor(t *testing.T) {
+	var accessInfo *GrpcAccessInfo
+	info := &grpc.StreamServerInfo{FullMethod: "/milvus.proto.proxy.ProxyStream/SearchStream"}
+	handler := func(srv any, ss grpc.ServerStream) error {
+		accessInfo = ss.Context().Value(AccessKey{}).(*GrpcAccessInfo)
+		if err := ss.RecvMsg(&milvuspb.SearchRequest{}); err != nil {
+			return err
+		}
+		return ss.SendMsg(&milvuspb.SearchResults{Status: merr.Success()})
+	}
+	err := StreamAccessLogInterceptor(nil, &mockServerStream{ctx: context.Background()}, info, func(srv any, ss grpc.ServerStream) error {
+		return StreamUpdateAccessInfoInterceptor(srv, ss, info, handler)
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "SearchStream", getMethodName(accessInfo))
+	assert.Equal(t, "test", getCollectionName(accessInfo))
+	assert.Equal(t, "Successful", getMethodStatus(accessInfo))
+}
@@ -110,6 +110,7 @@ func NewRotateLogger(logCfg *paramtable.AccessLogConfig, minioCfg *paramtable.Mi
 		if logCfg.RemoteMaxTime.GetAsInt() > 0 {
 			handler.retentionPolicy = getTimeRetentionFunc(logCfg.RemoteMaxTime.GetAsInt(), prefix, ext)
 		}
+		handler.backupsRetentionPolicy = getBackupsRetentionFunc(logCfg.RemoteMaxBackups.GetAsInt(), prefix, ext)
 
 		logger.handler = handler
 	}
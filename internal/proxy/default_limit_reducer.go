@@ -35,7 +35,8 @@ func (r *defaultLimitReducer) Reduce(results []*internalpb.RetrieveResults) (*mi
 func (r *defaultLimitReducer) afterReduce(result *milvuspb.QueryResults) error {
 	collectionName := r.collectionName
 	schema := r.schema
-	outputFieldsID := r.req.GetOutputFieldsId()
+	// copy the ids, the ts column is dropped in place and the reducer may be reused for the streaming results
+	outputFieldsID := append([]int64{}, r.req.GetOutputFieldsId()...)
 
 	result.CollectionName = collectionName
 	var err error
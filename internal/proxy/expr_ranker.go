@@ -0,0 +1,278 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/expr-lang/expr"
+	"github.com/expr-lang/expr/ast"
+	"github.com/expr-lang/expr/parser"
+	"github.com/expr-lang/expr/vm"
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// exprScoreKey is the variable of the normalized scores of all ann searches in the rank expression,
+// score[i] is the score of the i-th ann search, 0 if the entity is not recalled by it.
+const exprScoreKey = "score"
+
+// exprRankFunctions are the math functions available in the rank expression besides the builtins of expr.
+var exprRankFunctions = map[string]any{
+	"log":   math.Log,
+	"log10": math.Log10,
+	"log1p": math.Log1p,
+	"exp":   math.Exp,
+	"sqrt":  math.Sqrt,
+	"pow":   math.Pow,
+}
+
+// normalizeScore maps the distance of the metric into [0, 1], higher is better.
+func normalizeScore(metricType string, score float32) float32 {
+	switch {
+	case strings.EqualFold(metricType, metric.COSINE):
+		return (1 + score) / 2
+	case metric.PositivelyRelated(metricType):
+		return 0.5 + float32(math.Atan(float64(score)))/math.Pi
+	default:
+		return 1 - 2*float32(math.Atan(float64(score)))/math.Pi
+	}
+}
+
+// exprRanker ranks the merged results of hybrid search by a user-defined expression
+// over the normalized scores and the numeric scalar fields of the entities.
+type exprRanker struct {
+	code    string
+	nLegs   int
+	fields  []string // scalar fields referenced by the expression
+	program *vm.Program
+}
+
+type identifierCollector struct {
+	names []string
+}
+
+func (c *identifierCollector) Visit(node *ast.Node) {
+	if identifier, ok := (*node).(*ast.IdentifierNode); ok {
+		c.names = append(c.names, identifier.Value)
+	}
+}
+
+func newExprRanker(code string, nLegs int) (*exprRanker, error) {
+	tree, err := parser.Parse(code)
+	if err != nil {
+		return nil, err
+	}
+	collector := &identifierCollector{}
+	ast.Walk(&tree.Node, collector)
+	fields := lo.Uniq(lo.Filter(collector.names, func(name string, _ int) bool {
+		_, isFunction := exprRankFunctions[name]
+		return name != exprScoreKey && !isFunction
+	}))
+
+	r := &exprRanker{
+		code:   code,
+		nLegs:  nLegs,
+		fields: fields,
+	}
+	// all the fields are evaluated as float64
+	r.program, err = expr.Compile(code, expr.Env(r.env(make([]float64, nLegs), make(map[string]float64))), expr.AsFloat64())
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *exprRanker) env(scores []float64, values map[string]float64) map[string]any {
+	env := make(map[string]any, len(exprRankFunctions)+len(r.fields)+1)
+	for name, fn := range exprRankFunctions {
+		env[name] = fn
+	}
+	for _, field := range r.fields {
+		env[field] = values[field]
+	}
+	env[exprScoreKey] = scores
+	return env
+}
+
+// validate checks the fields referenced by the expression are numeric scalar fields of the collection.
+func (r *exprRanker) validate(schema *schemaInfo) error {
+	for _, name := range r.fields {
+		field, ok := lo.Find(schema.GetFields(), func(field *schemapb.FieldSchema) bool {
+			return field.GetName() == name
+		})
+		if !ok {
+			return merr.WrapErrParameterInvalidMsg("field %s in rank expression not found", name)
+		}
+		if !typeutil.IsIntegerType(field.GetDataType()) && !typeutil.IsFloatingType(field.GetDataType()) {
+			return merr.WrapErrParameterInvalidMsg("field %s in rank expression is %s, only numeric fields are supported",
+				name, field.GetDataType().String())
+		}
+	}
+	return nil
+}
+
+func getNumericValue(fieldData *schemapb.FieldData, idx int64) float64 {
+	scalars := fieldData.GetScalars()
+	switch fieldData.GetType() {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return float64(scalars.GetIntData().GetData()[idx])
+	case schemapb.DataType_Int64:
+		return float64(scalars.GetLongData().GetData()[idx])
+	case schemapb.DataType_Float:
+		return float64(scalars.GetFloatData().GetData()[idx])
+	case schemapb.DataType_Double:
+		return scalars.GetDoubleData().GetData()[idx]
+	default:
+		return 0
+	}
+}
+
+type exprCandidate struct {
+	pk       any
+	groupVal any
+	scores   []float64
+	values   map[string]float64
+	score    float32
+}
+
+// rank merges the normalized results of all ann searches in order, evaluates the expression
+// for each recalled entity and keeps the topk entities with the highest scores.
+func (r *exprRanker) rank(ctx context.Context, params *rankParams, pkType schemapb.DataType, legResults []*milvuspb.SearchResults) (*milvuspb.SearchResults, error) {
+	tr := timerecord.NewTimeRecorder("exprRanker.rank")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+	log.Ctx(ctx).Debug("rank search results by expr",
+		zap.String("expr", r.code),
+		zap.Int("len(legResults)", len(legResults)),
+		zap.Int64("offset", params.offset),
+		zap.Int64("limit", params.limit))
+
+	ret := &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       params.limit,
+			FieldsData: make([]*schemapb.FieldData, 0),
+			Scores:     []float32{},
+			Ids:        &schemapb.IDs{},
+			Topks:      []int64{},
+		},
+	}
+	switch pkType {
+	case schemapb.DataType_Int64:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: make([]int64, 0)}}
+	case schemapb.DataType_VarChar:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: make([]string, 0)}}
+	default:
+		return nil, errors.New("unsupported pk type")
+	}
+
+	candidates := make([]*exprCandidate, 0)
+	pkCandidates := make(map[any]*exprCandidate)
+	groupValType := schemapb.DataType_None
+	for i, result := range legResults {
+		data := result.GetResults()
+		if data.GetGroupByFieldValue() != nil {
+			groupValType = data.GetGroupByFieldValue().GetType()
+		}
+		fieldsData := lo.Filter(data.GetFieldsData(), func(fieldData *schemapb.FieldData, _ int) bool {
+			return lo.Contains(r.fields, fieldData.GetFieldName())
+		})
+		for j, score := range data.GetScores() {
+			pk := typeutil.GetPK(data.GetIds(), int64(j))
+			candidate, ok := pkCandidates[pk]
+			if !ok {
+				candidate = &exprCandidate{
+					pk:     pk,
+					scores: make([]float64, r.nLegs),
+					values: make(map[string]float64),
+				}
+				pkCandidates[pk] = candidate
+				candidates = append(candidates, candidate)
+			}
+			if data.GetGroupByFieldValue() != nil {
+				candidate.groupVal = typeutil.GetData(data.GetGroupByFieldValue(), j)
+			}
+			candidate.scores[i] = float64(score)
+			for _, fieldData := range fieldsData {
+				candidate.values[fieldData.GetFieldName()] = getNumericValue(fieldData, int64(j))
+			}
+		}
+	}
+
+	for _, candidate := range candidates {
+		output, err := vm.Run(r.program, r.env(candidate.scores, candidate.values))
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("failed to evaluate rank expression: %v", err)
+		}
+		score := output.(float64)
+		if math.IsNaN(score) {
+			score = math.Inf(-1)
+		}
+		candidate.score = float32(score)
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	if params.groupSize > 0 {
+		// keep the hits of the top groups, offset and limit are the number of groups
+		selected := groupSearchHits(lo.Map(candidates, func(candidate *exprCandidate, _ int) any {
+			return candidate.groupVal
+		}), params.offset, params.limit, params.groupSize)
+		candidates = lo.Map(selected, func(idx int, _ int) *exprCandidate {
+			return candidates[idx]
+		})
+	} else if int64(len(candidates)) <= params.offset {
+		candidates = nil
+	} else {
+		candidates = candidates[params.offset:]
+		if int64(len(candidates)) > params.limit {
+			candidates = candidates[:params.limit]
+		}
+	}
+	ret.Results.Topks = append(ret.Results.Topks, int64(len(candidates)))
+	for _, candidate := range candidates {
+		typeutil.AppendPKs(ret.Results.Ids, candidate.pk)
+		score := candidate.score
+		if params.roundDecimal != -1 {
+			multiplier := math.Pow(10.0, float64(params.roundDecimal))
+			score = float32(math.Floor(float64(score)*multiplier+0.5) / multiplier)
+		}
+		ret.Results.Scores = append(ret.Results.Scores, score)
+		if params.groupSize > 0 && groupValType != schemapb.DataType_None {
+			if err := typeutil.AppendGroupByValue(ret.Results, candidate.groupVal, groupValType); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return ret, nil
+}
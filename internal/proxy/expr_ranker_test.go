@@ -0,0 +1,149 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+func TestNormalizeScore(t *testing.T) {
+	assert.InDelta(t, 1.0, normalizeScore(metric.COSINE, 1), 1e-6)
+	assert.InDelta(t, 0.5, normalizeScore(metric.COSINE, 0), 1e-6)
+	assert.InDelta(t, 0.5, normalizeScore(metric.IP, 0), 1e-6)
+	assert.Greater(t, normalizeScore(metric.IP, 10), normalizeScore(metric.IP, 1))
+	assert.InDelta(t, 1.0, normalizeScore(metric.L2, 0), 1e-6)
+	assert.Less(t, normalizeScore(metric.L2, 10), normalizeScore(metric.L2, 1))
+	assert.Greater(t, normalizeScore(metric.L2, 1000), float32(0))
+}
+
+func TestExprRanker(t *testing.T) {
+	schema := newSchemaInfo(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "popularity", DataType: schemapb.DataType_Int64},
+			{FieldID: 102, Name: "title", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	})
+
+	t.Run("validate", func(t *testing.T) {
+		ranker, err := newExprRanker("score[0] * log1p(popularity)", 2)
+		assert.NoError(t, err)
+		assert.NoError(t, ranker.validate(schema))
+
+		ranker, err = newExprRanker("score[0] + title", 2)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, ranker.validate(schema), merr.ErrParameterInvalid)
+
+		ranker, err = newExprRanker("score[0] + likes", 2)
+		assert.NoError(t, err)
+		assert.ErrorIs(t, ranker.validate(schema), merr.ErrParameterInvalid)
+
+		_, err = newExprRanker(`score[0] + "a"`, 2)
+		assert.Error(t, err)
+	})
+
+	genResult := func(ids []int64, scores []float32, popularity []int64) *milvuspb.SearchResults {
+		return &milvuspb.SearchResults{
+			Results: &schemapb.SearchResultData{
+				NumQueries: 1,
+				Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+				Scores:     scores,
+				Topks:      []int64{int64(len(ids))},
+				FieldsData: []*schemapb.FieldData{{
+					FieldName: "popularity",
+					FieldId:   101,
+					Type:      schemapb.DataType_Int64,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: popularity}},
+					}},
+				}},
+			},
+		}
+	}
+	legResults := []*milvuspb.SearchResults{
+		genResult([]int64{1, 2, 3}, []float32{0.9, 0.8, 0.7}, []int64{1, 100, 10}),
+		genResult([]int64{3, 4}, []float32{0.9, 0.5}, []int64{10, 1000}),
+	}
+
+	t.Run("rank", func(t *testing.T) {
+		ranker, err := newExprRanker("0.5*score[0] + 0.5*score[1]", 2)
+		assert.NoError(t, err)
+		ret, err := ranker.rank(context.Background(), &rankParams{limit: 2, roundDecimal: -1}, schemapb.DataType_Int64, legResults)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 1}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.InDelta(t, 0.8, ret.GetResults().GetScores()[0], 1e-6)
+		assert.Equal(t, []int64{2}, ret.GetResults().GetTopks())
+	})
+
+	t.Run("rank with scalar field", func(t *testing.T) {
+		ranker, err := newExprRanker("score[0] + score[1] + log10(popularity)", 2)
+		assert.NoError(t, err)
+		ret, err := ranker.rank(context.Background(), &rankParams{limit: 3, offset: 1, roundDecimal: 2}, schemapb.DataType_Int64, legResults)
+		assert.NoError(t, err)
+		// 4: 3.5, 2: 2.8, 3: 2.6, 1: 0.9
+		assert.Equal(t, []int64{2, 3, 1}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.InDelta(t, 2.8, ret.GetResults().GetScores()[0], 1e-6)
+
+		ret, err = ranker.rank(context.Background(), &rankParams{limit: 3, offset: 4, roundDecimal: -1}, schemapb.DataType_Int64, legResults)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0}, ret.GetResults().GetTopks())
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		ranker, err := newExprRanker("score[0] + score[1]", 2)
+		assert.NoError(t, err)
+		groupResults := []*milvuspb.SearchResults{
+			genResult([]int64{1, 2, 3}, []float32{0.9, 0.8, 0.7}, []int64{1, 100, 10}),
+			genResult([]int64{3, 4}, []float32{0.9, 0.5}, []int64{10, 1000}),
+		}
+		for i, groupByValues := range [][]int64{{7, 7, 8}, {8, 7}} {
+			groupResults[i].Results.GroupByFieldValue = &schemapb.FieldData{
+				Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: groupByValues}},
+				}},
+			}
+		}
+		// 3: 1.6, 1: 0.9, 2: 0.8, 4: 0.5
+		ret, err := ranker.rank(context.Background(), &rankParams{limit: 2, roundDecimal: -1, groupSize: 2}, schemapb.DataType_Int64, groupResults)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{3, 1, 2}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{8, 7, 7}, ret.GetResults().GetGroupByFieldValue().GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("nan score", func(t *testing.T) {
+		ranker, err := newExprRanker("log(score[1])", 2)
+		assert.NoError(t, err)
+		ret, err := ranker.rank(context.Background(), &rankParams{limit: 4, roundDecimal: -1}, schemapb.DataType_Int64, []*milvuspb.SearchResults{
+			genResult([]int64{1}, []float32{0.9}, []int64{1}),
+			genResult([]int64{2}, []float32{-1}, []int64{1}),
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.True(t, math.IsInf(float64(ret.GetResults().GetScores()[0]), -1))
+	})
+}
@@ -151,6 +151,11 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 		}
 	}
 
+	if collectionID != UniqueID(0) {
+		// the cached results may be out of date after the meta of the collection changed
+		globalQueryCache.RemoveCollection(collectionID)
+	}
+
 	if request.GetBase().GetMsgType() == commonpb.MsgType_DropCollection {
 		// no need to handle error, since this Proxy may not create dml stream for the collection.
 		node.chMgr.removeDMLStream(request.GetCollectionID())
@@ -348,6 +353,135 @@ func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListData
 	return dct.result, nil
 }
 
+// AlterDatabase alters the properties of a database, such as load config and quota settings.
+func (node *Proxy) AlterDatabase(ctx context.Context, request *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkAdminPrivilege(ctx); err != nil {
+		return merr.Status(err), nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterDatabase")
+	defer sp.End()
+
+	method := "AlterDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.TotalLabel,
+	).Inc()
+
+	adt := &alterDatabaseTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		AlterDatabaseRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	log := log.With(
+		zap.String("traceID", sp.SpanContext().TraceID().String()),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.DbName),
+	)
+
+	log.Info(rpcReceived(method), zap.Any("properties", request.GetProperties()))
+	if err := node.sched.ddQueue.Enqueue(adt); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcEnqueued(method))
+	if err := adt.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.SuccessLabel,
+	).Inc()
+
+	metrics.ProxyReqLatency.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+	).Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	return adt.result, nil
+}
+
+// DescribeDatabase returns the id, create time and properties of a database.
+func (node *Proxy) DescribeDatabase(ctx context.Context, request *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	resp := &internalpb.DescribeDatabaseResponse{}
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+	if err := checkAdminPrivilege(ctx); err != nil {
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DescribeDatabase")
+	defer sp.End()
+
+	method := "DescribeDatabase"
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.TotalLabel,
+	).Inc()
+
+	ddt := &describeDatabaseTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		DescribeDatabaseRequest: request,
+		rootCoord:               node.rootCoord,
+	}
+
+	log := log.With(
+		zap.String("traceID", sp.SpanContext().TraceID().String()),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("dbName", request.DbName),
+	)
+
+	log.Info(rpcReceived(method))
+	if err := node.sched.ddQueue.Enqueue(ddt); err != nil {
+		log.Warn(rpcFailedToEnqueue(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.AbandonLabel).Inc()
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	log.Info(rpcEnqueued(method))
+	if err := ddt.WaitToFinish(); err != nil {
+		log.Warn(rpcFailedToWaitToFinish(method), zap.Error(err))
+		metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method, metrics.FailLabel).Inc()
+		resp.Status = merr.Status(err)
+		return resp, nil
+	}
+
+	log.Info(rpcDone(method))
+	metrics.ProxyFunctionCall.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+		metrics.SuccessLabel,
+	).Inc()
+
+	metrics.ProxyReqLatency.WithLabelValues(
+		strconv.FormatInt(paramtable.GetNodeID(), 10),
+		method,
+	).Observe(float64(tr.ElapseSpan().Milliseconds()))
+
+	return ddt.result, nil
+}
+
 // CreateCollection create a collection by the schema.
 // TODO(dragondriver): add more detailed ut for ConsistencyLevel, should we support multiple consistency level in Proxy?
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
@@ -2597,6 +2731,31 @@ func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest)
 
 // Search search the most similar records of requests.
 func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	qt := &searchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Search),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: request,
+		tr:      timerecord.NewTimeRecorder("search"),
+		qc:      node.queryCoord,
+		node:    node,
+		lb:      node.lbPolicy,
+	}
+	result, err := node.search(ctx, qt)
+	if err == nil && result.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success && qt.iterator != nil {
+		setSearchIteratorCursor(ctx, qt.iterator.nextCursor)
+	}
+	return result, err
+}
+
+func (node *Proxy) search(ctx context.Context, qt *searchTask) (*milvuspb.SearchResults, error) {
+	request := qt.request
 	receiveSize := proto.Size(request)
 	metrics.ProxyReceiveBytes.WithLabelValues(
 		strconv.FormatInt(paramtable.GetNodeID(), 10),
@@ -2640,23 +2799,6 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		request.PlaceholderGroup = placeholderGroupBytes
 	}
 
-	qt := &searchTask{
-		ctx:       ctx,
-		Condition: NewTaskCondition(ctx),
-		SearchRequest: &internalpb.SearchRequest{
-			Base: commonpbutil.NewMsgBase(
-				commonpbutil.WithMsgType(commonpb.MsgType_Search),
-				commonpbutil.WithSourceID(paramtable.GetNodeID()),
-			),
-			ReqID: paramtable.GetNodeID(),
-		},
-		request: request,
-		tr:      timerecord.NewTimeRecorder("search"),
-		qc:      node.queryCoord,
-		node:    node,
-		lb:      node.lbPolicy,
-	}
-
 	guaranteeTs := request.GuaranteeTimestamp
 
 	log := log.Ctx(ctx).With(
@@ -2750,6 +2892,10 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 		request.CollectionName,
 	).Observe(float64(searchDur))
 
+	// the streamed results carry the info of partial results already
+	if qt.result != nil && !qt.streamed {
+		qt.result.Status = setPartialResults(ctx, qt.result.GetStatus(), qt.partial)
+	}
 	if qt.result != nil {
 		sentSize := proto.Size(qt.result)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
@@ -2889,6 +3035,9 @@ func (node *Proxy) HybridSearch(ctx context.Context, request *milvuspb.HybridSea
 		request.CollectionName,
 	).Observe(float64(searchDur))
 
+	if qt.result != nil {
+		qt.result.Status = setPartialResults(ctx, qt.result.GetStatus(), qt.partial)
+	}
 	if qt.result != nil {
 		sentSize := proto.Size(qt.result)
 		metrics.ProxyReadReqSendBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Add(float64(sentSize))
@@ -3167,7 +3316,95 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		qc:      node.queryCoord,
 		lb:      node.lbPolicy,
 	}
-	return node.query(ctx, qt)
+	result, err := node.query(ctx, qt)
+	if qt.iterator != nil && qt.iterator.nextCursor != "" {
+		setQueryIteratorCursor(ctx, qt.iterator.nextCursor)
+	}
+	if err == nil && result.GetStatus().GetErrorCode() == commonpb.ErrorCode_Success {
+		result.Status = setPartialResults(ctx, result.GetStatus(), qt.partial)
+	}
+	return result, err
+}
+
+// QueryStream gets the records and sends them in batches of proxy.streamBatchSize.
+// The records of the queries without limit are sent as soon as the shard leaders return them,
+// the count and paginated results are reduced as a whole and then split into batches.
+// The batches are sent in the order of the results, the last batch carries the error status if the query fails.
+func (node *Proxy) QueryStream(request *milvuspb.QueryRequest, srv proxypb.ProxyStream_QueryStreamServer) error {
+	ctx := srv.Context()
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Retrieve),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: request,
+		qc:      node.queryCoord,
+		lb:      node.lbPolicy,
+		stream:  srv,
+	}
+	result, err := node.query(ctx, qt)
+	if err != nil {
+		return err
+	}
+	// the streamed results are already sent by the task
+	if qt.streamReducer != nil && merr.Ok(result.GetStatus()) {
+		return nil
+	}
+	for _, batch := range splitQueryResults(result, streamBatchSize()) {
+		if err := srv.Send(batch); err != nil {
+			log.Ctx(ctx).Warn("failed to send the query results", zap.Error(err))
+			return err
+		}
+		metrics.ProxyStreamSentBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Add(float64(proto.Size(batch)))
+	}
+	return nil
+}
+
+// SearchStream searches the most similar records and sends the results in batches of proxy.streamBatchSize,
+// the results of a query vector are never split into two batches. The results are reduced and requeried
+// by groups of query vectors and each group is sent as soon as it is ready, the results of search iterator
+// and rerank are reduced as a whole and then split into batches.
+// The last batch carries the error status if the search fails.
+func (node *Proxy) SearchStream(request *milvuspb.SearchRequest, srv proxypb.ProxyStream_SearchStreamServer) error {
+	ctx := srv.Context()
+	qt := &searchTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		SearchRequest: &internalpb.SearchRequest{
+			Base: commonpbutil.NewMsgBase(
+				commonpbutil.WithMsgType(commonpb.MsgType_Search),
+				commonpbutil.WithSourceID(paramtable.GetNodeID()),
+			),
+			ReqID: paramtable.GetNodeID(),
+		},
+		request: request,
+		tr:      timerecord.NewTimeRecorder("search"),
+		qc:      node.queryCoord,
+		node:    node,
+		lb:      node.lbPolicy,
+		stream:  srv,
+	}
+	result, err := node.search(ctx, qt)
+	if err != nil {
+		return err
+	}
+	// the streamed results are already sent by the task
+	if qt.streamed && merr.Ok(result.GetStatus()) {
+		return nil
+	}
+	for _, batch := range splitSearchResults(result, streamBatchSize()) {
+		if err := srv.Send(batch); err != nil {
+			log.Ctx(ctx).Warn("failed to send the search results", zap.Error(err))
+			return err
+		}
+		metrics.ProxyStreamSentBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SearchLabel).Add(float64(proto.Size(batch)))
+	}
+	return nil
 }
 
 // CreateAlias create alias for collection, then you can search the collection with alias.
@@ -4841,6 +5078,66 @@ func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.Refr
 	return merr.Success(), nil
 }
 
+// AlterRateLimits sets the DML and DQL rate limits of a user or a role.
+func (node *Proxy) AlterRateLimits(ctx context.Context, req *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-AlterRateLimits")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("user", req.GetUserName()),
+		zap.String("role", req.GetRoleName()))
+
+	log.Info("AlterRateLimits", zap.Any("rates", req.GetRates()))
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkAdminPrivilege(ctx); err != nil {
+		return merr.Status(err), nil
+	}
+	if req.GetUserName() != "" {
+		if err := ValidateUsername(req.GetUserName()); err != nil {
+			return merr.Status(err), nil
+		}
+	}
+	if req.GetRoleName() != "" {
+		if err := ValidateRoleName(req.GetRoleName()); err != nil {
+			return merr.Status(err), nil
+		}
+	}
+
+	result, err := node.rootCoord.AlterRateLimits(ctx, req)
+	if err != nil {
+		log.Warn("fail to alter rate limits", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return result, nil
+}
+
+// DescribeRateLimits returns the DML and DQL rate limits of a user or a role.
+func (node *Proxy) DescribeRateLimits(ctx context.Context, req *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error) {
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-DescribeRateLimits")
+	defer sp.End()
+
+	log := log.Ctx(ctx).With(
+		zap.String("user", req.GetUserName()),
+		zap.String("role", req.GetRoleName()))
+
+	log.Debug("DescribeRateLimits")
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+	}
+	if err := checkAdminPrivilege(ctx); err != nil {
+		return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+	}
+
+	result, err := node.rootCoord.DescribeRateLimits(ctx, req)
+	if err != nil {
+		log.Warn("fail to describe rate limits", zap.Error(err))
+		return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+	}
+	return result, nil
+}
+
 // SetRates limits the rates of requests.
 func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesRequest) (*commonpb.Status, error) {
 	resp := merr.Success()
@@ -4850,7 +5147,16 @@ func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesReques
 	}
 
 	err := node.multiRateLimiter.SetRates(request.GetRates())
-	// TODO: set multiple rate limiter rates
+	if err != nil {
+		resp = merr.Status(err)
+		return resp, nil
+	}
+	err = node.multiRateLimiter.SetDatabaseRates(request.GetDatabaseRates())
+	if err != nil {
+		resp = merr.Status(err)
+		return resp, nil
+	}
+	err = node.multiRateLimiter.SetPrincipalRates(request.GetUserRates(), request.GetRoleRates())
 	if err != nil {
 		resp = merr.Status(err)
 		return resp, nil
@@ -4859,6 +5165,17 @@ func (node *Proxy) SetRates(ctx context.Context, request *proxypb.SetRatesReques
 	return resp, nil
 }
 
+// InvalidateQueryCache drops the cached results of the collections computed before the dml sent by another proxy.
+func (node *Proxy) InvalidateQueryCache(ctx context.Context, request *proxypb.InvalidateQueryCacheRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	for collectionID, ts := range request.GetDmlTimestamps() {
+		globalQueryCache.InvalidateCollection(collectionID, ts)
+	}
+	return merr.Success(), nil
+}
+
 func (node *Proxy) CheckHealth(ctx context.Context, request *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return &milvuspb.CheckHealthResponse{
@@ -5080,6 +5397,68 @@ func (node *Proxy) DropResourceGroup(ctx context.Context, request *milvuspb.Drop
 	return t.result, nil
 }
 
+func (node *Proxy) UpdateResourceGroups(ctx context.Context, request *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	method := "UpdateResourceGroups"
+	for name := range request.GetResourceGroups() {
+		if err := ValidateResourceGroupName(name); err != nil {
+			log.Warn("UpdateResourceGroups failed",
+				zap.Error(err),
+			)
+			return getErrResponse(err, method), nil
+		}
+	}
+
+	ctx, sp := otel.Tracer(typeutil.ProxyRole).Start(ctx, "Proxy-UpdateResourceGroups")
+	defer sp.End()
+	tr := timerecord.NewTimeRecorder(method)
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.TotalLabel).Inc()
+	t := &UpdateResourceGroupsTask{
+		ctx:                         ctx,
+		Condition:                   NewTaskCondition(ctx),
+		UpdateResourceGroupsRequest: request,
+		queryCoord:                  node.queryCoord,
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.String("role", typeutil.ProxyRole),
+	)
+
+	log.Info("UpdateResourceGroups received")
+
+	if err := node.sched.ddQueue.Enqueue(t); err != nil {
+		log.Warn("UpdateResourceGroups failed to enqueue",
+			zap.Error(err))
+
+		return getErrResponse(err, method), nil
+	}
+
+	log.Debug("UpdateResourceGroups enqueued",
+		zap.Uint64("BeginTS", t.BeginTs()),
+		zap.Uint64("EndTS", t.EndTs()))
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("UpdateResourceGroups failed to WaitToFinish",
+			zap.Error(err),
+			zap.Uint64("BeginTS", t.BeginTs()),
+			zap.Uint64("EndTS", t.EndTs()))
+		return getErrResponse(err, method), nil
+	}
+
+	log.Info("UpdateResourceGroups done",
+		zap.Uint64("BeginTS", t.BeginTs()),
+		zap.Uint64("EndTS", t.EndTs()))
+
+	metrics.ProxyFunctionCall.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method,
+		metrics.SuccessLabel).Inc()
+	metrics.ProxyReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return t.result, nil
+}
+
 func (node *Proxy) TransferNode(ctx context.Context, request *milvuspb.TransferNodeRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(node.GetStateCode()); err != nil {
 		return merr.Status(err), nil
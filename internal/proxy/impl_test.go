@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/mocks"
@@ -44,6 +45,7 @@ import (
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream/mqwrapper"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
@@ -74,6 +76,26 @@ func TestProxy_InvalidateCollectionMetaCache_remove_stream(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
 }
 
+func TestProxy_InvalidateQueryCache(t *testing.T) {
+	paramtable.Init()
+	cache := globalQueryCache
+	globalQueryCache = newQueryResultCache()
+	defer func() { globalQueryCache = cache }()
+	globalQueryCache.Put("a", 1, 100, nil)
+
+	node := &Proxy{}
+	node.UpdateStateCode(commonpb.StateCode_Healthy)
+	status, err := node.InvalidateQueryCache(context.Background(), &proxypb.InvalidateQueryCacheRequest{
+		DmlTimestamps: map[int64]uint64{1: 150},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+	_, ok := globalQueryCache.Get("a", metrics.SearchLabel)
+	assert.False(t, ok)
+	// the dml of other proxies are not notified again
+	assert.Empty(t, globalQueryCache.takePendingDml())
+}
+
 func TestProxy_CheckHealth(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		node := &Proxy{session: &sessionutil.Session{SessionRaw: sessionutil.SessionRaw{ServerID: 1}}}
@@ -264,6 +286,20 @@ func TestProxy_ResourceGroup(t *testing.T) {
 		assert.Equal(t, resp.ErrorCode, commonpb.ErrorCode_Success)
 	})
 
+	t.Run("update resource groups", func(t *testing.T) {
+		qc.EXPECT().UpdateResourceGroups(mock.Anything, mock.Anything).Return(successStatus, nil)
+		resp, err := node.UpdateResourceGroups(ctx, &milvuspb.UpdateResourceGroupsRequest{
+			ResourceGroups: map[string]*rgpb.ResourceGroupConfig{
+				"rg": {
+					Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+					Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, resp.ErrorCode, commonpb.ErrorCode_Success)
+	})
+
 	t.Run("transfer node", func(t *testing.T) {
 		qc.EXPECT().TransferNode(mock.Anything, mock.Anything).Return(successStatus, nil)
 		resp, err := node.TransferNode(ctx, &milvuspb.TransferNodeRequest{
@@ -353,6 +389,16 @@ func TestProxy_InvalidResourceGroupName(t *testing.T) {
 		assert.Equal(t, resp.ErrorCode, commonpb.ErrorCode_Success)
 	})
 
+	t.Run("update resource groups", func(t *testing.T) {
+		resp, err := node.UpdateResourceGroups(ctx, &milvuspb.UpdateResourceGroupsRequest{
+			ResourceGroups: map[string]*rgpb.ResourceGroupConfig{
+				"...": {},
+			},
+		})
+		assert.NoError(t, err)
+		assert.ErrorIs(t, merr.Error(resp), merr.ErrParameterInvalid)
+	})
+
 	t.Run("transfer node", func(t *testing.T) {
 		resp, err := node.TransferNode(ctx, &milvuspb.TransferNodeRequest{
 			SourceResourceGroup: "...",
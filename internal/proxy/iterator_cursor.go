@@ -0,0 +1,80 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+// parseIteratorParams returns the cursor of the previous page if any,
+// otherwise returns whether the iterator is enabled on the first page.
+func parseIteratorParams(enableKey, cursorKey string, params []*commonpb.KeyValuePair) (string, bool, error) {
+	cursor, err := funcutil.GetAttrByKeyFromRepeatedKV(cursorKey, params)
+	if err == nil && cursor != "" {
+		return cursor, true, nil
+	}
+
+	enableStr, err := funcutil.GetAttrByKeyFromRepeatedKV(enableKey, params)
+	if err != nil {
+		return "", false, nil
+	}
+	enable, err := strconv.ParseBool(enableStr)
+	if err != nil {
+		return "", false, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", enableKey, enableStr)
+	}
+	return "", enable, nil
+}
+
+// decodeIteratorCursor decodes the cursor returned to the client into the cursor message.
+func decodeIteratorCursor(cursorStr string, cursor proto.Message) error {
+	data, err := base64.RawURLEncoding.DecodeString(cursorStr)
+	if err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid iterator cursor: %v", err)
+	}
+	if err := proto.Unmarshal(data, cursor); err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid iterator cursor: %v", err)
+	}
+	return nil
+}
+
+// encodeIteratorCursor encodes the cursor message to be returned to the client.
+func encodeIteratorCursor(cursor proto.Message) (string, error) {
+	data, err := proto.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// setIteratorCursorHeader sends the cursor of the next page to the client by grpc header.
+func setIteratorCursorHeader(ctx context.Context, header string, cursor string) {
+	if err := grpc.SetHeader(ctx, metadata.Pairs(header, cursor)); err != nil {
+		log.Ctx(ctx).Warn("failed to set iterator cursor", zap.String("header", header), zap.Error(err))
+	}
+}
@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+func TestParseIteratorParams(t *testing.T) {
+	cursor, enable, err := parseIteratorParams(QueryIteratorKey, QueryIteratorCursorKey, nil)
+	assert.NoError(t, err)
+	assert.False(t, enable)
+	assert.Empty(t, cursor)
+
+	// the keys of search iterator don't enable query iterator
+	params := []*commonpb.KeyValuePair{{Key: SearchIteratorKey, Value: "true"}, {Key: SearchIteratorCursorKey, Value: "foo"}}
+	_, enable, err = parseIteratorParams(QueryIteratorKey, QueryIteratorCursorKey, params)
+	assert.NoError(t, err)
+	assert.False(t, enable)
+
+	cursor, enable, err = parseIteratorParams(SearchIteratorKey, SearchIteratorCursorKey, params)
+	assert.NoError(t, err)
+	assert.True(t, enable)
+	assert.Equal(t, "foo", cursor)
+
+	_, _, err = parseIteratorParams(QueryIteratorKey, QueryIteratorCursorKey, []*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "yes please"}})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestIteratorCursorCodec(t *testing.T) {
+	cursorStr, err := encodeIteratorCursor(&internalpb.QueryIteratorCursor{CollectionID: 1, GuaranteeTimestamp: 100})
+	assert.NoError(t, err)
+	cursor := &internalpb.QueryIteratorCursor{}
+	assert.NoError(t, decodeIteratorCursor(cursorStr, cursor))
+	assert.EqualValues(t, 1, cursor.GetCollectionID())
+	assert.EqualValues(t, 100, cursor.GetGuaranteeTimestamp())
+
+	assert.ErrorIs(t, decodeIteratorCursor("%%%", cursor), merr.ErrParameterInvalid)
+	assert.ErrorIs(t, decodeIteratorCursor("AAAA", cursor), merr.ErrParameterInvalid)
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.uber.org/atomic"
+)
+
+const (
+	// hedgeLatencyWindow is the number of the recent response times the hedge delay is computed from
+	hedgeLatencyWindow = 1024
+	// hedgeMinSamples is the number of response times required before hedging any request
+	hedgeMinSamples = 32
+	// hedgeMaxTokens bounds the hedged requests sent in a burst
+	hedgeMaxTokens = 10
+)
+
+// hedgePolicy decides when a channel workload is hedged to another replica.
+// A workload is hedged if its shard leader has not answered within the configured percentile of the recent
+// response times, and the hedge budget is not exhausted. Every workload earns proxy.hedge.budgetRatio token
+// and every hedged workload costs one, so the hedged requests never exceed the ratio of all requests.
+type hedgePolicy struct {
+	mu        sync.Mutex
+	latencies []int64 // ring buffer of the recent response times in ms
+	next      int
+	tokens    float64
+}
+
+func newHedgePolicy() *hedgePolicy {
+	return &hedgePolicy{
+		latencies: make([]int64, 0, hedgeLatencyWindow),
+	}
+}
+
+// record records the response time in ms reported by the shard leader.
+func (h *hedgePolicy) record(latency int64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if len(h.latencies) < hedgeLatencyWindow {
+		h.latencies = append(h.latencies, latency)
+		return
+	}
+	h.latencies[h.next] = latency
+	h.next = (h.next + 1) % hedgeLatencyWindow
+}
+
+// delay returns the time to wait for the shard leader before hedging the workload,
+// false if hedging is disabled or there are not enough response times.
+func (h *hedgePolicy) delay() (time.Duration, bool) {
+	if !Params.ProxyCfg.Hedge.Enabled.GetAsBool() {
+		return 0, false
+	}
+
+	h.mu.Lock()
+	if len(h.latencies) < hedgeMinSamples {
+		h.mu.Unlock()
+		return 0, false
+	}
+	latencies := make([]int64, len(h.latencies))
+	copy(latencies, h.latencies)
+	h.tokens += Params.ProxyCfg.Hedge.BudgetRatio.GetAsFloat()
+	if h.tokens > hedgeMaxTokens {
+		h.tokens = hedgeMaxTokens
+	}
+	h.mu.Unlock()
+
+	sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+	percentile := Params.ProxyCfg.Hedge.LatencyPercentile.GetAsFloat()
+	idx := int(percentile * float64(len(latencies)))
+	if idx >= len(latencies) {
+		idx = len(latencies) - 1
+	}
+	if idx < 0 {
+		idx = 0
+	}
+	delay := time.Duration(latencies[idx]) * time.Millisecond
+	if minDelay := Params.ProxyCfg.Hedge.MinDelay.GetAsDuration(time.Millisecond); delay < minDelay {
+		delay = minDelay
+	}
+	return delay, true
+}
+
+// acquire consumes a token of the hedge budget, returns false if the budget is exhausted.
+func (h *hedgePolicy) acquire() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.tokens < 1 {
+		return false
+	}
+	h.tokens--
+	return true
+}
+
+type hedgeGuardKey struct{}
+
+// withHedgeGuard returns the context shared by the executions of a hedged workload.
+func withHedgeGuard(ctx context.Context) context.Context {
+	return context.WithValue(ctx, hedgeGuardKey{}, atomic.NewBool(false))
+}
+
+// commitHedgedResult returns whether the execution could commit its result,
+// only the first finished execution of a hedged workload commits its result.
+func commitHedgedResult(ctx context.Context) bool {
+	committed, ok := ctx.Value(hedgeGuardKey{}).(*atomic.Bool)
+	if !ok {
+		return true
+	}
+	return committed.CompareAndSwap(false, true)
+}
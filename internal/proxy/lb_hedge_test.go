@@ -0,0 +1,108 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestHedgePolicy(t *testing.T) {
+	paramtable.Get().Save(Params.ProxyCfg.Hedge.Enabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.Hedge.Enabled.Key)
+
+	t.Run("delay", func(t *testing.T) {
+		h := newHedgePolicy()
+		for i := 1; i < hedgeMinSamples; i++ {
+			h.record(int64(i))
+		}
+		_, ok := h.delay()
+		assert.False(t, ok)
+
+		for i := hedgeMinSamples; i <= 100; i++ {
+			h.record(int64(i))
+		}
+		delay, ok := h.delay()
+		assert.True(t, ok)
+		assert.Equal(t, 96*time.Millisecond, delay)
+
+		// the min delay
+		paramtable.Get().Save(Params.ProxyCfg.Hedge.LatencyPercentile.Key, "0")
+		defer paramtable.Get().Reset(Params.ProxyCfg.Hedge.LatencyPercentile.Key)
+		delay, ok = h.delay()
+		assert.True(t, ok)
+		assert.Equal(t, 10*time.Millisecond, delay)
+
+		// the oldest response times are dropped
+		for i := 0; i < hedgeLatencyWindow; i++ {
+			h.record(1000)
+		}
+		delay, ok = h.delay()
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, delay)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.Hedge.Enabled.Key, "false")
+		defer paramtable.Get().Save(Params.ProxyCfg.Hedge.Enabled.Key, "true")
+		h := newHedgePolicy()
+		for i := 0; i < hedgeMinSamples; i++ {
+			h.record(10)
+		}
+		_, ok := h.delay()
+		assert.False(t, ok)
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.Hedge.BudgetRatio.Key, "0.5")
+		defer paramtable.Get().Reset(Params.ProxyCfg.Hedge.BudgetRatio.Key)
+		h := newHedgePolicy()
+		for i := 0; i < hedgeMinSamples; i++ {
+			h.record(10)
+		}
+		assert.False(t, h.acquire())
+		h.delay()
+		assert.False(t, h.acquire())
+		h.delay()
+		assert.True(t, h.acquire())
+		assert.False(t, h.acquire())
+
+		// the tokens are bounded
+		for i := 0; i < 100; i++ {
+			h.delay()
+		}
+		for i := 0; i < hedgeMaxTokens; i++ {
+			assert.True(t, h.acquire())
+		}
+		assert.False(t, h.acquire())
+	})
+}
+
+func TestCommitHedgedResult(t *testing.T) {
+	ctx := context.Background()
+	assert.True(t, commitHedgedResult(ctx))
+	assert.True(t, commitHedgedResult(ctx))
+
+	ctx = withHedgeGuard(ctx)
+	assert.True(t, commitHedgedResult(ctx))
+	assert.False(t, commitHedgedResult(ctx))
+}
@@ -17,17 +17,26 @@ package proxy
 
 import (
 	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -39,10 +48,13 @@ type ChannelWorkload struct {
 	collectionName string
 	collectionID   int64
 	channel        string
-	shardLeaders   []int64
+	shardLeaders   []nodeInfo
 	nq             int64
 	exec           executeFunc
 	retryTimes     uint
+	replicaID      int64  // the replica pinned by the request, 0 if not pinned
+	replicaTag     string // only the replicas with the tag serve the request if not empty
+	hedgeable      bool   // exec commits its result by commitHedgedResult, so it could be hedged to another replica
 }
 
 type CollectionWorkLoad struct {
@@ -51,6 +63,10 @@ type CollectionWorkLoad struct {
 	collectionID   int64
 	nq             int64
 	exec           executeFunc
+	replicaID      int64
+	replicaTag     string
+	hedgeable      bool
+	partial        *partialResults // nil if partial results are not allowed
 }
 
 type LBPolicy interface {
@@ -64,16 +80,26 @@ type LBPolicy interface {
 type LBPolicyImpl struct {
 	balancer  LBBalancer
 	clientMgr shardClientMgr
+	// zone of the proxy, the shard leaders in the same zone are preferred if not empty
+	zone  string
+	hedge *hedgePolicy
 }
 
 func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
 	balancePolicy := params.Params.ProxyCfg.ReplicaSelectionPolicy.GetValue()
 
-	var balancer LBBalancer
+	var (
+		balancer LBBalancer
+		zone     string
+	)
 	switch balancePolicy {
 	case "round_robin":
 		log.Info("use round_robin policy on replica selection")
 		balancer = NewRoundRobinBalancer()
+	case "zone_aware":
+		zone = sessionutil.GetServerLabelsFromEnv()[params.Params.ProxyCfg.ZoneLabelKey.GetValue()]
+		log.Info("use zone_aware policy on replica selection", zap.String("zone", zone))
+		balancer = NewLookAsideBalancer(clientMgr)
 	default:
 		log.Info("use look_aside policy on replica selection")
 		balancer = NewLookAsideBalancer(clientMgr)
@@ -82,6 +108,8 @@ func NewLBPolicyImpl(clientMgr shardClientMgr) *LBPolicyImpl {
 	return &LBPolicyImpl{
 		balancer:  balancer,
 		clientMgr: clientMgr,
+		zone:      zone,
+		hedge:     newHedgePolicy(),
 	}
 }
 
@@ -97,21 +125,21 @@ func (lb *LBPolicyImpl) selectNode(ctx context.Context, workload ChannelWorkload
 		zap.String("channelName", workload.channel),
 	)
 
-	filterAvailableNodes := func(node int64, _ int) bool {
-		return !excludeNodes.Contain(node)
+	filterAvailableNodes := func(node nodeInfo, _ int) bool {
+		return !excludeNodes.Contain(node.nodeID)
 	}
 
-	getShardLeaders := func() ([]int64, error) {
+	getShardLeaders := func() ([]nodeInfo, error) {
 		shardLeaders, err := globalMetaCache.GetShards(ctx, false, workload.db, workload.collectionName, workload.collectionID)
 		if err != nil {
 			return nil, err
 		}
 
-		return lo.Map(shardLeaders[workload.channel], func(node nodeInfo, _ int) int64 { return node.nodeID }), nil
+		return shardLeaders[workload.channel], nil
 	}
 
 	availableNodes := lo.Filter(workload.shardLeaders, filterAvailableNodes)
-	targetNode, err := lb.balancer.SelectNode(ctx, availableNodes, workload.nq)
+	targetNode, err := lb.selectPreferredNode(ctx, workload, availableNodes)
 	if err != nil {
 		globalMetaCache.DeprecateShardCache(workload.db, workload.collectionName)
 		nodes, err := getShardLeaders()
@@ -124,15 +152,15 @@ func (lb *LBPolicyImpl) selectNode(ctx context.Context, workload ChannelWorkload
 		availableNodes := lo.Filter(nodes, filterAvailableNodes)
 		if len(availableNodes) == 0 {
 			log.Warn("no available shard delegator found",
-				zap.Int64s("nodes", nodes),
+				zap.Int64s("nodes", lo.Map(nodes, func(node nodeInfo, _ int) int64 { return node.nodeID })),
 				zap.Int64s("excluded", excludeNodes.Collect()))
 			return -1, merr.WrapErrChannelNotAvailable("no available shard delegator found")
 		}
 
-		targetNode, err = lb.balancer.SelectNode(ctx, availableNodes, workload.nq)
+		targetNode, err = lb.selectPreferredNode(ctx, workload, availableNodes)
 		if err != nil {
 			log.Warn("failed to select shard",
-				zap.Int64s("availableNodes", availableNodes),
+				zap.Int64s("availableNodes", lo.Map(availableNodes, func(node nodeInfo, _ int) int64 { return node.nodeID })),
 				zap.Error(err))
 			return -1, err
 		}
@@ -141,6 +169,80 @@ func (lb *LBPolicyImpl) selectNode(ctx context.Context, workload ChannelWorkload
 	return targetNode, nil
 }
 
+// selectPreferredNode selects the node from the preferred shard leaders first,
+// which are the leaders of the replica pinned by the request, or the leaders in the same zone as the proxy.
+// The other leaders are selected only if none of the preferred ones is available.
+// If the request carries a replica tag, only the leaders of the replicas with the tag are taken into account,
+// so that the workloads of different replica tags are isolated.
+// The leaders of the exclusive replicas serve the requests with their tags only.
+func (lb *LBPolicyImpl) selectPreferredNode(ctx context.Context, workload ChannelWorkload, nodes []nodeInfo) (int64, error) {
+	if workload.replicaTag != "" {
+		nodes = lo.Filter(nodes, func(node nodeInfo, _ int) bool {
+			return node.replicaTag == workload.replicaTag
+		})
+		if len(nodes) == 0 {
+			return -1, merr.WrapErrChannelNotAvailable(workload.channel, fmt.Sprintf("no shard delegator with replica tag %s available", workload.replicaTag))
+		}
+	} else {
+		nodes = lo.Filter(nodes, func(node nodeInfo, _ int) bool {
+			return !node.exclusive
+		})
+		if len(nodes) == 0 {
+			return -1, merr.WrapErrChannelNotAvailable(workload.channel, "no shard delegator of non-exclusive replicas available")
+		}
+	}
+
+	var preferred []int64
+	switch {
+	case workload.replicaID != 0:
+		preferred = lo.FilterMap(nodes, func(node nodeInfo, _ int) (int64, bool) {
+			return node.nodeID, node.replicaID == workload.replicaID
+		})
+	case lb.zone != "":
+		zoneKey := params.Params.ProxyCfg.ZoneLabelKey.GetValue()
+		preferred = lo.FilterMap(nodes, func(node nodeInfo, _ int) (int64, bool) {
+			return node.nodeID, node.labels[zoneKey] == lb.zone
+		})
+	}
+
+	allNodes := lo.Map(nodes, func(node nodeInfo, _ int) int64 { return node.nodeID })
+	if len(preferred) == 0 || len(preferred) == len(allNodes) {
+		return lb.balancer.SelectNode(ctx, allNodes, workload.nq)
+	}
+
+	targetNode, err := lb.balancer.SelectNode(ctx, preferred, workload.nq)
+	if err == nil {
+		return targetNode, nil
+	}
+	log.Ctx(ctx).WithRateGroup("proxy.LBPolicy", 1, 60).RatedInfo(10, "no preferred shard delegator available, fallback to the others",
+		zap.String("channelName", workload.channel),
+		zap.Int64s("preferred", preferred),
+		zap.Error(err))
+	return lb.balancer.SelectNode(ctx, allNodes, workload.nq)
+}
+
+// parseReplicaID returns the replica pinned by the search/query params, 0 if not pinned.
+func parseReplicaID(params []*commonpb.KeyValuePair) (int64, error) {
+	replicaIDStr, err := funcutil.GetAttrByKeyFromRepeatedKV(ReplicaIDKey, params)
+	if err != nil {
+		return 0, nil
+	}
+	replicaID, err := strconv.ParseInt(replicaIDStr, 10, 64)
+	if err != nil || replicaID < 0 {
+		return 0, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", ReplicaIDKey, replicaIDStr)
+	}
+	return replicaID, nil
+}
+
+// parseReplicaTag returns the replica tag of the search/query params, empty if not set.
+func parseReplicaTag(params []*commonpb.KeyValuePair) string {
+	replicaTag, err := funcutil.GetAttrByKeyFromRepeatedKV(ReplicaTagKey, params)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(replicaTag)
+}
+
 // ExecuteWithRetry will choose a qn to execute the workload, and retry if failed, until reach the max retryTimes.
 func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWorkload) error {
 	excludeNodes := typeutil.NewUniqueSet()
@@ -177,7 +279,7 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 			return lastErr
 		}
 
-		err = workload.exec(ctx, targetNode, client, workload.channel)
+		err = lb.execute(ctx, workload, targetNode, client, excludeNodes)
 		if err != nil {
 			log.Warn("search/query channel failed",
 				zap.Int64("nodeID", targetNode),
@@ -196,6 +298,95 @@ func (lb *LBPolicyImpl) ExecuteWithRetry(ctx context.Context, workload ChannelWo
 	return err
 }
 
+// execute executes the workload on the target node. If the workload is hedgeable and the target node has not answered
+// within the hedge delay, the workload is executed on another replica too, the first successful response wins
+// and the other execution is canceled.
+func (lb *LBPolicyImpl) execute(ctx context.Context, workload ChannelWorkload, targetNode int64, client types.QueryNodeClient, excludeNodes typeutil.UniqueSet) error {
+	if !workload.hedgeable {
+		return workload.exec(ctx, targetNode, client, workload.channel)
+	}
+	delay, ok := lb.hedge.delay()
+	if !ok {
+		return workload.exec(ctx, targetNode, client, workload.channel)
+	}
+
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", workload.collectionID),
+		zap.String("channelName", workload.channel),
+	)
+	ctx, cancel := context.WithCancel(withHedgeGuard(ctx))
+	defer cancel()
+
+	type execResult struct {
+		node int64
+		err  error
+	}
+	results := make(chan execResult, 2)
+	run := func(node int64, client types.QueryNodeClient) {
+		go func() {
+			results <- execResult{node: node, err: workload.exec(ctx, node, client, workload.channel)}
+		}()
+	}
+	run(targetNode, client)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case result := <-results:
+		return result.err
+	case <-timer.C:
+	}
+
+	hedgeNode, hedgeClient, ok := lb.selectHedgeNode(ctx, workload, targetNode, excludeNodes)
+	if !ok {
+		return (<-results).err
+	}
+	defer lb.balancer.CancelWorkload(hedgeNode, workload.nq)
+	log.Debug("hedge the workload to another replica", zap.Int64("nodeID", targetNode), zap.Int64("hedgeNodeID", hedgeNode))
+	metrics.ProxyHedgedRequestCount.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.TotalLabel).Inc()
+	run(hedgeNode, hedgeClient)
+
+	// return the first successful result, or the error of the target node if both failed
+	var targetErr error
+	for i := 0; i < 2; i++ {
+		result := <-results
+		if result.err == nil {
+			if result.node == hedgeNode {
+				metrics.ProxyHedgedRequestCount.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.SuccessLabel).Inc()
+			}
+			return nil
+		}
+		if result.node == hedgeNode {
+			log.Warn("hedged search/query channel failed", zap.Int64("nodeID", hedgeNode), zap.Error(result.err))
+			excludeNodes.Insert(hedgeNode)
+		} else {
+			targetErr = result.err
+		}
+	}
+	return targetErr
+}
+
+// selectHedgeNode selects another shard leader than the target node to hedge the workload,
+// false if no shard leader is available or the hedge budget is exhausted.
+func (lb *LBPolicyImpl) selectHedgeNode(ctx context.Context, workload ChannelWorkload, targetNode int64, excludeNodes typeutil.UniqueSet) (int64, types.QueryNodeClient, bool) {
+	nodes := lo.Filter(workload.shardLeaders, func(node nodeInfo, _ int) bool {
+		return node.nodeID != targetNode && !excludeNodes.Contain(node.nodeID)
+	})
+	if len(nodes) == 0 || !lb.hedge.acquire() {
+		return -1, nil, false
+	}
+	hedgeNode, err := lb.selectPreferredNode(ctx, workload, nodes)
+	if err != nil {
+		return -1, nil, false
+	}
+	client, err := lb.clientMgr.GetClient(ctx, hedgeNode)
+	if err != nil {
+		lb.balancer.CancelWorkload(hedgeNode, workload.nq)
+		return -1, nil, false
+	}
+	return hedgeNode, client, true
+}
+
 // Execute will execute collection workload in parallel
 func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad) error {
 	dml2leaders, err := globalMetaCache.GetShards(ctx, true, workload.db, workload.collectionName, workload.collectionID)
@@ -203,14 +394,28 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 		log.Ctx(ctx).Warn("failed to get shards", zap.Error(err))
 		return err
 	}
+	for channel, nodes := range dml2leaders {
+		if len(nodes) == 0 && workload.partial == nil {
+			log.Ctx(ctx).Warn("no available shard delegator found", zap.String("channelName", channel))
+			return merr.WrapErrChannelNotAvailable(channel, "no available shard delegator found")
+		}
+	}
+	if workload.partial != nil {
+		workload.partial.addChannels(lo.Keys(dml2leaders), globalMetaCache.GetShardNumRows(workload.db, workload.collectionName))
+	}
 
 	wg, ctx := errgroup.WithContext(ctx)
 	for channel, nodes := range dml2leaders {
 		channel := channel
-		nodes := lo.Map(nodes, func(node nodeInfo, _ int) int64 { return node.nodeID })
+		nodes := nodes
+		if len(nodes) == 0 {
+			log.Ctx(ctx).Warn("no available shard delegator found, skip the channel for partial results", zap.String("channelName", channel))
+			workload.partial.addMissingChannel(channel)
+			continue
+		}
 		retryOnReplica := Params.ProxyCfg.RetryTimesOnReplica.GetAsInt()
 		wg.Go(func() error {
-			return lb.ExecuteWithRetry(ctx, ChannelWorkload{
+			err := lb.ExecuteWithRetry(ctx, ChannelWorkload{
 				db:             workload.db,
 				collectionName: workload.collectionName,
 				collectionID:   workload.collectionID,
@@ -219,14 +424,33 @@ func (lb *LBPolicyImpl) Execute(ctx context.Context, workload CollectionWorkLoad
 				nq:             workload.nq,
 				exec:           workload.exec,
 				retryTimes:     uint(len(nodes) * retryOnReplica),
+				replicaID:      workload.replicaID,
+				replicaTag:     workload.replicaTag,
+				hedgeable:      workload.hedgeable,
 			})
+			if err != nil && workload.partial != nil && ctx.Err() == nil {
+				log.Ctx(ctx).Warn("failed to search/query channel, skip the channel for partial results",
+					zap.String("channelName", channel), zap.Error(err))
+				workload.partial.addMissingChannel(channel)
+				return nil
+			}
+			return err
 		})
 	}
 
-	return wg.Wait()
+	if err := wg.Wait(); err != nil {
+		return err
+	}
+	if workload.partial != nil && workload.partial.allMissing() {
+		return merr.WrapErrChannelNotAvailable(strings.Join(workload.partial.missingChannels, ","), "no channel available for partial results")
+	}
+	return nil
 }
 
 func (lb *LBPolicyImpl) UpdateCostMetrics(node int64, cost *internalpb.CostAggregation) {
+	if cost != nil {
+		lb.hedge.record(cost.GetResponseTime())
+	}
 	lb.balancer.UpdateCostMetrics(node, cost)
 }
 
@@ -17,11 +17,14 @@ package proxy
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 	"go.uber.org/atomic"
@@ -33,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -50,6 +54,7 @@ type LBPolicySuite struct {
 	lbPolicy   *LBPolicyImpl
 
 	nodes    []int64
+	leaders  []nodeInfo
 	channels []string
 	qnList   []*mocks.MockQueryNode
 
@@ -63,6 +68,9 @@ func (s *LBPolicySuite) SetupSuite() {
 
 func (s *LBPolicySuite) SetupTest() {
 	s.nodes = []int64{1, 2, 3, 4, 5}
+	s.leaders = lo.Map(s.nodes, func(node int64, _ int) nodeInfo {
+		return nodeInfo{nodeID: node, replicaID: 100 + node%2, labels: map[string]string{"zone": fmt.Sprintf("az%d", node%3)}}
+	})
 	s.channels = []string{"channel1", "channel2"}
 	successStatus := commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
 	qc := mocks.NewMockQueryCoordClient(s.T())
@@ -75,11 +83,13 @@ func (s *LBPolicySuite) SetupTest() {
 				ChannelName: s.channels[0],
 				NodeIds:     s.nodes,
 				NodeAddrs:   []string{"localhost:9000", "localhost:9001", "localhost:9002", "localhost:9003", "localhost:9004"},
+				NumRows:     300,
 			},
 			{
 				ChannelName: s.channels[1],
 				NodeIds:     s.nodes,
 				NodeAddrs:   []string{"localhost:9000", "localhost:9001", "localhost:9002", "localhost:9003", "localhost:9004"},
+				NumRows:     100,
 			},
 		},
 	}, nil).Maybe()
@@ -167,7 +177,7 @@ func (s *LBPolicySuite) TestSelectNode() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 	}, typeutil.NewUniqueSet())
 	s.NoError(err)
@@ -182,7 +192,7 @@ func (s *LBPolicySuite) TestSelectNode() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   []int64{},
+		shardLeaders:   []nodeInfo{},
 		nq:             1,
 	}, typeutil.NewUniqueSet())
 	s.NoError(err)
@@ -196,7 +206,7 @@ func (s *LBPolicySuite) TestSelectNode() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   []int64{},
+		shardLeaders:   []nodeInfo{},
 		nq:             1,
 	}, typeutil.NewUniqueSet())
 	s.ErrorIs(err, merr.ErrNodeNotAvailable)
@@ -210,7 +220,7 @@ func (s *LBPolicySuite) TestSelectNode() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 	}, typeutil.NewUniqueSet(s.nodes...))
 	s.ErrorIs(err, merr.ErrChannelNotAvailable)
@@ -226,13 +236,224 @@ func (s *LBPolicySuite) TestSelectNode() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 	}, typeutil.NewUniqueSet())
 	s.ErrorIs(err, merr.ErrServiceUnavailable)
 	s.Equal(int64(-1), targetNode)
 }
 
+func (s *LBPolicySuite) TestSelectPreferredNode() {
+	ctx := context.Background()
+	workload := ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.leaders,
+		nq:             1,
+	}
+
+	// no preference, select from all nodes
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, s.nodes, mock.Anything).Return(1, nil).Once()
+	targetNode, err := s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(1), targetNode)
+
+	// prefer the nodes in the same zone
+	s.lbPolicy.zone = "az1"
+	defer func() { s.lbPolicy.zone = "" }()
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{1, 4}, mock.Anything).Return(4, nil).Once()
+	targetNode, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(4), targetNode)
+
+	// the replica pinned by the request takes precedence over the zone
+	workload.replicaID = 100
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{2, 4}, mock.Anything).Return(2, nil).Once()
+	targetNode, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(2), targetNode)
+
+	// fallback to the others if the preferred nodes are excluded
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{1, 3, 5}, mock.Anything).Return(3, nil).Once()
+	targetNode, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet(2, 4))
+	s.NoError(err)
+	s.Equal(int64(3), targetNode)
+
+	// fallback to the others if the preferred nodes are unavailable
+	workload.replicaID = 0
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{1, 4}, mock.Anything).Return(-1, merr.ErrServiceUnavailable).Once()
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, s.nodes, mock.Anything).Return(5, nil).Once()
+	targetNode, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(5), targetNode)
+}
+
+func (s *LBPolicySuite) TestSelectNodeWithReplicaTag() {
+	ctx := context.Background()
+	leaders := lo.Map(s.leaders, func(node nodeInfo, _ int) nodeInfo {
+		node.replicaTag = lo.Ternary(node.replicaID == 100, "batch", "online")
+		return node
+	})
+	workload := ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   leaders,
+		nq:             1,
+		replicaTag:     "batch",
+	}
+
+	// only the nodes of the replicas with the tag are selected
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{2, 4}, mock.Anything).Return(4, nil).Once()
+	targetNode, err := s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(4), targetNode)
+
+	// prefer the nodes in the same zone among the replicas with the tag
+	workload.replicaTag = "online"
+	s.lbPolicy.zone = "az1"
+	defer func() { s.lbPolicy.zone = "" }()
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{1}, mock.Anything).Return(1, nil).Once()
+	targetNode, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(1), targetNode)
+
+	// never fallback to the replicas with the other tags
+	workload.replicaTag = "batch"
+	_, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet(2, 4))
+	s.ErrorIs(err, merr.ErrChannelNotAvailable)
+
+	// the exclusive replicas serve the requests with their tags only
+	s.lbPolicy.zone = ""
+	workload.shardLeaders = lo.Map(leaders, func(node nodeInfo, _ int) nodeInfo {
+		node.exclusive = node.replicaTag == "batch"
+		return node
+	})
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{2, 4}, mock.Anything).Return(2, nil).Once()
+	targetNode, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(2), targetNode)
+
+	workload.replicaTag = ""
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{1, 3, 5}, mock.Anything).Return(3, nil).Once()
+	targetNode, err = s.lbPolicy.selectNode(ctx, workload, typeutil.NewUniqueSet())
+	s.NoError(err)
+	s.Equal(int64(3), targetNode)
+}
+
+func (s *LBPolicySuite) TestParseReplicaTag() {
+	s.Empty(parseReplicaTag(nil))
+	s.Equal("batch", parseReplicaTag([]*commonpb.KeyValuePair{{Key: ReplicaTagKey, Value: " batch "}}))
+}
+
+func (s *LBPolicySuite) TestParseReplicaID() {
+	replicaID, err := parseReplicaID(nil)
+	s.NoError(err)
+	s.Zero(replicaID)
+
+	replicaID, err = parseReplicaID([]*commonpb.KeyValuePair{{Key: ReplicaIDKey, Value: "100"}})
+	s.NoError(err)
+	s.EqualValues(100, replicaID)
+
+	_, err = parseReplicaID([]*commonpb.KeyValuePair{{Key: ReplicaIDKey, Value: "abc"}})
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+}
+
+func (s *LBPolicySuite) TestExecuteWithHedge() {
+	ctx := context.Background()
+	Params.Save(Params.ProxyCfg.Hedge.Enabled.Key, "true")
+	Params.Save(Params.ProxyCfg.Hedge.BudgetRatio.Key, "1")
+	defer Params.Reset(Params.ProxyCfg.Hedge.Enabled.Key)
+	defer Params.Reset(Params.ProxyCfg.Hedge.BudgetRatio.Key)
+	for i := 0; i < hedgeMinSamples; i++ {
+		s.lbPolicy.hedge.record(10)
+	}
+
+	s.mgr.ExpectedCalls = nil
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, []int64{2, 3, 4, 5}, mock.Anything).Return(2, nil).Once()
+	s.lbBalancer.EXPECT().CancelWorkload(int64(1), mock.Anything).Once()
+	s.lbBalancer.EXPECT().CancelWorkload(int64(2), mock.Anything).Once()
+
+	// node 1 is slow, the hedged request to node 2 wins and the request to node 1 is canceled
+	committed := atomic.NewInt64(0)
+	canceled := make(chan struct{})
+	err := s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.leaders,
+		nq:             1,
+		exec: func(ctx context.Context, node UniqueID, qn types.QueryNodeClient, channel string) error {
+			if node == 1 {
+				<-ctx.Done()
+				close(canceled)
+				return ctx.Err()
+			}
+			if commitHedgedResult(ctx) {
+				committed.Store(node)
+			}
+			return nil
+		},
+		retryTimes: 1,
+		hedgeable:  true,
+	})
+	s.NoError(err)
+	s.EqualValues(2, committed.Load())
+	<-canceled
+
+	// not hedgeable
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.lbBalancer.EXPECT().CancelWorkload(int64(1), mock.Anything).Once()
+	err = s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.leaders,
+		nq:             1,
+		exec: func(ctx context.Context, node UniqueID, qn types.QueryNodeClient, channel string) error {
+			time.Sleep(50 * time.Millisecond)
+			return nil
+		},
+		retryTimes: 1,
+	})
+	s.NoError(err)
+
+	// both failed, the error of the target node is returned
+	s.lbBalancer.ExpectedCalls = nil
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil).Once()
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(2, nil).Once()
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+	err = s.lbPolicy.ExecuteWithRetry(ctx, ChannelWorkload{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		channel:        s.channels[0],
+		shardLeaders:   s.leaders,
+		nq:             1,
+		exec: func(ctx context.Context, node UniqueID, qn types.QueryNodeClient, channel string) error {
+			if node == 1 {
+				time.Sleep(50 * time.Millisecond)
+				return merr.ErrServiceUnavailable
+			}
+			return merr.ErrNodeNotAvailable
+		},
+		retryTimes: 1,
+		hedgeable:  true,
+	})
+	s.ErrorIs(err, merr.ErrServiceUnavailable)
+}
+
 func (s *LBPolicySuite) TestExecuteWithRetry() {
 	ctx := context.Background()
 
@@ -246,7 +467,7 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
 			return nil
@@ -263,7 +484,7 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
 			return nil
@@ -283,7 +504,7 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
 			return nil
@@ -301,7 +522,7 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
 			return nil
@@ -322,7 +543,7 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
 			counter++
@@ -347,7 +568,7 @@ func (s *LBPolicySuite) TestExecuteWithRetry() {
 		collectionName: s.collectionName,
 		collectionID:   s.collectionID,
 		channel:        s.channels[0],
-		shardLeaders:   s.nodes,
+		shardLeaders:   s.leaders,
 		nq:             1,
 		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
 			_, err := qn.Search(ctx, nil)
@@ -411,6 +632,50 @@ func (s *LBPolicySuite) TestExecute() {
 	s.ErrorIs(err, mockErr)
 }
 
+func (s *LBPolicySuite) TestExecuteWithPartialResults() {
+	ctx := context.Background()
+	mockErr := errors.New("mock error")
+	s.mgr.EXPECT().GetClient(mock.Anything, mock.Anything).Return(s.qn, nil)
+	s.lbBalancer.EXPECT().SelectNode(mock.Anything, mock.Anything, mock.Anything).Return(1, nil)
+	s.lbBalancer.EXPECT().CancelWorkload(mock.Anything, mock.Anything)
+
+	// test some channel failed
+	partial := &partialResults{}
+	err := s.lbPolicy.Execute(ctx, CollectionWorkLoad{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			if channel == s.channels[1] {
+				return mockErr
+			}
+			return nil
+		},
+		partial: partial,
+	})
+	s.NoError(err)
+	s.True(partial.isPartial())
+	s.Equal([]string{s.channels[1]}, partial.missingChannels)
+	// the fraction is weighted by the rows of the channels
+	s.Equal(0.75, partial.searchedFraction())
+
+	// test all channel failed
+	partial = &partialResults{}
+	err = s.lbPolicy.Execute(ctx, CollectionWorkLoad{
+		db:             dbName,
+		collectionName: s.collectionName,
+		collectionID:   s.collectionID,
+		nq:             1,
+		exec: func(ctx context.Context, ui UniqueID, qn types.QueryNodeClient, channel string) error {
+			return mockErr
+		},
+		partial: partial,
+	})
+	s.ErrorIs(err, merr.ErrChannelNotAvailable)
+	s.True(partial.allMissing())
+}
+
 func (s *LBPolicySuite) TestUpdateCostMetrics() {
 	s.lbBalancer.EXPECT().UpdateCostMetrics(mock.Anything, mock.Anything)
 	s.lbPolicy.UpdateCostMetrics(1, &internalpb.CostAggregation{})
@@ -426,9 +691,17 @@ func (s *LBPolicySuite) TestNewLBPolicy() {
 	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.RoundRobinBalancer")
 	policy.Close()
 
+	s.T().Setenv(sessionutil.SupportedLabelPrefix+"ZONE", "az1")
+	Params.Save(Params.ProxyCfg.ReplicaSelectionPolicy.Key, "zone_aware")
+	policy = NewLBPolicyImpl(s.mgr)
+	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.LookAsideBalancer")
+	s.Equal("az1", policy.zone)
+	policy.Close()
+
 	Params.Save(Params.ProxyCfg.ReplicaSelectionPolicy.Key, "look_aside")
 	policy = NewLBPolicyImpl(s.mgr)
 	s.Equal(reflect.TypeOf(policy.balancer).String(), "*proxy.LookAsideBalancer")
+	s.Empty(policy.zone)
 	policy.Close()
 }
 
@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -70,6 +71,8 @@ type Cache interface {
 	// GetCollectionSchema get collection's schema.
 	GetCollectionSchema(ctx context.Context, database, collectionName string) (*schemaInfo, error)
 	GetShards(ctx context.Context, withCache bool, database, collectionName string, collectionID int64) (map[string][]nodeInfo, error)
+	// GetShardNumRows returns the rows of the shards of the cached shard leaders, nil if not cached.
+	GetShardNumRows(database, collectionName string) map[string]int64
 	DeprecateShardCache(database, collectionName string)
 	RemoveCollection(ctx context.Context, database, collectionName string)
 	RemoveCollectionsByID(ctx context.Context, collectionID UniqueID) []string
@@ -96,6 +99,8 @@ type collectionBasicInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
+	queryCacheEnabled   bool
+	allowPartialResults bool
 }
 
 type collectionInfo struct {
@@ -105,6 +110,8 @@ type collectionInfo struct {
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
 	consistencyLevel    commonpb.ConsistencyLevel
+	queryCacheEnabled   bool
+	allowPartialResults bool
 }
 
 // schemaInfo is a helper function wraps *schemapb.CollectionSchema
@@ -176,6 +183,8 @@ func (info *collectionInfo) getBasicInfo() *collectionBasicInfo {
 		createdTimestamp:    info.createdTimestamp,
 		createdUtcTimestamp: info.createdUtcTimestamp,
 		consistencyLevel:    info.consistencyLevel,
+		queryCacheEnabled:   info.queryCacheEnabled,
+		allowPartialResults: info.allowPartialResults,
 	}
 
 	return basicInfo
@@ -189,8 +198,10 @@ func (info *collectionInfo) isCollectionCached() bool {
 type shardLeaders struct {
 	idx        *atomic.Int64
 	deprecated *atomic.Bool
+	updateTs   time.Time
 
 	shardLeaders map[string][]nodeInfo
+	numRows      map[string]int64
 }
 
 type shardLeadersReader struct {
@@ -211,7 +222,7 @@ func (it shardLeadersReader) Shuffle() map[string][]nodeInfo {
 
 		// make each copy has same probability to be first replica
 		for index, leader := range shuffled {
-			if leader == leaders[int(it.idx)%l] {
+			if leader.nodeID == leaders[int(it.idx)%l].nodeID {
 				shuffled[0], shuffled[index] = shuffled[index], shuffled[0]
 			}
 		}
@@ -221,6 +232,23 @@ func (it shardLeadersReader) Shuffle() map[string][]nodeInfo {
 	return result
 }
 
+// hasUnavailable returns whether any channel has no available shard leader.
+func (sl *shardLeaders) hasUnavailable() bool {
+	for _, leaders := range sl.shardLeaders {
+		if len(leaders) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheable returns whether the shard leaders could be served from cache,
+// the shard leaders with unavailable channels are only cached for proxy.shardLeaderCacheInterval.
+func (sl *shardLeaders) cacheable() bool {
+	return !sl.hasUnavailable() ||
+		time.Since(sl.updateTs) < Params.ProxyCfg.ShardLeaderCacheInterval.GetAsDuration(time.Second)
+}
+
 // GetReader returns shuffer reader for shard leader.
 func (sl *shardLeaders) GetReader() shardLeadersReader {
 	idx := sl.idx.Inc()
@@ -373,6 +401,8 @@ func (m *MetaCache) update(ctx context.Context, database, collectionName string,
 		createdTimestamp:    collection.CreatedTimestamp,
 		createdUtcTimestamp: collection.CreatedUtcTimestamp,
 		consistencyLevel:    collection.ConsistencyLevel,
+		queryCacheEnabled:   common.IsQueryCacheEnabled(collection.GetProperties()...),
+		allowPartialResults: common.IsAllowPartialResults(collection.GetProperties()...),
 	}
 
 	log.Info("meta update success", zap.String("database", database), zap.String("collectionName", collectionName), zap.Int64("collectionID", collection.CollectionID))
@@ -610,6 +640,7 @@ func (m *MetaCache) describeCollection(ctx context.Context, database, collection
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
 		ConsistencyLevel:     coll.ConsistencyLevel,
 		DbName:               coll.GetDbName(),
+		Properties:           coll.GetProperties(),
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -783,7 +814,9 @@ func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
 	m.credMap[username].Sha256Password = credInfo.Sha256Password
 }
 
-// GetShards update cache if withCache == false
+// GetShards update cache if withCache == false.
+// The channels without available shard leader are returned with empty node list,
+// and the shard leaders with unavailable channels are cached briefly.
 func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, collectionName string, collectionID int64) (map[string][]nodeInfo, error) {
 	method := "GetShards"
 	log := log.Ctx(ctx).With(
@@ -797,7 +830,7 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, col
 
 	cacheShardLeaders, ok := m.getCollectionShardLeader(database, collectionName)
 	if withCache {
-		if ok {
+		if ok && cacheShardLeaders.cacheable() {
 			metrics.ProxyCacheStatsCounter.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), method, metrics.CacheHitLabel).Inc()
 			iterator := cacheShardLeaders.GetReader()
 			return iterator.Shuffle(), nil
@@ -811,7 +844,8 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, col
 			commonpbutil.WithMsgType(commonpb.MsgType_GetShardLeaders),
 			commonpbutil.WithSourceID(paramtable.GetNodeID()),
 		),
-		CollectionID: info.collID,
+		CollectionID:          info.collID,
+		WithUnavailableShards: true,
 	}
 
 	tr := timerecord.NewTimeRecorder("UpdateShardCache")
@@ -824,10 +858,16 @@ func (m *MetaCache) GetShards(ctx context.Context, withCache bool, database, col
 	}
 
 	shards := parseShardLeaderList2QueryNode(resp.GetShards())
+	numRows := make(map[string]int64, len(resp.GetShards()))
+	for _, shard := range resp.GetShards() {
+		numRows[shard.GetChannelName()] = shard.GetNumRows()
+	}
 	newShardLeaders := &shardLeaders{
 		shardLeaders: shards,
+		numRows:      numRows,
 		deprecated:   atomic.NewBool(false),
 		idx:          atomic.NewInt64(0),
+		updateTs:     time.Now(),
 	}
 
 	// lock leader
@@ -861,7 +901,19 @@ func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) m
 		qns := make([]nodeInfo, len(leaders.GetNodeIds()))
 
 		for j := range qns {
-			qns[j] = nodeInfo{leaders.GetNodeIds()[j], leaders.GetNodeAddrs()[j]}
+			qns[j] = nodeInfo{nodeID: leaders.GetNodeIds()[j], address: leaders.GetNodeAddrs()[j]}
+			if j < len(leaders.GetReplicaIds()) {
+				qns[j].replicaID = leaders.GetReplicaIds()[j]
+			}
+			if j < len(leaders.GetReplicaTags()) {
+				qns[j].replicaTag = leaders.GetReplicaTags()[j]
+			}
+			if j < len(leaders.GetReplicaExclusives()) {
+				qns[j].exclusive = leaders.GetReplicaExclusives()[j]
+			}
+			if j < len(leaders.GetNodeLabels()) {
+				qns[j].labels = leaders.GetNodeLabels()[j].GetLabels()
+			}
 		}
 
 		shard2QueryNodes[leaders.GetChannelName()] = qns
@@ -870,6 +922,13 @@ func parseShardLeaderList2QueryNode(shardsLeaders []*querypb.ShardLeadersList) m
 	return shard2QueryNodes
 }
 
+func (m *MetaCache) GetShardNumRows(database, collectionName string) map[string]int64 {
+	if shards, ok := m.getCollectionShardLeader(database, collectionName); ok {
+		return shards.numRows
+	}
+	return nil
+}
+
 // DeprecateShardCache clear the shard leader cache of a collection
 func (m *MetaCache) DeprecateShardCache(database, collectionName string) {
 	log.Info("clearing shard cache for collection", zap.String("collectionName", collectionName))
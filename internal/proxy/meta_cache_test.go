@@ -42,6 +42,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/crypto"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -475,6 +476,21 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
 
+func TestShardLeadersCacheable(t *testing.T) {
+	paramtable.Init()
+	leaders := &shardLeaders{
+		shardLeaders: map[string][]nodeInfo{"channel-1": {{nodeID: 1}}, "channel-2": {{nodeID: 2}}},
+		updateTs:     time.Now().Add(-time.Hour),
+	}
+	assert.True(t, leaders.cacheable())
+
+	// the shard leaders with unavailable channels are cached briefly
+	leaders.shardLeaders["channel-2"] = nil
+	assert.False(t, leaders.cacheable())
+	leaders.updateTs = time.Now()
+	assert.True(t, leaders.cacheable())
+}
+
 func TestMetaCache_GetShards(t *testing.T) {
 	var (
 		ctx            = context.Background()
@@ -654,6 +654,51 @@ func (_c *MockCache_GetPrivilegeInfo_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetShardNumRows provides a mock function with given fields: database, collectionName
+func (_m *MockCache) GetShardNumRows(database string, collectionName string) map[string]int64 {
+	ret := _m.Called(database, collectionName)
+
+	var r0 map[string]int64
+	if rf, ok := ret.Get(0).(func(string, string) map[string]int64); ok {
+		r0 = rf(database, collectionName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]int64)
+		}
+	}
+
+	return r0
+}
+
+// MockCache_GetShardNumRows_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetShardNumRows'
+type MockCache_GetShardNumRows_Call struct {
+	*mock.Call
+}
+
+// GetShardNumRows is a helper method to define mock.On call
+//   - database string
+//   - collectionName string
+func (_e *MockCache_Expecter) GetShardNumRows(database interface{}, collectionName interface{}) *MockCache_GetShardNumRows_Call {
+	return &MockCache_GetShardNumRows_Call{Call: _e.mock.On("GetShardNumRows", database, collectionName)}
+}
+
+func (_c *MockCache_GetShardNumRows_Call) Run(run func(database string, collectionName string)) *MockCache_GetShardNumRows_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *MockCache_GetShardNumRows_Call) Return(_a0 map[string]int64) *MockCache_GetShardNumRows_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockCache_GetShardNumRows_Call) RunAndReturn(run func(string, string) map[string]int64) *MockCache_GetShardNumRows_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetShards provides a mock function with given fields: ctx, withCache, database, collectionName, collectionID
 func (_m *MockCache) GetShards(ctx context.Context, withCache bool, database string, collectionName string, collectionID int64) (map[string][]nodeInfo, error) {
 	ret := _m.Called(ctx, withCache, database, collectionName, collectionID)
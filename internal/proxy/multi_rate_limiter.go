@@ -19,6 +19,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 	"time"
@@ -49,12 +50,51 @@ func GetQuotaErrorString(errCode commonpb.ErrorCode) string {
 	return QuotaErrorString[errCode]
 }
 
+// rateLimiterLevel is the level of the rate limiter in the limiting hierarchy,
+// requests are checked from the cluster level down to the partition level.
+// The user and role levels limit the requests sent by the principals, they are
+// checked after the cluster level.
+type rateLimiterLevel int
+
+const (
+	clusterLevel rateLimiterLevel = iota
+	databaseLevel
+	collectionLevel
+	partitionLevel
+	userLevel
+	roleLevel
+)
+
+func (level rateLimiterLevel) String() string {
+	switch level {
+	case clusterLevel:
+		return "cluster"
+	case databaseLevel:
+		return "database"
+	case collectionLevel:
+		return "collection"
+	case partitionLevel:
+		return "partition"
+	case userLevel:
+		return "user"
+	case roleLevel:
+		return "role"
+	default:
+		return "unknown"
+	}
+}
+
 // MultiRateLimiter includes multilevel rate limiters, such as global rateLimiter,
-// collection level rateLimiter and so on. It also implements Limiter interface.
+// database level, collection level, partition level and user/role level rateLimiter.
+// It also implements Limiter interface.
 type MultiRateLimiter struct {
 	quotaStatesMu sync.RWMutex
 	// for DML and DQL
+	databaseLimiters   map[string]*rateLimiter
 	collectionLimiters map[int64]*rateLimiter
+	partitionLimiters  map[int64]map[int64]*rateLimiter
+	userLimiters       map[string]*rateLimiter
+	roleLimiters       map[string]*rateLimiter
 	// for DDL
 	globalDDLLimiter *rateLimiter
 }
@@ -62,14 +102,19 @@ type MultiRateLimiter struct {
 // NewMultiRateLimiter returns a new MultiRateLimiter.
 func NewMultiRateLimiter() *MultiRateLimiter {
 	m := &MultiRateLimiter{
+		databaseLimiters:   make(map[string]*rateLimiter, 0),
 		collectionLimiters: make(map[int64]*rateLimiter, 0),
-		globalDDLLimiter:   newRateLimiter(true),
+		partitionLimiters:  make(map[int64]map[int64]*rateLimiter, 0),
+		userLimiters:       make(map[string]*rateLimiter, 0),
+		roleLimiters:       make(map[string]*rateLimiter, 0),
+		globalDDLLimiter:   newRateLimiter(clusterLevel, ""),
 	}
 	return m
 }
 
-// Check checks if request would be limited or denied.
-func (m *MultiRateLimiter) Check(collectionIDs []int64, rt internalpb.RateType, n int) error {
+// Check checks if request would be limited or denied. The limiters are checked level by level,
+// cluster -> user -> role -> database -> collection -> partition, the returned error tells which level rejects the request.
+func (m *MultiRateLimiter) Check(ctx context.Context, dbName string, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error {
 	if !Params.QuotaConfig.QuotaAndLimitsEnabled.GetAsBool() {
 		return nil
 	}
@@ -77,43 +122,91 @@ func (m *MultiRateLimiter) Check(collectionIDs []int64, rt internalpb.RateType,
 	m.quotaStatesMu.RLock()
 	defer m.quotaStatesMu.RUnlock()
 
+	// store done limiters to cancel them when error occurs.
+	doneLimiters := make([]*rateLimiter, 0, len(collectionIDToPartIDs)+3)
 	checkFunc := func(limiter *rateLimiter) error {
 		if limiter == nil {
 			return nil
 		}
 
+		var err error
 		limit, rate := limiter.limit(rt, n)
 		if rate == 0 {
-			return limiter.getQuotaExceededError(rt)
+			err = limiter.getQuotaExceededError(rt)
+		} else if limit {
+			err = limiter.getRateLimitError(rate)
 		}
-		if limit {
-			return limiter.getRateLimitError(rate)
+		if err != nil {
+			for _, done := range doneLimiters {
+				done.cancel(rt, n)
+			}
+			return err
 		}
+		doneLimiters = append(doneLimiters, limiter)
 		return nil
 	}
 
 	// first, check global level rate limits
-	ret := checkFunc(m.globalDDLLimiter)
-
-	// second check collection level rate limits
-	// only dml, dql and flush have collection level rate limits
-	if ret == nil && len(collectionIDs) > 0 && !isNotCollectionLevelLimitRequest(rt) {
-		// store done limiters to cancel them when error occurs.
-		doneLimiters := make([]*rateLimiter, 0, len(collectionIDs)+1)
-		doneLimiters = append(doneLimiters, m.globalDDLLimiter)
-
-		for _, collectionID := range collectionIDs {
-			ret = checkFunc(m.collectionLimiters[collectionID])
-			if ret != nil {
-				for _, limiter := range doneLimiters {
-					limiter.cancel(rt, n)
-				}
-				break
+	if err := checkFunc(m.globalDDLLimiter); err != nil {
+		return err
+	}
+
+	// only dml, dql and flush have database, collection and partition level rate limits
+	if isNotCollectionLevelLimitRequest(rt) {
+		return nil
+	}
+
+	// second, check user and role level rate limits
+	for _, limiter := range m.getPrincipalLimiters(ctx) {
+		if err := checkFunc(limiter); err != nil {
+			return err
+		}
+	}
+
+	// then, check database level rate limits
+	if dbName != "" {
+		if err := checkFunc(m.databaseLimiters[dbName]); err != nil {
+			return err
+		}
+	}
+
+	// then, check collection and partition level rate limits
+	for collectionID, partitionIDs := range collectionIDToPartIDs {
+		if err := checkFunc(m.collectionLimiters[collectionID]); err != nil {
+			return err
+		}
+		for _, partitionID := range partitionIDs {
+			if err := checkFunc(m.partitionLimiters[collectionID][partitionID]); err != nil {
+				return err
 			}
-			doneLimiters = append(doneLimiters, m.collectionLimiters[collectionID])
 		}
 	}
-	return ret
+	return nil
+}
+
+// getPrincipalLimiters returns the rate limiters of the current user and the roles granted to the user.
+// Requests without user information, such as the requests when authorization is disabled, are not limited.
+func (m *MultiRateLimiter) getPrincipalLimiters(ctx context.Context) []*rateLimiter {
+	if len(m.userLimiters) == 0 && len(m.roleLimiters) == 0 {
+		return nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return nil
+	}
+
+	limiters := make([]*rateLimiter, 0)
+	if limiter, ok := m.userLimiters[username]; ok {
+		limiters = append(limiters, limiter)
+	}
+	if len(m.roleLimiters) > 0 && globalMetaCache != nil {
+		for _, roleName := range globalMetaCache.GetUserRole(username) {
+			if limiter, ok := m.roleLimiters[roleName]; ok {
+				limiters = append(limiters, limiter)
+			}
+		}
+	}
+	return limiters
 }
 
 func isNotCollectionLevelLimitRequest(rt internalpb.RateType) bool {
@@ -133,8 +226,8 @@ func (m *MultiRateLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 	defer m.quotaStatesMu.RUnlock()
 	serviceStates := make(map[milvuspb.QuotaState]typeutil.Set[commonpb.ErrorCode])
 
-	// deduplicate same (state, code) pair from different collection
-	for _, limiter := range m.collectionLimiters {
+	// deduplicate same (state, code) pair from different database, collection and partition
+	collectStates := func(limiter *rateLimiter) {
 		limiter.quotaStates.Range(func(state milvuspb.QuotaState, errCode commonpb.ErrorCode) bool {
 			if serviceStates[state] == nil {
 				serviceStates[state] = typeutil.NewSet[commonpb.ErrorCode]()
@@ -143,6 +236,17 @@ func (m *MultiRateLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 			return true
 		})
 	}
+	for _, limiter := range m.databaseLimiters {
+		collectStates(limiter)
+	}
+	for _, limiter := range m.collectionLimiters {
+		collectStates(limiter)
+	}
+	for _, limiters := range m.partitionLimiters {
+		for _, limiter := range limiters {
+			collectStates(limiter)
+		}
+	}
 
 	states := make([]milvuspb.QuotaState, 0)
 	reasons := make([]string, 0)
@@ -156,46 +260,176 @@ func (m *MultiRateLimiter) GetQuotaStates() ([]milvuspb.QuotaState, []string) {
 	return states, reasons
 }
 
-// SetQuotaStates sets quota states for MultiRateLimiter.
+// SetRates sets the collection and partition level rates and quota states for MultiRateLimiter.
 func (m *MultiRateLimiter) SetRates(rates []*proxypb.CollectionRate) error {
 	m.quotaStatesMu.Lock()
 	defer m.quotaStatesMu.Unlock()
 	collectionSet := typeutil.NewUniqueSet()
 	for _, collectionRates := range rates {
-		collectionSet.Insert(collectionRates.Collection)
-		rateLimiter, ok := m.collectionLimiters[collectionRates.GetCollection()]
+		collectionID := collectionRates.GetCollection()
+		collectionSet.Insert(collectionID)
+		limiter, ok := m.collectionLimiters[collectionID]
 		if !ok {
-			rateLimiter = newRateLimiter(false)
+			limiter = newRateLimiter(collectionLevel, strconv.FormatInt(collectionID, 10))
 		}
-		err := rateLimiter.setRates(collectionRates)
+		err := limiter.setRates(collectionRates)
 		if err != nil {
 			return err
 		}
-		m.collectionLimiters[collectionRates.GetCollection()] = rateLimiter
+		m.collectionLimiters[collectionID] = limiter
+
+		partitionLimiters := make(map[int64]*rateLimiter, len(collectionRates.GetPartitionRates()))
+		for _, partitionRates := range collectionRates.GetPartitionRates() {
+			partitionID := partitionRates.GetPartition()
+			partitionLimiter, ok := m.partitionLimiters[collectionID][partitionID]
+			if !ok {
+				partitionLimiter = newRateLimiter(partitionLevel, fmt.Sprintf("%d/%d", collectionID, partitionID))
+			}
+			err := partitionLimiter.setRates(partitionRates)
+			if err != nil {
+				return err
+			}
+			partitionLimiters[partitionID] = partitionLimiter
+		}
+		// partitions without rates are not limited, remove their rate limiters
+		if len(partitionLimiters) > 0 {
+			m.partitionLimiters[collectionID] = partitionLimiters
+		} else {
+			delete(m.partitionLimiters, collectionID)
+		}
 	}
 
 	// remove dropped collection's rate limiter
 	for collectionID := range m.collectionLimiters {
 		if !collectionSet.Contain(collectionID) {
 			delete(m.collectionLimiters, collectionID)
+			delete(m.partitionLimiters, collectionID)
+		}
+	}
+	return nil
+}
+
+// SetDatabaseRates sets the database level rates and quota states for MultiRateLimiter.
+func (m *MultiRateLimiter) SetDatabaseRates(rates []*proxypb.DatabaseRate) error {
+	m.quotaStatesMu.Lock()
+	defer m.quotaStatesMu.Unlock()
+	databaseSet := typeutil.NewSet[string]()
+	for _, databaseRates := range rates {
+		dbName := databaseRates.GetDbName()
+		databaseSet.Insert(dbName)
+		rateLimiter, ok := m.databaseLimiters[dbName]
+		if !ok {
+			rateLimiter = newRateLimiter(databaseLevel, dbName)
+		}
+		err := rateLimiter.setRates(databaseRates)
+		if err != nil {
+			return err
 		}
+		m.databaseLimiters[dbName] = rateLimiter
 	}
+
+	// remove dropped database's rate limiter
+	for dbName := range m.databaseLimiters {
+		if !databaseSet.Contain(dbName) {
+			delete(m.databaseLimiters, dbName)
+		}
+	}
+	return nil
+}
+
+// SetPrincipalRates sets the user and role level rates for MultiRateLimiter,
+// the principals which are not in the rates are not limited any more.
+func (m *MultiRateLimiter) SetPrincipalRates(userRates, roleRates []*proxypb.PrincipalRate) error {
+	m.quotaStatesMu.Lock()
+	defer m.quotaStatesMu.Unlock()
+	userLimiters, err := newPrincipalLimiters(userLevel, m.userLimiters, userRates)
+	if err != nil {
+		return err
+	}
+	roleLimiters, err := newPrincipalLimiters(roleLevel, m.roleLimiters, roleRates)
+	if err != nil {
+		return err
+	}
+	m.userLimiters = userLimiters
+	m.roleLimiters = roleLimiters
+	return nil
+}
+
+// newPrincipalLimiters returns the rate limiters of the principals, the existing limiters are reused.
+// The rate types not in the principal rates are reset to unlimited.
+func newPrincipalLimiters(level rateLimiterLevel, limiters map[string]*rateLimiter, rates []*proxypb.PrincipalRate) (map[string]*rateLimiter, error) {
+	result := make(map[string]*rateLimiter, len(rates))
+	for _, principalRates := range rates {
+		name := principalRates.GetName()
+		limiter, ok := limiters[name]
+		if !ok {
+			limiter = newRateLimiter(level, name)
+		}
+		fullRates := make(map[internalpb.RateType]float64)
+		limiter.limiters.Range(func(rt internalpb.RateType, _ *ratelimitutil.Limiter) bool {
+			fullRates[rt] = float64(ratelimitutil.Inf)
+			return true
+		})
+		for _, rate := range principalRates.GetRates() {
+			fullRates[rate.GetRt()] = rate.GetR()
+		}
+		err := limiter.setRates(&principalLevelRates{rates: fullRates})
+		if err != nil {
+			return nil, err
+		}
+		result[name] = limiter
+	}
+	return result, nil
+}
+
+// principalLevelRates implements levelRates for user and role, principals have no quota states.
+type principalLevelRates struct {
+	rates map[internalpb.RateType]float64
+}
+
+func (r *principalLevelRates) GetRates() []*internalpb.Rate {
+	rates := make([]*internalpb.Rate, 0, len(r.rates))
+	for rt, rate := range r.rates {
+		rates = append(rates, &internalpb.Rate{Rt: rt, R: rate})
+	}
+	return rates
+}
+
+func (r *principalLevelRates) GetStates() []milvuspb.QuotaState {
 	return nil
 }
 
+func (r *principalLevelRates) GetCodes() []commonpb.ErrorCode {
+	return nil
+}
+
+// levelRates is the rates and quota states of one level, such as
+// proxypb.DatabaseRate, proxypb.CollectionRate and proxypb.PartitionRate.
+type levelRates interface {
+	GetRates() []*internalpb.Rate
+	GetStates() []milvuspb.QuotaState
+	GetCodes() []commonpb.ErrorCode
+}
+
 // rateLimiter implements Limiter.
 type rateLimiter struct {
+	level rateLimiterLevel
+	// name identifies the limited object, the database name, collection id, "collectionID/partitionID",
+	// user name or role name
+	name        string
 	limiters    *typeutil.ConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter]
 	quotaStates *typeutil.ConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode]
 }
 
 // newRateLimiter returns a new RateLimiter.
-func newRateLimiter(globalLevel bool) *rateLimiter {
+func newRateLimiter(level rateLimiterLevel, name string) *rateLimiter {
 	rl := &rateLimiter{
+		level:       level,
+		name:        name,
 		limiters:    typeutil.NewConcurrentMap[internalpb.RateType, *ratelimitutil.Limiter](),
 		quotaStates: typeutil.NewConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode](),
 	}
-	rl.registerLimiters(globalLevel)
+	rl.registerLimiters()
 	return rl
 }
 
@@ -217,19 +451,22 @@ func (rl *rateLimiter) cancel(rt internalpb.RateType, n int) {
 	limit.Cancel(n)
 }
 
-func (rl *rateLimiter) setRates(collectionRate *proxypb.CollectionRate) error {
+func (rl *rateLimiter) setRates(levelRates levelRates) error {
 	log := log.Ctx(context.TODO()).WithRateGroup("proxy.rateLimiter", 1.0, 60.0).With(
 		zap.Int64("proxyNodeID", paramtable.GetNodeID()),
-		zap.Int64("CollectionID", collectionRate.Collection),
+		zap.String("level", rl.level.String()),
+		zap.String("name", rl.name),
 	)
-	for _, r := range collectionRate.GetRates() {
+	for _, r := range levelRates.GetRates() {
 		if limit, ok := rl.limiters.Get(r.GetRt()); ok {
 			limit.SetLimit(ratelimitutil.Limit(r.GetR()))
-			setRateGaugeByRateType(r.GetRt(), paramtable.GetNodeID(), collectionRate.Collection, r.GetR())
+			if collectionRate, ok := levelRates.(*proxypb.CollectionRate); ok {
+				setRateGaugeByRateType(r.GetRt(), paramtable.GetNodeID(), collectionRate.GetCollection(), r.GetR())
+			}
 		} else {
 			return fmt.Errorf("unregister rateLimiter for rateType %s", r.GetRt().String())
 		}
-		log.RatedDebug(30, "current collection rates in proxy",
+		log.RatedDebug(30, "current rates in proxy",
 			zap.String("rateType", r.Rt.String()),
 			zap.String("rateLimit", ratelimitutil.Limit(r.GetR()).String()),
 		)
@@ -237,11 +474,11 @@ func (rl *rateLimiter) setRates(collectionRate *proxypb.CollectionRate) error {
 
 	// clear old quota states
 	rl.quotaStates = typeutil.NewConcurrentMap[milvuspb.QuotaState, commonpb.ErrorCode]()
-	for i := 0; i < len(collectionRate.GetStates()); i++ {
-		rl.quotaStates.Insert(collectionRate.States[i], collectionRate.Codes[i])
-		log.RatedWarn(30, "Proxy set collection quota states",
-			zap.String("state", collectionRate.GetStates()[i].String()),
-			zap.String("reason", collectionRate.GetCodes()[i].String()),
+	for i := 0; i < len(levelRates.GetStates()); i++ {
+		rl.quotaStates.Insert(levelRates.GetStates()[i], levelRates.GetCodes()[i])
+		log.RatedWarn(30, "Proxy set quota states",
+			zap.String("state", levelRates.GetStates()[i].String()),
+			zap.String("reason", levelRates.GetCodes()[i].String()),
 		)
 	}
 
@@ -249,21 +486,33 @@ func (rl *rateLimiter) setRates(collectionRate *proxypb.CollectionRate) error {
 }
 
 func (rl *rateLimiter) getQuotaExceededError(rt internalpb.RateType) error {
+	if rl.level == userLevel || rl.level == roleLevel {
+		// principals have no quota states, zero rate means the requests are denied
+		return merr.WrapErrServiceQuotaExceeded(fmt.Sprintf("%s is denied for the %s", rt.String(), rl.level.String()), rl.describe())
+	}
 	switch rt {
 	case internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete, internalpb.RateType_DMLBulkLoad:
 		if errCode, ok := rl.quotaStates.Get(milvuspb.QuotaState_DenyToWrite); ok {
-			return merr.WrapErrServiceQuotaExceeded(GetQuotaErrorString(errCode))
+			return merr.WrapErrServiceQuotaExceeded(GetQuotaErrorString(errCode), rl.describe())
 		}
 	case internalpb.RateType_DQLSearch, internalpb.RateType_DQLQuery:
 		if errCode, ok := rl.quotaStates.Get(milvuspb.QuotaState_DenyToRead); ok {
-			return merr.WrapErrServiceQuotaExceeded(GetQuotaErrorString(errCode))
+			return merr.WrapErrServiceQuotaExceeded(GetQuotaErrorString(errCode), rl.describe())
 		}
 	}
 	return nil
 }
 
 func (rl *rateLimiter) getRateLimitError(rate float64) error {
-	return merr.WrapErrServiceRateLimit(rate, "request is rejected by grpc RateLimiter middleware, please retry later")
+	return merr.WrapErrServiceRateLimit(rate, "request is rejected by grpc RateLimiter middleware, please retry later", rl.describe())
+}
+
+// describe returns which level and object the rate limiter belongs to.
+func (rl *rateLimiter) describe() string {
+	if rl.level == clusterLevel {
+		return "rejected at cluster level"
+	}
+	return fmt.Sprintf("rejected at %s level, %s=%s", rl.level.String(), rl.level.String(), rl.name)
 }
 
 // setRateGaugeByRateType sets ProxyLimiterRate metrics.
@@ -287,10 +536,32 @@ func setRateGaugeByRateType(rateType internalpb.RateType, nodeID int64, collecti
 	}
 }
 
-// registerLimiters register limiter for all rate types.
-func (rl *rateLimiter) registerLimiters(globalLevel bool) {
+// registerLimiters register limiter for all rate types of the level,
+// database, partition, user and role level only limit DML and DQL requests.
+func (rl *rateLimiter) registerLimiters() {
 	log := log.Ctx(context.TODO()).WithRateGroup("proxy.rateLimiter", 1.0, 60.0)
+	if rl.level == userLevel || rl.level == roleLevel {
+		// the limits of principals are not configurable, they are set by SetPrincipalRates
+		for rt := range internalpb.RateType_name {
+			if isDMLOrDQLRateType(internalpb.RateType(rt)) {
+				rl.limiters.GetOrInsert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Inf, math.MaxFloat64))
+			}
+		}
+		return
+	}
 	quotaConfig := &Params.QuotaConfig
+	pick := func(global, db, collection, partition *paramtable.ParamItem) *paramtable.ParamItem {
+		switch rl.level {
+		case clusterLevel:
+			return global
+		case databaseLevel:
+			return db
+		case partitionLevel:
+			return partition
+		default:
+			return collection
+		}
+	}
 	for rt := range internalpb.RateType_name {
 		var r *paramtable.ParamItem
 		switch internalpb.RateType(rt) {
@@ -301,49 +572,30 @@ func (rl *rateLimiter) registerLimiters(globalLevel bool) {
 		case internalpb.RateType_DDLIndex:
 			r = &quotaConfig.MaxIndexRate
 		case internalpb.RateType_DDLFlush:
-			if globalLevel {
-				r = &quotaConfig.MaxFlushRate
-			} else {
-				r = &quotaConfig.MaxFlushRatePerCollection
-			}
+			r = pick(&quotaConfig.MaxFlushRate, nil, &quotaConfig.MaxFlushRatePerCollection, nil)
 		case internalpb.RateType_DDLCompaction:
 			r = &quotaConfig.MaxCompactionRate
 		case internalpb.RateType_DMLInsert:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxInsertRate
-			} else {
-				r = &quotaConfig.DMLMaxInsertRatePerCollection
-			}
+			r = pick(&quotaConfig.DMLMaxInsertRate, &quotaConfig.DMLMaxInsertRatePerDB,
+				&quotaConfig.DMLMaxInsertRatePerCollection, &quotaConfig.DMLMaxInsertRatePerPartition)
 		case internalpb.RateType_DMLUpsert:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxUpsertRate
-			} else {
-				r = &quotaConfig.DMLMaxUpsertRatePerCollection
-			}
+			r = pick(&quotaConfig.DMLMaxUpsertRate, &quotaConfig.DMLMaxUpsertRatePerDB,
+				&quotaConfig.DMLMaxUpsertRatePerCollection, &quotaConfig.DMLMaxUpsertRatePerPartition)
 		case internalpb.RateType_DMLDelete:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxDeleteRate
-			} else {
-				r = &quotaConfig.DMLMaxDeleteRatePerCollection
-			}
+			r = pick(&quotaConfig.DMLMaxDeleteRate, &quotaConfig.DMLMaxDeleteRatePerDB,
+				&quotaConfig.DMLMaxDeleteRatePerCollection, &quotaConfig.DMLMaxDeleteRatePerPartition)
 		case internalpb.RateType_DMLBulkLoad:
-			if globalLevel {
-				r = &quotaConfig.DMLMaxBulkLoadRate
-			} else {
-				r = &quotaConfig.DMLMaxBulkLoadRatePerCollection
-			}
+			r = pick(&quotaConfig.DMLMaxBulkLoadRate, &quotaConfig.DMLMaxBulkLoadRatePerDB,
+				&quotaConfig.DMLMaxBulkLoadRatePerCollection, &quotaConfig.DMLMaxBulkLoadRatePerPartition)
 		case internalpb.RateType_DQLSearch:
-			if globalLevel {
-				r = &quotaConfig.DQLMaxSearchRate
-			} else {
-				r = &quotaConfig.DQLMaxSearchRatePerCollection
-			}
+			r = pick(&quotaConfig.DQLMaxSearchRate, &quotaConfig.DQLMaxSearchRatePerDB,
+				&quotaConfig.DQLMaxSearchRatePerCollection, &quotaConfig.DQLMaxSearchRatePerPartition)
 		case internalpb.RateType_DQLQuery:
-			if globalLevel {
-				r = &quotaConfig.DQLMaxQueryRate
-			} else {
-				r = &quotaConfig.DQLMaxQueryRatePerCollection
-			}
+			r = pick(&quotaConfig.DQLMaxQueryRate, &quotaConfig.DQLMaxQueryRatePerDB,
+				&quotaConfig.DQLMaxQueryRatePerCollection, &quotaConfig.DQLMaxQueryRatePerPartition)
+		}
+		if (rl.level == databaseLevel || rl.level == partitionLevel) && !isDMLOrDQLRateType(internalpb.RateType(rt)) {
+			continue
 		}
 		limit := ratelimitutil.Limit(r.GetAsFloat())
 		burst := r.GetAsFloat() // use rate as burst, because Limiter is with punishment mechanism, burst is insignificant.
@@ -373,3 +625,13 @@ func (rl *rateLimiter) registerLimiters(globalLevel bool) {
 			zap.String("burst", fmt.Sprintf("%v", burst)))
 	}
 }
+
+func isDMLOrDQLRateType(rt internalpb.RateType) bool {
+	switch rt {
+	case internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete, internalpb.RateType_DMLBulkLoad,
+		internalpb.RateType_DQLSearch, internalpb.RateType_DQLQuery:
+		return true
+	default:
+		return false
+	}
+}
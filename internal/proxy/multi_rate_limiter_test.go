@@ -42,7 +42,7 @@ func TestMultiRateLimiter(t *testing.T) {
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
 		multiLimiter := NewMultiRateLimiter()
-		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(false)
+		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(collectionLevel, "")
 		for _, rt := range internalpb.RateType_value {
 			if isNotCollectionLevelLimitRequest(internalpb.RateType(rt)) {
 				multiLimiter.globalDDLLimiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(5), 1))
@@ -52,18 +52,18 @@ func TestMultiRateLimiter(t *testing.T) {
 		}
 		for _, rt := range internalpb.RateType_value {
 			if isNotCollectionLevelLimitRequest(internalpb.RateType(rt)) {
-				err := multiLimiter.Check([]int64{collectionID}, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{collectionID}, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType(rt), 5)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{collectionID}, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType(rt), 5)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			} else {
-				err := multiLimiter.Check([]int64{collectionID}, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{collectionID}, internalpb.RateType(rt), math.MaxInt)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType(rt), math.MaxInt)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{collectionID}, internalpb.RateType(rt), math.MaxInt)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType(rt), math.MaxInt)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			}
 		}
@@ -74,9 +74,9 @@ func TestMultiRateLimiter(t *testing.T) {
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
 		multiLimiter := NewMultiRateLimiter()
-		multiLimiter.collectionLimiters[1] = newRateLimiter(false)
-		multiLimiter.collectionLimiters[2] = newRateLimiter(false)
-		multiLimiter.collectionLimiters[3] = newRateLimiter(false)
+		multiLimiter.collectionLimiters[1] = newRateLimiter(collectionLevel, "")
+		multiLimiter.collectionLimiters[2] = newRateLimiter(collectionLevel, "")
+		multiLimiter.collectionLimiters[3] = newRateLimiter(collectionLevel, "")
 		for _, rt := range internalpb.RateType_value {
 			if isNotCollectionLevelLimitRequest(internalpb.RateType(rt)) {
 				multiLimiter.globalDDLLimiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(5), 1))
@@ -89,25 +89,25 @@ func TestMultiRateLimiter(t *testing.T) {
 		}
 		for _, rt := range internalpb.RateType_value {
 			if internalpb.RateType(rt) == internalpb.RateType_DDLFlush {
-				err := multiLimiter.Check([]int64{1, 2, 3}, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}, 2: {}, 3: {}}, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{1, 2, 3}, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}, 2: {}, 3: {}}, internalpb.RateType(rt), 5)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{1, 2, 3}, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}, 2: {}, 3: {}}, internalpb.RateType(rt), 5)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			} else if isNotCollectionLevelLimitRequest(internalpb.RateType(rt)) {
-				err := multiLimiter.Check([]int64{1}, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}}, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{1}, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}}, internalpb.RateType(rt), 5)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{1}, internalpb.RateType(rt), 5)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}}, internalpb.RateType(rt), 5)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			} else {
-				err := multiLimiter.Check([]int64{1}, internalpb.RateType(rt), 1)
+				err := multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}}, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{2}, internalpb.RateType(rt), 1)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{2: {}}, internalpb.RateType(rt), 1)
 				assert.NoError(t, err)
-				err = multiLimiter.Check([]int64{3}, internalpb.RateType(rt), 1)
+				err = multiLimiter.Check(context.Background(), "", map[int64][]int64{3: {}}, internalpb.RateType(rt), 1)
 				assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
 			}
 		}
@@ -116,11 +116,11 @@ func TestMultiRateLimiter(t *testing.T) {
 
 	t.Run("not enable quotaAndLimit", func(t *testing.T) {
 		multiLimiter := NewMultiRateLimiter()
-		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(false)
+		multiLimiter.collectionLimiters[collectionID] = newRateLimiter(collectionLevel, "")
 		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "false")
 		for _, rt := range internalpb.RateType_value {
-			err := multiLimiter.Check([]int64{collectionID}, internalpb.RateType(rt), 1)
+			err := multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType(rt), 1)
 			assert.NoError(t, err)
 		}
 		Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
@@ -133,7 +133,7 @@ func TestMultiRateLimiter(t *testing.T) {
 			multiLimiter := NewMultiRateLimiter()
 			bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
 			paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
-			err := multiLimiter.Check([]int64{collectionID}, internalpb.RateType_DMLInsert, 1*1024*1024)
+			err := multiLimiter.Check(context.Background(), "", map[int64][]int64{collectionID: {}}, internalpb.RateType_DMLInsert, 1*1024*1024)
 			assert.NoError(t, err)
 			Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
 			Params.Save(Params.QuotaConfig.DMLMaxInsertRate.Key, bakInsertRate)
@@ -207,12 +207,156 @@ func TestMultiRateLimiter(t *testing.T) {
 		assert.Contains(t, codes, GetQuotaErrorString(commonpb.ErrorCode_DiskQuotaExhausted))
 		assert.Contains(t, codes, GetQuotaErrorString(commonpb.ErrorCode_ForceDeny))
 	})
+
+	t.Run("test database and partition level limit", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		defer Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
+
+		multiLimiter := NewMultiRateLimiter()
+		multiLimiter.databaseLimiters["db1"] = newRateLimiter(databaseLevel, "db1")
+		multiLimiter.collectionLimiters[1] = newRateLimiter(collectionLevel, "1")
+		multiLimiter.partitionLimiters[1] = map[int64]*rateLimiter{
+			10: newRateLimiter(partitionLevel, "1/10"),
+		}
+		multiLimiter.partitionLimiters[1][10].limiters.Insert(internalpb.RateType_DMLInsert, ratelimitutil.NewLimiter(ratelimitutil.Limit(5), 1))
+
+		// rejected by partition level
+		err := multiLimiter.Check(context.Background(), "db1", map[int64][]int64{1: {10}}, internalpb.RateType_DMLInsert, 5)
+		assert.NoError(t, err)
+		err = multiLimiter.Check(context.Background(), "db1", map[int64][]int64{1: {10}}, internalpb.RateType_DMLInsert, 5)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.Contains(t, err.Error(), "partition=1/10")
+
+		// rejected by database level
+		multiLimiter.databaseLimiters["db1"].limiters.Insert(internalpb.RateType_DMLInsert, ratelimitutil.NewLimiter(ratelimitutil.Limit(5), 1))
+		err = multiLimiter.Check(context.Background(), "db1", map[int64][]int64{1: {}}, internalpb.RateType_DMLInsert, 5)
+		assert.NoError(t, err)
+		err = multiLimiter.Check(context.Background(), "db1", map[int64][]int64{1: {}}, internalpb.RateType_DMLInsert, 5)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.Contains(t, err.Error(), "database=db1")
+
+		// other databases are not limited by db1
+		err = multiLimiter.Check(context.Background(), "db2", map[int64][]int64{2: {}}, internalpb.RateType_DMLInsert, 5)
+		assert.NoError(t, err)
+	})
+
+	t.Run("test set database rates", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		defer Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
+
+		multiLimiter := NewMultiRateLimiter()
+		err := multiLimiter.SetDatabaseRates([]*proxypb.DatabaseRate{
+			{
+				DbName: "db1",
+				Rates:  []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 0}},
+				States: []milvuspb.QuotaState{milvuspb.QuotaState_DenyToWrite},
+				Codes:  []commonpb.ErrorCode{commonpb.ErrorCode_DiskQuotaExhausted},
+			},
+		})
+		assert.NoError(t, err)
+		err = multiLimiter.Check(context.Background(), "db1", map[int64][]int64{1: {}}, internalpb.RateType_DMLInsert, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+		assert.Contains(t, err.Error(), "database=db1")
+
+		// DDL is not limited at database level
+		err = multiLimiter.SetDatabaseRates([]*proxypb.DatabaseRate{
+			{
+				DbName: "db1",
+				Rates:  []*internalpb.Rate{{Rt: internalpb.RateType_DDLCollection, R: 0}},
+			},
+		})
+		assert.Error(t, err)
+
+		err = multiLimiter.SetDatabaseRates(nil)
+		assert.NoError(t, err)
+		assert.Len(t, multiLimiter.databaseLimiters, 0)
+	})
+
+	t.Run("test set partition rates", func(t *testing.T) {
+		multiLimiter := NewMultiRateLimiter()
+		err := multiLimiter.SetRates([]*proxypb.CollectionRate{
+			{
+				Collection: 1,
+				PartitionRates: []*proxypb.PartitionRate{
+					{
+						Partition: 10,
+						Rates:     []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 1}},
+						States:    []milvuspb.QuotaState{milvuspb.QuotaState_DenyToRead},
+						Codes:     []commonpb.ErrorCode{commonpb.ErrorCode_ForceDeny},
+					},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.Len(t, multiLimiter.partitionLimiters[1], 1)
+		states, codes := multiLimiter.GetQuotaStates()
+		assert.ElementsMatch(t, []milvuspb.QuotaState{milvuspb.QuotaState_DenyToRead}, states)
+		assert.ElementsMatch(t, []string{GetQuotaErrorString(commonpb.ErrorCode_ForceDeny)}, codes)
+
+		err = multiLimiter.SetRates([]*proxypb.CollectionRate{{Collection: 1}})
+		assert.NoError(t, err)
+		assert.Len(t, multiLimiter.partitionLimiters, 0)
+	})
+
+	t.Run("test user and role level limit", func(t *testing.T) {
+		bak := Params.QuotaConfig.QuotaAndLimitsEnabled.GetValue()
+		paramtable.Get().Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, "true")
+		defer Params.Save(Params.QuotaConfig.QuotaAndLimitsEnabled.Key, bak)
+
+		cacheBak := globalMetaCache
+		defer func() { globalMetaCache = cacheBak }()
+		mockCache := NewMockCache(t)
+		mockCache.EXPECT().GetUserRole("alice").Return([]string{"role1"}).Maybe()
+		mockCache.EXPECT().GetUserRole("bob").Return([]string{}).Maybe()
+		globalMetaCache = mockCache
+
+		multiLimiter := NewMultiRateLimiter()
+		err := multiLimiter.SetPrincipalRates(
+			[]*proxypb.PrincipalRate{{Name: "alice", Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 5}}}},
+			[]*proxypb.PrincipalRate{{Name: "role1", Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DQLQuery, R: 0}}}},
+		)
+		assert.NoError(t, err)
+
+		aliceCtx := GetContext(context.Background(), "alice:123456")
+		err = multiLimiter.Check(aliceCtx, "", map[int64][]int64{1: {}}, internalpb.RateType_DQLSearch, 10)
+		assert.NoError(t, err)
+		err = multiLimiter.Check(aliceCtx, "", map[int64][]int64{1: {}}, internalpb.RateType_DQLSearch, 10)
+		assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+		assert.Contains(t, err.Error(), "user=alice")
+
+		// rejected by the role granted to the user
+		err = multiLimiter.Check(aliceCtx, "", map[int64][]int64{1: {}}, internalpb.RateType_DQLQuery, 1)
+		assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+		assert.Contains(t, err.Error(), "role=role1")
+
+		// other users and requests without user are not limited
+		bobCtx := GetContext(context.Background(), "bob:123456")
+		err = multiLimiter.Check(bobCtx, "", map[int64][]int64{1: {}}, internalpb.RateType_DQLSearch, 10)
+		assert.NoError(t, err)
+		err = multiLimiter.Check(context.Background(), "", map[int64][]int64{1: {}}, internalpb.RateType_DQLQuery, 1)
+		assert.NoError(t, err)
+
+		// the rate types not set are reset to unlimited
+		aliceLimiter := multiLimiter.userLimiters["alice"]
+		err = multiLimiter.SetPrincipalRates([]*proxypb.PrincipalRate{{Name: "alice"}}, nil)
+		assert.NoError(t, err)
+		assert.Same(t, aliceLimiter, multiLimiter.userLimiters["alice"])
+		limit, _ := aliceLimiter.limiters.Get(internalpb.RateType_DQLSearch)
+		assert.Equal(t, ratelimitutil.Inf, limit.Limit())
+		assert.Len(t, multiLimiter.roleLimiters, 0)
+
+		// only DML and DQL could be limited
+		err = multiLimiter.SetPrincipalRates([]*proxypb.PrincipalRate{{Name: "alice", Rates: []*internalpb.Rate{{Rt: internalpb.RateType_DDLCollection, R: 1}}}}, nil)
+		assert.Error(t, err)
+	})
 }
 
 func TestRateLimiter(t *testing.T) {
 	t.Run("test limit", func(t *testing.T) {
 		paramtable.Get().CleanEvent()
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel, "")
 		for _, rt := range internalpb.RateType_value {
 			limiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1))
 		}
@@ -228,7 +372,7 @@ func TestRateLimiter(t *testing.T) {
 
 	t.Run("test setRates", func(t *testing.T) {
 		paramtable.Get().CleanEvent()
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel, "")
 		for _, rt := range internalpb.RateType_value {
 			limiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1))
 		}
@@ -269,7 +413,7 @@ func TestRateLimiter(t *testing.T) {
 
 	t.Run("test get error code", func(t *testing.T) {
 		paramtable.Get().CleanEvent()
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel, "")
 		for _, rt := range internalpb.RateType_value {
 			limiter.limiters.Insert(internalpb.RateType(rt), ratelimitutil.NewLimiter(ratelimitutil.Limit(1000), 1))
 		}
@@ -299,7 +443,7 @@ func TestRateLimiter(t *testing.T) {
 
 	t.Run("tests refresh rate by config", func(t *testing.T) {
 		paramtable.Get().CleanEvent()
-		limiter := newRateLimiter(false)
+		limiter := newRateLimiter(collectionLevel, "")
 
 		etcdCli, _ := etcd.GetEtcdClient(
 			Params.EtcdCfg.UseEmbedEtcd.GetAsBool(),
@@ -0,0 +1,186 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+const (
+	// PartialResultsMissingChannelsHeader is the grpc response header carrying the channels missing in the results.
+	PartialResultsMissingChannelsHeader = "partial-results-missing-channels"
+	// PartialResultsSearchedFractionHeader is the grpc response header carrying the fraction of the data searched.
+	PartialResultsSearchedFractionHeader = "partial-results-searched-fraction"
+	// PartialResultsReason is the reason of the successful response status of partial results,
+	// the detail of the status is the json of PartialResultsInfo.
+	PartialResultsReason = "partial results"
+)
+
+// PartialResultsInfo is the info of partial results carried by the response status.
+type PartialResultsInfo struct {
+	MissingChannels  []string `json:"missing_channels"`
+	SearchedFraction float64  `json:"searched_fraction"`
+}
+
+// GetPartialResultsInfo returns the info of partial results from the response status, false if the results are complete.
+func GetPartialResultsInfo(status *commonpb.Status) (*PartialResultsInfo, bool) {
+	if !merr.Ok(status) || status.GetReason() != PartialResultsReason {
+		return nil, false
+	}
+	info := &PartialResultsInfo{}
+	if err := json.Unmarshal([]byte(status.GetDetail()), info); err != nil {
+		return nil, false
+	}
+	return info, true
+}
+
+// partialResults records the channels missing in the results of a request allowing partial results.
+// A channel is missing if it has no available shard leader or fails on all of its shard leaders,
+// the request fails only if all the channels are missing.
+type partialResults struct {
+	mu              sync.Mutex
+	channelRows     map[string]int64 // rows of all the channels, reported by the shard leaders
+	missingChannels []string
+}
+
+// parseAllowPartialResults returns whether the request allows partial results,
+// the search/query params take precedence over the collection property.
+func parseAllowPartialResults(params []*commonpb.KeyValuePair, collectionInfo *collectionBasicInfo) (bool, error) {
+	allowStr, err := funcutil.GetAttrByKeyFromRepeatedKV(AllowPartialResultsKey, params)
+	if err != nil {
+		return collectionInfo.allowPartialResults, nil
+	}
+	allow, err := strconv.ParseBool(allowStr)
+	if err != nil {
+		return false, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", AllowPartialResultsKey, allowStr)
+	}
+	return allow, nil
+}
+
+// addChannels adds the channels searched by a request with their rows, numRows could be nil if unknown.
+// A hybrid search adds the channels for each of its sub searches.
+func (p *partialResults) addChannels(channels []string, numRows map[string]int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.channelRows == nil {
+		p.channelRows = make(map[string]int64, len(channels))
+	}
+	for _, channel := range channels {
+		p.channelRows[channel] = numRows[channel]
+	}
+}
+
+func (p *partialResults) addMissingChannel(channel string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !lo.Contains(p.missingChannels, channel) {
+		p.missingChannels = append(p.missingChannels, channel)
+	}
+}
+
+// isPartial returns whether any channel is missing in the results.
+func (p *partialResults) isPartial() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.missingChannels) > 0
+}
+
+// allMissing returns whether all the channels are missing in the results.
+func (p *partialResults) allMissing() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.missingChannels) > 0 && len(p.missingChannels) >= len(p.channelRows)
+}
+
+// searchedFraction returns the fraction of the rows of the searched channels,
+// the channels are weighted evenly if the rows are unknown.
+func (p *partialResults) searchedFraction() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if len(p.channelRows) == 0 {
+		return 1
+	}
+	var totalRows, missingRows int64
+	for _, rows := range p.channelRows {
+		totalRows += rows
+	}
+	for _, channel := range p.missingChannels {
+		missingRows += p.channelRows[channel]
+	}
+	if totalRows == 0 {
+		return float64(len(p.channelRows)-len(p.missingChannels)) / float64(len(p.channelRows))
+	}
+	return float64(totalRows-missingRows) / float64(totalRows)
+}
+
+func (p *partialResults) info() *PartialResultsInfo {
+	p.mu.Lock()
+	missingChannels := append([]string{}, p.missingChannels...)
+	p.mu.Unlock()
+	return &PartialResultsInfo{
+		MissingChannels:  missingChannels,
+		SearchedFraction: p.searchedFraction(),
+	}
+}
+
+// status returns the successful response status carrying the info of partial results,
+// nil if the results are complete.
+func (p *partialResults) status() *commonpb.Status {
+	if p == nil || !p.isPartial() {
+		return nil
+	}
+	detail, err := json.Marshal(p.info())
+	if err != nil {
+		return nil
+	}
+	status := merr.Success(PartialResultsReason)
+	status.Detail = string(detail)
+	return status
+}
+
+// setPartialResults sends the info of partial results to the client by grpc header,
+// and returns the response status carrying the info, the status is returned as is if the results are complete.
+func setPartialResults(ctx context.Context, status *commonpb.Status, p *partialResults) *commonpb.Status {
+	partialStatus := p.status()
+	if partialStatus == nil {
+		return status
+	}
+
+	info := p.info()
+	md := metadata.Pairs(
+		PartialResultsMissingChannelsHeader, strings.Join(info.MissingChannels, ","),
+		PartialResultsSearchedFractionHeader, strconv.FormatFloat(info.SearchedFraction, 'f', -1, 64),
+	)
+	if err := grpc.SetHeader(ctx, md); err != nil {
+		log.Ctx(ctx).Warn("failed to set partial results header", zap.Error(err))
+	}
+	return partialStatus
+}
@@ -0,0 +1,104 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type mockServerTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *mockServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestParseAllowPartialResults(t *testing.T) {
+	allow, err := parseAllowPartialResults(nil, &collectionBasicInfo{})
+	assert.NoError(t, err)
+	assert.False(t, allow)
+
+	allow, err = parseAllowPartialResults(nil, &collectionBasicInfo{allowPartialResults: true})
+	assert.NoError(t, err)
+	assert.True(t, allow)
+
+	// the request params take precedence over the collection property
+	allow, err = parseAllowPartialResults([]*commonpb.KeyValuePair{{Key: AllowPartialResultsKey, Value: "false"}}, &collectionBasicInfo{allowPartialResults: true})
+	assert.NoError(t, err)
+	assert.False(t, allow)
+
+	allow, err = parseAllowPartialResults([]*commonpb.KeyValuePair{{Key: AllowPartialResultsKey, Value: "true"}}, &collectionBasicInfo{})
+	assert.NoError(t, err)
+	assert.True(t, allow)
+
+	_, err = parseAllowPartialResults([]*commonpb.KeyValuePair{{Key: AllowPartialResultsKey, Value: "yes"}}, &collectionBasicInfo{})
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
+func TestPartialResults(t *testing.T) {
+	p := &partialResults{}
+	p.addChannels([]string{"ch1", "ch2", "ch3", "ch4"}, map[string]int64{"ch1": 100, "ch2": 100, "ch3": 100, "ch4": 700})
+	assert.False(t, p.isPartial())
+	assert.Equal(t, float64(1), p.searchedFraction())
+
+	stream := &mockServerTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+	status := merr.Success()
+	assert.Equal(t, status, setPartialResults(ctx, status, p))
+	assert.Equal(t, status, setPartialResults(ctx, status, nil))
+	assert.Empty(t, stream.header)
+	_, ok := GetPartialResultsInfo(status)
+	assert.False(t, ok)
+
+	p.addMissingChannel("ch1")
+	p.addMissingChannel("ch2")
+	p.addMissingChannel("ch2")
+	p.addMissingChannel("ch3")
+	assert.True(t, p.isPartial())
+	assert.False(t, p.allMissing())
+	assert.Equal(t, 0.7, p.searchedFraction())
+
+	status = setPartialResults(ctx, status, p)
+	assert.True(t, merr.Ok(status))
+	info, ok := GetPartialResultsInfo(status)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"ch1", "ch2", "ch3"}, info.MissingChannels)
+	assert.Equal(t, 0.7, info.SearchedFraction)
+	assert.Equal(t, []string{"ch1,ch2,ch3"}, stream.header.Get(PartialResultsMissingChannelsHeader))
+	assert.Equal(t, []string{"0.7"}, stream.header.Get(PartialResultsSearchedFractionHeader))
+
+	p.addMissingChannel("ch4")
+	assert.True(t, p.allMissing())
+	assert.Equal(t, float64(0), p.searchedFraction())
+
+	// the channels are weighted evenly if the rows are unknown
+	p = &partialResults{}
+	p.addChannels([]string{"ch1", "ch2", "ch3", "ch4"}, nil)
+	p.addMissingChannel("ch1")
+	assert.Equal(t, 0.75, p.searchedFraction())
+}
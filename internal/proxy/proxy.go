@@ -34,10 +34,12 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy/accesslog"
 	"github.com/milvus-io/milvus/internal/proxy/connection"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
+	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -123,6 +125,10 @@ type Proxy struct {
 	// resource manager
 	resourceManager        resource.Manager
 	replicateStreamManager *ReplicateStreamManager
+
+	// clients of the other proxies to notify the dml for query cache
+	proxyWatcher       proxyutil.ProxyWatcherInterface
+	proxyClientManager proxyutil.ProxyClientManagerInterface
 }
 
 // NewProxy returns a Proxy struct.
@@ -284,6 +290,14 @@ func (node *Proxy) Init() error {
 	node.metricsCacheManager = metricsinfo.NewMetricsCacheManager()
 	log.Debug("create metrics cache manager done", zap.String("role", typeutil.ProxyRole))
 
+	node.proxyClientManager = proxyutil.NewProxyClientManager(proxyutil.DefaultProxyCreator)
+	if node.etcdCli != nil {
+		node.proxyWatcher = proxyutil.NewProxyWatcher(node.etcdCli, node.proxyClientManager.AddProxyClients)
+		node.proxyWatcher.AddSessionFunc(node.proxyClientManager.AddProxyClient)
+		node.proxyWatcher.DelSessionFunc(node.proxyClientManager.DelProxyClient)
+	}
+	log.Debug("create proxy client manager done", zap.String("role", typeutil.ProxyRole))
+
 	if err := InitMetaCache(node.ctx, node.rootCoord, node.queryCoord, node.shardMgr); err != nil {
 		log.Warn("failed to init meta cache", zap.String("role", typeutil.ProxyRole), zap.Error(err))
 		return err
@@ -377,6 +391,43 @@ func (node *Proxy) sendChannelsTimeTickLoop() {
 	}()
 }
 
+// syncQueryCacheLoop starts a goroutine that notifies the other proxies to drop the cached results
+// of the collections received dml from this proxy.
+func (node *Proxy) syncQueryCacheLoop() {
+	node.wg.Add(1)
+	go func() {
+		defer node.wg.Done()
+
+		ticker := time.NewTicker(Params.ProxyCfg.QueryCache.SyncInterval.GetAsDuration(time.Millisecond))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-node.ctx.Done():
+				log.Info("sync query cache loop exit")
+				return
+			case <-ticker.C:
+				pending := globalQueryCache.takePendingDml()
+				if len(pending) == 0 || !Params.ProxyCfg.QueryCache.Enabled.GetAsBool() {
+					continue
+				}
+				err := node.proxyClientManager.InvalidateQueryCache(node.ctx, &proxypb.InvalidateQueryCacheRequest{
+					Base: commonpbutil.NewMsgBase(
+						commonpbutil.WithSourceID(paramtable.GetNodeID()),
+					),
+					DmlTimestamps: pending,
+				})
+				if err != nil {
+					log.Warn("failed to notify dml to the other proxies, retry later", zap.Error(err))
+					// keep the dml to notify again, the local cache has been invalidated by them already
+					for collectionID, ts := range pending {
+						globalQueryCache.NotifyDml(collectionID, ts)
+					}
+				}
+			}
+		}
+	}()
+}
+
 // Start starts a proxy node.
 func (node *Proxy) Start() error {
 	if err := node.sched.Start(); err != nil {
@@ -405,6 +456,14 @@ func (node *Proxy) Start() error {
 
 	node.sendChannelsTimeTickLoop()
 
+	if node.proxyWatcher != nil {
+		if err := node.proxyWatcher.WatchProxy(node.ctx); err != nil {
+			log.Warn("failed to watch proxies", zap.String("role", typeutil.ProxyRole), zap.Error(err))
+			return err
+		}
+	}
+	node.syncQueryCacheLoop()
+
 	// Start callbacks
 	for _, cb := range node.startCallbacks {
 		cb()
@@ -446,6 +505,11 @@ func (node *Proxy) Stop() error {
 		log.Info("close channels time ticker", zap.String("role", typeutil.ProxyRole))
 	}
 
+	if node.proxyWatcher != nil {
+		node.proxyWatcher.Stop()
+		log.Info("stop proxy watcher", zap.String("role", typeutil.ProxyRole))
+	}
+
 	node.wg.Wait()
 
 	for _, cb := range node.closeCallbacks {
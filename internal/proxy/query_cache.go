@@ -0,0 +1,253 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// globalQueryCache caches the shard results of search and query requests of this proxy.
+var globalQueryCache = newQueryResultCache()
+
+// queryResultCache caches the results returned by the shard leaders for identical search and query requests,
+// the requests are reduced by proxy as usual so the offset, requery and rerank of the request are not cached.
+//
+// The key is the hash of the shard request, including the collection, partitions, serialized plan and
+// the guarantee timestamp rounded down to proxy.queryCache.timestampGranularity.
+// An entry is dropped once any proxy sends a dml of the collection after the entry is computed,
+// or after proxy.queryCache.ttl. The dml of this proxy drops the entries immediately, and is notified
+// to the other proxies every proxy.queryCache.syncInterval. The least recently used entries are evicted
+// if the total size exceeds proxy.queryCache.maxMemorySize.
+type queryResultCache struct {
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+	size    int64
+	// dmlTs is the timestamp of the latest dml of the collections sent by any proxy
+	dmlTs map[int64]Timestamp
+	// pendingDmlTs is the timestamp of the latest dml of the collections sent by this proxy,
+	// which is not notified to the other proxies yet
+	pendingDmlTs map[int64]Timestamp
+}
+
+type queryCacheEntry struct {
+	key          string
+	collectionID int64
+	ts           Timestamp // begin timestamp of the request computing the results
+	expireAt     time.Time
+	size         int64
+	results      []proto.Message
+}
+
+func newQueryResultCache() *queryResultCache {
+	return &queryResultCache{
+		entries:      make(map[string]*list.Element),
+		lru:          list.New(),
+		dmlTs:        make(map[int64]Timestamp),
+		pendingDmlTs: make(map[int64]Timestamp),
+	}
+}
+
+// useQueryCache returns whether the results of the request could be cached,
+// only the requests at Bounded or Eventually consistency on the collections enabled query cache are cached.
+func useQueryCache(collectionInfo *collectionBasicInfo, consistencyLevel commonpb.ConsistencyLevel) bool {
+	if !Params.ProxyCfg.QueryCache.Enabled.GetAsBool() || !collectionInfo.queryCacheEnabled {
+		return false
+	}
+	return consistencyLevel == commonpb.ConsistencyLevel_Bounded || consistencyLevel == commonpb.ConsistencyLevel_Eventually
+}
+
+// roundQueryCacheTs rounds down the physical time of the timestamp to the granularity.
+func roundQueryCacheTs(ts Timestamp) Timestamp {
+	granularity := Params.ProxyCfg.QueryCache.TimestampGranularity.GetAsInt64()
+	physical, _ := tsoutil.ParseHybridTs(ts)
+	if granularity > 0 {
+		physical = physical / granularity * granularity
+	}
+	return tsoutil.ComposeTS(physical, 0)
+}
+
+func hashQueryCacheKey(req proto.Message) (string, error) {
+	buf := proto.NewBuffer(nil)
+	buf.SetDeterministic(true)
+	if err := buf.Marshal(req); err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(buf.Bytes())
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// searchCacheKey returns the cache key of the search request, the fields varying between the identical requests are ignored.
+func searchCacheKey(req *internalpb.SearchRequest) (string, error) {
+	req = typeutil.Clone(req)
+	req.Base = nil
+	req.ReqID = 0
+	req.MvccTimestamp = 0
+	req.TimeoutTimestamp = 0
+	req.GuaranteeTimestamp = roundQueryCacheTs(req.GetGuaranteeTimestamp())
+	return hashQueryCacheKey(req)
+}
+
+// queryCacheKey returns the cache key of the query request, the fields varying between the identical requests are ignored.
+func queryCacheKey(req *internalpb.RetrieveRequest) (string, error) {
+	req = typeutil.Clone(req)
+	req.Base = nil
+	req.ReqID = 0
+	req.MvccTimestamp = 0
+	req.TimeoutTimestamp = 0
+	req.GuaranteeTimestamp = roundQueryCacheTs(req.GetGuaranteeTimestamp())
+	return hashQueryCacheKey(req)
+}
+
+// Get returns a copy of the cached results of the key.
+func (c *queryResultCache) Get(key string, queryType string) ([]proto.Message, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.entries[key]
+	if ok {
+		entry := elem.Value.(*queryCacheEntry)
+		if time.Now().After(entry.expireAt) {
+			c.remove(elem)
+			c.updateMetrics()
+			ok = false
+		} else {
+			c.lru.MoveToFront(elem)
+		}
+	}
+	if !ok {
+		metrics.ProxyQueryCacheCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), queryType, metrics.CacheMissLabel).Inc()
+		return nil, false
+	}
+	metrics.ProxyQueryCacheCounter.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), queryType, metrics.CacheHitLabel).Inc()
+
+	entry := elem.Value.(*queryCacheEntry)
+	results := make([]proto.Message, 0, len(entry.results))
+	for _, result := range entry.results {
+		results = append(results, proto.Clone(result))
+	}
+	return results, true
+}
+
+// Put caches a copy of the results computed by the request began at ts,
+// the results are dropped if the collection received dml after ts.
+func (c *queryResultCache) Put(key string, collectionID int64, ts Timestamp, results []proto.Message) {
+	entry := &queryCacheEntry{
+		key:          key,
+		collectionID: collectionID,
+		ts:           ts,
+		expireAt:     time.Now().Add(Params.ProxyCfg.QueryCache.TTL.GetAsDuration(time.Second)),
+		results:      make([]proto.Message, 0, len(results)),
+	}
+	for _, result := range results {
+		entry.size += int64(proto.Size(result))
+		entry.results = append(entry.results, proto.Clone(result))
+	}
+	maxSize := Params.ProxyCfg.QueryCache.MaxMemorySize.GetAsInt64() * 1024 * 1024
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if entry.size > maxSize || ts < c.dmlTs[collectionID] {
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	c.entries[key] = c.lru.PushFront(entry)
+	c.size += entry.size
+	for c.size > maxSize {
+		c.remove(c.lru.Back())
+	}
+	c.updateMetrics()
+}
+
+// InvalidateCollection drops the cached results of the collection computed before the dml at ts.
+func (c *queryResultCache) InvalidateCollection(collectionID int64, ts Timestamp) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ts > c.dmlTs[collectionID] {
+		c.dmlTs[collectionID] = ts
+	}
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		entry := elem.Value.(*queryCacheEntry)
+		if entry.collectionID == collectionID && entry.ts < ts {
+			c.remove(elem)
+		}
+		elem = next
+	}
+	c.updateMetrics()
+}
+
+// NotifyDml drops the cached results of the collection computed before the dml at ts sent by this proxy,
+// and keeps the dml to notify the other proxies.
+func (c *queryResultCache) NotifyDml(collectionID int64, ts Timestamp) {
+	c.InvalidateCollection(collectionID, ts)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if ts > c.pendingDmlTs[collectionID] {
+		c.pendingDmlTs[collectionID] = ts
+	}
+}
+
+// takePendingDml returns the dml timestamps of the collections not notified to the other proxies yet.
+func (c *queryResultCache) takePendingDml() map[int64]Timestamp {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pending := c.pendingDmlTs
+	c.pendingDmlTs = make(map[int64]Timestamp)
+	return pending
+}
+
+// RemoveCollection drops all the cached results of the collection.
+func (c *queryResultCache) RemoveCollection(collectionID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for elem := c.lru.Front(); elem != nil; {
+		next := elem.Next()
+		if elem.Value.(*queryCacheEntry).collectionID == collectionID {
+			c.remove(elem)
+		}
+		elem = next
+	}
+	c.updateMetrics()
+}
+
+func (c *queryResultCache) remove(elem *list.Element) {
+	entry := c.lru.Remove(elem).(*queryCacheEntry)
+	delete(c.entries, entry.key)
+	c.size -= entry.size
+}
+
+func (c *queryResultCache) updateMetrics() {
+	metrics.ProxyQueryCacheSize.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10)).Set(float64(c.size))
+}
@@ -0,0 +1,203 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/tsoutil"
+)
+
+func TestUseQueryCache(t *testing.T) {
+	info := &collectionBasicInfo{queryCacheEnabled: true}
+	assert.False(t, useQueryCache(info, commonpb.ConsistencyLevel_Bounded))
+
+	paramtable.Get().Save(Params.ProxyCfg.QueryCache.Enabled.Key, "true")
+	defer paramtable.Get().Reset(Params.ProxyCfg.QueryCache.Enabled.Key)
+	assert.True(t, useQueryCache(info, commonpb.ConsistencyLevel_Bounded))
+	assert.True(t, useQueryCache(info, commonpb.ConsistencyLevel_Eventually))
+	assert.False(t, useQueryCache(info, commonpb.ConsistencyLevel_Strong))
+	assert.False(t, useQueryCache(info, commonpb.ConsistencyLevel_Session))
+	assert.False(t, useQueryCache(&collectionBasicInfo{}, commonpb.ConsistencyLevel_Bounded))
+}
+
+func TestQueryCacheKey(t *testing.T) {
+	now := time.UnixMilli(time.Now().UnixMilli() / 1000 * 1000)
+	genRequest := func(reqID int64, guaranteeTs uint64) *internalpb.SearchRequest {
+		return &internalpb.SearchRequest{
+			Base:               &commonpb.MsgBase{MsgID: reqID},
+			ReqID:              reqID,
+			CollectionID:       1,
+			PartitionIDs:       []int64{10},
+			SerializedExprPlan: []byte("plan"),
+			PlaceholderGroup:   []byte("vectors"),
+			GuaranteeTimestamp: guaranteeTs,
+			TimeoutTimestamp:   guaranteeTs + 100,
+			Nq:                 1,
+			Topk:               10,
+		}
+	}
+
+	key1, err := searchCacheKey(genRequest(1, tsoutil.ComposeTSByTime(now, 1)))
+	assert.NoError(t, err)
+	key2, err := searchCacheKey(genRequest(2, tsoutil.ComposeTSByTime(now.Add(500*time.Millisecond), 0)))
+	assert.NoError(t, err)
+	assert.Equal(t, key1, key2)
+
+	// the guarantee timestamp in the next granularity
+	key2, err = searchCacheKey(genRequest(1, tsoutil.ComposeTSByTime(now.Add(time.Second), 0)))
+	assert.NoError(t, err)
+	assert.NotEqual(t, key1, key2)
+
+	req := genRequest(1, tsoutil.ComposeTSByTime(now, 1))
+	req.PartitionIDs = []int64{11}
+	key2, err = searchCacheKey(req)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key1, key2)
+
+	req = genRequest(1, tsoutil.ComposeTSByTime(now, 1))
+	req.SerializedExprPlan = []byte("another plan")
+	key2, err = searchCacheKey(req)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key1, key2)
+
+	retrieve := &internalpb.RetrieveRequest{CollectionID: 1, SerializedExprPlan: []byte("plan"), GuaranteeTimestamp: 1}
+	key1, err = queryCacheKey(retrieve)
+	assert.NoError(t, err)
+	retrieve.ReqID = 2
+	retrieve.MvccTimestamp = 100
+	key2, err = queryCacheKey(retrieve)
+	assert.NoError(t, err)
+	assert.Equal(t, key1, key2)
+	retrieve.Limit = 10
+	key2, err = queryCacheKey(retrieve)
+	assert.NoError(t, err)
+	assert.NotEqual(t, key1, key2)
+}
+
+func TestQueryResultCache(t *testing.T) {
+	genResults := func(n int) []proto.Message {
+		return []proto.Message{
+			&internalpb.RetrieveResults{Base: &commonpb.MsgBase{SourceID: 1}, SealedSegmentIDsRetrieved: make([]int64, n)},
+			&internalpb.RetrieveResults{Base: &commonpb.MsgBase{SourceID: 2}},
+		}
+	}
+
+	t.Run("get and put", func(t *testing.T) {
+		cache := newQueryResultCache()
+		_, ok := cache.Get("a", metrics.QueryLabel)
+		assert.False(t, ok)
+
+		results := genResults(1)
+		cache.Put("a", 1, 100, results)
+		cached, ok := cache.Get("a", metrics.QueryLabel)
+		assert.True(t, ok)
+		assert.Len(t, cached, 2)
+		assert.True(t, proto.Equal(results[0], cached[0]))
+
+		// the cached results are copied
+		cached[0].(*internalpb.RetrieveResults).Base.SourceID = 3
+		cached, ok = cache.Get("a", metrics.QueryLabel)
+		assert.True(t, ok)
+		assert.EqualValues(t, 1, cached[0].(*internalpb.RetrieveResults).GetBase().GetSourceID())
+	})
+
+	t.Run("ttl", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.QueryCache.TTL.Key, "0")
+		defer paramtable.Get().Reset(Params.ProxyCfg.QueryCache.TTL.Key)
+
+		cache := newQueryResultCache()
+		cache.Put("a", 1, 100, genResults(1))
+		time.Sleep(time.Millisecond)
+		_, ok := cache.Get("a", metrics.QueryLabel)
+		assert.False(t, ok)
+		assert.Zero(t, cache.size)
+	})
+
+	t.Run("memory bound", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.QueryCache.MaxMemorySize.Key, "1")
+		defer paramtable.Get().Reset(Params.ProxyCfg.QueryCache.MaxMemorySize.Key)
+
+		cache := newQueryResultCache()
+		cache.Put("a", 1, 100, genResults(400000))
+		cache.Put("b", 1, 100, genResults(400000))
+		_, ok := cache.Get("a", metrics.QueryLabel)
+		assert.True(t, ok)
+		// b is the least recently used one
+		cache.Put("c", 1, 100, genResults(400000))
+		_, ok = cache.Get("b", metrics.QueryLabel)
+		assert.False(t, ok)
+		_, ok = cache.Get("a", metrics.QueryLabel)
+		assert.True(t, ok)
+		_, ok = cache.Get("c", metrics.QueryLabel)
+		assert.True(t, ok)
+		assert.LessOrEqual(t, cache.size, int64(1024*1024))
+
+		// too large to cache
+		cache.Put("d", 1, 100, genResults(2000000))
+		_, ok = cache.Get("d", metrics.QueryLabel)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalidate", func(t *testing.T) {
+		cache := newQueryResultCache()
+		cache.Put("a", 1, 100, genResults(1))
+		cache.Put("b", 1, 200, genResults(1))
+		cache.Put("c", 2, 100, genResults(1))
+
+		cache.InvalidateCollection(1, 150)
+		_, ok := cache.Get("a", metrics.QueryLabel)
+		assert.False(t, ok)
+		_, ok = cache.Get("b", metrics.QueryLabel)
+		assert.True(t, ok)
+		_, ok = cache.Get("c", metrics.QueryLabel)
+		assert.True(t, ok)
+
+		// the results computed before the dml are not cached
+		cache.Put("a", 1, 120, genResults(1))
+		_, ok = cache.Get("a", metrics.QueryLabel)
+		assert.False(t, ok)
+
+		cache.RemoveCollection(2)
+		_, ok = cache.Get("c", metrics.QueryLabel)
+		assert.False(t, ok)
+		_, ok = cache.Get("b", metrics.QueryLabel)
+		assert.True(t, ok)
+	})
+
+	t.Run("notify dml", func(t *testing.T) {
+		cache := newQueryResultCache()
+		cache.Put("a", 1, 100, genResults(1))
+
+		cache.NotifyDml(1, 150)
+		cache.NotifyDml(1, 120)
+		cache.NotifyDml(2, 100)
+		_, ok := cache.Get("a", metrics.QueryLabel)
+		assert.False(t, ok)
+
+		assert.Equal(t, map[int64]Timestamp{1: 150, 2: 100}, cache.takePendingDml())
+		assert.Empty(t, cache.takePendingDml())
+	})
+}
@@ -0,0 +1,173 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// QueryIteratorKey enables query iterator on the first batch, it is distinct from the search iterator key
+	// so that the cursors of the two iterators are never mixed up.
+	QueryIteratorKey = "pk_iterator"
+	// QueryIteratorCursorKey is the cursor returned by the previous batch.
+	QueryIteratorCursorKey = "pk_iterator_cursor"
+	// QueryIteratorCursorHeader is the grpc response header carrying the cursor of the next batch.
+	QueryIteratorCursorHeader = "query-iterator-cursor"
+)
+
+// queryIterator pages through the query results sorted by primary key instead of offset.
+// Each batch queries the entities whose primary keys are greater than the last returned one,
+// at the snapshot pinned by the first batch, so the cost of every batch is the same as the first one
+// and the batches stay consistent while the collection is being inserted and deleted.
+type queryIterator struct {
+	cursor     *internalpb.QueryIteratorCursor // nil for the first batch
+	nextCursor string
+}
+
+// parseQueryIterator returns nil if query iterator is not enabled by the query params.
+func parseQueryIterator(queryParamsPair []*commonpb.KeyValuePair) (*queryIterator, error) {
+	cursorStr, enable, err := parseIteratorParams(QueryIteratorKey, QueryIteratorCursorKey, queryParamsPair)
+	if err != nil || !enable {
+		return nil, err
+	}
+	if cursorStr == "" {
+		return &queryIterator{}, nil
+	}
+	cursor := &internalpb.QueryIteratorCursor{}
+	if err := decodeIteratorCursor(cursorStr, cursor); err != nil {
+		return nil, err
+	}
+	return &queryIterator{cursor: cursor}, nil
+}
+
+// prepare validates the query request and applies the primary key bound of the cursor to the query plan.
+func (it *queryIterator) prepare(t *queryTask) error {
+	switch {
+	case t.plan.GetQuery().GetIsCount():
+		return merr.WrapErrParameterInvalidMsg("count is not supported by query iterator")
+	case t.queryParams.limit == typeutil.Unlimited:
+		return merr.WrapErrParameterInvalidMsg("query iterator requires limit as the batch size")
+	case it.cursor != nil && t.queryParams.offset != 0:
+		return merr.WrapErrParameterInvalidMsg("offset is only supported by the first batch of query iterator")
+	}
+	if it.cursor == nil {
+		return nil
+	}
+
+	if it.cursor.GetCollectionID() != t.GetCollectionID() {
+		return merr.WrapErrParameterInvalidMsg("query iterator cursor of collection %d used on collection %d",
+			it.cursor.GetCollectionID(), t.GetCollectionID())
+	}
+	if typeutil.GetSizeOfIDs(it.cursor.GetLastPk()) == 0 {
+		return nil
+	}
+
+	pkField, err := t.schema.GetPkField()
+	if err != nil {
+		return err
+	}
+	var value *planpb.GenericValue
+	switch pk := typeutil.GetPK(it.cursor.GetLastPk(), 0).(type) {
+	case int64:
+		if pkField.GetDataType() == schemapb.DataType_Int64 {
+			value = &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: pk}}
+		}
+	case string:
+		if pkField.GetDataType() == schemapb.DataType_VarChar {
+			value = &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: pk}}
+		}
+	}
+	if value == nil {
+		return merr.WrapErrParameterInvalidMsg("primary key of query iterator cursor not match the collection")
+	}
+	greater := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{
+					FieldId:        pkField.GetFieldID(),
+					DataType:       pkField.GetDataType(),
+					IsPrimaryKey:   true,
+					IsAutoID:       pkField.GetAutoID(),
+					IsPartitionKey: pkField.GetIsPartitionKey(),
+				},
+				Op:    planpb.OpType_GreaterThan,
+				Value: value,
+			},
+		},
+	}
+	query := t.plan.GetQuery()
+	if query.GetPredicates() == nil {
+		query.Predicates = greater
+	} else {
+		query.Predicates = &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  query.GetPredicates(),
+					Right: greater,
+				},
+			},
+		}
+	}
+	return nil
+}
+
+// snapshotTs returns the timestamp read by all batches, which is the guarantee timestamp of the first batch.
+// The first batch at Eventually consistency reads the snapshot at the bounded staleness.
+func (it *queryIterator) snapshotTs(guaranteeTs Timestamp, beginTs Timestamp) Timestamp {
+	if it.cursor != nil {
+		return it.cursor.GetGuaranteeTimestamp()
+	}
+	if guaranteeTs <= 1 {
+		return parseGuaranteeTsFromConsistency(guaranteeTs, beginTs, commonpb.ConsistencyLevel_Bounded)
+	}
+	return guaranteeTs
+}
+
+// next generates the cursor of the next batch from the reduced result of the current batch.
+func (it *queryIterator) next(collectionID int64, snapshotTs Timestamp, pkField *schemapb.FieldSchema, result []*schemapb.FieldData) error {
+	cursor := &internalpb.QueryIteratorCursor{
+		CollectionID:       collectionID,
+		GuaranteeTimestamp: snapshotTs,
+		LastPk:             it.cursor.GetLastPk(),
+	}
+	pkData, err := typeutil.GetPrimaryFieldData(result, pkField)
+	if err != nil {
+		return err
+	}
+	if n := typeutil.GetPKSize(pkData); n > 0 {
+		lastPk := &schemapb.IDs{}
+		typeutil.AppendPKs(lastPk, typeutil.GetData(pkData, n-1))
+		cursor.LastPk = lastPk
+	}
+
+	it.nextCursor, err = encodeIteratorCursor(cursor)
+	return err
+}
+
+// setQueryIteratorCursor sends the cursor of the next batch to the client by grpc header.
+func setQueryIteratorCursor(ctx context.Context, cursor string) {
+	setIteratorCursorHeader(ctx, QueryIteratorCursorHeader, cursor)
+}
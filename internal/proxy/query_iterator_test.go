@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type QueryIteratorSuite struct {
+	suite.Suite
+}
+
+func (s *QueryIteratorSuite) genTask(limit int64, predicates *planpb.Expr) *queryTask {
+	return &queryTask{
+		RetrieveRequest: &internalpb.RetrieveRequest{CollectionID: 1},
+		queryParams:     &queryParams{limit: limit},
+		schema: newSchemaInfo(&schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "value", DataType: schemapb.DataType_Int64},
+			},
+		}),
+		plan: &planpb.PlanNode{
+			Node: &planpb.PlanNode_Query{Query: &planpb.QueryPlanNode{Predicates: predicates, Limit: limit}},
+		},
+	}
+}
+
+func (s *QueryIteratorSuite) TestParse() {
+	it, err := parseQueryIterator(nil)
+	s.NoError(err)
+	s.Nil(it)
+
+	it, err = parseQueryIterator([]*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "false"}})
+	s.NoError(err)
+	s.Nil(it)
+
+	_, err = parseQueryIterator([]*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "yes please"}})
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+
+	it, err = parseQueryIterator([]*commonpb.KeyValuePair{{Key: QueryIteratorKey, Value: "true"}})
+	s.NoError(err)
+	s.NotNil(it)
+	s.Nil(it.cursor)
+
+	_, err = parseQueryIterator([]*commonpb.KeyValuePair{{Key: QueryIteratorCursorKey, Value: "%%%"}})
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+
+	cursor, err := encodeIteratorCursor(&internalpb.QueryIteratorCursor{CollectionID: 1, GuaranteeTimestamp: 100})
+	s.NoError(err)
+	it, err = parseQueryIterator([]*commonpb.KeyValuePair{{Key: QueryIteratorCursorKey, Value: cursor}})
+	s.NoError(err)
+	s.EqualValues(1, it.cursor.GetCollectionID())
+	s.EqualValues(100, it.cursor.GetGuaranteeTimestamp())
+}
+
+func (s *QueryIteratorSuite) TestPrepareFirstBatch() {
+	it := &queryIterator{}
+	task := s.genTask(10, nil)
+	s.NoError(it.prepare(task))
+	s.Nil(task.plan.GetQuery().GetPredicates())
+
+	task.queryParams.offset = 10
+	s.NoError(it.prepare(task))
+
+	s.ErrorIs(it.prepare(s.genTask(typeutil.Unlimited, nil)), merr.ErrParameterInvalid)
+
+	task = s.genTask(10, nil)
+	task.plan.GetQuery().IsCount = true
+	s.ErrorIs(it.prepare(task), merr.ErrParameterInvalid)
+}
+
+func (s *QueryIteratorSuite) TestNextBatch() {
+	it := &queryIterator{}
+	s.EqualValues(100, it.snapshotTs(100, 200))
+	// eventually consistency reads the snapshot at bounded staleness
+	s.Greater(it.snapshotTs(1, 200), uint64(1))
+
+	result := []*schemapb.FieldData{
+		getFieldData("value", 101, schemapb.DataType_Int64, []int64{10, 20, 30}, 0),
+		getFieldData("pk", 100, schemapb.DataType_Int64, []int64{1, 2, 3}, 0),
+	}
+	pkField, err := s.genTask(10, nil).schema.GetPkField()
+	s.NoError(err)
+	s.NoError(it.next(1, 100, pkField, result))
+
+	it, err = parseQueryIterator([]*commonpb.KeyValuePair{{Key: QueryIteratorCursorKey, Value: it.nextCursor}})
+	s.NoError(err)
+	s.EqualValues(100, it.snapshotTs(200, 300))
+	s.Equal([]int64{3}, it.cursor.GetLastPk().GetIntId().GetData())
+
+	predicates := &planpb.Expr{Expr: &planpb.Expr_AlwaysTrueExpr{AlwaysTrueExpr: &planpb.AlwaysTrueExpr{}}}
+	task := s.genTask(10, predicates)
+	s.NoError(it.prepare(task))
+	and := task.plan.GetQuery().GetPredicates().GetBinaryExpr()
+	s.Equal(planpb.BinaryExpr_LogicalAnd, and.GetOp())
+	s.Equal(predicates, and.GetLeft())
+	greater := and.GetRight().GetUnaryRangeExpr()
+	s.Equal(planpb.OpType_GreaterThan, greater.GetOp())
+	s.EqualValues(100, greater.GetColumnInfo().GetFieldId())
+	s.True(greater.GetColumnInfo().GetIsPrimaryKey())
+	s.EqualValues(3, greater.GetValue().GetInt64Val())
+
+	task = s.genTask(10, nil)
+	s.NoError(it.prepare(task))
+	s.EqualValues(3, task.plan.GetQuery().GetPredicates().GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+	// an empty batch keeps the cursor
+	empty := []*schemapb.FieldData{getFieldData("pk", 100, schemapb.DataType_Int64, []int64{}, 0)}
+	s.NoError(it.next(1, 100, pkField, empty))
+	next := &internalpb.QueryIteratorCursor{}
+	s.NoError(decodeIteratorCursor(it.nextCursor, next))
+	s.Equal([]int64{3}, next.GetLastPk().GetIntId().GetData())
+	s.Error(it.next(1, 100, pkField, nil))
+
+	task = s.genTask(10, nil)
+	task.queryParams.offset = 10
+	s.ErrorIs(it.prepare(task), merr.ErrParameterInvalid)
+
+	task = s.genTask(10, nil)
+	task.CollectionID = 2
+	s.ErrorIs(it.prepare(task), merr.ErrParameterInvalid)
+
+	it.cursor.LastPk = &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a"}}}}
+	s.ErrorIs(it.prepare(s.genTask(10, nil)), merr.ErrParameterInvalid)
+}
+
+func TestQueryIterator(t *testing.T) {
+	suite.Run(t, new(QueryIteratorSuite))
+}
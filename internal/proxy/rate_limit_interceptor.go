@@ -23,25 +23,30 @@ import (
 	"strconv"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"google.golang.org/grpc"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
+	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // RateLimitInterceptor returns a new unary server interceptors that performs request rate limiting.
 func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
-		collectionIDs, rt, n, err := getRequestInfo(req)
+		dbName, collectionIDToPartIDs, rt, n, err := getRequestInfo(req)
 		if err != nil {
 			return handler(ctx, req)
 		}
 
-		err = limiter.Check(collectionIDs, rt, n)
+		err = limiter.Check(ctx, dbName, collectionIDToPartIDs, rt, n)
 		nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
 		metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
 		if err != nil {
@@ -56,72 +61,140 @@ func RateLimitInterceptor(limiter types.Limiter) grpc.UnaryServerInterceptor {
 	}
 }
 
-// getRequestInfo returns collection name and rateType of request and return tokens needed.
-func getRequestInfo(req interface{}) ([]int64, internalpb.RateType, int, error) {
+// partitionKeySource is where the partition keys of a request are taken from in partition key mode,
+// the partition key column of the rows for insert and upsert, the filter expression for the others.
+type partitionKeySource struct {
+	fieldsData []*schemapb.FieldData
+	expr       string
+}
+
+// getCollectionAndPartitionIDs returns the collection id and the ids of the given partitions,
+// partitions which could not be found are skipped, they are not limited at partition level.
+// If no partition is given, the partitions are the ones which the partition keys are hashed to in partition key mode.
+func getCollectionAndPartitionIDs(dbName, collectionName string, keys partitionKeySource, partitionNames ...string) map[int64][]int64 {
+	collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), dbName, collectionName)
+	if lo.EveryBy(partitionNames, func(name string) bool { return name == "" }) {
+		partitionNames = hashPartitionKeys(dbName, collectionName, keys)
+	}
+	partitionIDs := make([]int64, 0, len(partitionNames))
+	for _, partitionName := range partitionNames {
+		if partitionName == "" {
+			continue
+		}
+		partitionID, err := globalMetaCache.GetPartitionID(context.TODO(), dbName, collectionName, partitionName)
+		if err != nil {
+			continue
+		}
+		partitionIDs = append(partitionIDs, partitionID)
+	}
+	return map[int64][]int64{collectionID: partitionIDs}
+}
+
+// hashPartitionKeys returns the names of the partitions which the partition keys of the request are hashed to,
+// nil is returned if the collection is not in partition key mode or the partition keys could not be resolved.
+func hashPartitionKeys(dbName, collectionName string, keys partitionKeySource) []string {
+	if len(keys.fieldsData) == 0 && keys.expr == "" {
+		return nil
+	}
+	ctx := context.TODO()
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dbName, collectionName)
+	if err != nil || !schema.IsPartitionKeyCollection() {
+		return nil
+	}
+	partitionKeyField, err := typeutil.GetPartitionKeyFieldSchema(schema.CollectionSchema)
+	if err != nil {
+		return nil
+	}
+	partitionNames, err := getDefaultPartitionNames(ctx, dbName, collectionName)
+	if err != nil {
+		return nil
+	}
+
+	if keys.expr != "" {
+		plan, err := planparserv2.CreateRetrievePlan(schema.CollectionSchema, keys.expr)
+		if err != nil {
+			return nil
+		}
+		expr, err := ParseExprFromPlan(plan)
+		if err != nil {
+			return nil
+		}
+		hashedPartitionNames, err := typeutil2.HashKey2Partitions(partitionKeyField, ParsePartitionKeys(expr), partitionNames)
+		if err != nil {
+			return nil
+		}
+		return hashedPartitionNames
+	}
+
+	for _, fieldData := range keys.fieldsData {
+		if fieldData.GetFieldName() != partitionKeyField.GetName() {
+			continue
+		}
+		hashValues, err := typeutil.HashKey2Partitions(fieldData, partitionNames)
+		if err != nil {
+			return nil
+		}
+		return lo.Uniq(lo.Map(hashValues, func(value uint32, _ int) string { return partitionNames[value] }))
+	}
+	return nil
+}
+
+// getCollectionID returns the collection id of request without partitions.
+func getCollectionID(dbName, collectionName string) map[int64][]int64 {
+	collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), dbName, collectionName)
+	return map[int64][]int64{collectionID: {}}
+}
+
+// getRequestInfo returns database name, collection and partition ids, rateType of request and return tokens needed.
+func getRequestInfo(req interface{}) (string, map[int64][]int64, internalpb.RateType, int, error) {
 	switch r := req.(type) {
 	case *milvuspb.InsertRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DMLInsert, proto.Size(r), nil
+		return r.GetDbName(), getCollectionAndPartitionIDs(r.GetDbName(), r.GetCollectionName(), partitionKeySource{fieldsData: r.GetFieldsData()}, r.GetPartitionName()), internalpb.RateType_DMLInsert, proto.Size(r), nil
 	case *milvuspb.UpsertRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DMLUpsert, proto.Size(r), nil
+		return r.GetDbName(), getCollectionAndPartitionIDs(r.GetDbName(), r.GetCollectionName(), partitionKeySource{fieldsData: r.GetFieldsData()}, r.GetPartitionName()), internalpb.RateType_DMLUpsert, proto.Size(r), nil
 	case *milvuspb.DeleteRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DMLDelete, proto.Size(r), nil
+		return r.GetDbName(), getCollectionAndPartitionIDs(r.GetDbName(), r.GetCollectionName(), partitionKeySource{expr: r.GetExpr()}, r.GetPartitionName()), internalpb.RateType_DMLDelete, proto.Size(r), nil
 	case *milvuspb.ImportRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DMLBulkLoad, proto.Size(r), nil
+		return r.GetDbName(), getCollectionAndPartitionIDs(r.GetDbName(), r.GetCollectionName(), partitionKeySource{}, r.GetPartitionName()), internalpb.RateType_DMLBulkLoad, proto.Size(r), nil
 	case *milvuspb.SearchRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DQLSearch, int(r.GetNq()), nil
+		return r.GetDbName(), getCollectionAndPartitionIDs(r.GetDbName(), r.GetCollectionName(), partitionKeySource{expr: r.GetDsl()}, r.GetPartitionNames()...), internalpb.RateType_DQLSearch, int(r.GetNq()), nil
 	case *milvuspb.QueryRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DQLQuery, 1, nil // think of the query request's nq as 1
+		return r.GetDbName(), getCollectionAndPartitionIDs(r.GetDbName(), r.GetCollectionName(), partitionKeySource{expr: r.GetExpr()}, r.GetPartitionNames()...), internalpb.RateType_DQLQuery, 1, nil // think of the query request's nq as 1
 	case *milvuspb.CreateCollectionRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLCollection, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLCollection, 1, nil
 	case *milvuspb.DropCollectionRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLCollection, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLCollection, 1, nil
 	case *milvuspb.LoadCollectionRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLCollection, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLCollection, 1, nil
 	case *milvuspb.ReleaseCollectionRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLCollection, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLCollection, 1, nil
 	case *milvuspb.CreatePartitionRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLPartition, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLPartition, 1, nil
 	case *milvuspb.DropPartitionRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLPartition, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLPartition, 1, nil
 	case *milvuspb.LoadPartitionsRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLPartition, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLPartition, 1, nil
 	case *milvuspb.ReleasePartitionsRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLPartition, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLPartition, 1, nil
 	case *milvuspb.CreateIndexRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLIndex, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLIndex, 1, nil
 	case *milvuspb.DropIndexRequest:
-		collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), r.GetCollectionName())
-		return []int64{collectionID}, internalpb.RateType_DDLIndex, 1, nil
+		return r.GetDbName(), getCollectionID(r.GetDbName(), r.GetCollectionName()), internalpb.RateType_DDLIndex, 1, nil
 	case *milvuspb.FlushRequest:
-		collectionIDs := make([]int64, 0, len(r.GetCollectionNames()))
+		collectionIDToPartIDs := make(map[int64][]int64, len(r.GetCollectionNames()))
 		for _, collectionName := range r.GetCollectionNames() {
 			collectionID, _ := globalMetaCache.GetCollectionID(context.TODO(), r.GetDbName(), collectionName)
-			collectionIDs = append(collectionIDs, collectionID)
+			collectionIDToPartIDs[collectionID] = []int64{}
 		}
-		return collectionIDs, internalpb.RateType_DDLFlush, 1, nil
+		return r.GetDbName(), collectionIDToPartIDs, internalpb.RateType_DDLFlush, 1, nil
 	case *milvuspb.ManualCompactionRequest:
-		return nil, internalpb.RateType_DDLCompaction, 1, nil
+		return "", nil, internalpb.RateType_DDLCompaction, 1, nil
 		// TODO: support more request
 	default:
 		if req == nil {
-			return nil, 0, 0, fmt.Errorf("null request")
+			return "", nil, 0, 0, fmt.Errorf("null request")
 		}
-		return nil, 0, 0, fmt.Errorf("unsupported request type %s", reflect.TypeOf(req).Name())
+		return "", nil, 0, 0, fmt.Errorf("unsupported request type %s", reflect.TypeOf(req).Name())
 	}
 }
 
@@ -27,8 +27,10 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 type limiterMock struct {
@@ -38,7 +40,7 @@ type limiterMock struct {
 	quotaStateReasons []commonpb.ErrorCode
 }
 
-func (l *limiterMock) Check(collection []int64, rt internalpb.RateType, n int) error {
+func (l *limiterMock) Check(ctx context.Context, dbName string, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error {
 	if l.rate == 0 {
 		return merr.ErrServiceQuotaExceeded
 	}
@@ -57,115 +59,189 @@ func TestRateLimitInterceptor(t *testing.T) {
 			mock.AnythingOfType("string"),
 		).Return(int64(0), nil)
 		globalMetaCache = mockCache
-		collection, rt, size, err := getRequestInfo(&milvuspb.InsertRequest{})
+		_, collection, rt, size, err := getRequestInfo(&milvuspb.InsertRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, proto.Size(&milvuspb.InsertRequest{}), size)
 		assert.Equal(t, internalpb.RateType_DMLInsert, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.UpsertRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.UpsertRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, proto.Size(&milvuspb.InsertRequest{}), size)
 		assert.Equal(t, internalpb.RateType_DMLUpsert, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.DeleteRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.DeleteRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, proto.Size(&milvuspb.DeleteRequest{}), size)
 		assert.Equal(t, internalpb.RateType_DMLDelete, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.ImportRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.ImportRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, proto.Size(&milvuspb.ImportRequest{}), size)
 		assert.Equal(t, internalpb.RateType_DMLBulkLoad, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.SearchRequest{Nq: 5})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.SearchRequest{Nq: 5})
 		assert.NoError(t, err)
 		assert.Equal(t, 5, size)
 		assert.Equal(t, internalpb.RateType_DQLSearch, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.QueryRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.QueryRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DQLQuery, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.CreateCollectionRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.CreateCollectionRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLCollection, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.LoadCollectionRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.LoadCollectionRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLCollection, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.ReleaseCollectionRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.ReleaseCollectionRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLCollection, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.DropCollectionRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.DropCollectionRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLCollection, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.CreatePartitionRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.CreatePartitionRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLPartition, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.LoadPartitionsRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.LoadPartitionsRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLPartition, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.ReleasePartitionsRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.ReleasePartitionsRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLPartition, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.DropPartitionRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.DropPartitionRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLPartition, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.CreateIndexRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.CreateIndexRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLIndex, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.DropIndexRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.DropIndexRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLIndex, rt)
-		assert.ElementsMatch(t, collection, []int64{int64(0)})
+		assert.Equal(t, map[int64][]int64{0: {}}, collection)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.FlushRequest{})
+		mockCache.On("GetPartitionID",
+			mock.Anything, // context.Context
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+			mock.AnythingOfType("string"),
+		).Return(int64(10), nil)
+		dbName, collection, rt, _, err := getRequestInfo(&milvuspb.SearchRequest{DbName: "db1", PartitionNames: []string{"p1"}})
+		assert.NoError(t, err)
+		assert.Equal(t, "db1", dbName)
+		assert.Equal(t, internalpb.RateType_DQLSearch, rt)
+		assert.Equal(t, map[int64][]int64{0: {10}}, collection)
+
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.FlushRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLFlush, rt)
 		assert.Len(t, collection, 0)
 
-		collection, rt, size, err = getRequestInfo(&milvuspb.ManualCompactionRequest{})
+		_, collection, rt, size, err = getRequestInfo(&milvuspb.ManualCompactionRequest{})
 		assert.NoError(t, err)
 		assert.Equal(t, 1, size)
 		assert.Equal(t, internalpb.RateType_DDLCompaction, rt)
 		assert.Len(t, collection, 0)
 	})
 
+	t.Run("test getRequestInfo in partition key mode", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Name: "test",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "key", DataType: schemapb.DataType_Int64, IsPartitionKey: true},
+			},
+		}
+		partitions := map[string]int64{"_default_0": 1000, "_default_1": 1001}
+		mockCache := NewMockCache(t)
+		mockCache.EXPECT().GetCollectionID(mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+		mockCache.EXPECT().GetCollectionSchema(mock.Anything, mock.Anything, mock.Anything).Return(newSchemaInfo(schema), nil)
+		mockCache.EXPECT().GetPartitions(mock.Anything, mock.Anything, mock.Anything).Return(partitions, nil)
+		mockCache.EXPECT().GetPartitionID(mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, dbName, collectionName, partitionName string) (int64, error) {
+				return partitions[partitionName], nil
+			})
+		globalMetaCache = mockCache
+
+		hashedPartitionID := func(key int64) int64 {
+			value, _ := typeutil.Hash32Int64(key)
+			return 1000 + int64(value%2)
+		}
+
+		keys := []int64{1, 2, 3, 4}
+		_, collection, _, _, err := getRequestInfo(&milvuspb.InsertRequest{
+			CollectionName: "test",
+			FieldsData: []*schemapb.FieldData{
+				{
+					FieldName: "key",
+					Type:      schemapb.DataType_Int64,
+					Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: keys}},
+					}},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		expected := typeutil.NewSet[int64]()
+		for _, key := range keys {
+			expected.Insert(hashedPartitionID(key))
+		}
+		assert.ElementsMatch(t, expected.Collect(), collection[1])
+
+		_, collection, _, _, err = getRequestInfo(&milvuspb.DeleteRequest{CollectionName: "test", Expr: "key in [1]"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64][]int64{1: {hashedPartitionID(1)}}, collection)
+
+		_, collection, _, _, err = getRequestInfo(&milvuspb.SearchRequest{CollectionName: "test", Dsl: "key == 2"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64][]int64{1: {hashedPartitionID(2)}}, collection)
+
+		_, collection, _, _, err = getRequestInfo(&milvuspb.QueryRequest{CollectionName: "test", Expr: "key == 3"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64][]int64{1: {hashedPartitionID(3)}}, collection)
+
+		// the filter without partition keys is not limited at partition level
+		_, collection, _, _, err = getRequestInfo(&milvuspb.QueryRequest{CollectionName: "test", Expr: "pk > 0"})
+		assert.NoError(t, err)
+		assert.Equal(t, map[int64][]int64{1: {}}, collection)
+	})
+
 	t.Run("test getFailedResponse", func(t *testing.T) {
 		testGetFailedResponse := func(req interface{}, rt internalpb.RateType, err error, fullMethod string) {
 			rsp := getFailedResponse(req, err)
@@ -75,6 +75,35 @@ func (ws *weightedScorer) scorerType() rankType {
 	return weightedRankType
 }
 
+// exprScorer normalizes the distances of an ann search into [0, 1], higher is better.
+// The normalized scores of all ann searches are combined by the shared rank expression.
+type exprScorer struct {
+	baseScorer
+	metricType string
+	ranker     *exprRanker
+}
+
+func (es *exprScorer) reScore(input *milvuspb.SearchResults) {
+	for i, score := range input.Results.GetScores() {
+		input.Results.Scores[i] = normalizeScore(es.metricType, score)
+	}
+}
+
+func (es *exprScorer) scorerType() rankType {
+	return udfExprRankType
+}
+
+// getExprRanker returns the rank expression if the reScorers are exprScorers, otherwise nil.
+func getExprRanker(scorers []reScorer) *exprRanker {
+	if len(scorers) == 0 {
+		return nil
+	}
+	if es, ok := scorers[0].(*exprScorer); ok {
+		return es.ranker
+	}
+	return nil
+}
+
 func NewReScorer(reqs []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValuePair) ([]reScorer, error) {
 	res := make([]reScorer, len(reqs))
 	rankTypeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RankTypeKey, rankParams)
@@ -167,6 +196,24 @@ func NewReScorer(reqs []*milvuspb.SearchRequest, rankParams []*commonpb.KeyValue
 				weight: weights[i],
 			}
 		}
+	case udfExprRankType:
+		code, ok := params[ExprParamsKey].(string)
+		if !ok {
+			return nil, errors.New(ExprParamsKey + " not found in rank_params or not a string")
+		}
+		ranker, err := newExprRanker(code, len(reqs))
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid rank expression: %v", err)
+		}
+		log.Debug("expr params", zap.String("expr", code), zap.Strings("fields", ranker.fields))
+		for i := range reqs {
+			res[i] = &exprScorer{
+				baseScorer: baseScorer{
+					scorerName: "expr",
+				},
+				ranker: ranker,
+			}
+		}
 	default:
 		return nil, errors.Errorf("unsupported rank type %s", rankTypeStr)
 	}
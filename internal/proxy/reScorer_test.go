@@ -121,4 +121,46 @@ func TestRescorer(t *testing.T) {
 		assert.Equal(t, weightedRankType, rescorers[0].scorerType())
 		assert.Equal(t, float32(weights[0]), rescorers[0].(*weightedScorer).weight)
 	})
+
+	t.Run("expr without param", func(t *testing.T) {
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "expr"},
+			{Key: RankParamsKey, Value: "{}"},
+		}
+
+		_, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "expr not found in rank_params")
+	})
+
+	t.Run("invalid expr", func(t *testing.T) {
+		b, err := json.Marshal(map[string]string{ExprParamsKey: "score[0] +"})
+		assert.NoError(t, err)
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "expr"},
+			{Key: RankParamsKey, Value: string(b)},
+		}
+
+		_, err = NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid rank expression")
+	})
+
+	t.Run("expr", func(t *testing.T) {
+		b, err := json.Marshal(map[string]string{ExprParamsKey: "0.7*score[0] + 0.3*score[1] * log(popularity)"})
+		assert.NoError(t, err)
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: RankTypeKey, Value: "expr"},
+			{Key: RankParamsKey, Value: string(b)},
+		}
+
+		rescorers, err := NewReScorer([]*milvuspb.SearchRequest{{}, {}}, rankParams)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, len(rescorers))
+		assert.Equal(t, udfExprRankType, rescorers[0].scorerType())
+		ranker := getExprRanker(rescorers)
+		assert.NotNil(t, ranker)
+		assert.Same(t, ranker, rescorers[1].(*exprScorer).ranker)
+		assert.Equal(t, []string{"popularity"}, ranker.fields)
+	})
 }
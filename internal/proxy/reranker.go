@@ -0,0 +1,267 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reranker"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/timerecord"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// RerankParamsKey is the key of the rerank params in the search params of search
+// or the rank params of hybrid search, the value is a json object, for example:
+// {"query": "what is milvus", "fields": ["title"], "candidate_size": 100, "timeout_ms": 500, "fallback": true}
+const RerankParamsKey = "rerank_params"
+
+var rerankProvider struct {
+	mu       sync.Mutex
+	inited   bool
+	provider reranker.Provider
+}
+
+// getRerankProvider returns the reranker provider configured for the proxy, nil if not configured.
+// The provider is created once on success, a failed creation is retried by the next call.
+func getRerankProvider() (reranker.Provider, error) {
+	rerankProvider.mu.Lock()
+	defer rerankProvider.mu.Unlock()
+	if rerankProvider.inited {
+		return rerankProvider.provider, nil
+	}
+	provider, err := reranker.NewProvider(&Params.ProxyCfg.Rerank)
+	if err != nil {
+		log.Warn("failed to init reranker provider", zap.Error(err))
+		return nil, err
+	}
+	rerankProvider.provider = provider
+	rerankProvider.inited = true
+	return provider, nil
+}
+
+// rerankParams re-orders the final results of a search request by a reranking model.
+// The search recalls candidate_size candidates, the reranker scores them by the query text and
+// the text fields of the candidates, then the offset and limit of the request are applied.
+type rerankParams struct {
+	Query         string   `json:"query"`
+	Fields        []string `json:"fields"`
+	CandidateSize int64    `json:"candidate_size"`
+	TimeoutMs     int64    `json:"timeout_ms"`
+	Fallback      *bool    `json:"fallback"`
+
+	limit    int64
+	offset   int64
+	provider reranker.Provider
+}
+
+// parseRerankParams returns nil if reranking is not requested.
+func parseRerankParams(params []*commonpb.KeyValuePair) (*rerankParams, error) {
+	paramsStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RerankParamsKey, params)
+	if err != nil {
+		return nil, nil
+	}
+	p := &rerankParams{}
+	if err := json.Unmarshal([]byte(paramsStr), p); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid %s: %v", RerankParamsKey, err)
+	}
+	if p.Query == "" {
+		return nil, merr.WrapErrParameterInvalidMsg("query of %s not set", RerankParamsKey)
+	}
+	maxCandidateSize := Params.ProxyCfg.Rerank.MaxCandidateSize.GetAsInt64()
+	if p.CandidateSize < 0 || p.CandidateSize > maxCandidateSize {
+		return nil, merr.WrapErrParameterInvalidRange(0, maxCandidateSize, p.CandidateSize, "invalid rerank candidate size")
+	}
+	if p.TimeoutMs < 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("invalid rerank timeout %d", p.TimeoutMs)
+	}
+	if p.TimeoutMs == 0 {
+		p.TimeoutMs = Params.ProxyCfg.Rerank.Timeout.GetAsInt64()
+	}
+	if p.Fallback == nil {
+		p.Fallback = lo.ToPtr(true)
+	}
+
+	p.provider, err = getRerankProvider()
+	if err != nil {
+		return nil, err
+	}
+	if p.provider == nil {
+		return nil, merr.WrapErrParameterInvalidMsg("rerank provider not configured")
+	}
+	return p, nil
+}
+
+// checkNoGroupBy returns error if any of the search params or rank params groups by field,
+// the reranker scores the candidates one by one and can not keep the groups.
+func (p *rerankParams) checkNoGroupBy(params ...[]*commonpb.KeyValuePair) error {
+	for _, kvs := range params {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, kvs); err == nil {
+			return merr.WrapErrParameterInvalidMsg("rerank is not supported by group by")
+		}
+	}
+	return nil
+}
+
+// resolveFields picks the text fields passed to the reranker from the output fields,
+// all the VarChar output fields are used if the fields are not specified.
+func (p *rerankParams) resolveFields(schema *schemaInfo, outputFields []string) error {
+	varCharFields := lo.FilterMap(schema.GetFields(), func(field *schemapb.FieldSchema, _ int) (string, bool) {
+		return field.GetName(), field.GetDataType() == schemapb.DataType_VarChar && lo.Contains(outputFields, field.GetName())
+	})
+	if len(p.Fields) == 0 {
+		p.Fields = varCharFields
+	}
+	if len(p.Fields) == 0 {
+		return merr.WrapErrParameterInvalidMsg("no VarChar field in output fields to rerank")
+	}
+	for _, field := range p.Fields {
+		if !lo.Contains(varCharFields, field) {
+			return merr.WrapErrParameterInvalidMsg("rerank field %s must be a VarChar field in output fields", field)
+		}
+	}
+	return nil
+}
+
+// expandSearchCandidates returns a copy of the search params with the topk and offset rewritten to recall
+// the candidates, the offset and limit of the request are applied after reranking.
+func (p *rerankParams) expandSearchCandidates(searchParams []*commonpb.KeyValuePair) ([]*commonpb.KeyValuePair, error) {
+	for _, kv := range searchParams {
+		switch kv.GetKey() {
+		case TopKKey:
+			limit, err := strconv.ParseInt(kv.GetValue(), 0, 64)
+			if err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", TopKKey, kv.GetValue())
+			}
+			p.limit = limit
+		case OffsetKey:
+			offset, err := strconv.ParseInt(kv.GetValue(), 0, 64)
+			if err != nil {
+				return nil, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", OffsetKey, kv.GetValue())
+			}
+			p.offset = offset
+		}
+	}
+	return lo.Map(searchParams, func(kv *commonpb.KeyValuePair, _ int) *commonpb.KeyValuePair {
+		switch kv.GetKey() {
+		case TopKKey:
+			return &commonpb.KeyValuePair{Key: kv.GetKey(), Value: strconv.FormatInt(p.candidateSize(), 10)}
+		case OffsetKey:
+			return &commonpb.KeyValuePair{Key: kv.GetKey(), Value: "0"}
+		default:
+			return kv
+		}
+	}), nil
+}
+
+// expandRankCandidates returns the rank params of hybrid search recalling the candidates.
+func (p *rerankParams) expandRankCandidates(params *rankParams) *rankParams {
+	p.limit = params.limit
+	p.offset = params.offset
+	return &rankParams{
+		limit:        p.candidateSize(),
+		offset:       0,
+		roundDecimal: params.roundDecimal,
+	}
+}
+
+func (p *rerankParams) candidateSize() int64 {
+	return lo.Max([]int64{p.CandidateSize, p.limit + p.offset})
+}
+
+// rerank re-orders the search results of a single query by the reranker, and applies the offset and limit.
+// The results keep the vector order if the reranker fails and fallback is enabled.
+func (p *rerankParams) rerank(ctx context.Context, data *schemapb.SearchResultData) (*schemapb.SearchResultData, error) {
+	tr := timerecord.NewTimeRecorder("rerank")
+	defer tr.CtxElapse(ctx, "done")
+
+	n := len(data.GetScores())
+	order := lo.Range(n)
+	scores := data.GetScores()
+	if n > 0 {
+		documents := make([]*reranker.Document, n)
+		for i := range documents {
+			documents[i] = &reranker.Document{
+				ID:     typeutil.GetPK(data.GetIds(), int64(i)),
+				Fields: make(map[string]string, len(p.Fields)),
+			}
+		}
+		for _, fieldData := range data.GetFieldsData() {
+			if !lo.Contains(p.Fields, fieldData.GetFieldName()) {
+				continue
+			}
+			for i, text := range fieldData.GetScalars().GetStringData().GetData() {
+				documents[i].Fields[fieldData.GetFieldName()] = text
+			}
+		}
+
+		rerankCtx, cancel := context.WithTimeout(ctx, time.Duration(p.TimeoutMs)*time.Millisecond)
+		rerankScores, err := p.provider.Rerank(rerankCtx, p.Query, documents)
+		cancel()
+		if err == nil && len(rerankScores) != n {
+			err = merr.WrapErrServiceInternal("reranker returns mismatched number of scores")
+		}
+		if err != nil {
+			if !*p.Fallback {
+				log.Ctx(ctx).Warn("failed to rerank search results", zap.Error(err))
+				return nil, err
+			}
+			log.Ctx(ctx).Warn("failed to rerank search results, fallback to the vector order", zap.Error(err))
+		} else {
+			scores = rerankScores
+			sort.SliceStable(order, func(i, j int) bool {
+				return scores[order[i]] > scores[order[j]]
+			})
+		}
+	}
+
+	if int64(len(order)) <= p.offset {
+		order = nil
+	} else {
+		order = order[p.offset:]
+	}
+	if int64(len(order)) > p.limit {
+		order = order[:p.limit]
+	}
+	ret := &schemapb.SearchResultData{
+		NumQueries:   1,
+		TopK:         p.limit,
+		Ids:          &schemapb.IDs{},
+		Scores:       make([]float32, 0, len(order)),
+		Topks:        []int64{int64(len(order))},
+		FieldsData:   typeutil.PrepareResultFieldData(data.GetFieldsData(), int64(len(order))),
+		OutputFields: data.GetOutputFields(),
+	}
+	for _, idx := range order {
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(data.GetIds(), int64(idx)))
+		ret.Scores = append(ret.Scores, scores[idx])
+		typeutil.AppendFieldData(ret.FieldsData, data.GetFieldsData(), int64(idx))
+	}
+	return ret, nil
+}
@@ -0,0 +1,166 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/reranker"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// lengthReranker scores the documents by the length of the title
+type lengthReranker struct {
+	err error
+}
+
+func (r *lengthReranker) Rerank(ctx context.Context, query string, documents []*reranker.Document) ([]float32, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+	return lo.Map(documents, func(doc *reranker.Document, _ int) float32 {
+		return float32(len(doc.Fields["title"]))
+	}), nil
+}
+
+func TestRerankParams(t *testing.T) {
+	schema := newSchemaInfo(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "title", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "body", DataType: schemapb.DataType_VarChar},
+			{FieldID: 103, Name: "likes", DataType: schemapb.DataType_Int64},
+		},
+	})
+
+	t.Run("provider init retried", func(t *testing.T) {
+		paramtable.Get().Save(Params.ProxyCfg.Rerank.Provider.Key, "unknown")
+		_, err := getRerankProvider()
+		assert.Error(t, err)
+
+		paramtable.Get().Reset(Params.ProxyCfg.Rerank.Provider.Key)
+		provider, err := getRerankProvider()
+		assert.NoError(t, err)
+		assert.Nil(t, provider)
+	})
+
+	t.Run("parse", func(t *testing.T) {
+		p, err := parseRerankParams(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, p)
+
+		_, err = parseRerankParams([]*commonpb.KeyValuePair{{Key: RerankParamsKey, Value: "{"}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = parseRerankParams([]*commonpb.KeyValuePair{{Key: RerankParamsKey, Value: `{"fields": ["title"]}`}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = parseRerankParams([]*commonpb.KeyValuePair{{Key: RerankParamsKey, Value: `{"query": "q", "candidate_size": 100000}`}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		// no provider configured
+		_, err = parseRerankParams([]*commonpb.KeyValuePair{{Key: RerankParamsKey, Value: `{"query": "q"}`}})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+
+	t.Run("resolve fields", func(t *testing.T) {
+		p := &rerankParams{}
+		assert.NoError(t, p.resolveFields(schema, []string{"title", "likes"}))
+		assert.Equal(t, []string{"title"}, p.Fields)
+
+		p = &rerankParams{}
+		assert.ErrorIs(t, p.resolveFields(schema, []string{"likes"}), merr.ErrParameterInvalid)
+
+		p = &rerankParams{Fields: []string{"body"}}
+		assert.ErrorIs(t, p.resolveFields(schema, []string{"title"}), merr.ErrParameterInvalid)
+	})
+
+	t.Run("group by", func(t *testing.T) {
+		p := &rerankParams{}
+		assert.NoError(t, p.checkNoGroupBy(nil, []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}}))
+		assert.ErrorIs(t, p.checkNoGroupBy(nil, []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "likes"}}), merr.ErrParameterInvalid)
+	})
+
+	t.Run("expand candidates", func(t *testing.T) {
+		p := &rerankParams{CandidateSize: 50}
+		searchParams := []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}, {Key: OffsetKey, Value: "5"}}
+		expanded, err := p.expandSearchCandidates(searchParams)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 10, p.limit)
+		assert.EqualValues(t, 5, p.offset)
+		assert.Equal(t, "50", expanded[0].GetValue())
+		assert.Equal(t, "0", expanded[1].GetValue())
+		// the search params of request are kept
+		assert.Equal(t, "10", searchParams[0].GetValue())
+		assert.Equal(t, "5", searchParams[1].GetValue())
+
+		p = &rerankParams{CandidateSize: 5}
+		rank := p.expandRankCandidates(&rankParams{limit: 10, offset: 2, roundDecimal: -1})
+		assert.EqualValues(t, 12, rank.limit)
+		assert.EqualValues(t, 0, rank.offset)
+	})
+
+	genResult := func() *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 1,
+			TopK:       4,
+			Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4}}}},
+			Scores:     []float32{0.9, 0.8, 0.7, 0.6},
+			Topks:      []int64{4},
+			FieldsData: []*schemapb.FieldData{{
+				FieldName: "title",
+				FieldId:   101,
+				Type:      schemapb.DataType_VarChar,
+				Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"a", "abc", "ab", "abcd"}}},
+				}},
+			}},
+		}
+	}
+
+	t.Run("rerank", func(t *testing.T) {
+		p := &rerankParams{Fields: []string{"title"}, TimeoutMs: 100, Fallback: lo.ToPtr(true), limit: 2, offset: 1, provider: &lengthReranker{}}
+		ret, err := p.rerank(context.Background(), genResult())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{2, 3}, ret.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{3, 2}, ret.GetScores())
+		assert.Equal(t, []string{"abc", "ab"}, ret.GetFieldsData()[0].GetScalars().GetStringData().GetData())
+		assert.Equal(t, []int64{2}, ret.GetTopks())
+
+		p.offset = 4
+		ret, err = p.rerank(context.Background(), genResult())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0}, ret.GetTopks())
+	})
+
+	t.Run("fallback", func(t *testing.T) {
+		p := &rerankParams{Fields: []string{"title"}, TimeoutMs: 100, Fallback: lo.ToPtr(true), limit: 2, provider: &lengthReranker{err: errors.New("mock")}}
+		ret, err := p.rerank(context.Background(), genResult())
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2}, ret.GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{0.9, 0.8}, ret.GetScores())
+
+		p.Fallback = lo.ToPtr(false)
+		_, err = p.rerank(context.Background(), genResult())
+		assert.Error(t, err)
+	})
+}
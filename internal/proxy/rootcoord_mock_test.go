@@ -1095,6 +1095,14 @@ func (coord *RootCoordMock) ListPolicy(ctx context.Context, in *internalpb.ListP
 	return &internalpb.ListPolicyResponse{}, nil
 }
 
+func (coord *RootCoordMock) AlterRateLimits(ctx context.Context, in *internalpb.AlterRateLimitsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (coord *RootCoordMock) DescribeRateLimits(ctx context.Context, in *internalpb.DescribeRateLimitsRequest, opts ...grpc.CallOption) (*internalpb.DescribeRateLimitsResponse, error) {
+	return &internalpb.DescribeRateLimitsResponse{Status: merr.Success()}, nil
+}
+
 func (coord *RootCoordMock) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	return &commonpb.Status{}, nil
 }
@@ -1111,6 +1119,14 @@ func (coord *RootCoordMock) ListDatabases(ctx context.Context, in *milvuspb.List
 	return &milvuspb.ListDatabasesResponse{}, nil
 }
 
+func (coord *RootCoordMock) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	return &internalpb.DescribeDatabaseResponse{Status: merr.Success()}, nil
+}
+
+func (coord *RootCoordMock) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func (coord *RootCoordMock) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	if coord.checkHealthFunc != nil {
 		return coord.checkHealthFunc(ctx, req)
@@ -1201,6 +1217,14 @@ func (m *mockRootCoord) ListPolicy(ctx context.Context, in *internalpb.ListPolic
 	return &internalpb.ListPolicyResponse{}, nil
 }
 
+func (m *mockRootCoord) AlterRateLimits(ctx context.Context, in *internalpb.AlterRateLimitsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
+func (m *mockRootCoord) DescribeRateLimits(ctx context.Context, in *internalpb.DescribeRateLimitsRequest, opts ...grpc.CallOption) (*internalpb.DescribeRateLimitsResponse, error) {
+	return &internalpb.DescribeRateLimitsResponse{Status: merr.Success()}, nil
+}
+
 func (m *mockRootCoord) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest, opts ...grpc.CallOption) (*milvuspb.CheckHealthResponse, error) {
 	return &milvuspb.CheckHealthResponse{
 		IsHealthy: true,
@@ -1219,6 +1243,14 @@ func (m *mockRootCoord) ListDatabases(ctx context.Context, in *milvuspb.ListData
 	return &milvuspb.ListDatabasesResponse{}, nil
 }
 
+func (m *mockRootCoord) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest, opts ...grpc.CallOption) (*internalpb.DescribeDatabaseResponse, error) {
+	return &internalpb.DescribeDatabaseResponse{Status: merr.Success()}, nil
+}
+
+func (m *mockRootCoord) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return merr.Success(), nil
+}
+
 func newMockRootCoord() *mockRootCoord {
 	return &mockRootCoord{}
 }
@@ -0,0 +1,195 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// SearchIteratorKey enables search iterator on the first page.
+	SearchIteratorKey = "iterator"
+	// SearchIteratorCursorKey is the cursor returned by the previous page.
+	SearchIteratorCursorKey = "iterator_cursor"
+	// SearchIteratorCursorHeader is the grpc response header carrying the cursor of the next page.
+	SearchIteratorCursorHeader = "search-iterator-cursor"
+)
+
+// searchIterator pages through the search results by score bound instead of offset.
+// Each page is a range search starting from the score of the last returned hit,
+// the hits on the bound which have been returned are filtered out by primary keys,
+// so the cost of every page is the same as the first one.
+type searchIterator struct {
+	cursor     *internalpb.SearchIteratorCursor // nil for the first page
+	nextCursor string
+}
+
+// parseSearchIterator returns nil if search iterator is not enabled by the search params.
+func parseSearchIterator(searchParamsPair []*commonpb.KeyValuePair) (*searchIterator, error) {
+	cursorStr, enable, err := parseIteratorParams(SearchIteratorKey, SearchIteratorCursorKey, searchParamsPair)
+	if err != nil || !enable {
+		return nil, err
+	}
+	if cursorStr == "" {
+		return &searchIterator{}, nil
+	}
+	cursor := &internalpb.SearchIteratorCursor{}
+	if err := decodeIteratorCursor(cursorStr, cursor); err != nil {
+		return nil, err
+	}
+	return &searchIterator{cursor: cursor}, nil
+}
+
+// prepare validates the search request and applies the bound of the cursor to the search plan.
+func (it *searchIterator) prepare(t *searchTask, plan *planpb.PlanNode) error {
+	queryInfo := plan.GetVectorAnns().GetQueryInfo()
+	switch {
+	case plan.GetVectorAnns() == nil || t.SearchRequest.GetBm25Info() != nil:
+		return merr.WrapErrParameterInvalidMsg("search iterator only supports vector search")
+	case t.SearchRequest.GetNq() != 1:
+		return merr.WrapErrParameterInvalidMsg("search iterator only supports nq=1, got %d", t.SearchRequest.GetNq())
+	case t.offset != 0:
+		return merr.WrapErrParameterInvalidMsg("offset is not supported by search iterator")
+	case queryInfo.GetGroupByFieldId() > 0:
+		return merr.WrapErrParameterInvalidMsg("group by is not supported by search iterator")
+	case queryInfo.GetRoundDecimal() != -1:
+		return merr.WrapErrParameterInvalidMsg("round decimal is not supported by search iterator")
+	}
+	if it.cursor == nil {
+		return nil
+	}
+
+	if it.cursor.GetCollectionID() != t.GetCollectionID() {
+		return merr.WrapErrParameterInvalidMsg("search iterator cursor of collection %d used on collection %d",
+			it.cursor.GetCollectionID(), t.GetCollectionID())
+	}
+	if queryInfo.GetMetricType() == "" {
+		queryInfo.MetricType = it.cursor.GetMetricType()
+	} else if !strings.EqualFold(queryInfo.GetMetricType(), it.cursor.GetMetricType()) {
+		return merr.WrapErrParameterInvalidMsg("metric type %s not match the search iterator cursor %s",
+			queryInfo.GetMetricType(), it.cursor.GetMetricType())
+	}
+
+	params := make(map[string]any)
+	if queryInfo.GetSearchParams() != "" {
+		if err := json.Unmarshal([]byte(queryInfo.GetSearchParams()), &params); err != nil {
+			return merr.WrapErrParameterInvalidMsg("failed to parse search params: %v", err)
+		}
+	}
+	// range_filter is inclusive, the hits on the bound which have been returned are filtered by pk
+	params[rangeFilterKey] = it.cursor.GetLastBound()
+	if _, ok := params[radiusKey]; !ok {
+		if metric.PositivelyRelated(it.cursor.GetMetricType()) {
+			params[radiusKey] = -math.MaxFloat32
+		} else {
+			params[radiusKey] = math.MaxFloat32
+		}
+	}
+	searchParams, err := json.Marshal(params)
+	if err != nil {
+		return err
+	}
+	queryInfo.SearchParams = string(searchParams)
+
+	if it.cursor.GetBoundIds() != nil && typeutil.GetSizeOfIDs(it.cursor.GetBoundIds()) > 0 {
+		pkField, err := t.schema.GetPkField()
+		if err != nil {
+			return err
+		}
+		returned := &planpb.Expr{
+			Expr: &planpb.Expr_UnaryExpr{
+				UnaryExpr: &planpb.UnaryExpr{
+					Op:    planpb.UnaryExpr_Not,
+					Child: planparserv2.CreateRequeryPlan(pkField, it.cursor.GetBoundIds()).GetQuery().GetPredicates(),
+				},
+			},
+		}
+		vectorAnns := plan.GetVectorAnns()
+		if vectorAnns.GetPredicates() == nil {
+			vectorAnns.Predicates = returned
+		} else {
+			vectorAnns.Predicates = &planpb.Expr{
+				Expr: &planpb.Expr_BinaryExpr{
+					BinaryExpr: &planpb.BinaryExpr{
+						Op:    planpb.BinaryExpr_LogicalAnd,
+						Left:  vectorAnns.GetPredicates(),
+						Right: returned,
+					},
+				},
+			}
+		}
+	}
+	return nil
+}
+
+// channelsMvcc returns the mvcc timestamps of the first page, nil for the first page.
+func (it *searchIterator) channelsMvcc() map[string]uint64 {
+	return it.cursor.GetChannelsMvcc()
+}
+
+// next generates the cursor of the next page from the reduced result of the current page.
+func (it *searchIterator) next(collectionID int64, metricType string, channelsMvcc map[string]uint64, result *schemapb.SearchResultData) error {
+	cursor := &internalpb.SearchIteratorCursor{
+		CollectionID: collectionID,
+		ChannelsMvcc: channelsMvcc,
+		MetricType:   metricType,
+		LastBound:    it.cursor.GetLastBound(),
+		BoundIds:     it.cursor.GetBoundIds(),
+	}
+	if it.cursor != nil {
+		// keep reading the snapshot of the first page
+		cursor.ChannelsMvcc = it.cursor.GetChannelsMvcc()
+	}
+	if cursor.GetMetricType() == "" {
+		cursor.MetricType = it.cursor.GetMetricType()
+	}
+
+	scores := result.GetScores()
+	if len(scores) > 0 {
+		lastBound := scores[len(scores)-1]
+		boundIDs := &schemapb.IDs{}
+		if it.cursor != nil && it.cursor.GetLastBound() == lastBound {
+			boundIDs = typeutil.Clone(it.cursor.GetBoundIds())
+		}
+		for i := len(scores) - 1; i >= 0 && scores[i] == lastBound; i-- {
+			typeutil.AppendPKs(boundIDs, typeutil.GetPK(result.GetIds(), int64(i)))
+		}
+		cursor.LastBound = lastBound
+		cursor.BoundIds = boundIDs
+	}
+
+	var err error
+	it.nextCursor, err = encodeIteratorCursor(cursor)
+	return err
+}
+
+// setSearchIteratorCursor sends the cursor of the next page to the client by grpc header.
+func setSearchIteratorCursor(ctx context.Context, cursor string) {
+	setIteratorCursorHeader(ctx, SearchIteratorCursorHeader, cursor)
+}
@@ -0,0 +1,169 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+)
+
+type SearchIteratorSuite struct {
+	suite.Suite
+}
+
+func (s *SearchIteratorSuite) genTask(nq int64) *searchTask {
+	return &searchTask{
+		SearchRequest: &internalpb.SearchRequest{CollectionID: 1, Nq: nq},
+		schema: newSchemaInfo(&schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			},
+		}),
+	}
+}
+
+func (s *SearchIteratorSuite) genPlan(queryInfo *planpb.QueryInfo) *planpb.PlanNode {
+	return &planpb.PlanNode{
+		Node: &planpb.PlanNode_VectorAnns{
+			VectorAnns: &planpb.VectorANNS{FieldId: 101, QueryInfo: queryInfo},
+		},
+	}
+}
+
+func (s *SearchIteratorSuite) genResult(ids []int64, scores []float32) *schemapb.SearchResultData {
+	return &schemapb.SearchResultData{
+		NumQueries: 1,
+		Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+		Scores:     scores,
+		Topks:      []int64{int64(len(ids))},
+	}
+}
+
+func (s *SearchIteratorSuite) TestParse() {
+	it, err := parseSearchIterator(nil)
+	s.NoError(err)
+	s.Nil(it)
+
+	it, err = parseSearchIterator([]*commonpb.KeyValuePair{{Key: SearchIteratorKey, Value: "false"}})
+	s.NoError(err)
+	s.Nil(it)
+
+	_, err = parseSearchIterator([]*commonpb.KeyValuePair{{Key: SearchIteratorKey, Value: "yes please"}})
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+
+	it, err = parseSearchIterator([]*commonpb.KeyValuePair{{Key: SearchIteratorKey, Value: "true"}})
+	s.NoError(err)
+	s.NotNil(it)
+	s.Nil(it.cursor)
+
+	_, err = parseSearchIterator([]*commonpb.KeyValuePair{{Key: SearchIteratorCursorKey, Value: "%%%"}})
+	s.ErrorIs(err, merr.ErrParameterInvalid)
+
+	cursor, err := encodeIteratorCursor(&internalpb.SearchIteratorCursor{CollectionID: 1, LastBound: 0.5})
+	s.NoError(err)
+	it, err = parseSearchIterator([]*commonpb.KeyValuePair{{Key: SearchIteratorCursorKey, Value: cursor}})
+	s.NoError(err)
+	s.EqualValues(1, it.cursor.GetCollectionID())
+	s.EqualValues(0.5, it.cursor.GetLastBound())
+}
+
+func (s *SearchIteratorSuite) TestPrepareFirstPage() {
+	it := &searchIterator{}
+	queryInfo := &planpb.QueryInfo{Topk: 10, RoundDecimal: -1, SearchParams: `{"nprobe": 16}`}
+	s.NoError(it.prepare(s.genTask(1), s.genPlan(queryInfo)))
+	s.Equal(`{"nprobe": 16}`, queryInfo.GetSearchParams())
+
+	s.ErrorIs(it.prepare(s.genTask(2), s.genPlan(queryInfo)), merr.ErrParameterInvalid)
+
+	task := s.genTask(1)
+	task.offset = 10
+	s.ErrorIs(it.prepare(task, s.genPlan(queryInfo)), merr.ErrParameterInvalid)
+
+	s.ErrorIs(it.prepare(s.genTask(1), s.genPlan(&planpb.QueryInfo{RoundDecimal: 2})), merr.ErrParameterInvalid)
+	s.ErrorIs(it.prepare(s.genTask(1), s.genPlan(&planpb.QueryInfo{RoundDecimal: -1, GroupByFieldId: 100})), merr.ErrParameterInvalid)
+}
+
+func (s *SearchIteratorSuite) TestNextPage() {
+	it := &searchIterator{}
+	channelsMvcc := map[string]uint64{"dml_0": 100, "dml_1": 101}
+	s.NoError(it.next(1, metric.L2, channelsMvcc, s.genResult([]int64{1, 2, 3}, []float32{0.1, 0.2, 0.2})))
+
+	it, err := parseSearchIterator([]*commonpb.KeyValuePair{{Key: SearchIteratorCursorKey, Value: it.nextCursor}})
+	s.NoError(err)
+	s.Equal(channelsMvcc, it.channelsMvcc())
+	s.Equal(metric.L2, it.cursor.GetMetricType())
+	s.EqualValues(0.2, it.cursor.GetLastBound())
+	s.ElementsMatch([]int64{2, 3}, it.cursor.GetBoundIds().GetIntId().GetData())
+
+	queryInfo := &planpb.QueryInfo{Topk: 3, RoundDecimal: -1, SearchParams: `{"nprobe": 16}`}
+	plan := s.genPlan(queryInfo)
+	plan.GetVectorAnns().Predicates = &planpb.Expr{Expr: &planpb.Expr_AlwaysTrueExpr{AlwaysTrueExpr: &planpb.AlwaysTrueExpr{}}}
+	s.NoError(it.prepare(s.genTask(1), plan))
+	s.Equal(metric.L2, queryInfo.GetMetricType())
+	params := make(map[string]float64)
+	s.NoError(json.Unmarshal([]byte(queryInfo.GetSearchParams()), &params))
+	s.EqualValues(16, params["nprobe"])
+	s.EqualValues(float32(0.2), float32(params[rangeFilterKey]))
+	s.Greater(params[radiusKey], params[rangeFilterKey])
+	and := plan.GetVectorAnns().GetPredicates().GetBinaryExpr()
+	s.Equal(planpb.BinaryExpr_LogicalAnd, and.GetOp())
+	s.Len(and.GetRight().GetUnaryExpr().GetChild().GetTermExpr().GetValues(), 2)
+
+	// the whole page is on the same bound, the previous bound ids are kept
+	s.NoError(it.next(1, metric.L2, map[string]uint64{"dml_0": 200}, s.genResult([]int64{4}, []float32{0.2})))
+	it, err = parseSearchIterator([]*commonpb.KeyValuePair{{Key: SearchIteratorCursorKey, Value: it.nextCursor}})
+	s.NoError(err)
+	s.Equal(channelsMvcc, it.channelsMvcc())
+	s.ElementsMatch([]int64{2, 3, 4}, it.cursor.GetBoundIds().GetIntId().GetData())
+
+	// an empty page keeps the cursor
+	s.NoError(it.next(1, "", nil, &schemapb.SearchResultData{NumQueries: 1, Topks: []int64{0}}))
+	next := &internalpb.SearchIteratorCursor{}
+	s.NoError(decodeIteratorCursor(it.nextCursor, next))
+	s.Equal(metric.L2, next.GetMetricType())
+	s.ElementsMatch([]int64{2, 3, 4}, next.GetBoundIds().GetIntId().GetData())
+
+	task := s.genTask(1)
+	task.CollectionID = 2
+	s.ErrorIs(it.prepare(task, s.genPlan(&planpb.QueryInfo{RoundDecimal: -1})), merr.ErrParameterInvalid)
+	s.ErrorIs(it.prepare(s.genTask(1), s.genPlan(&planpb.QueryInfo{RoundDecimal: -1, MetricType: metric.IP})), merr.ErrParameterInvalid)
+}
+
+func (s *SearchIteratorSuite) TestPositivelyRelated() {
+	it := &searchIterator{cursor: &internalpb.SearchIteratorCursor{CollectionID: 1, MetricType: metric.IP, LastBound: 0.8}}
+	queryInfo := &planpb.QueryInfo{RoundDecimal: -1, MetricType: "ip"}
+	plan := s.genPlan(queryInfo)
+	s.NoError(it.prepare(s.genTask(1), plan))
+	params := make(map[string]float64)
+	s.NoError(json.Unmarshal([]byte(queryInfo.GetSearchParams()), &params))
+	s.Less(params[radiusKey], params[rangeFilterKey])
+	s.Nil(plan.GetVectorAnns().GetPredicates())
+}
+
+func TestSearchIterator(t *testing.T) {
+	suite.Run(t, new(SearchIteratorSuite))
+}
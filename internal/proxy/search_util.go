@@ -4,17 +4,29 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
+	"sync"
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/parser/planparserv2"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
@@ -80,17 +92,38 @@ func initSearchRequest(ctx context.Context, t *searchTask) error {
 		}
 		t.offset = offset
 
-		plan, err := planparserv2.CreateSearchPlan(t.schema.CollectionSchema, t.request.Dsl, annsField, queryInfo)
-		if err != nil {
-			log.Warn("failed to create query plan", zap.Error(err),
-				zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
-				zap.String("anns field", annsField), zap.Any("query info", queryInfo))
-			return merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+		var plan *planpb.PlanNode
+		textField := lo.FindOrElse(t.schema.GetFields(), nil, func(field *schemapb.FieldSchema) bool {
+			return field.GetName() == annsField && bm25.IsAnalyzerEnabled(field)
+		})
+		if textField != nil {
+			plan, err = createBM25SearchPlan(t, textField, queryInfo)
+			if err != nil {
+				log.Warn("failed to create full-text search plan", zap.Error(err), zap.String("anns field", annsField))
+				return err
+			}
+			queryInfo.MetricType = metric.BM25
+			t.SearchRequest.Bm25Info = &internalpb.BM25SearchInfo{FieldID: textField.GetFieldID()}
+		} else {
+			plan, err = planparserv2.CreateSearchPlan(t.schema.CollectionSchema, t.request.Dsl, annsField, queryInfo)
+			if err != nil {
+				log.Warn("failed to create query plan", zap.Error(err),
+					zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
+					zap.String("anns field", annsField), zap.Any("query info", queryInfo))
+				return merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+			}
 		}
 		log.Debug("create query plan",
 			zap.String("dsl", t.request.Dsl), // may be very large if large term passed.
 			zap.String("anns field", annsField), zap.Any("query info", queryInfo))
 
+		if t.iterator != nil {
+			if err := t.iterator.prepare(t, plan); err != nil {
+				log.Warn("failed to prepare search iterator", zap.Error(err))
+				return err
+			}
+		}
+
 		if t.partitionKeyMode {
 			expr, err := ParseExprFromPlan(plan)
 			if err != nil {
@@ -114,20 +147,25 @@ func initSearchRequest(ctx context.Context, t *searchTask) error {
 			}
 		}
 
-		plan.OutputFieldIds = outputFieldIDs
-
 		t.SearchRequest.Topk = queryInfo.GetTopk()
+		t.SearchRequest.GroupSize = queryInfo.GetGroupSize()
 		t.SearchRequest.MetricType = queryInfo.GetMetricType()
 		t.SearchRequest.DslType = commonpb.DslType_BoolExprV1
 
-		estimateSize, err := t.estimateResultSize(nq, t.SearchRequest.Topk)
-		if err != nil {
-			log.Warn("failed to estimate result size", zap.Error(err))
-			return err
-		}
-		if estimateSize >= requeryThreshold {
-			t.requery = true
-			plan.OutputFieldIds = nil
+		if textField != nil {
+			// full-text search only returns the ids and scores, output fields are always requeried
+			t.requery = len(outputFieldIDs) > 0
+		} else {
+			plan.OutputFieldIds = outputFieldIDs
+			estimateSize, err := t.estimateResultSize(nq, t.SearchRequest.Topk*lo.Max([]int64{t.SearchRequest.GroupSize, 1}))
+			if err != nil {
+				log.Warn("failed to estimate result size", zap.Error(err))
+				return err
+			}
+			if estimateSize >= requeryThreshold {
+				t.requery = true
+				plan.OutputFieldIds = nil
+			}
 		}
 
 		t.SearchRequest.SerializedExprPlan, err = proto.Marshal(plan)
@@ -153,3 +191,150 @@ func initSearchRequest(ctx context.Context, t *searchTask) error {
 
 	return nil
 }
+
+// createBM25SearchPlan creates the plan of full-text search on an analyzer enabled VarChar field.
+// It is a query plan retrieving the primary keys which pass the filter, query nodes score
+// the rows by the term frequency indexes of segments with the weights filled by fillBM25SearchInfo.
+func createBM25SearchPlan(t *searchTask, textField *schemapb.FieldSchema, queryInfo *planpb.QueryInfo) (*planpb.PlanNode, error) {
+	if metricType := queryInfo.GetMetricType(); metricType != "" && !strings.EqualFold(metricType, metric.BM25) {
+		return nil, merr.WrapErrParameterInvalidMsg("metric type %s not supported for full-text search, expected %s", metricType, metric.BM25)
+	}
+	if queryInfo.GetGroupByFieldId() > 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("group by is not supported for full-text search")
+	}
+
+	placeholderGroup := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(t.request.GetPlaceholderGroup(), placeholderGroup); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("failed to unmarshal placeholder group: %v", err)
+	}
+	for _, placeholder := range placeholderGroup.GetPlaceholders() {
+		if placeholder.GetType() != commonpb.PlaceholderType_VarChar {
+			return nil, merr.WrapErrParameterInvalid(commonpb.PlaceholderType_VarChar.String(), placeholder.GetType().String(), "query text type not match")
+		}
+	}
+
+	var plan *planpb.PlanNode
+	if t.request.GetDsl() == "" {
+		plan = &planpb.PlanNode{
+			Node: &planpb.PlanNode_Query{
+				Query: &planpb.QueryPlanNode{},
+			},
+		}
+	} else {
+		var err error
+		plan, err = planparserv2.CreateRetrievePlan(t.schema.CollectionSchema, t.request.GetDsl())
+		if err != nil {
+			return nil, merr.WrapErrParameterInvalidMsg("failed to create query plan: %v", err)
+		}
+	}
+	pkField, err := t.schema.GetPkField()
+	if err != nil {
+		return nil, err
+	}
+	plan.GetQuery().Limit = typeutil.Unlimited
+	plan.OutputFieldIds = []int64{pkField.GetFieldID()}
+	return plan, nil
+}
+
+// fillBM25SearchInfo fills the average document length and the query weights of full-text search
+// by the term stats of collection, which are summed up from the shard leaders of all channels,
+// so that the scores of different shards are comparable.
+func fillBM25SearchInfo(ctx context.Context, lb LBPolicy, schema *schemapb.CollectionSchema, dbName, collectionName string, req *internalpb.SearchRequest) error {
+	info := req.GetBm25Info()
+	field := typeutil.GetField(schema, info.GetFieldID())
+	if field == nil {
+		return merr.WrapErrFieldNotFound(info.GetFieldID())
+	}
+	analyzer, err := bm25.NewFieldAnalyzer(field)
+	if err != nil {
+		return err
+	}
+
+	placeholderGroup := &commonpb.PlaceholderGroup{}
+	if err := proto.Unmarshal(req.GetPlaceholderGroup(), placeholderGroup); err != nil {
+		return merr.WrapErrParameterInvalidMsg("failed to unmarshal placeholder group: %v", err)
+	}
+	queries := make([][]string, 0)
+	terms := typeutil.NewSet[string]()
+	for _, placeholder := range placeholderGroup.GetPlaceholders() {
+		for _, value := range placeholder.GetValues() {
+			queryTerms := analyzer.Analyze(string(value))
+			queries = append(queries, queryTerms)
+			terms.Insert(queryTerms...)
+		}
+	}
+
+	var mu sync.Mutex
+	stats := bm25.NewStats()
+	err = lb.Execute(ctx, CollectionWorkLoad{
+		db:             dbName,
+		collectionID:   req.GetCollectionID(),
+		collectionName: collectionName,
+		nq:             1,
+		exec: func(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
+			result, err := qn.GetStatistics(ctx, &querypb.GetStatisticsRequest{
+				Req: &internalpb.GetStatisticsRequest{
+					Base: commonpbutil.NewMsgBase(
+						commonpbutil.WithMsgType(commonpb.MsgType_GetPartitionStatistics),
+						commonpbutil.WithSourceID(paramtable.GetNodeID()),
+						commonpbutil.WithTargetID(nodeID),
+					),
+					CollectionID:       req.GetCollectionID(),
+					GuaranteeTimestamp: req.GetGuaranteeTimestamp(),
+					Bm25FieldID:        info.GetFieldID(),
+					Bm25Terms:          terms.Collect(),
+				},
+				DmlChannels: []string{channel},
+				Scope:       querypb.DataScope_All,
+			})
+			if err = merr.CheckRPCCall(result, err); err != nil {
+				log.Ctx(ctx).Warn("failed to get bm25 stats",
+					zap.Int64("nodeID", nodeID),
+					zap.String("channel", channel),
+					zap.Error(err))
+				globalMetaCache.DeprecateShardCache(dbName, collectionName)
+				return err
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			stats.Merge(bm25.NewStatsFromProto(result.GetBm25Stats()))
+			return nil
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get bm25 stats")
+	}
+
+	info.AvgDocLen = stats.AvgDocLen()
+	info.Queries = lo.Map(queries, func(queryTerms []string, _ int) *internalpb.BM25QueryWeights {
+		return &internalpb.BM25QueryWeights{Weights: stats.QueryWeights(queryTerms)}
+	})
+	return nil
+}
+
+// groupSearchHits groups the hits sorted by score by their group values. It keeps the groups
+// in [offset, offset+limit) ordered by their best hits, each group keeps at most groupSize hits.
+// The indexes of the selected hits are returned, the hits of a group are adjacent.
+func groupSearchHits(groupVals []any, offset int64, limit int64, groupSize int64) []int {
+	groups := make([]any, 0)
+	groupHits := make(map[any][]int)
+	for i, val := range groupVals {
+		hits, ok := groupHits[val]
+		if !ok && int64(len(groups)) >= offset+limit {
+			continue
+		}
+		if ok && int64(len(hits)) >= groupSize {
+			continue
+		}
+		if !ok {
+			groups = append(groups, val)
+		}
+		groupHits[val] = append(hits, i)
+	}
+
+	selected := make([]int, 0)
+	for i := offset; i < int64(len(groups)); i++ {
+		selected = append(selected, groupHits[groups[i]]...)
+	}
+	return selected
+}
@@ -16,8 +16,12 @@ import (
 type queryNodeCreatorFunc func(ctx context.Context, addr string, nodeID int64) (types.QueryNodeClient, error)
 
 type nodeInfo struct {
-	nodeID  UniqueID
-	address string
+	nodeID     UniqueID
+	address    string
+	replicaID  UniqueID
+	replicaTag string
+	exclusive  bool // the replica serves the requests with its tag only
+	labels     map[string]string
 }
 
 func (n nodeInfo) String() string {
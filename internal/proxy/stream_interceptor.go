@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+// StreamServerInterceptor returns a new stream server interceptor which fills the database,
+// checks the privilege and limits the rate of the requests received by the streaming apis,
+// the same as the unary interceptors do for the unary apis.
+func StreamServerInterceptor(limiter types.Limiter) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &interceptedServerStream{ServerStream: ss, ctx: ss.Context(), limiter: limiter})
+	}
+}
+
+type interceptedServerStream struct {
+	grpc.ServerStream
+	ctx     context.Context
+	limiter types.Limiter
+}
+
+func (s *interceptedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *interceptedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	ctx, req := fillDatabase(s.ctx, m)
+	ctx, err := PrivilegeInterceptor(ctx, req)
+	if err != nil {
+		return err
+	}
+	s.ctx = ctx
+
+	dbName, collectionIDToPartIDs, rt, n, err := getRequestInfo(req)
+	if err != nil {
+		return nil
+	}
+	err = s.limiter.Check(ctx, dbName, collectionIDToPartIDs, rt, n)
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.TotalLabel).Inc()
+	if err != nil {
+		metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.FailLabel).Inc()
+		return err
+	}
+	metrics.ProxyRateLimitReqCount.WithLabelValues(nodeID, rt.String(), metrics.SuccessLabel).Inc()
+	return nil
+}
@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type mockRecvServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req proto.Message
+}
+
+func (s *mockRecvServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockRecvServerStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestStreamServerInterceptor(t *testing.T) {
+	mockCache := NewMockCache(t)
+	mockCache.On("GetCollectionID",
+		mock.Anything, // context.Context
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+	).Return(int64(1), nil)
+	originCache := globalMetaCache
+	globalMetaCache = mockCache
+	defer func() { globalMetaCache = originCache }()
+
+	run := func(limiter *limiterMock) (*milvuspb.QueryRequest, error) {
+		interceptor := StreamServerInterceptor(limiter)
+		ss := &mockRecvServerStream{ctx: context.Background(), req: &milvuspb.QueryRequest{CollectionName: "foo"}}
+		var received *milvuspb.QueryRequest
+		err := interceptor(nil, ss, &grpc.StreamServerInfo{}, func(srv any, stream grpc.ServerStream) error {
+			received = &milvuspb.QueryRequest{}
+			return stream.RecvMsg(received)
+		})
+		return received, err
+	}
+
+	req, err := run(&limiterMock{rate: 100})
+	assert.NoError(t, err)
+	assert.Equal(t, "foo", req.GetCollectionName())
+	assert.Equal(t, "default", req.GetDbName())
+
+	_, err = run(&limiterMock{rate: 100, limit: true})
+	assert.ErrorIs(t, err, merr.ErrServiceRateLimit)
+}
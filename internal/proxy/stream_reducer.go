@@ -0,0 +1,327 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/streamrpc"
+	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// streamBatchSize returns the max size in bytes of a batch of the streaming results.
+func streamBatchSize() int64 {
+	return Params.ProxyCfg.StreamBatchSize.GetAsInt64() * 1024 * 1024
+}
+
+// queryStreamReducer reduces the results streamed by the shard leaders and sends them to the client in batches
+// as soon as they are received, so the whole result is never kept in the proxy.
+// The rows of the primary keys already sent are skipped, a shard retried on another replica won't send duplicated rows.
+// The rows of a shard are not ordered by the primary keys, so the sent ones are kept in a set,
+// the query fails once the set holds more than proxy.streamMaxDedupPKs keys.
+type queryStreamReducer struct {
+	mu        sync.Mutex
+	srv       streamrpc.QueryResultsStreamServer
+	reducer   *defaultLimitReducer
+	batchSize int64
+	maxPKs    int
+
+	outputFields []string
+	sentPKs      typeutil.Set[any]
+	sentBatches  int
+}
+
+func newQueryStreamReducer(ctx context.Context, srv streamrpc.QueryResultsStreamServer, params *queryParams, req *internalpb.RetrieveRequest,
+	schema *schemapb.CollectionSchema, collectionName string, outputFields []string,
+) *queryStreamReducer {
+	return &queryStreamReducer{
+		srv:          srv,
+		reducer:      newDefaultLimitReducer(ctx, params, req, schema, collectionName),
+		batchSize:    streamBatchSize(),
+		maxPKs:       Params.ProxyCfg.StreamMaxDedupPKs.GetAsInt(),
+		outputFields: outputFields,
+		sentPKs:      typeutil.NewSet[any](),
+	}
+}
+
+// Reduce sends the rows of the result in batches, it could be called by the shards concurrently.
+func (r *queryStreamReducer) Reduce(result *internalpb.RetrieveResults) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var (
+		batch     = make([]*schemapb.FieldData, len(result.GetFieldsData()))
+		rows      int
+		batchSize int64
+	)
+	for i := 0; i < typeutil.GetSizeOfIDs(result.GetIds()); i++ {
+		pk := typeutil.GetPK(result.GetIds(), int64(i))
+		if r.sentPKs.Contain(pk) {
+			continue
+		}
+		if r.sentPKs.Len() >= r.maxPKs {
+			return merr.WrapErrServiceQuotaExceeded(fmt.Sprintf("the streaming query returns more than %d rows", r.maxPKs),
+				"set a limit or use the query iterator")
+		}
+		r.sentPKs.Insert(pk)
+		batchSize += typeutil.AppendFieldData(batch, result.GetFieldsData(), int64(i))
+		rows++
+		if batchSize >= r.batchSize {
+			if err := r.send(batch); err != nil {
+				return err
+			}
+			batch = make([]*schemapb.FieldData, len(result.GetFieldsData()))
+			rows, batchSize = 0, 0
+		}
+	}
+	if rows > 0 {
+		return r.send(batch)
+	}
+	return nil
+}
+
+// Finish sends an empty result if no row is sent, so the client always receives the schema of the output fields.
+func (r *queryStreamReducer) Finish() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.sentBatches > 0 {
+		return nil
+	}
+	result, err := r.reducer.Reduce(nil)
+	if err != nil {
+		return err
+	}
+	return r.sendResult(result)
+}
+
+func (r *queryStreamReducer) send(fieldsData []*schemapb.FieldData) error {
+	result := &milvuspb.QueryResults{FieldsData: fieldsData}
+	if err := r.reducer.afterReduce(result); err != nil {
+		return err
+	}
+	return r.sendResult(result)
+}
+
+func (r *queryStreamReducer) sendResult(result *milvuspb.QueryResults) error {
+	result.OutputFields = r.outputFields
+	if err := r.srv.Send(result); err != nil {
+		return err
+	}
+	r.sentBatches++
+	metrics.ProxyStreamSentBytes.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Add(float64(proto.Size(result)))
+	return nil
+}
+
+// splitQueryResults splits the reduced query results into batches of at most batchSize bytes.
+func splitQueryResults(result *milvuspb.QueryResults, batchSize int64) []*milvuspb.QueryResults {
+	var rowNum int
+	if len(result.GetFieldsData()) > 0 {
+		n, err := funcutil.GetNumRowOfFieldData(result.GetFieldsData()[0])
+		if err == nil {
+			rowNum = int(n)
+		}
+	}
+	if int64(proto.Size(result)) <= batchSize || rowNum <= 1 {
+		return []*milvuspb.QueryResults{result}
+	}
+
+	newBatch := func() *milvuspb.QueryResults {
+		return &milvuspb.QueryResults{
+			Status:         result.GetStatus(),
+			FieldsData:     make([]*schemapb.FieldData, len(result.GetFieldsData())),
+			CollectionName: result.GetCollectionName(),
+			OutputFields:   result.GetOutputFields(),
+		}
+	}
+	batches := make([]*milvuspb.QueryResults, 0)
+	batch := newBatch()
+	var size int64
+	for i := 0; i < rowNum; i++ {
+		size += typeutil.AppendFieldData(batch.FieldsData, result.GetFieldsData(), int64(i))
+		if size >= batchSize || i == rowNum-1 {
+			batches = append(batches, batch)
+			batch = newBatch()
+			size = 0
+		}
+	}
+	return batches
+}
+
+// splitSearchResults splits the search results into batches of at most batchSize bytes,
+// the results of a query are never split into two batches.
+func splitSearchResults(result *milvuspb.SearchResults, batchSize int64) []*milvuspb.SearchResults {
+	data := result.GetResults()
+	if data == nil || int64(proto.Size(result)) <= batchSize || data.GetNumQueries() <= 1 {
+		return []*milvuspb.SearchResults{result}
+	}
+
+	newBatch := func() *milvuspb.SearchResults {
+		return &milvuspb.SearchResults{
+			Status: result.GetStatus(),
+			Results: &schemapb.SearchResultData{
+				TopK:           data.GetTopK(),
+				FieldsData:     typeutil.PrepareResultFieldData(data.GetFieldsData(), data.GetTopK()),
+				Ids:            &schemapb.IDs{},
+				OutputFields:   data.GetOutputFields(),
+				AllSearchCount: data.GetAllSearchCount(),
+			},
+			CollectionName: result.GetCollectionName(),
+		}
+	}
+	batches := make([]*milvuspb.SearchResults, 0)
+	batch := newBatch()
+	var (
+		size    int64
+		offset  int64
+		groupBy = make([]*schemapb.FieldData, 1)
+	)
+	for q, topk := range data.GetTopks() {
+		for i := offset; i < offset+topk; i++ {
+			pk := typeutil.GetPK(data.GetIds(), i)
+			typeutil.AppendPKs(batch.Results.Ids, pk)
+			batch.Results.Scores = append(batch.Results.Scores, data.GetScores()[i])
+			size += typeutil.AppendFieldData(batch.Results.FieldsData, data.GetFieldsData(), i)
+			if data.GetGroupByFieldValue() != nil {
+				size += typeutil.AppendFieldData(groupBy, []*schemapb.FieldData{data.GetGroupByFieldValue()}, i)
+			}
+			if strPK, ok := pk.(string); ok {
+				size += int64(len(strPK)) + 4
+			} else {
+				size += 12
+			}
+		}
+		batch.Results.Topks = append(batch.Results.Topks, topk)
+		batch.Results.NumQueries++
+		offset += topk
+
+		if size >= batchSize || q == len(data.GetTopks())-1 {
+			batch.Results.GroupByFieldValue = groupBy[0]
+			batches = append(batches, batch)
+			batch = newBatch()
+			groupBy = make([]*schemapb.FieldData, 1)
+			size = 0
+		}
+	}
+	return batches
+}
+
+// streamSearchResults reduces the search results by groups of queries and sends each group to the client
+// as soon as it is reduced and requeried, so the reduced results of all queries are never kept at the same time.
+// A group holds the queries whose hits before reducing are about proxy.streamBatchSize bytes.
+func (t *searchTask) streamSearchResults(ctx context.Context, results []*schemapb.SearchResultData, nq, topk int64, metricType string, pkType schemapb.DataType) error {
+	batchSize := streamBatchSize()
+	// offsets[i][q] is the offset of the first hit of query q in results[i]
+	offsets := make([][]int64, len(results))
+	rowSizes := make([]float64, len(results))
+	for i, result := range results {
+		offsets[i] = make([]int64, nq+1)
+		for q := int64(0); q < nq && q < int64(len(result.GetTopks())); q++ {
+			offsets[i][q+1] = offsets[i][q] + result.GetTopks()[q]
+		}
+		if rows := offsets[i][nq]; rows > 0 {
+			rowSizes[i] = float64(proto.Size(result)) / float64(rows)
+		}
+	}
+
+	// the info of partial results is known before reducing, every batch carries it
+	status := setPartialResults(ctx, merr.Success(), t.partial)
+	nodeID := strconv.FormatInt(paramtable.GetNodeID(), 10)
+	for start := int64(0); start < nq; {
+		end, size := start, float64(0)
+		for end < nq && size < float64(batchSize) {
+			for i := range results {
+				size += rowSizes[i] * float64(offsets[i][end+1]-offsets[i][end])
+			}
+			end++
+		}
+
+		group := make([]*schemapb.SearchResultData, 0, len(results))
+		for i, result := range results {
+			group = append(group, sliceSearchResultData(result, offsets[i], start, end))
+		}
+		var err error
+		if groupSize := t.SearchRequest.GetGroupSize(); groupSize > 0 {
+			t.result, err = reduceSearchResultDataWithGroupBy(ctx, group, end-start, topk, groupSize, metricType, pkType, t.offset)
+		} else {
+			t.result, err = reduceSearchResultData(ctx, group, end-start, topk, metricType, pkType, t.offset)
+		}
+		if err != nil {
+			return err
+		}
+		t.result.Status = status
+		t.result.CollectionName = t.collectionName
+		t.fillInFieldInfo()
+		if t.requery {
+			if err := t.Requery(); err != nil {
+				return err
+			}
+		}
+		t.result.Results.OutputFields = t.userOutputFields
+
+		for _, batch := range splitSearchResults(t.result, batchSize) {
+			if err := t.stream.Send(batch); err != nil {
+				return err
+			}
+			t.streamed = true
+			metrics.ProxyStreamSentBytes.WithLabelValues(nodeID, metrics.SearchLabel).Add(float64(proto.Size(batch)))
+		}
+		start = end
+	}
+	t.result = &milvuspb.SearchResults{
+		Status:         status,
+		CollectionName: t.collectionName,
+	}
+	return nil
+}
+
+// sliceSearchResultData returns the hits of the queries in [start, end) of the search result data,
+// offsets are the offsets of the first hit of each query.
+func sliceSearchResultData(data *schemapb.SearchResultData, offsets []int64, start, end int64) *schemapb.SearchResultData {
+	ret := &schemapb.SearchResultData{
+		NumQueries: end - start,
+		TopK:       data.GetTopK(),
+		FieldsData: typeutil.PrepareResultFieldData(data.GetFieldsData(), offsets[end]-offsets[start]),
+		Ids:        &schemapb.IDs{},
+		Scores:     data.GetScores()[offsets[start]:offsets[end]],
+		Topks:      data.GetTopks()[start:end],
+	}
+	if start == 0 {
+		ret.AllSearchCount = data.GetAllSearchCount()
+	}
+	groupBy := make([]*schemapb.FieldData, 1)
+	for i := offsets[start]; i < offsets[end]; i++ {
+		typeutil.AppendPKs(ret.Ids, typeutil.GetPK(data.GetIds(), i))
+		typeutil.AppendFieldData(ret.FieldsData, data.GetFieldsData(), i)
+		if data.GetGroupByFieldValue() != nil {
+			typeutil.AppendFieldData(groupBy, []*schemapb.FieldData{data.GetGroupByFieldValue()}, i)
+		}
+	}
+	ret.GroupByFieldValue = groupBy[0]
+	return ret
+}
@@ -0,0 +1,215 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+)
+
+type mockQueryResultsStream struct {
+	results []*milvuspb.QueryResults
+	err     error
+}
+
+func (s *mockQueryResultsStream) Send(result *milvuspb.QueryResults) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.results = append(s.results, result)
+	return nil
+}
+
+func (s *mockQueryResultsStream) Context() context.Context {
+	return context.Background()
+}
+
+func TestQueryStreamReducer(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "value", DataType: schemapb.DataType_Float},
+		},
+	}
+	genResult := func(pks []int64, values []float32) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Ids: &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
+			FieldsData: []*schemapb.FieldData{
+				getFieldData("", 100, schemapb.DataType_Int64, pks, 0),
+				getFieldData("", common.TimeStampField, schemapb.DataType_Int64, make([]int64, len(pks)), 0),
+				getFieldData("", 101, schemapb.DataType_Float, values, 0),
+			},
+		}
+	}
+	req := &internalpb.RetrieveRequest{OutputFieldsId: []int64{100, common.TimeStampField, 101}}
+
+	t.Run("reduce", func(t *testing.T) {
+		srv := &mockQueryResultsStream{}
+		reducer := newQueryStreamReducer(context.Background(), srv, &queryParams{}, req, schema, "foo", []string{"pk", "value"})
+		// 20 bytes per row including the timestamp, a batch holds at most two rows
+		reducer.batchSize = 30
+
+		assert.NoError(t, reducer.Reduce(genResult([]int64{1, 2, 3}, []float32{0.1, 0.2, 0.3})))
+		// pk 3 is already sent
+		assert.NoError(t, reducer.Reduce(genResult([]int64{3, 4}, []float32{0.3, 0.4})))
+		assert.NoError(t, reducer.Reduce(genResult([]int64{}, []float32{})))
+		assert.NoError(t, reducer.Finish())
+
+		assert.Len(t, srv.results, 3)
+		pks := make([]int64, 0)
+		for _, result := range srv.results {
+			assert.True(t, merr.Ok(result.GetStatus()))
+			assert.Equal(t, "foo", result.GetCollectionName())
+			assert.Equal(t, []string{"pk", "value"}, result.GetOutputFields())
+			assert.Len(t, result.GetFieldsData(), 2)
+			assert.Equal(t, "pk", result.GetFieldsData()[0].GetFieldName())
+			assert.Equal(t, "value", result.GetFieldsData()[1].GetFieldName())
+			pks = append(pks, result.GetFieldsData()[0].GetScalars().GetLongData().GetData()...)
+		}
+		assert.Equal(t, []int64{1, 2, 3, 4}, pks)
+		assert.Equal(t, []float32{0.1, 0.2}, srv.results[0].GetFieldsData()[1].GetScalars().GetFloatData().GetData())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		srv := &mockQueryResultsStream{}
+		reducer := newQueryStreamReducer(context.Background(), srv, &queryParams{}, &internalpb.RetrieveRequest{OutputFieldsId: []int64{100, 101}}, schema, "foo", []string{"pk", "value"})
+		assert.NoError(t, reducer.Reduce(genResult([]int64{}, []float32{})))
+		assert.NoError(t, reducer.Finish())
+		assert.Len(t, srv.results, 1)
+		assert.True(t, merr.Ok(srv.results[0].GetStatus()))
+		assert.Len(t, srv.results[0].GetFieldsData(), 2)
+	})
+
+	t.Run("too many rows", func(t *testing.T) {
+		srv := &mockQueryResultsStream{}
+		reducer := newQueryStreamReducer(context.Background(), srv, &queryParams{}, req, schema, "foo", []string{"pk", "value"})
+		reducer.maxPKs = 3
+
+		assert.NoError(t, reducer.Reduce(genResult([]int64{1, 2}, []float32{0.1, 0.2})))
+		// the duplicated pk 2 doesn't count
+		assert.NoError(t, reducer.Reduce(genResult([]int64{2, 3}, []float32{0.2, 0.3})))
+		err := reducer.Reduce(genResult([]int64{4}, []float32{0.4}))
+		assert.ErrorIs(t, err, merr.ErrServiceQuotaExceeded)
+	})
+
+	t.Run("send failed", func(t *testing.T) {
+		srv := &mockQueryResultsStream{err: errors.New("mock")}
+		reducer := newQueryStreamReducer(context.Background(), srv, &queryParams{}, req, schema, "foo", []string{"pk", "value"})
+		assert.Error(t, reducer.Reduce(genResult([]int64{1}, []float32{0.1})))
+	})
+}
+
+func TestSplitQueryResults(t *testing.T) {
+	result := &milvuspb.QueryResults{
+		Status:         merr.Success(),
+		CollectionName: "foo",
+		OutputFields:   []string{"pk"},
+		FieldsData:     []*schemapb.FieldData{getFieldData("pk", 100, schemapb.DataType_Int64, []int64{1, 2, 3, 4, 5}, 0)},
+	}
+	assert.Len(t, splitQueryResults(result, 1024), 1)
+
+	batches := splitQueryResults(result, 16)
+	assert.Len(t, batches, 3)
+	pks := make([]int64, 0)
+	for _, batch := range batches {
+		assert.Equal(t, "foo", batch.GetCollectionName())
+		assert.Equal(t, []string{"pk"}, batch.GetOutputFields())
+		assert.Equal(t, "pk", batch.GetFieldsData()[0].GetFieldName())
+		pks = append(pks, batch.GetFieldsData()[0].GetScalars().GetLongData().GetData()...)
+	}
+	assert.Equal(t, []int64{1, 2, 3, 4, 5}, pks)
+
+	failed := &milvuspb.QueryResults{Status: merr.Status(merr.ErrServiceRateLimit)}
+	assert.Equal(t, []*milvuspb.QueryResults{failed}, splitQueryResults(failed, 16))
+}
+
+func TestSplitSearchResults(t *testing.T) {
+	result := &milvuspb.SearchResults{
+		Status:         merr.Success(),
+		CollectionName: "foo",
+		Results: &schemapb.SearchResultData{
+			NumQueries:   3,
+			TopK:         2,
+			Topks:        []int64{2, 1, 2},
+			Scores:       []float32{0.9, 0.8, 0.7, 0.6, 0.5},
+			Ids:          &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5}}}},
+			FieldsData:   []*schemapb.FieldData{getFieldData("value", 101, schemapb.DataType_Int64, []int64{10, 20, 30, 40, 50}, 0)},
+			OutputFields: []string{"value"},
+		},
+	}
+	assert.Len(t, splitSearchResults(result, 1024), 1)
+
+	// the first two queries fill a batch
+	batches := splitSearchResults(result, 50)
+	assert.Len(t, batches, 2)
+	assert.EqualValues(t, 2, batches[0].GetResults().GetNumQueries())
+	assert.Equal(t, []int64{2, 1}, batches[0].GetResults().GetTopks())
+	assert.Equal(t, []int64{1, 2, 3}, batches[0].GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.9, 0.8, 0.7}, batches[0].GetResults().GetScores())
+	assert.Equal(t, []int64{10, 20, 30}, batches[0].GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.EqualValues(t, 1, batches[1].GetResults().GetNumQueries())
+	assert.Equal(t, []int64{2}, batches[1].GetResults().GetTopks())
+	assert.Equal(t, []int64{4, 5}, batches[1].GetResults().GetIds().GetIntId().GetData())
+	assert.Equal(t, []int64{40, 50}, batches[1].GetResults().GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	for _, batch := range batches {
+		assert.Equal(t, "foo", batch.GetCollectionName())
+		assert.EqualValues(t, 2, batch.GetResults().GetTopK())
+		assert.Equal(t, []string{"value"}, batch.GetResults().GetOutputFields())
+		assert.Equal(t, "value", batch.GetResults().GetFieldsData()[0].GetFieldName())
+	}
+}
+
+func TestSliceSearchResultData(t *testing.T) {
+	data := &schemapb.SearchResultData{
+		NumQueries:     3,
+		TopK:           2,
+		Topks:          []int64{2, 1, 2},
+		Scores:         []float32{0.9, 0.8, 0.7, 0.6, 0.5},
+		Ids:            &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3, 4, 5}}}},
+		FieldsData:     []*schemapb.FieldData{getFieldData("value", 101, schemapb.DataType_Int64, []int64{10, 20, 30, 40, 50}, 0)},
+		AllSearchCount: 10,
+	}
+	offsets := []int64{0, 2, 3, 5}
+
+	head := sliceSearchResultData(data, offsets, 0, 2)
+	assert.EqualValues(t, 2, head.GetNumQueries())
+	assert.EqualValues(t, 2, head.GetTopK())
+	assert.EqualValues(t, 10, head.GetAllSearchCount())
+	assert.Equal(t, []int64{2, 1}, head.GetTopks())
+	assert.Equal(t, []int64{1, 2, 3}, head.GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.9, 0.8, 0.7}, head.GetScores())
+	assert.Equal(t, []int64{10, 20, 30}, head.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+	assert.Nil(t, head.GetGroupByFieldValue())
+
+	// the search count is only kept by the first group
+	tail := sliceSearchResultData(data, offsets, 2, 3)
+	assert.EqualValues(t, 1, tail.GetNumQueries())
+	assert.EqualValues(t, 0, tail.GetAllSearchCount())
+	assert.Equal(t, []int64{2}, tail.GetTopks())
+	assert.Equal(t, []int64{4, 5}, tail.GetIds().GetIntId().GetData())
+	assert.Equal(t, []float32{0.6, 0.5}, tail.GetScores())
+	assert.Equal(t, []int64{40, 50}, tail.GetFieldsData()[0].GetScalars().GetLongData().GetData())
+}
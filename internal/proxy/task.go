@@ -23,6 +23,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/golang/protobuf/proto"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
@@ -42,17 +43,22 @@ import (
 )
 
 const (
-	IgnoreGrowingKey     = "ignore_growing"
-	ReduceStopForBestKey = "reduce_stop_for_best"
-	GroupByFieldKey      = "group_by_field"
-	AnnsFieldKey         = "anns_field"
-	TopKKey              = "topk"
-	NQKey                = "nq"
-	MetricTypeKey        = common.MetricTypeKey
-	SearchParamsKey      = "params"
-	RoundDecimalKey      = "round_decimal"
-	OffsetKey            = "offset"
-	LimitKey             = "limit"
+	IgnoreGrowingKey       = "ignore_growing"
+	ReduceStopForBestKey   = "reduce_stop_for_best"
+	GroupByFieldKey        = "group_by_field"
+	GroupSizeKey           = "group_size"
+	GroupStrictSizeKey     = "group_strict_size"
+	AnnsFieldKey           = "anns_field"
+	TopKKey                = "topk"
+	NQKey                  = "nq"
+	MetricTypeKey          = common.MetricTypeKey
+	SearchParamsKey        = "params"
+	RoundDecimalKey        = "round_decimal"
+	OffsetKey              = "offset"
+	LimitKey               = "limit"
+	ReplicaIDKey           = "replica_id"
+	ReplicaTagKey          = "replica_tag"
+	AllowPartialResultsKey = "allow_partial_results"
 
 	InsertTaskName                = "InsertTask"
 	CreateCollectionTaskName      = "CreateCollectionTask"
@@ -79,14 +85,17 @@ const (
 	UpsertTaskName                = "UpsertTask"
 	CreateResourceGroupTaskName   = "CreateResourceGroupTask"
 	DropResourceGroupTaskName     = "DropResourceGroupTask"
+	UpdateResourceGroupsTaskName  = "UpdateResourceGroupsTask"
 	TransferNodeTaskName          = "TransferNodeTask"
 	TransferReplicaTaskName       = "TransferReplicaTask"
 	ListResourceGroupsTaskName    = "ListResourceGroupsTask"
 	DescribeResourceGroupTaskName = "DescribeResourceGroupTask"
 
-	CreateDatabaseTaskName = "CreateCollectionTask"
-	DropDatabaseTaskName   = "DropDatabaseTaskName"
-	ListDatabaseTaskName   = "ListDatabaseTaskName"
+	CreateDatabaseTaskName   = "CreateCollectionTask"
+	DropDatabaseTaskName     = "DropDatabaseTaskName"
+	ListDatabaseTaskName     = "ListDatabaseTaskName"
+	AlterDatabaseTaskName    = "AlterDatabaseTaskName"
+	DescribeDatabaseTaskName = "DescribeDatabaseTaskName"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
@@ -95,6 +104,7 @@ const (
 	RankParamsKey    = "params"
 	RRFParamsKey     = "k"
 	WeightsParamsKey = "weights"
+	ExprParamsKey    = "expr"
 )
 
 type task interface {
@@ -344,6 +354,10 @@ func (t *createCollectionTask) PreExecute(ctx context.Context) error {
 				return err
 			}
 		}
+		// valid analyzer params if full-text search enabled
+		if err = validateAnalyzer(t.schema.Name, field); err != nil {
+			return err
+		}
 	}
 
 	if err := validateMultipleVectorFields(t.schema); err != nil {
@@ -1504,11 +1518,7 @@ func (t *loadCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	// To compat with LoadCollcetion before Milvus@2.1
-	if t.ReplicaNumber == 0 {
-		t.ReplicaNumber = 1
-	}
-
+	// the replica number is left 0 if not specified, QueryCoord applies the database default or 1
 	return nil
 }
 
@@ -2080,6 +2090,71 @@ func (t *DropResourceGroupTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+type UpdateResourceGroupsTask struct {
+	baseTask
+	Condition
+	*milvuspb.UpdateResourceGroupsRequest
+	ctx        context.Context
+	queryCoord types.QueryCoordClient
+	result     *commonpb.Status
+}
+
+func (t *UpdateResourceGroupsTask) TraceCtx() context.Context {
+	return t.ctx
+}
+
+func (t *UpdateResourceGroupsTask) ID() UniqueID {
+	return t.Base.MsgID
+}
+
+func (t *UpdateResourceGroupsTask) SetID(uid UniqueID) {
+	t.Base.MsgID = uid
+}
+
+func (t *UpdateResourceGroupsTask) Name() string {
+	return UpdateResourceGroupsTaskName
+}
+
+func (t *UpdateResourceGroupsTask) Type() commonpb.MsgType {
+	return t.Base.MsgType
+}
+
+func (t *UpdateResourceGroupsTask) BeginTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *UpdateResourceGroupsTask) EndTs() Timestamp {
+	return t.Base.Timestamp
+}
+
+func (t *UpdateResourceGroupsTask) SetTs(ts Timestamp) {
+	t.Base.Timestamp = ts
+}
+
+func (t *UpdateResourceGroupsTask) OnEnqueue() error {
+	if t.Base == nil {
+		t.Base = commonpbutil.NewMsgBase()
+	}
+	return nil
+}
+
+func (t *UpdateResourceGroupsTask) PreExecute(ctx context.Context) error {
+	t.Base.MsgType = commonpb.MsgType_UpdateResourceGroups
+	t.Base.SourceID = paramtable.GetNodeID()
+
+	return nil
+}
+
+func (t *UpdateResourceGroupsTask) Execute(ctx context.Context) error {
+	var err error
+	t.result, err = t.queryCoord.UpdateResourceGroups(ctx, t.UpdateResourceGroupsRequest)
+	return err
+}
+
+func (t *UpdateResourceGroupsTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
 type DescribeResourceGroupTask struct {
 	baseTask
 	Condition
@@ -2187,6 +2262,10 @@ func (t *DescribeResourceGroupTask) Execute(ctx context.Context) error {
 				NumLoadedReplica: numLoadedReplica,
 				NumOutgoingNode:  numOutgoingNode,
 				NumIncomingNode:  numIncomingNode,
+				Config:           rgInfo.GetConfig(),
+				Nodes: lo.Map(rgInfo.GetNodes(), func(nodeID int64, _ int) *commonpb.NodeInfo {
+					return &commonpb.NodeInfo{NodeID: nodeID}
+				}),
 			},
 		}
 	} else {
@@ -2,12 +2,17 @@ package proxy
 
 import (
 	"context"
+	"strconv"
+	"strings"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -212,3 +217,163 @@ func (ldt *listDatabaseTask) Execute(ctx context.Context) error {
 func (ldt *listDatabaseTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+type alterDatabaseTask struct {
+	baseTask
+	Condition
+	*internalpb.AlterDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *commonpb.Status
+}
+
+func (adt *alterDatabaseTask) TraceCtx() context.Context {
+	return adt.ctx
+}
+
+func (adt *alterDatabaseTask) ID() UniqueID {
+	return adt.Base.MsgID
+}
+
+func (adt *alterDatabaseTask) SetID(uid UniqueID) {
+	adt.Base.MsgID = uid
+}
+
+func (adt *alterDatabaseTask) Name() string {
+	return AlterDatabaseTaskName
+}
+
+func (adt *alterDatabaseTask) Type() commonpb.MsgType {
+	return adt.Base.MsgType
+}
+
+func (adt *alterDatabaseTask) BeginTs() Timestamp {
+	return adt.Base.Timestamp
+}
+
+func (adt *alterDatabaseTask) EndTs() Timestamp {
+	return adt.Base.Timestamp
+}
+
+func (adt *alterDatabaseTask) SetTs(ts Timestamp) {
+	adt.Base.Timestamp = ts
+}
+
+func (adt *alterDatabaseTask) OnEnqueue() error {
+	if adt.Base == nil {
+		adt.Base = commonpbutil.NewMsgBase()
+	}
+	adt.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+func (adt *alterDatabaseTask) PreExecute(ctx context.Context) error {
+	if err := ValidateDatabaseName(adt.GetDbName()); err != nil {
+		return err
+	}
+	return validateDatabaseProperties(adt.GetProperties())
+}
+
+func (adt *alterDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	adt.result, err = adt.rootCoord.AlterDatabase(ctx, adt.AlterDatabaseRequest)
+	return err
+}
+
+func (adt *alterDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+type describeDatabaseTask struct {
+	baseTask
+	Condition
+	*internalpb.DescribeDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoordClient
+	result    *internalpb.DescribeDatabaseResponse
+}
+
+func (ddt *describeDatabaseTask) TraceCtx() context.Context {
+	return ddt.ctx
+}
+
+func (ddt *describeDatabaseTask) ID() UniqueID {
+	return ddt.Base.MsgID
+}
+
+func (ddt *describeDatabaseTask) SetID(uid UniqueID) {
+	ddt.Base.MsgID = uid
+}
+
+func (ddt *describeDatabaseTask) Name() string {
+	return DescribeDatabaseTaskName
+}
+
+func (ddt *describeDatabaseTask) Type() commonpb.MsgType {
+	return ddt.Base.MsgType
+}
+
+func (ddt *describeDatabaseTask) BeginTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *describeDatabaseTask) EndTs() Timestamp {
+	return ddt.Base.Timestamp
+}
+
+func (ddt *describeDatabaseTask) SetTs(ts Timestamp) {
+	ddt.Base.Timestamp = ts
+}
+
+func (ddt *describeDatabaseTask) OnEnqueue() error {
+	if ddt.Base == nil {
+		ddt.Base = commonpbutil.NewMsgBase()
+	}
+	ddt.Base.SourceID = paramtable.GetNodeID()
+	return nil
+}
+
+func (ddt *describeDatabaseTask) PreExecute(ctx context.Context) error {
+	return ValidateDatabaseName(ddt.GetDbName())
+}
+
+func (ddt *describeDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	ddt.result, err = ddt.rootCoord.DescribeDatabase(ctx, ddt.DescribeDatabaseRequest)
+	return err
+}
+
+func (ddt *describeDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// validateDatabaseProperties checks the values of the known database properties,
+// an empty value means the property is going to be removed.
+func validateDatabaseProperties(props []*commonpb.KeyValuePair) error {
+	for _, prop := range props {
+		if prop.GetValue() == "" {
+			continue
+		}
+		switch prop.GetKey() {
+		case common.DatabaseReplicaNumber:
+			num, err := strconv.ParseInt(prop.GetValue(), 10, 64)
+			if err != nil || num <= 0 {
+				return merr.WrapErrParameterInvalidMsg("invalid database replica number: %s", prop.GetValue())
+			}
+		case common.DatabaseResourceGroups:
+			for _, rg := range strings.Split(prop.GetValue(), ",") {
+				if strings.TrimSpace(rg) == "" {
+					return merr.WrapErrParameterInvalidMsg("invalid database resource groups: %s", prop.GetValue())
+				}
+			}
+		case common.DatabaseInsertRateMaxKey, common.DatabaseUpsertRateMaxKey,
+			common.DatabaseDeleteRateMaxKey, common.DatabaseBulkLoadRateMaxKey,
+			common.DatabaseQueryRateMaxKey, common.DatabaseSearchRateMaxKey,
+			common.DatabaseDiskQuotaKey:
+			if _, err := strconv.ParseFloat(prop.GetValue(), 64); err != nil {
+				return merr.WrapErrParameterInvalidMsg("invalid value of database property %s: %s", prop.GetKey(), prop.GetValue())
+			}
+		}
+	}
+	return nil
+}
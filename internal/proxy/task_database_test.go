@@ -9,6 +9,9 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
 
@@ -151,3 +154,120 @@ func TestListDatabaseTask(t *testing.T) {
 		assert.Equal(t, UniqueID(0), task.ID())
 	})
 }
+
+func TestAlterDatabaseTask(t *testing.T) {
+	paramtable.Init()
+	rc := NewRootCoordMock()
+	defer rc.Close()
+
+	ctx := context.Background()
+	task := &alterDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		AlterDatabaseRequest: &internalpb.AlterDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgID:     100,
+				Timestamp: 100,
+			},
+			DbName: "db",
+			Properties: []*commonpb.KeyValuePair{
+				{Key: common.DatabaseReplicaNumber, Value: "2"},
+				{Key: common.DatabaseResourceGroups, Value: "rg1,rg2"},
+				{Key: common.DatabaseInsertRateMaxKey, Value: "10"},
+			},
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		err := task.PreExecute(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, AlterDatabaseTaskName, task.Name())
+		assert.Equal(t, UniqueID(100), task.ID())
+		assert.Equal(t, Timestamp(100), task.BeginTs())
+		assert.Equal(t, Timestamp(100), task.EndTs())
+		err = task.Execute(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, task.result)
+
+		task.Base = nil
+		err = task.OnEnqueue()
+		assert.NoError(t, err)
+		assert.Equal(t, paramtable.GetNodeID(), task.GetBase().GetSourceID())
+		assert.Equal(t, UniqueID(0), task.ID())
+	})
+
+	t.Run("pre execute fail", func(t *testing.T) {
+		task.DbName = "#0xc0de"
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid properties", func(t *testing.T) {
+		task.DbName = "db"
+		invalids := []*commonpb.KeyValuePair{
+			{Key: common.DatabaseReplicaNumber, Value: "0"},
+			{Key: common.DatabaseReplicaNumber, Value: "abc"},
+			{Key: common.DatabaseResourceGroups, Value: "rg1,,rg2"},
+			{Key: common.DatabaseQueryRateMaxKey, Value: "abc"},
+		}
+		for _, prop := range invalids {
+			task.Properties = []*commonpb.KeyValuePair{prop}
+			err := task.PreExecute(ctx)
+			assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		}
+
+		// empty value means removing the property
+		task.Properties = []*commonpb.KeyValuePair{{Key: common.DatabaseReplicaNumber, Value: ""}}
+		err := task.PreExecute(ctx)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDescribeDatabaseTask(t *testing.T) {
+	paramtable.Init()
+	rc := NewRootCoordMock()
+	defer rc.Close()
+
+	ctx := context.Background()
+	task := &describeDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		DescribeDatabaseRequest: &internalpb.DescribeDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgID:     100,
+				Timestamp: 100,
+			},
+			DbName: "db",
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+	}
+
+	t.Run("ok", func(t *testing.T) {
+		err := task.PreExecute(ctx)
+		assert.NoError(t, err)
+
+		assert.Equal(t, DescribeDatabaseTaskName, task.Name())
+		assert.Equal(t, UniqueID(100), task.ID())
+		assert.Equal(t, Timestamp(100), task.BeginTs())
+		assert.Equal(t, Timestamp(100), task.EndTs())
+		err = task.Execute(ctx)
+		assert.NoError(t, err)
+		assert.NotNil(t, task.result)
+
+		task.Base = nil
+		err = task.OnEnqueue()
+		assert.NoError(t, err)
+		assert.Equal(t, paramtable.GetNodeID(), task.GetBase().GetSourceID())
+		assert.Equal(t, UniqueID(0), task.ID())
+	})
+
+	t.Run("pre execute fail", func(t *testing.T) {
+		task.DbName = "#0xc0de"
+		err := task.PreExecute(ctx)
+		assert.Error(t, err)
+	})
+}
@@ -182,6 +182,7 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 	if err != nil {
 		return err
 	}
+	globalQueryCache.NotifyDml(dt.collectionID, dt.EndTs())
 	dt.count += numRows
 	return nil
 }
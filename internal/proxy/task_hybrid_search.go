@@ -8,6 +8,7 @@ import (
 	"strconv"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.opentelemetry.io/otel"
 	"go.uber.org/zap"
 
@@ -59,6 +60,10 @@ type hybridSearchTask struct {
 	reScorers       []reScorer
 	queryChannelsTs map[string]Timestamp
 	rankParams      *rankParams
+	rerank          *rerankParams
+	replicaID       int64           // the replica pinned by the request, 0 if not pinned
+	replicaTag      string          // only the replicas with the tag serve the request if not empty
+	partial         *partialResults // nil if partial results are not allowed
 }
 
 func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
@@ -131,6 +136,26 @@ func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
 	log.Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
+	t.rerank, err = parseRerankParams(t.request.GetRankParams())
+	if err != nil {
+		log.Warn("invalid rerank params", zap.Error(err))
+		return err
+	}
+	if t.rerank != nil {
+		groupParams := append([][]*commonpb.KeyValuePair{t.request.GetRankParams()},
+			lo.Map(t.request.GetRequests(), func(req *milvuspb.SearchRequest, _ int) []*commonpb.KeyValuePair {
+				return req.GetSearchParams()
+			})...)
+		if err := t.rerank.checkNoGroupBy(groupParams...); err != nil {
+			log.Warn("invalid rerank params", zap.Error(err))
+			return err
+		}
+		if err := t.rerank.resolveFields(t.schema, t.request.GetOutputFields()); err != nil {
+			log.Warn("invalid rerank fields", zap.Error(err))
+			return err
+		}
+	}
+
 	if len(t.request.OutputFields) > 0 {
 		t.requery = true
 	}
@@ -158,11 +183,34 @@ func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
 		}
 	}
 
+	allowPartial, err := parseAllowPartialResults(t.request.GetRankParams(), collectionInfo)
+	if err != nil {
+		log.Warn("invalid allow partial results", zap.Error(err))
+		return err
+	}
+	if allowPartial {
+		t.partial = &partialResults{}
+	}
+
+	t.replicaID, err = parseReplicaID(t.request.GetRankParams())
+	if err != nil {
+		log.Warn("invalid replica id", zap.Error(err))
+		return err
+	}
+	t.replicaTag = parseReplicaTag(t.request.GetRankParams())
+
 	t.reScorers, err = NewReScorer(t.request.GetRequests(), t.request.GetRankParams())
 	if err != nil {
 		log.Info("generate reScorer failed", zap.Any("rank params", t.request.GetRankParams()), zap.Error(err))
 		return err
 	}
+	ranker := getExprRanker(t.reScorers)
+	if ranker != nil {
+		if err := ranker.validate(t.schema); err != nil {
+			log.Info("invalid rank expression", zap.Error(err))
+			return err
+		}
+	}
 
 	t.searchTasks = make([]*searchTask, len(t.request.GetRequests()))
 	for index := range t.request.Requests {
@@ -180,6 +228,14 @@ func (t *hybridSearchTask) PreExecute(ctx context.Context) error {
 		searchReq.GuaranteeTimestamp = guaranteeTs
 		searchReq.UseDefaultConsistency = useDefaultConsistency
 		searchReq.OutputFields = nil
+		if err := applyGroupSearchParams(t.request.GetRankParams(), searchReq); err != nil {
+			log.Info("invalid group by params", zap.Error(err))
+			return err
+		}
+		if ranker != nil {
+			// the scalar fields used by the rank expression are returned along with the ann search results
+			searchReq.OutputFields = ranker.fields
+		}
 
 		t.searchTasks[index] = &searchTask{
 			ctx:            ctx,
@@ -257,6 +313,9 @@ func (t *hybridSearchTask) hybridSearchShard(ctx context.Context, nodeID int64,
 			zap.String("reason", result.GetStatus().GetReason()))
 		return errors.Wrapf(merr.Error(result.GetStatus()), "fail to hybrid search on QueryNode %d", nodeID)
 	}
+	if !commitHedgedResult(ctx) {
+		return nil
+	}
 	t.resultBuf.Insert(result)
 	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
 
@@ -272,6 +331,12 @@ func (t *hybridSearchTask) Execute(ctx context.Context) error {
 	defer tr.CtxElapse(ctx, "done")
 
 	for _, searchTask := range t.searchTasks {
+		if searchTask.SearchRequest.GetBm25Info() != nil {
+			if err := fillBM25SearchInfo(ctx, t.lb, t.schema.CollectionSchema, t.request.GetDbName(), t.request.GetCollectionName(), searchTask.SearchRequest); err != nil {
+				log.Warn("failed to fill full-text search info", zap.Error(err))
+				return err
+			}
+		}
 		t.HybridSearchRequest.Reqs = append(t.HybridSearchRequest.Reqs, searchTask.SearchRequest)
 	}
 
@@ -282,6 +347,10 @@ func (t *hybridSearchTask) Execute(ctx context.Context) error {
 		collectionName: t.request.GetCollectionName(),
 		nq:             1,
 		exec:           t.hybridSearchShard,
+		replicaID:      t.replicaID,
+		replicaTag:     t.replicaTag,
+		hedgeable:      true,
+		partial:        t.partial,
 	})
 	if err != nil {
 		log.Warn("hybrid search execute failed", zap.Error(err))
@@ -296,6 +365,9 @@ type rankParams struct {
 	limit        int64
 	offset       int64
 	roundDecimal int64
+	// groupSize is the max number of hits in each group if grouping by field, 0 if not grouping,
+	// limit and offset are the number of groups then.
+	groupSize int64
 }
 
 // parseRankParams get limit and offset from rankParams, both are optional.
@@ -343,13 +415,48 @@ func parseRankParams(rankParamsPair []*commonpb.KeyValuePair) (*rankParams, erro
 		return nil, fmt.Errorf("%s [%s] is invalid, should be -1 or an integer in range [0, 6]", RoundDecimalKey, roundDecimalStr)
 	}
 
+	groupSize, _, err := parseGroupSize(rankParamsPair)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, rankParamsPair); err == nil && groupSize == 0 {
+		groupSize = 1
+	}
+
 	return &rankParams{
 		limit:        limit,
 		offset:       offset,
 		roundDecimal: roundDecimal,
+		groupSize:    groupSize,
 	}, nil
 }
 
+// groupSearchParamKeys are the search params of grouping by field, which are set in the rank params
+// of hybrid search and applied to all the ann searches.
+var groupSearchParamKeys = []string{GroupByFieldKey, GroupSizeKey, GroupStrictSizeKey}
+
+// applyGroupSearchParams copies the group by params of hybrid search to an ann search.
+func applyGroupSearchParams(rankParamsPair []*commonpb.KeyValuePair, searchReq *milvuspb.SearchRequest) error {
+	groupByField, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, rankParamsPair)
+	if err != nil {
+		return nil
+	}
+	if legGroupByField, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupByFieldKey, searchReq.GetSearchParams()); err == nil && legGroupByField != groupByField {
+		return merr.WrapErrParameterInvalidMsg("inconsistent %s in hybrid search request, expect %s, actual %s",
+			GroupByFieldKey, groupByField, legGroupByField)
+	}
+	searchParams := lo.Filter(searchReq.GetSearchParams(), func(kv *commonpb.KeyValuePair, _ int) bool {
+		return !lo.Contains(groupSearchParamKeys, kv.GetKey())
+	})
+	for _, kv := range rankParamsPair {
+		if lo.Contains(groupSearchParamKeys, kv.GetKey()) {
+			searchParams = append(searchParams, &commonpb.KeyValuePair{Key: kv.GetKey(), Value: kv.GetValue()})
+		}
+	}
+	searchReq.SearchParams = searchParams
+	return nil
+}
+
 func (t *hybridSearchTask) collectHybridSearchResults(ctx context.Context) error {
 	select {
 	case <-t.TraceCtx().Done():
@@ -357,9 +464,11 @@ func (t *hybridSearchTask) collectHybridSearchResults(ctx context.Context) error
 		return fmt.Errorf("hybrid search task wait to finish timeout, msgID=%d", t.ID())
 	default:
 		log.Ctx(ctx).Debug("all hybrid searches are finished or canceled")
+		metricTypes := make([]string, len(t.searchTasks))
 		t.resultBuf.Range(func(res *querypb.HybridSearchResult) bool {
 			for index, searchResult := range res.GetResults() {
 				t.searchTasks[index].resultBuf.Insert(searchResult)
+				metricTypes[index] = searchResult.GetMetricType()
 			}
 			log.Ctx(ctx).Debug("proxy receives one hybrid search result",
 				zap.Int64("sourceID", res.GetBase().GetSourceID()))
@@ -372,6 +481,9 @@ func (t *hybridSearchTask) collectHybridSearchResults(ctx context.Context) error
 			if err != nil {
 				return err
 			}
+			if scorer, ok := t.reScorers[i].(*exprScorer); ok {
+				scorer.metricType = metricTypes[i]
+			}
 			t.reScorers[i].reScore(searchTask.result)
 			t.multipleRecallResults.Insert(searchTask.result)
 		}
@@ -415,12 +527,24 @@ func (t *hybridSearchTask) PostExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+	if t.rerank != nil {
+		// recall the candidates for the reranker, the offset and limit are applied after reranking
+		t.rankParams = t.rerank.expandRankCandidates(t.rankParams)
+	}
 
-	t.result, err = rankSearchResultData(ctx, 1,
-		t.rankParams,
-		primaryFieldSchema.GetDataType(),
-		metricType,
-		t.multipleRecallResults.Collect())
+	if ranker := getExprRanker(t.reScorers); ranker != nil {
+		// the rank expression refers to the score of each ann search by index
+		legResults := lo.Map(t.searchTasks, func(searchTask *searchTask, _ int) *milvuspb.SearchResults {
+			return searchTask.result
+		})
+		t.result, err = ranker.rank(ctx, t.rankParams, primaryFieldSchema.GetDataType(), legResults)
+	} else {
+		t.result, err = rankSearchResultData(ctx, 1,
+			t.rankParams,
+			primaryFieldSchema.GetDataType(),
+			metricType,
+			t.multipleRecallResults.Collect())
+	}
 	if err != nil {
 		log.Warn("rank search result failed", zap.Error(err))
 		return err
@@ -436,6 +560,13 @@ func (t *hybridSearchTask) PostExecute(ctx context.Context) error {
 			return err
 		}
 	}
+	if t.rerank != nil {
+		t.result.Results, err = t.rerank.rerank(ctx, t.result.GetResults())
+		if err != nil {
+			log.Warn("failed to rerank", zap.Error(err))
+			return err
+		}
+	}
 	t.result.Results.OutputFields = t.userOutputFields
 
 	log.Debug("hybrid search post execute done")
@@ -460,7 +591,7 @@ func (t *hybridSearchTask) Requery() error {
 		QueryParams: []*commonpb.KeyValuePair{
 			{
 				Key:   LimitKey,
-				Value: strconv.FormatInt(t.rankParams.limit, 10),
+				Value: strconv.FormatInt(t.rankParams.limit*lo.Max([]int64{t.rankParams.groupSize, 1}), 10),
 			},
 		},
 	}
@@ -525,15 +656,25 @@ func rankSearchResultData(ctx context.Context,
 	for i := int64(0); i < nq; i++ {
 		accumulatedScores[i] = make(map[interface{}]float32)
 	}
+	// group by value of each id if grouping by field
+	groupVals := make(map[interface{}]interface{})
+	groupValType := schemapb.DataType_None
 
 	for _, result := range searchResults {
 		scores := result.GetResults().GetScores()
+		groupByFieldValue := result.GetResults().GetGroupByFieldValue()
+		if groupByFieldValue != nil {
+			groupValType = groupByFieldValue.GetType()
+		}
 		start := int64(0)
 		for i := int64(0); i < nq; i++ {
 			realTopk := result.GetResults().Topks[i]
 			for j := start; j < start+realTopk; j++ {
 				id := typeutil.GetPK(result.GetResults().GetIds(), j)
 				accumulatedScores[i][id] += scores[j]
+				if params.groupSize > 0 && groupByFieldValue != nil {
+					groupVals[id] = typeutil.GetData(groupByFieldValue, int(j))
+				}
 			}
 			start += realTopk
 		}
@@ -565,6 +706,29 @@ func rankSearchResultData(ctx context.Context,
 
 		sort.Slice(keys, less)
 
+		if params.groupSize > 0 {
+			// keep the hits of the top groups, offset and limit are the number of groups
+			selected := groupSearchHits(lo.Map(keys, func(key interface{}, _ int) interface{} {
+				return groupVals[key]
+			}), offset, limit, params.groupSize)
+			ret.Results.Topks = append(ret.Results.Topks, int64(len(selected)))
+			for _, index := range selected {
+				typeutil.AppendPKs(ret.Results.Ids, keys[index])
+				score := idSet[keys[index]]
+				if roundDecimal != -1 {
+					multiplier := math.Pow(10.0, float64(roundDecimal))
+					score = float32(math.Floor(float64(score)*multiplier+0.5) / multiplier)
+				}
+				ret.Results.Scores = append(ret.Results.Scores, score)
+				if groupValType != schemapb.DataType_None {
+					if err := typeutil.AppendGroupByValue(ret.Results, groupVals[keys[index]], groupValType); err != nil {
+						return nil, err
+					}
+				}
+			}
+			continue
+		}
+
 		if int64(len(keys)) > topk {
 			keys = keys[:topk]
 		}
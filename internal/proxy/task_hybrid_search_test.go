@@ -14,6 +14,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -23,6 +24,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -339,3 +341,75 @@ func TestHybridSearchTask_PostExecute(t *testing.T) {
 		assert.Equal(t, qt.result.GetStatus().GetErrorCode(), commonpb.ErrorCode_Success)
 	})
 }
+
+func TestHybridSearchTask_GroupBy(t *testing.T) {
+	paramtable.Init()
+
+	t.Run("apply group search params", func(t *testing.T) {
+		rankParams := []*commonpb.KeyValuePair{
+			{Key: LimitKey, Value: "10"},
+			{Key: GroupByFieldKey, Value: "doc_id"},
+			{Key: GroupSizeKey, Value: "3"},
+		}
+		searchReq := &milvuspb.SearchRequest{SearchParams: []*commonpb.KeyValuePair{
+			{Key: TopKKey, Value: "10"},
+			{Key: GroupSizeKey, Value: "1"},
+		}}
+		assert.NoError(t, applyGroupSearchParams(rankParams, searchReq))
+		assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+			{Key: TopKKey, Value: "10"},
+			{Key: GroupByFieldKey, Value: "doc_id"},
+			{Key: GroupSizeKey, Value: "3"},
+		}, searchReq.GetSearchParams())
+
+		searchReq = &milvuspb.SearchRequest{SearchParams: []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "title"}}}
+		assert.ErrorIs(t, applyGroupSearchParams(rankParams, searchReq), merr.ErrParameterInvalid)
+
+		// no group by in rank params, the ann searches are not changed
+		searchReq = &milvuspb.SearchRequest{SearchParams: []*commonpb.KeyValuePair{{Key: GroupByFieldKey, Value: "title"}}}
+		assert.NoError(t, applyGroupSearchParams(rankParams[:1], searchReq))
+		assert.Len(t, searchReq.GetSearchParams(), 1)
+
+		params, err := parseRankParams(rankParams)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, params.groupSize)
+		params, err = parseRankParams(rankParams[:2])
+		assert.NoError(t, err)
+		assert.EqualValues(t, 1, params.groupSize)
+	})
+
+	t.Run("rank search results", func(t *testing.T) {
+		genResult := func(ids []int64, scores []float32, groupByValues []int64) *milvuspb.SearchResults {
+			return &milvuspb.SearchResults{
+				Results: &schemapb.SearchResultData{
+					NumQueries: 1,
+					Ids:        &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}},
+					Scores:     scores,
+					Topks:      []int64{int64(len(ids))},
+					GroupByFieldValue: &schemapb.FieldData{
+						Type: schemapb.DataType_Int64,
+						Field: &schemapb.FieldData_Scalars{Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: groupByValues}},
+						}},
+					},
+				},
+			}
+		}
+		results := []*milvuspb.SearchResults{
+			genResult([]int64{1, 2, 3, 4}, []float32{0.9, 0.8, 0.7, 0.6}, []int64{10, 10, 10, 20}),
+			genResult([]int64{5, 4, 6}, []float32{0.85, 0.05, 0.2}, []int64{30, 20, 20}),
+		}
+		// 1: 0.9, 5: 0.85, 2: 0.8, 3: 0.7, 4: 0.65, 6: 0.2
+		ret, err := rankSearchResultData(context.TODO(), 1, &rankParams{limit: 2, roundDecimal: -1, groupSize: 2},
+			schemapb.DataType_Int64, metric.IP, results)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 5}, ret.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{10, 10, 30}, ret.GetResults().GetGroupByFieldValue().GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{3}, ret.GetResults().GetTopks())
+
+		ret, err = rankSearchResultData(context.TODO(), 1, &rankParams{limit: 2, offset: 1, roundDecimal: -1, groupSize: 1},
+			schemapb.DataType_Int64, metric.IP, results)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{5, 4}, ret.GetResults().GetIds().GetIntId().GetData())
+	})
+}
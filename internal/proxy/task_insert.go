@@ -268,6 +268,7 @@ func (it *insertTask) Execute(ctx context.Context) error {
 		it.result.Status = merr.Status(err)
 		return err
 	}
+	globalQueryCache.NotifyDml(collID, it.EndTs())
 	sendMsgDur := tr.RecordSpan()
 	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.InsertLabel).Observe(float64(sendMsgDur.Milliseconds()))
 	totalExecDur := tr.ElapseSpan()
@@ -3,6 +3,7 @@ package proxy
 import (
 	"context"
 	"fmt"
+	"io"
 	"strconv"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -26,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/retry"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -64,7 +67,17 @@ type queryTask struct {
 	channelsMvcc     map[string]Timestamp
 	fastSkip         bool
 
-	reQuery bool
+	reQuery  bool
+	cacheKey string // empty if the results are not cached
+
+	// stream is set if the results are streamed to the client in batches
+	stream        streamrpc.QueryResultsStreamServer
+	streamReducer *queryStreamReducer // nil if the results are reduced as a whole
+
+	iterator   *queryIterator
+	replicaID  int64           // the replica pinned by the request, 0 if not pinned
+	replicaTag string          // only the replicas with the tag serve the request if not empty
+	partial    *partialResults // nil if partial results are not allowed
 }
 
 type queryParams struct {
@@ -332,6 +345,22 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	t.queryParams = queryParams
 	t.RetrieveRequest.Limit = queryParams.limit + queryParams.offset
 
+	// the requery of search shares the search params, which may enable the search iterator
+	if !t.reQuery {
+		t.iterator, err = parseQueryIterator(t.request.GetQueryParams())
+		if err != nil {
+			log.Warn("invalid query iterator", zap.Error(err))
+			return err
+		}
+	}
+
+	t.replicaID, err = parseReplicaID(t.request.GetQueryParams())
+	if err != nil {
+		log.Warn("invalid replica id", zap.Error(err))
+		return err
+	}
+	t.replicaTag = parseReplicaTag(t.request.GetQueryParams())
+
 	schema, _ := globalMetaCache.GetCollectionSchema(ctx, t.request.GetDbName(), t.collectionName)
 	t.schema = schema
 
@@ -350,7 +379,15 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 	t.plan.Node.(*planpb.PlanNode_Query).Query.Limit = t.RetrieveRequest.Limit
 
-	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited {
+	if t.iterator != nil {
+		if err := t.iterator.prepare(t); err != nil {
+			log.Warn("failed to prepare query iterator", zap.Error(err))
+			return err
+		}
+	}
+
+	// the streaming query could iterate the whole collection without limit
+	if planparserv2.IsAlwaysTruePlan(t.plan) && t.RetrieveRequest.Limit == typeutil.Unlimited && t.stream == nil {
 		return fmt.Errorf("empty expression should be used with limit")
 	}
 
@@ -416,6 +453,11 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 			guaranteeTs = parseGuaranteeTsFromConsistency(guaranteeTs, t.BeginTs(), consistencyLevel)
 		}
 	}
+	// all batches of query iterator read the same snapshot
+	if t.iterator != nil {
+		guaranteeTs = t.iterator.snapshotTs(guaranteeTs, t.BeginTs())
+		t.MvccTimestamp = guaranteeTs
+	}
 	t.GuaranteeTimestamp = guaranteeTs
 
 	deadline, ok := t.TraceCtx().Deadline()
@@ -424,6 +466,32 @@ func (t *queryTask) PreExecute(ctx context.Context) error {
 	}
 
 	t.DbID = 0 // TODO
+
+	// the results without limit are streamed as soon as the shard leaders return them,
+	// the count and paginated results are reduced as a whole and then split into batches
+	if t.stream != nil && !t.RetrieveRequest.IsCount && t.queryParams.limit == typeutil.Unlimited {
+		t.streamReducer = newQueryStreamReducer(ctx, t.stream, t.queryParams, t.RetrieveRequest, t.schema.CollectionSchema, t.collectionName, t.userOutputFields)
+	}
+
+	allowPartial, err := parseAllowPartialResults(t.request.GetQueryParams(), collectionInfo)
+	if err != nil {
+		log.Warn("invalid allow partial results", zap.Error(err))
+		return err
+	}
+	// all batches of query iterator and streaming query read all the channels
+	if allowPartial && t.iterator == nil && t.stream == nil {
+		t.partial = &partialResults{}
+	}
+
+	// the requests reading the snapshot of channels or a pinned snapshot and the streaming requests are not cached
+	if len(t.channelsMvcc) == 0 && t.iterator == nil && t.stream == nil && useQueryCache(collectionInfo, consistencyLevel) {
+		t.cacheKey, err = queryCacheKey(t.RetrieveRequest)
+		if err != nil {
+			log.Warn("failed to generate query cache key", zap.Error(err))
+			return err
+		}
+	}
+
 	log.Debug("Query PreExecute done.",
 		zap.Uint64("guarantee_ts", guaranteeTs),
 		zap.Uint64("mvcc_ts", t.GetMvccTimestamp()),
@@ -439,18 +507,52 @@ func (t *queryTask) Execute(ctx context.Context) error {
 		zap.String("requestType", "query"))
 
 	t.resultBuf = typeutil.NewConcurrentSet[*internalpb.RetrieveResults]()
+	if t.cacheKey != "" {
+		if results, ok := globalQueryCache.Get(t.cacheKey, metrics.QueryLabel); ok {
+			for _, result := range results {
+				t.resultBuf.Insert(result.(*internalpb.RetrieveResults))
+			}
+			log.Debug("Query hits query cache")
+			return nil
+		}
+	}
+
+	exec := t.queryShard
+	if t.streamReducer != nil {
+		exec = t.queryShardStream
+	}
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.CollectionID,
 		collectionName: t.collectionName,
 		nq:             1,
-		exec:           t.queryShard,
+		exec:           exec,
+		replicaID:      t.replicaID,
+		replicaTag:     t.replicaTag,
+		hedgeable:      t.streamReducer == nil,
+		partial:        t.partial,
 	})
 	if err != nil {
 		log.Warn("fail to execute query", zap.Error(err))
 		return errors.Wrap(err, "failed to query")
 	}
 
+	if t.streamReducer != nil {
+		if err := t.streamReducer.Finish(); err != nil {
+			log.Warn("fail to send the query results", zap.Error(err))
+			return err
+		}
+		log.Debug("Query Execute done, results are streamed.")
+		return nil
+	}
+
+	// the partial results are not cached
+	if t.cacheKey != "" && (t.partial == nil || !t.partial.isPartial()) {
+		globalQueryCache.Put(t.cacheKey, t.GetCollectionID(), t.BeginTs(), lo.Map(t.resultBuf.Collect(), func(result *internalpb.RetrieveResults, _ int) proto.Message {
+			return result
+		}))
+	}
+
 	log.Debug("Query Execute done.")
 	return nil
 }
@@ -465,6 +567,11 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
 		zap.String("requestType", "query"))
 
+	// the results are already sent in Execute
+	if t.streamReducer != nil {
+		return nil
+	}
+
 	var err error
 
 	toReduceResults := make([]*internalpb.RetrieveResults, 0)
@@ -492,6 +599,16 @@ func (t *queryTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 	t.result.OutputFields = t.userOutputFields
+	if t.iterator != nil {
+		pkField, err := t.schema.GetPkField()
+		if err != nil {
+			return err
+		}
+		if err := t.iterator.next(t.GetCollectionID(), t.GetGuaranteeTimestamp(), pkField, t.result.GetFieldsData()); err != nil {
+			log.Warn("failed to generate query iterator cursor", zap.Error(err))
+			return err
+		}
+	}
 	metrics.ProxyReduceResultLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.QueryLabel).Observe(float64(tr.RecordSpan().Milliseconds()))
 
 	log.Debug("Query PostExecute done")
@@ -543,11 +660,68 @@ func (t *queryTask) queryShard(ctx context.Context, nodeID int64, qn types.Query
 	}
 
 	log.Debug("get query result")
+	if !commitHedgedResult(ctx) {
+		return nil
+	}
 	t.resultBuf.Insert(result)
 	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
 	return nil
 }
 
+// queryShardStream queries the shard by the streaming api of the shard leader,
+// the results are sent to the client in batches as soon as they are received.
+func (t *queryTask) queryShardStream(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
+	retrieveReq := typeutil.Clone(t.RetrieveRequest)
+	retrieveReq.GetBase().TargetID = nodeID
+	req := &querypb.QueryRequest{
+		Req:         retrieveReq,
+		DmlChannels: []string{channel},
+		Scope:       querypb.DataScope_All,
+	}
+
+	log := log.Ctx(ctx).With(zap.Int64("collection", t.GetCollectionID()),
+		zap.Int64s("partitionIDs", t.GetPartitionIDs()),
+		zap.Int64("nodeID", nodeID),
+		zap.String("channel", channel))
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	client, err := qn.QueryStream(ctx, req)
+	if err != nil {
+		log.Warn("QueryNode query stream return error", zap.Error(err))
+		globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+		return err
+	}
+	for {
+		result, err := client.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			log.Warn("QueryNode query stream receive error", zap.Error(err))
+			globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+			return err
+		}
+		if result.GetStatus().GetErrorCode() == commonpb.ErrorCode_NotShardLeader {
+			log.Warn("QueryNode is not shardLeader")
+			globalMetaCache.DeprecateShardCache(t.request.GetDbName(), t.collectionName)
+			return errInvalidShardLeaders
+		}
+		if err := merr.Error(result.GetStatus()); err != nil {
+			log.Warn("QueryNode query stream result error", zap.Error(err))
+			return errors.Wrapf(err, "fail to Query on QueryNode %d", nodeID)
+		}
+		// the client may be gone, retrying on other replicas is useless
+		if err := t.streamReducer.Reduce(result); err != nil {
+			log.Warn("fail to send the query results", zap.Error(err))
+			return retry.Unrecoverable(err)
+		}
+	}
+
+	log.Debug("get query stream result")
+	return nil
+}
+
 // IDs2Expr converts ids slices to bool expresion with specified field name
 func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
 	var idsStr string
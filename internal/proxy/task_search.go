@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -70,6 +71,18 @@ type searchTask struct {
 	node            types.ProxyComponent
 	lb              LBPolicy
 	queryChannelsTs map[string]Timestamp
+
+	iterator   *searchIterator
+	rerank     *rerankParams
+	cacheKey   string          // empty if the results are not cached
+	replicaID  int64           // the replica pinned by the request, 0 if not pinned
+	replicaTag string          // only the replicas with the tag serve the request if not empty
+	partial    *partialResults // nil if partial results are not allowed
+
+	// stream is set if the results are streamed to the client in batches,
+	// streamed is true once any batch is sent
+	stream   streamrpc.SearchResultsStreamServer
+	streamed bool
 }
 
 func getPartitionIDs(ctx context.Context, dbName string, collectionName string, partitionNames []string) (partitionIDs []UniqueID, err error) {
@@ -206,15 +219,60 @@ func parseSearchInfo(searchParamsPair []*commonpb.KeyValuePair, schema *schemapb
 		}
 	}
 
+	// 6. parse group size and strict group size
+	groupSize, groupStrictSize, err := parseGroupSize(searchParamsPair)
+	if err != nil {
+		return nil, 0, err
+	}
+	if groupByFieldId == 0 {
+		if groupSize != 0 || groupStrictSize {
+			return nil, 0, merr.WrapErrParameterInvalidMsg("%s and %s require %s", GroupSizeKey, GroupStrictSizeKey, GroupByFieldKey)
+		}
+	} else if groupSize == 0 {
+		groupSize = 1
+	} else if err := validateTopKLimit(queryTopK * groupSize); err != nil {
+		return nil, 0, fmt.Errorf("(%s+%s)*%s [%d] is invalid, %w", OffsetKey, TopKKey, GroupSizeKey, queryTopK*groupSize, err)
+	}
+
 	return &planpb.QueryInfo{
-		Topk:           queryTopK,
-		MetricType:     metricType,
-		SearchParams:   searchParamStr,
-		RoundDecimal:   roundDecimal,
-		GroupByFieldId: groupByFieldId,
+		Topk:            queryTopK,
+		MetricType:      metricType,
+		SearchParams:    searchParamStr,
+		RoundDecimal:    roundDecimal,
+		GroupByFieldId:  groupByFieldId,
+		GroupSize:       groupSize,
+		GroupStrictSize: groupStrictSize,
 	}, offset, nil
 }
 
+// parseGroupSize parses the max number of hits in each group of group by,
+// 0 is returned if group size is not set.
+func parseGroupSize(params []*commonpb.KeyValuePair) (int64, bool, error) {
+	var (
+		groupSize       int64
+		groupStrictSize bool
+	)
+	groupSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupSizeKey, params)
+	if err == nil {
+		groupSize, err = strconv.ParseInt(groupSizeStr, 0, 64)
+		if err != nil {
+			return 0, false, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", GroupSizeKey, groupSizeStr)
+		}
+		maxGroupSize := Params.QuotaConfig.MaxGroupSize.GetAsInt64()
+		if groupSize <= 0 || groupSize > maxGroupSize {
+			return 0, false, merr.WrapErrParameterInvalidRange(1, maxGroupSize, groupSize, "invalid group size")
+		}
+	}
+	groupStrictSizeStr, err := funcutil.GetAttrByKeyFromRepeatedKV(GroupStrictSizeKey, params)
+	if err == nil {
+		groupStrictSize, err = strconv.ParseBool(groupStrictSizeStr)
+		if err != nil {
+			return 0, false, merr.WrapErrParameterInvalidMsg("%s [%s] is invalid", GroupStrictSizeKey, groupStrictSizeStr)
+		}
+	}
+	return groupSize, groupStrictSize, nil
+}
+
 func getOutputFieldIDs(schema *schemaInfo, outputFields []string) (outputFieldIDs []UniqueID, err error) {
 	outputFieldIDs = make([]UniqueID, 0, len(outputFields))
 	for _, name := range outputFields {
@@ -318,11 +376,52 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	log.Debug("translate output fields",
 		zap.Strings("output fields", t.request.GetOutputFields()))
 
+	t.iterator, err = parseSearchIterator(t.request.GetSearchParams())
+	if err != nil {
+		log.Warn("invalid search iterator", zap.Error(err))
+		return err
+	}
+
+	t.replicaID, err = parseReplicaID(t.request.GetSearchParams())
+	if err != nil {
+		log.Warn("invalid replica id", zap.Error(err))
+		return err
+	}
+	t.replicaTag = parseReplicaTag(t.request.GetSearchParams())
+
+	t.rerank, err = parseRerankParams(t.request.GetSearchParams())
+	if err != nil {
+		log.Warn("invalid rerank params", zap.Error(err))
+		return err
+	}
+	if t.rerank != nil {
+		if t.iterator != nil {
+			return merr.WrapErrParameterInvalidMsg("rerank is not supported by search iterator")
+		}
+		if err := t.rerank.checkNoGroupBy(t.request.GetSearchParams()); err != nil {
+			log.Warn("invalid rerank params", zap.Error(err))
+			return err
+		}
+		if err := t.rerank.resolveFields(t.schema, t.request.GetOutputFields()); err != nil {
+			log.Warn("invalid rerank fields", zap.Error(err))
+			return err
+		}
+		searchParams, err := t.rerank.expandSearchCandidates(t.request.GetSearchParams())
+		if err != nil {
+			log.Warn("invalid rerank params", zap.Error(err))
+			return err
+		}
+		t.request.SearchParams = searchParams
+	}
+
 	err = initSearchRequest(ctx, t)
 	if err != nil {
 		log.Debug("init search request failed", zap.Error(err))
 		return err
 	}
+	if t.rerank != nil && t.SearchRequest.GetNq() != 1 {
+		return merr.WrapErrParameterInvalidMsg("rerank only supports nq=1, got %d", t.SearchRequest.GetNq())
+	}
 
 	collectionInfo, err2 := globalMetaCache.GetCollectionInfo(ctx, t.request.GetDbName(), collectionName, t.CollectionID)
 	if err2 != nil {
@@ -348,6 +447,24 @@ func (t *searchTask) PreExecute(ctx context.Context) error {
 	}
 	t.SearchRequest.GuaranteeTimestamp = guaranteeTs
 
+	allowPartial, err := parseAllowPartialResults(t.request.GetSearchParams(), collectionInfo)
+	if err != nil {
+		log.Warn("invalid allow partial results", zap.Error(err))
+		return err
+	}
+	// all pages of search iterator search all the channels
+	if allowPartial && t.iterator == nil {
+		t.partial = &partialResults{}
+	}
+
+	if t.iterator == nil && useQueryCache(collectionInfo, consistencyLevel) {
+		t.cacheKey, err = searchCacheKey(t.SearchRequest)
+		if err != nil {
+			log.Warn("failed to generate query cache key", zap.Error(err))
+			return err
+		}
+	}
+
 	log.Debug("search PreExecute done.",
 		zap.Uint64("guarantee_ts", guaranteeTs),
 		zap.Bool("use_default_consistency", useDefaultConsistency),
@@ -366,18 +483,46 @@ func (t *searchTask) Execute(ctx context.Context) error {
 
 	t.resultBuf = typeutil.NewConcurrentSet[*internalpb.SearchResults]()
 
+	if t.cacheKey != "" {
+		if results, ok := globalQueryCache.Get(t.cacheKey, metrics.SearchLabel); ok {
+			for _, result := range results {
+				t.resultBuf.Insert(result.(*internalpb.SearchResults))
+			}
+			log.Debug("Search hits query cache", zap.Int64("collection", t.GetCollectionID()))
+			return nil
+		}
+	}
+
+	if t.SearchRequest.GetBm25Info() != nil {
+		if err := fillBM25SearchInfo(ctx, t.lb, t.schema.CollectionSchema, t.request.GetDbName(), t.collectionName, t.SearchRequest); err != nil {
+			log.Warn("failed to fill full-text search info", zap.Error(err))
+			return err
+		}
+	}
+
 	err := t.lb.Execute(ctx, CollectionWorkLoad{
 		db:             t.request.GetDbName(),
 		collectionID:   t.SearchRequest.CollectionID,
 		collectionName: t.collectionName,
 		nq:             t.Nq,
 		exec:           t.searchShard,
+		replicaID:      t.replicaID,
+		replicaTag:     t.replicaTag,
+		hedgeable:      true,
+		partial:        t.partial,
 	})
 	if err != nil {
 		log.Warn("search execute failed", zap.Error(err))
 		return errors.Wrap(err, "failed to search")
 	}
 
+	// the partial results are not cached
+	if t.cacheKey != "" && (t.partial == nil || !t.partial.isPartial()) {
+		globalQueryCache.Put(t.cacheKey, t.GetCollectionID(), t.BeginTs(), lo.Map(t.resultBuf.Collect(), func(result *internalpb.SearchResults, _ int) proto.Message {
+			return result
+		}))
+	}
+
 	log.Debug("Search Execute done.",
 		zap.Int64("collection", t.GetCollectionID()),
 		zap.Int64s("partitionIDs", t.GetPartitionIDs()))
@@ -428,7 +573,7 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 
 	if len(validSearchResults) <= 0 {
 		t.fillInEmptyResult(Nq)
-		return nil
+		return t.nextIteratorCursor(MetricType)
 	}
 
 	// Reduce all search results
@@ -443,7 +588,16 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 		return err
 	}
 
-	t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	// the results of search iterator and rerank are reduced as a whole
+	if t.stream != nil && t.iterator == nil && t.rerank == nil {
+		return t.streamSearchResults(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.GetDataType())
+	}
+
+	if groupSize := t.SearchRequest.GetGroupSize(); groupSize > 0 {
+		t.result, err = reduceSearchResultDataWithGroupBy(ctx, validSearchResults, Nq, Topk, groupSize, MetricType, primaryFieldSchema.DataType, t.offset)
+	} else {
+		t.result, err = reduceSearchResultData(ctx, validSearchResults, Nq, Topk, MetricType, primaryFieldSchema.DataType, t.offset)
+	}
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		return err
@@ -454,6 +608,11 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	t.result.CollectionName = t.collectionName
 	t.fillInFieldInfo()
 
+	if err := t.nextIteratorCursor(MetricType); err != nil {
+		log.Warn("failed to generate search iterator cursor", zap.Error(err))
+		return err
+	}
+
 	if t.requery {
 		err = t.Requery()
 		if err != nil {
@@ -461,6 +620,13 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 			return err
 		}
 	}
+	if t.rerank != nil {
+		t.result.Results, err = t.rerank.rerank(ctx, t.result.GetResults())
+		if err != nil {
+			log.Warn("failed to rerank", zap.Error(err))
+			return err
+		}
+	}
 	t.result.Results.OutputFields = t.userOutputFields
 
 	log.Debug("Search post execute done",
@@ -469,9 +635,22 @@ func (t *searchTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
+func (t *searchTask) nextIteratorCursor(metricType string) error {
+	if t.iterator == nil {
+		return nil
+	}
+	return t.iterator.next(t.GetCollectionID(), metricType, t.queryChannelsTs, t.result.GetResults())
+}
+
 func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.QueryNodeClient, channel string) error {
 	searchReq := typeutil.Clone(t.SearchRequest)
 	searchReq.GetBase().TargetID = nodeID
+	if t.iterator != nil {
+		// all pages of search iterator read the same snapshot
+		if mvccTs, ok := t.iterator.channelsMvcc()[channel]; ok && mvccTs > 0 {
+			searchReq.MvccTimestamp = mvccTs
+		}
+	}
 	req := &querypb.SearchRequest{
 		Req:             searchReq,
 		DmlChannels:     []string{channel},
@@ -501,6 +680,9 @@ func (t *searchTask) searchShard(ctx context.Context, nodeID int64, qn types.Que
 			zap.String("reason", result.GetStatus().GetReason()))
 		return errors.Wrapf(merr.Error(result.GetStatus()), "fail to search on QueryNode %d", nodeID)
 	}
+	if !commitHedgedResult(ctx) {
+		return nil
+	}
 	t.resultBuf.Insert(result)
 	t.lb.UpdateCostMetrics(nodeID, result.CostAggregation)
 
@@ -918,6 +1100,128 @@ func reduceSearchResultData(ctx context.Context, subSearchResultData []*schemapb
 	return ret, nil
 }
 
+// reduceSearchResultDataWithGroupBy merges the results of the search grouped by field, topk is the number of groups
+// including the offset, each group keeps at most groupSize hits and the hits of a group are adjacent in the results.
+func reduceSearchResultDataWithGroupBy(ctx context.Context, subSearchResultData []*schemapb.SearchResultData,
+	nq int64, topk int64, groupSize int64, metricType string, pkType schemapb.DataType, offset int64,
+) (*milvuspb.SearchResults, error) {
+	tr := timerecord.NewTimeRecorder("reduceSearchResultDataWithGroupBy")
+	defer func() {
+		tr.CtxElapse(ctx, "done")
+	}()
+
+	limit := topk - offset
+	log.Ctx(ctx).Debug("reduceSearchResultDataWithGroupBy",
+		zap.Int("len(subSearchResultData)", len(subSearchResultData)),
+		zap.Int64("nq", nq),
+		zap.Int64("offset", offset),
+		zap.Int64("limit", limit),
+		zap.Int64("groupSize", groupSize),
+		zap.String("metricType", metricType))
+
+	ret := &milvuspb.SearchResults{
+		Status: merr.Success(),
+		Results: &schemapb.SearchResultData{
+			NumQueries: nq,
+			TopK:       topk,
+			FieldsData: typeutil.PrepareResultFieldData(subSearchResultData[0].GetFieldsData(), limit*groupSize),
+			Scores:     []float32{},
+			Ids:        &schemapb.IDs{},
+			Topks:      []int64{},
+		},
+	}
+	switch pkType {
+	case schemapb.DataType_Int64:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: make([]int64, 0, limit*groupSize)}}
+	case schemapb.DataType_VarChar:
+		ret.GetResults().Ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: make([]string, 0, limit*groupSize)}}
+	default:
+		return nil, errors.New("unsupported pk type")
+	}
+	for _, sData := range subSearchResultData {
+		if err := checkSearchResultData(sData, nq, topk); err != nil {
+			log.Ctx(ctx).Warn("invalid search results", zap.Error(err))
+			return ret, err
+		}
+	}
+
+	var (
+		subSearchNum      = len(subSearchResultData)
+		subSearchNqOffset = make([][]int64, subSearchNum)
+		skipDupCnt        int64
+		retSize           int64
+		realTopK          int64
+	)
+	for i := 0; i < subSearchNum; i++ {
+		subSearchNqOffset[i] = make([]int64, subSearchResultData[i].GetNumQueries())
+		for j := int64(1); j < nq; j++ {
+			subSearchNqOffset[i][j] = subSearchNqOffset[i][j-1] + subSearchResultData[i].Topks[j-1]
+		}
+	}
+	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
+
+	type hit struct {
+		subSearchIdx  int
+		resultDataIdx int64
+	}
+	for i := int64(0); i < nq; i++ {
+		var (
+			cursors   = make([]int64, subSearchNum)
+			idSet     = make(map[interface{}]struct{})
+			hits      = make([]hit, 0)
+			groupVals = make([]interface{}, 0)
+		)
+		// merge all the hits of the i-th query by score
+		for {
+			subSearchIdx, resultDataIdx := selectHighestScoreIndex(subSearchResultData, subSearchNqOffset, cursors, i)
+			if subSearchIdx == -1 {
+				break
+			}
+			cursors[subSearchIdx]++
+			subSearchRes := subSearchResultData[subSearchIdx]
+			id := typeutil.GetPK(subSearchRes.GetIds(), resultDataIdx)
+			if _, ok := idSet[id]; ok {
+				skipDupCnt++
+				continue
+			}
+			idSet[id] = struct{}{}
+			hits = append(hits, hit{subSearchIdx: subSearchIdx, resultDataIdx: resultDataIdx})
+			groupVals = append(groupVals, typeutil.GetData(subSearchRes.GetGroupByFieldValue(), int(resultDataIdx)))
+		}
+
+		selected := groupSearchHits(groupVals, offset, limit, groupSize)
+		for _, idx := range selected {
+			subSearchRes := subSearchResultData[hits[idx].subSearchIdx]
+			resultDataIdx := hits[idx].resultDataIdx
+			retSize += typeutil.AppendFieldData(ret.Results.FieldsData, subSearchRes.GetFieldsData(), resultDataIdx)
+			typeutil.AppendPKs(ret.Results.Ids, typeutil.GetPK(subSearchRes.GetIds(), resultDataIdx))
+			ret.Results.Scores = append(ret.Results.Scores, subSearchRes.GetScores()[resultDataIdx])
+			if err := typeutil.AppendGroupByValue(ret.Results, groupVals[idx], subSearchRes.GetGroupByFieldValue().GetType()); err != nil {
+				log.Ctx(ctx).Error("failed to append groupByValues", zap.Error(err))
+				return ret, err
+			}
+		}
+		realTopK = int64(len(selected))
+		ret.Results.Topks = append(ret.Results.Topks, realTopK)
+
+		// limit search result to avoid oom
+		if retSize > maxOutputSize {
+			return nil, fmt.Errorf("search results exceed the maxOutputSize Limit %d", maxOutputSize)
+		}
+	}
+	if skipDupCnt > 0 {
+		log.Ctx(ctx).Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
+	}
+
+	ret.Results.TopK = realTopK // realTopK is the topK of the nq-th query
+	if !metric.PositivelyRelated(metricType) {
+		for k := range ret.Results.Scores {
+			ret.Results.Scores[k] *= -1
+		}
+	}
+	return ret, nil
+}
+
 type rangeSearchParams struct {
 	radius      float64
 	rangeFilter float64
@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/dependency"
@@ -1713,6 +1714,90 @@ func TestTaskSearch_reduceGroupBySearchResultData(t *testing.T) {
 	}
 }
 
+func TestTaskSearch_reduceSearchResultDataWithGroupBy(t *testing.T) {
+	genResult := func(ids []int64, scores []float32, groupByValues []int64) *schemapb.SearchResultData {
+		result := getSearchResultData(1, 3)
+		result.Ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: ids}}
+		result.Scores = scores
+		result.Topks = []int64{int64(len(ids))}
+		result.GroupByFieldValue = &schemapb.FieldData{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: groupByValues}},
+				},
+			},
+		}
+		return result
+	}
+	results := []*schemapb.SearchResultData{
+		genResult([]int64{1, 2, 3, 4, 5}, []float32{10, 9, 8, 7, 6}, []int64{1, 1, 1, 2, 3}),
+		genResult([]int64{6, 7, 8}, []float32{9.5, 8.5, 7.5}, []int64{2, 3, 3}),
+	}
+
+	t.Run("group size", func(t *testing.T) {
+		reduced, err := reduceSearchResultDataWithGroupBy(context.TODO(), results, 1, 3, 2, metric.IP, schemapb.DataType_Int64, 0)
+		assert.NoError(t, err)
+		// the hits of a group are adjacent
+		assert.Equal(t, []int64{1, 2, 6, 4, 7, 8}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []float32{10, 9, 9.5, 7, 8.5, 7.5}, reduced.GetResults().GetScores())
+		assert.Equal(t, []int64{1, 1, 2, 2, 3, 3}, reduced.GetResults().GetGroupByFieldValue().GetScalars().GetLongData().GetData())
+		assert.Equal(t, []int64{6}, reduced.GetResults().GetTopks())
+	})
+
+	t.Run("offset", func(t *testing.T) {
+		reduced, err := reduceSearchResultDataWithGroupBy(context.TODO(), results, 1, 3, 2, metric.IP, schemapb.DataType_Int64, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{6, 4, 7, 8}, reduced.GetResults().GetIds().GetIntId().GetData())
+		assert.Equal(t, []int64{2, 2, 3, 3}, reduced.GetResults().GetGroupByFieldValue().GetScalars().GetLongData().GetData())
+	})
+
+	t.Run("one hit per group", func(t *testing.T) {
+		reduced, err := reduceSearchResultDataWithGroupBy(context.TODO(), results, 1, 3, 1, metric.IP, schemapb.DataType_Int64, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 6, 7}, reduced.GetResults().GetIds().GetIntId().GetData())
+	})
+}
+
+func TestSearchTask_parseGroupSize(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "doc_id", DataType: schemapb.DataType_Int64},
+		},
+	}
+	genParams := func(kvs ...string) []*commonpb.KeyValuePair {
+		params := []*commonpb.KeyValuePair{{Key: TopKKey, Value: "10"}}
+		for i := 0; i < len(kvs); i += 2 {
+			params = append(params, &commonpb.KeyValuePair{Key: kvs[i], Value: kvs[i+1]})
+		}
+		return params
+	}
+
+	info, _, err := parseSearchInfo(genParams(GroupByFieldKey, "doc_id"), schema)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 1, info.GetGroupSize())
+	assert.False(t, info.GetGroupStrictSize())
+
+	info, _, err = parseSearchInfo(genParams(GroupByFieldKey, "doc_id", GroupSizeKey, "3", GroupStrictSizeKey, "true"), schema)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 3, info.GetGroupSize())
+	assert.True(t, info.GetGroupStrictSize())
+
+	info, _, err = parseSearchInfo(genParams(), schema)
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, info.GetGroupSize())
+
+	_, _, err = parseSearchInfo(genParams(GroupSizeKey, "3"), schema)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, _, err = parseSearchInfo(genParams(GroupByFieldKey, "doc_id", GroupSizeKey, "0"), schema)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, _, err = parseSearchInfo(genParams(GroupByFieldKey, "doc_id", GroupSizeKey, "1000"), schema)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	_, _, err = parseSearchInfo(genParams(GroupByFieldKey, "doc_id", GroupStrictSizeKey, "maybe"), schema)
+	assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+}
+
 func TestSearchTask_ErrExecute(t *testing.T) {
 	var (
 		err error
@@ -2101,6 +2186,133 @@ func TestTaskSearch_parseQueryInfo(t *testing.T) {
 	})
 }
 
+func TestSearchTask_createBM25SearchPlan(t *testing.T) {
+	textField := &schemapb.FieldSchema{
+		FieldID:  101,
+		Name:     "text",
+		DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.MaxLengthKey, Value: "256"},
+			{Key: common.EnableAnalyzerKey, Value: "true"},
+		},
+	}
+	schema := newSchemaInfo(&schemapb.CollectionSchema{
+		Name: "test_bm25",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			textField,
+		},
+	})
+	genTask := func(placeholderType commonpb.PlaceholderType, dsl string) *searchTask {
+		placeholderGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{
+			Placeholders: []*commonpb.PlaceholderValue{{Tag: "$0", Type: placeholderType, Values: [][]byte{[]byte("milvus")}}},
+		})
+		require.NoError(t, err)
+		return &searchTask{
+			schema: schema,
+			request: &milvuspb.SearchRequest{
+				Dsl:              dsl,
+				PlaceholderGroup: placeholderGroup,
+			},
+		}
+	}
+
+	t.Run("without filter", func(t *testing.T) {
+		plan, err := createBM25SearchPlan(genTask(commonpb.PlaceholderType_VarChar, ""), textField, &planpb.QueryInfo{})
+		assert.NoError(t, err)
+		assert.Nil(t, plan.GetQuery().GetPredicates())
+		assert.Equal(t, int64(typeutil.Unlimited), plan.GetQuery().GetLimit())
+		assert.Equal(t, []int64{100}, plan.GetOutputFieldIds())
+	})
+
+	t.Run("with filter", func(t *testing.T) {
+		plan, err := createBM25SearchPlan(genTask(commonpb.PlaceholderType_VarChar, "pk > 10"), textField, &planpb.QueryInfo{MetricType: "bm25"})
+		assert.NoError(t, err)
+		assert.NotNil(t, plan.GetQuery().GetPredicates())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		_, err := createBM25SearchPlan(genTask(commonpb.PlaceholderType_VarChar, ""), textField, &planpb.QueryInfo{MetricType: metric.IP})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = createBM25SearchPlan(genTask(commonpb.PlaceholderType_VarChar, ""), textField, &planpb.QueryInfo{GroupByFieldId: 100})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = createBM25SearchPlan(genTask(commonpb.PlaceholderType_FloatVector, ""), textField, &planpb.QueryInfo{})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+		_, err = createBM25SearchPlan(genTask(commonpb.PlaceholderType_VarChar, "pk >"), textField, &planpb.QueryInfo{})
+		assert.ErrorIs(t, err, merr.ErrParameterInvalid)
+	})
+}
+
+func TestSearchTask_fillBM25SearchInfo(t *testing.T) {
+	paramtable.Init()
+	schema := &schemapb.CollectionSchema{
+		Name: "test_bm25",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{
+				FieldID:  101,
+				Name:     "text",
+				DataType: schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{
+					{Key: common.MaxLengthKey, Value: "256"},
+					{Key: common.EnableAnalyzerKey, Value: "true"},
+				},
+			},
+		},
+	}
+	placeholderGroup, err := proto.Marshal(&commonpb.PlaceholderGroup{
+		Placeholders: []*commonpb.PlaceholderValue{{Tag: "$0", Type: commonpb.PlaceholderType_VarChar, Values: [][]byte{[]byte("milvus"), []byte("vector database")}}},
+	})
+	require.NoError(t, err)
+	genRequest := func() *internalpb.SearchRequest {
+		return &internalpb.SearchRequest{
+			CollectionID:     1,
+			PlaceholderGroup: placeholderGroup,
+			Bm25Info:         &internalpb.BM25SearchInfo{FieldID: 101},
+		}
+	}
+
+	t.Run("stats of all shards", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		qn.EXPECT().GetStatistics(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *querypb.GetStatisticsRequest, _ ...grpc.CallOption) (*internalpb.GetStatisticsResponse, error) {
+			assert.EqualValues(t, 101, req.GetReq().GetBm25FieldID())
+			assert.ElementsMatch(t, []string{"milvus", "vector", "database"}, req.GetReq().GetBm25Terms())
+			return &internalpb.GetStatisticsResponse{
+				Status:    merr.Success(),
+				Bm25Stats: &internalpb.BM25Stats{NumRows: 2, NumTokens: 10, DocFreq: map[string]int64{"milvus": 1}},
+			}, nil
+		})
+		lb := NewMockLBPolicy(t)
+		lb.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, workload CollectionWorkLoad) error {
+			for _, channel := range []string{"ch1", "ch2"} {
+				if err := workload.exec(ctx, 1, qn, channel); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+
+		req := genRequest()
+		err := fillBM25SearchInfo(context.Background(), lb, schema, "default", "test_bm25", req)
+		assert.NoError(t, err)
+		assert.Equal(t, 5.0, req.GetBm25Info().GetAvgDocLen())
+		assert.Len(t, req.GetBm25Info().GetQueries(), 2)
+		assert.Contains(t, req.GetBm25Info().GetQueries()[0].GetWeights(), "milvus")
+		assert.Len(t, req.GetBm25Info().GetQueries()[1].GetWeights(), 2)
+	})
+
+	t.Run("shard failed", func(t *testing.T) {
+		qn := mocks.NewMockQueryNodeClient(t)
+		qn.EXPECT().GetStatistics(mock.Anything, mock.Anything).Return(nil, errors.New("mock error"))
+		lb := NewMockLBPolicy(t)
+		lb.EXPECT().Execute(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, workload CollectionWorkLoad) error {
+			return workload.exec(ctx, 1, qn, "ch1")
+		})
+		err := fillBM25SearchInfo(context.Background(), lb, schema, "default", "test_bm25", genRequest())
+		assert.Error(t, err)
+	})
+}
+
 func getSearchResultData(nq, topk int64) *schemapb.SearchResultData {
 	result := schemapb.SearchResultData{
 		NumQueries: nq,
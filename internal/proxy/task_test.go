@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/allocator"
 	"github.com/milvus-io/milvus/internal/mocks"
@@ -2717,6 +2718,50 @@ func TestDropResourceGroupTask(t *testing.T) {
 	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
 }
 
+func TestUpdateResourceGroupsTask(t *testing.T) {
+	rc := NewRootCoordMock()
+
+	defer rc.Close()
+	qc := getQueryCoordClient()
+	qc.EXPECT().UpdateResourceGroups(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+
+	ctx := context.Background()
+	mgr := newShardClientMgr()
+	InitMetaCache(ctx, rc, qc, mgr)
+
+	updateRGReq := &milvuspb.UpdateResourceGroupsRequest{
+		Base: &commonpb.MsgBase{
+			MsgID:     1,
+			Timestamp: 2,
+			TargetID:  3,
+		},
+		ResourceGroups: map[string]*rgpb.ResourceGroupConfig{
+			"rg": {
+				Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+				Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+			},
+		},
+	}
+
+	task := &UpdateResourceGroupsTask{
+		UpdateResourceGroupsRequest: updateRGReq,
+		ctx:                         ctx,
+		queryCoord:                  qc,
+	}
+	task.PreExecute(ctx)
+
+	assert.Equal(t, commonpb.MsgType_UpdateResourceGroups, task.Type())
+	assert.Equal(t, UniqueID(1), task.ID())
+	assert.Equal(t, Timestamp(2), task.BeginTs())
+	assert.Equal(t, Timestamp(2), task.EndTs())
+	assert.Equal(t, paramtable.GetNodeID(), task.Base.GetSourceID())
+	assert.Equal(t, UniqueID(3), task.Base.GetTargetID())
+
+	err := task.Execute(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+}
+
 func TestTransferNodeTask(t *testing.T) {
 	rc := NewRootCoordMock()
 
@@ -540,6 +540,7 @@ func (it *upsertTask) Execute(ctx context.Context) (err error) {
 		it.result.Status = merr.Status(err)
 		return err
 	}
+	globalQueryCache.NotifyDml(it.collectionID, it.EndTs())
 	sendMsgDur := tr.RecordSpan()
 	metrics.ProxySendMutationReqLatency.WithLabelValues(strconv.FormatInt(paramtable.GetNodeID(), 10), metrics.UpsertLabel).Observe(float64(sendMsgDur.Milliseconds()))
 	totalDur := tr.ElapseSpan()
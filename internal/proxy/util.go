@@ -36,6 +36,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	typeutil2 "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -368,6 +369,31 @@ func validateMaxCapacityPerRow(collectionName string, field *schemapb.FieldSchem
 	return nil
 }
 
+func validateAnalyzer(collectionName string, field *schemapb.FieldSchema) error {
+	enabled := false
+	for _, param := range field.GetTypeParams() {
+		if param.Key != common.EnableAnalyzerKey {
+			continue
+		}
+		var err error
+		enabled, err = strconv.ParseBool(param.Value)
+		if err != nil {
+			return fmt.Errorf("the value of %s must be a boolean", common.EnableAnalyzerKey)
+		}
+	}
+	if !enabled {
+		return nil
+	}
+	if field.GetDataType() != schemapb.DataType_VarChar {
+		return merr.WrapErrParameterInvalidMsg("analyzer can only be enabled on VarChar field, field %s of collection %s is %s",
+			field.GetName(), collectionName, field.GetDataType().String())
+	}
+	if _, err := bm25.NewFieldAnalyzer(field); err != nil {
+		return merr.WrapErrParameterInvalidMsg("invalid analyzer of field %s: %s", field.GetName(), err.Error())
+	}
+	return nil
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if !isVectorType(field.DataType) {
 		return nil
@@ -921,6 +947,31 @@ func GetRole(username string) ([]string, error) {
 	return globalMetaCache.GetUserRole(username), nil
 }
 
+// checkAdminPrivilege checks whether the current user is root or granted the admin role,
+// it's used by the requests which are not covered by the privilege interceptor.
+func checkAdminPrivilege(ctx context.Context) error {
+	if !Params.CommonCfg.AuthorizationEnabled.GetAsBool() {
+		return nil
+	}
+	username, err := GetCurUserFromContext(ctx)
+	if err != nil {
+		return merr.WrapErrPrivilegeNotAuthenticated(err.Error())
+	}
+	if username == util.UserRoot {
+		return nil
+	}
+	roleNames, err := GetRole(username)
+	if err != nil {
+		return err
+	}
+	for _, roleName := range roleNames {
+		if roleName == util.RoleAdmin {
+			return nil
+		}
+	}
+	return merr.WrapErrPrivilegeNotPermitted("only root or the users with admin role are permitted")
+}
+
 func PasswordVerify(ctx context.Context, username, rawPwd string) bool {
 	return passwordVerify(ctx, username, rawPwd, globalMetaCache)
 }
@@ -2053,6 +2053,48 @@ func Test_validateMaxCapacityPerRow(t *testing.T) {
 	})
 }
 
+func Test_validateAnalyzer(t *testing.T) {
+	newField := func(dataType schemapb.DataType, params ...*commonpb.KeyValuePair) *schemapb.FieldSchema {
+		return &schemapb.FieldSchema{
+			Name:       "text",
+			DataType:   dataType,
+			TypeParams: params,
+		}
+	}
+
+	t.Run("normal case", func(t *testing.T) {
+		field := newField(schemapb.DataType_VarChar,
+			&commonpb.KeyValuePair{Key: common.EnableAnalyzerKey, Value: "true"},
+			&commonpb.KeyValuePair{Key: common.AnalyzerParamsKey, Value: `{"tokenizer": "whitespace"}`})
+		assert.NoError(t, validateAnalyzer("collection", field))
+	})
+
+	t.Run("analyzer not enabled", func(t *testing.T) {
+		assert.NoError(t, validateAnalyzer("collection", newField(schemapb.DataType_Int64)))
+		assert.NoError(t, validateAnalyzer("collection", newField(schemapb.DataType_Int64,
+			&commonpb.KeyValuePair{Key: common.EnableAnalyzerKey, Value: "false"})))
+	})
+
+	t.Run("enable analyzer not bool", func(t *testing.T) {
+		field := newField(schemapb.DataType_VarChar,
+			&commonpb.KeyValuePair{Key: common.EnableAnalyzerKey, Value: "yes"})
+		assert.Error(t, validateAnalyzer("collection", field))
+	})
+
+	t.Run("not varchar", func(t *testing.T) {
+		field := newField(schemapb.DataType_JSON,
+			&commonpb.KeyValuePair{Key: common.EnableAnalyzerKey, Value: "true"})
+		assert.Error(t, validateAnalyzer("collection", field))
+	})
+
+	t.Run("invalid analyzer params", func(t *testing.T) {
+		field := newField(schemapb.DataType_VarChar,
+			&commonpb.KeyValuePair{Key: common.EnableAnalyzerKey, Value: "true"},
+			&commonpb.KeyValuePair{Key: common.AnalyzerParamsKey, Value: `{"tokenizer": "unknown"}`})
+		assert.Error(t, validateAnalyzer("collection", field))
+	})
+}
+
 func TestSendReplicateMessagePack(t *testing.T) {
 	ctx := context.Background()
 	mockStream := msgstream.NewMockMsgStream(t)
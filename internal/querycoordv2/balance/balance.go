@@ -53,6 +53,8 @@ var (
 	RoundRobinBalancerName    = "RoundRobinBalancer"
 	RowCountBasedBalancerName = "RowCountBasedBalancer"
 	ScoreBasedBalancerName    = "ScoreBasedBalancer"
+	MemoryBasedBalancerName   = "MemoryBasedBalancer"
+	WorkloadBasedBalancerName = "WorkloadBasedBalancer"
 	MultiTargetBalancerName   = "MultipleTargetBalancer"
 )
 
@@ -0,0 +1,221 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+const (
+	// same as the querynode, the DiskANN index takes 1/4 of its size in memory, the rest in disk
+	diskANNMemoryRatio = 4
+
+	// the failed describe collection is retried after the interval, rather than in each score calculation
+	fieldInfoRetryInterval = 10 * time.Second
+)
+
+// collectionFieldInfo is the per collection info used to estimate the resource usage of the segments
+type collectionFieldInfo struct {
+	createdAt  time.Time // the load time of the collection, the info is refreshed if the collection is reloaded
+	expireAt   time.Time // only set if failed to describe the collection
+	fieldSizes map[int64]uint64
+	rowSize    uint64
+	mmapFields typeutil.UniqueSet
+}
+
+// memory based balancer use the estimated memory and disk usage as node's score,
+// which is estimated from the schema, the index and the mmap settings like the querynode's checkSegmentSize,
+// the used memory reported by the node only guards against overloading the node.
+// so collections with different vector dims and index types end up with balanced memory.
+type MemoryBasedBalancer struct {
+	*ScoreBasedBalancer
+	broker     meta.Broker
+	fieldInfos *typeutil.ConcurrentMap[int64, *collectionFieldInfo]
+	describing *typeutil.ConcurrentSet[int64] // the collections being described
+}
+
+func NewMemoryBasedBalancer(scheduler task.Scheduler,
+	nodeManager *session.NodeManager,
+	dist *meta.DistributionManager,
+	meta *meta.Meta,
+	targetMgr *meta.TargetManager,
+	broker meta.Broker,
+) *MemoryBasedBalancer {
+	b := &MemoryBasedBalancer{
+		ScoreBasedBalancer: NewScoreBasedBalancer(scheduler, nodeManager, dist, meta, targetMgr),
+		broker:             broker,
+		fieldInfos:         typeutil.NewConcurrentMap[int64, *collectionFieldInfo](),
+		describing:         typeutil.NewConcurrentSet[int64](),
+	}
+	b.scorer = b
+	return b
+}
+
+// calculateScore returns the node's memory usage plus the weighted disk usage in bytes,
+// the memory usage of all collections is taken into account, as they share the node's memory.
+func (b *MemoryBasedBalancer) calculateScore(collectionID, nodeID int64) int {
+	memory, disk := uint64(0), uint64(0)
+	for _, s := range b.dist.SegmentDistManager.GetByNode(nodeID) {
+		segmentMemory, segmentDisk := b.estimateSegmentResource(s)
+		memory += segmentMemory
+		disk += segmentDisk
+	}
+
+	for _, view := range b.dist.GetLeaderView(nodeID) {
+		if info := b.getFieldInfo(view.CollectionID); info != nil {
+			memory += uint64(view.NumOfGrowingRows) * info.rowSize
+		}
+	}
+
+	// the reported used memory is only a guard against the memory which could not be estimated,
+	// e.g. the growing segments' index, the overloaded node is considered as full
+	if node := b.nodeManager.Get(nodeID); node != nil && isMemoryOverloaded(node) && node.MemoryTotal() > memory {
+		memory = node.MemoryTotal()
+	}
+	return int(float64(memory) + float64(disk)*params.Params.QueryCoordCfg.DiskUsageWeight.GetAsFloat())
+}
+
+// isMemoryOverloaded returns whether the used memory reported by the node exceeds the overloaded threshold
+func isMemoryOverloaded(node *session.NodeInfo) bool {
+	threshold := params.Params.QueryCoordCfg.OverloadedMemoryThresholdPercentage.GetAsFloat() / 100
+	return node.MemoryTotal() > 0 && float64(node.MemoryUsed()) >= float64(node.MemoryTotal())*threshold
+}
+
+// calculateSegmentScore calculate the score which the segment represented
+func (b *MemoryBasedBalancer) calculateSegmentScore(s *meta.Segment) int {
+	memory, disk := b.estimateSegmentResource(s)
+	return int(float64(memory) + float64(disk)*params.Params.QueryCoordCfg.DiskUsageWeight.GetAsFloat())
+}
+
+// estimateSegmentResource estimates the memory and disk usage of the segment after loaded,
+// the loaded index is used if the segment is in the distribution, otherwise the raw data size is used.
+// the mmap enabled fields take disk only.
+func (b *MemoryBasedBalancer) estimateSegmentResource(s *meta.Segment) (uint64, uint64) {
+	info := b.getFieldInfo(s.GetCollectionID())
+	if info == nil {
+		// use row count as a rough estimate, which keeps the segments spread if the schema is unavailable
+		return uint64(s.GetNumOfRows()), 0
+	}
+
+	memory, disk := uint64(0), uint64(0)
+	for fieldID, fieldSize := range info.fieldSizes {
+		fieldMemory, fieldDisk := fieldSize*uint64(s.GetNumOfRows()), uint64(0)
+		if indexInfo, ok := s.IndexInfo[fieldID]; ok {
+			fieldMemory, fieldDisk = estimateIndexResource(indexInfo)
+		}
+
+		if info.mmapFields.Contain(fieldID) {
+			disk += fieldMemory + fieldDisk
+		} else {
+			memory += fieldMemory
+			disk += fieldDisk
+		}
+	}
+	return memory, disk
+}
+
+// estimateIndexResource estimates the memory and disk usage of the index like the querynode,
+// all the indexes except DiskANN are considered as loaded in memory.
+func estimateIndexResource(indexInfo *querypb.FieldIndexInfo) (uint64, uint64) {
+	indexSize := uint64(indexInfo.GetIndexSize())
+	indexType, _ := funcutil.GetAttrByKeyFromRepeatedKV(common.IndexTypeKey, indexInfo.GetIndexParams())
+	if indexType == indexparamcheck.IndexDISKANN {
+		memory := indexSize / diskANNMemoryRatio
+		return memory, indexSize - memory
+	}
+	return uint64(float64(indexSize) * params.Params.QueryNodeCfg.MemoryIndexLoadPredictMemoryUsageFactor.GetAsFloat()), 0
+}
+
+func (b *MemoryBasedBalancer) getFieldInfo(collectionID int64) *collectionFieldInfo {
+	collection := b.meta.CollectionManager.GetCollection(collectionID)
+	if collection == nil {
+		b.fieldInfos.Remove(collectionID)
+		return nil
+	}
+
+	info, ok := b.fieldInfos.Get(collectionID)
+	if ok && info.createdAt.Equal(collection.CreatedAt) {
+		if info.expireAt.IsZero() {
+			return info
+		}
+		if time.Now().Before(info.expireAt) {
+			return nil
+		}
+	}
+
+	// describe the collection off the scoring path, the segments are estimated by row count until it's done
+	if b.describing.Insert(collectionID) {
+		go b.describeCollection(collectionID, collection.CreatedAt)
+	}
+	return nil
+}
+
+func (b *MemoryBasedBalancer) describeCollection(collectionID int64, createdAt time.Time) {
+	defer b.describing.Remove(collectionID)
+
+	ctx, cancel := context.WithTimeout(context.Background(), params.Params.QueryCoordCfg.BrokerTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	resp, err := b.broker.DescribeCollection(ctx, collectionID)
+	if err != nil {
+		log.Warn("failed to describe collection, estimate segment resource by row count",
+			zap.Int64("collectionID", collectionID), zap.Error(err))
+		b.fieldInfos.Insert(collectionID, &collectionFieldInfo{
+			createdAt: createdAt,
+			expireAt:  time.Now().Add(fieldInfoRetryInterval),
+		})
+		return
+	}
+
+	info := newCollectionFieldInfo(resp.GetSchema(), common.IsMmapEnabled(resp.GetProperties()...))
+	info.createdAt = createdAt
+	b.fieldInfos.Insert(collectionID, info)
+}
+
+func newCollectionFieldInfo(schema *schemapb.CollectionSchema, collectionMmapEnabled bool) *collectionFieldInfo {
+	info := &collectionFieldInfo{
+		fieldSizes: make(map[int64]uint64),
+		mmapFields: typeutil.NewUniqueSet(),
+	}
+	for _, field := range schema.GetFields() {
+		size, err := typeutil.EstimateAvgSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+		if err != nil {
+			continue
+		}
+		info.fieldSizes[field.GetFieldID()] = uint64(size)
+		info.rowSize += uint64(size)
+		// field mmap enabled if collection-level mmap enabled or the field mmap enabled
+		if collectionMmapEnabled || common.IsMmapEnabled(field.GetTypeParams()...) {
+			info.mmapFields.Insert(field.GetFieldID())
+		}
+	}
+	return info
+}
@@ -0,0 +1,264 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package balance
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/indexparamcheck"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type MemoryBasedBalancerTestSuite struct {
+	suite.Suite
+	balancer      *MemoryBasedBalancer
+	kv            kv.MetaKv
+	broker        *meta.MockBroker
+	mockScheduler *task.MockScheduler
+}
+
+func (suite *MemoryBasedBalancerTestSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *MemoryBasedBalancerTestSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+	suite.broker = meta.NewMockBroker(suite.T())
+
+	store := querycoord.NewCatalog(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	nodeManager := session.NewNodeManager()
+	testMeta := meta.NewMeta(idAllocator, store, nodeManager)
+	testTarget := meta.NewTargetManager(suite.broker, testMeta)
+
+	distManager := meta.NewDistributionManager()
+	suite.mockScheduler = task.NewMockScheduler(suite.T())
+	suite.balancer = NewMemoryBasedBalancer(suite.mockScheduler, nodeManager, distManager, testMeta, testTarget, suite.broker)
+}
+
+func (suite *MemoryBasedBalancerTestSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+// putCollection loads a collection with an int64 primary key and a float vector field,
+// the primary key takes 8 bytes and the vector takes dim * 4 bytes per row.
+func (suite *MemoryBasedBalancerTestSuite) putCollection(collectionID int64, dim string, mmapEnabled bool) {
+	collection := utils.CreateTestCollection(collectionID, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	suite.balancer.meta.CollectionManager.PutCollection(collection)
+
+	properties := []*commonpb.KeyValuePair{}
+	if mmapEnabled {
+		properties = append(properties, &commonpb.KeyValuePair{Key: common.MmapEnabledKey, Value: "true"})
+	}
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, collectionID).Return(&milvuspb.DescribeCollectionResponse{
+		Schema: &schemapb.CollectionSchema{
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+				{FieldID: 101, DataType: schemapb.DataType_FloatVector, TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: dim}}},
+			},
+		},
+		Properties: properties,
+	}, nil).Maybe()
+	suite.waitFieldInfo(collectionID)
+}
+
+// waitFieldInfo waits the collection described by the balancer in background
+func (suite *MemoryBasedBalancerTestSuite) waitFieldInfo(collectionID int64) {
+	suite.balancer.getFieldInfo(collectionID)
+	suite.Eventually(func() bool {
+		_, ok := suite.balancer.fieldInfos.Get(collectionID)
+		return ok && !suite.balancer.describing.Contain(collectionID)
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func (suite *MemoryBasedBalancerTestSuite) addNode(nodeID int64, memoryUsed, memoryTotal uint64) {
+	nodeInfo := session.NewNodeInfo(nodeID, "127.0.0.1:0")
+	nodeInfo.UpdateStats(session.WithMemoryUsed(memoryUsed), session.WithMemoryTotal(memoryTotal))
+	nodeInfo.SetState(session.NodeStateNormal)
+	suite.balancer.nodeManager.Add(nodeInfo)
+}
+
+func (suite *MemoryBasedBalancerTestSuite) TestEstimateSegmentResource() {
+	balancer := suite.balancer
+	suite.putCollection(1, "128", false)
+	suite.putCollection(2, "128", true)
+	memoryFactor := Params.QueryNodeCfg.MemoryIndexLoadPredictMemoryUsageFactor.GetAsFloat()
+
+	// raw data
+	memory, disk := balancer.estimateSegmentResource(&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 100, CollectionID: 1}})
+	suite.EqualValues(100*(8+512), memory)
+	suite.EqualValues(0, disk)
+
+	// memory index
+	segment := &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 100, CollectionID: 1},
+		IndexInfo: map[int64]*querypb.FieldIndexInfo{
+			101: {FieldID: 101, IndexSize: 10000, IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}}},
+		},
+	}
+	memory, disk = balancer.estimateSegmentResource(segment)
+	suite.EqualValues(100*8+uint64(10000*memoryFactor), memory)
+	suite.EqualValues(0, disk)
+
+	// disk index
+	segment.IndexInfo[101].IndexParams = []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: indexparamcheck.IndexDISKANN}}
+	memory, disk = balancer.estimateSegmentResource(segment)
+	suite.EqualValues(100*8+10000/diskANNMemoryRatio, memory)
+	suite.EqualValues(10000-10000/diskANNMemoryRatio, disk)
+
+	// mmap enabled
+	segment.CollectionID = 2
+	memory, disk = balancer.estimateSegmentResource(segment)
+	suite.EqualValues(0, memory)
+	suite.EqualValues(100*8+10000, disk)
+
+	// collection not loaded
+	memory, disk = balancer.estimateSegmentResource(&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 100, CollectionID: 3}})
+	suite.EqualValues(100, memory)
+	suite.EqualValues(0, disk)
+}
+
+func (suite *MemoryBasedBalancerTestSuite) TestDescribeCollectionFailed() {
+	balancer := suite.balancer
+	collection := utils.CreateTestCollection(1, 1)
+	balancer.meta.CollectionManager.PutCollection(collection)
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, int64(1)).Return(nil, errors.New("mock error")).Once()
+	suite.waitFieldInfo(1)
+
+	// estimate by row count, and not retry before the retry interval
+	for i := 0; i < 3; i++ {
+		memory, disk := balancer.estimateSegmentResource(&meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 100, CollectionID: 1}})
+		suite.EqualValues(100, memory)
+		suite.EqualValues(0, disk)
+	}
+}
+
+func (suite *MemoryBasedBalancerTestSuite) TestAssignSegment() {
+	balancer := suite.balancer
+	// collection 1 takes 520 bytes per row, collection 2 takes 40 bytes per row
+	suite.putCollection(1, "128", false)
+	suite.putCollection(2, "8", false)
+
+	// node 1 has less rows but takes more memory
+	balancer.dist.SegmentDistManager.Update(1, &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 1, NumOfRows: 1000, CollectionID: 1}, Node: 1})
+	balancer.dist.SegmentDistManager.Update(2, &meta.Segment{SegmentInfo: &datapb.SegmentInfo{ID: 2, NumOfRows: 10000, CollectionID: 2}, Node: 2})
+	suite.addNode(1, 0, 0)
+	suite.addNode(2, 0, 0)
+
+	toAssign := []*meta.Segment{{SegmentInfo: &datapb.SegmentInfo{ID: 3, NumOfRows: 100, CollectionID: 2}}}
+	plans := balancer.AssignSegment(2, toAssign, []int64{1, 2})
+	suite.Len(plans, 1)
+	suite.Equal(int64(2), plans[0].To)
+
+	// the reported used memory is larger than the estimated, but the node is not overloaded
+	suite.addNode(2, 5*1024*1024, 10*1024*1024)
+	plans = balancer.AssignSegment(2, toAssign, []int64{1, 2})
+	suite.Len(plans, 1)
+	suite.Equal(int64(2), plans[0].To)
+
+	// the node is overloaded by the reported used memory
+	suite.addNode(2, 10*1024*1024, 10*1024*1024)
+	plans = balancer.AssignSegment(2, toAssign, []int64{1, 2})
+	suite.Len(plans, 1)
+	suite.Equal(int64(1), plans[0].To)
+
+	// the growing rows takes memory
+	suite.addNode(2, 0, 0)
+	balancer.dist.LeaderViewManager.Update(1, &meta.LeaderView{ID: 1, CollectionID: 1, NumOfGrowingRows: 1000})
+	balancer.dist.LeaderViewManager.Update(2, &meta.LeaderView{ID: 2, CollectionID: 2, NumOfGrowingRows: 1000})
+	suite.Equal(1000*520*2, balancer.calculateScore(2, 1))
+	suite.Equal(11000*40, balancer.calculateScore(2, 2))
+}
+
+func (suite *MemoryBasedBalancerTestSuite) TestBalanceReplica() {
+	balancer := suite.balancer
+	suite.putCollection(1, "128", false)
+	balancer.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+
+	// the same row count, while the segments on node 1 are loaded without index
+	hnsw := func(indexSize int64) map[int64]*querypb.FieldIndexInfo {
+		return map[int64]*querypb.FieldIndexInfo{
+			101: {FieldID: 101, IndexSize: indexSize, IndexParams: []*commonpb.KeyValuePair{{Key: common.IndexTypeKey, Value: "HNSW"}}},
+		}
+	}
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, NumOfRows: 1000, CollectionID: 1, PartitionID: 1},
+		{ID: 2, NumOfRows: 1000, CollectionID: 1, PartitionID: 1},
+		{ID: 3, NumOfRows: 1000, CollectionID: 1, PartitionID: 1},
+		{ID: 4, NumOfRows: 1000, CollectionID: 1, PartitionID: 1},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(nil, segments, nil)
+	suite.broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{1}, nil).Maybe()
+	balancer.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	balancer.targetMgr.UpdateCollectionNextTarget(1)
+	balancer.targetMgr.UpdateCollectionCurrentTarget(1)
+	balancer.targetMgr.UpdateCollectionNextTarget(1)
+
+	balancer.dist.SegmentDistManager.Update(1,
+		&meta.Segment{SegmentInfo: segments[0], Node: 1},
+		&meta.Segment{SegmentInfo: segments[1], Node: 1},
+	)
+	balancer.dist.SegmentDistManager.Update(2,
+		&meta.Segment{SegmentInfo: segments[2], Node: 2, IndexInfo: hnsw(1000)},
+		&meta.Segment{SegmentInfo: segments[3], Node: 2, IndexInfo: hnsw(1000)},
+	)
+	for _, node := range []int64{1, 2} {
+		suite.addNode(node, 0, 0)
+		balancer.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, node)
+	}
+
+	segmentPlans, channelPlans := balancer.BalanceReplica(balancer.meta.ReplicaManager.Get(1))
+	suite.Empty(channelPlans)
+	suite.Len(segmentPlans, 1)
+	suite.Equal(int64(1), segmentPlans[0].From)
+	suite.Equal(int64(2), segmentPlans[0].To)
+}
+
+func TestMemoryBasedBalancerSuite(t *testing.T) {
+	suite.Run(t, new(MemoryBasedBalancerTestSuite))
+}
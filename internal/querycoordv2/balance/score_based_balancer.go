@@ -36,6 +36,14 @@ import (
 // and try to make each node has almost same score through balance segment.
 type ScoreBasedBalancer struct {
 	*RowCountBasedBalancer
+	scorer nodeScorer
+}
+
+// nodeScorer calculates the node's score and the score which the segment represented,
+// the ScoreBasedBalancer try to make each node has almost same score with them.
+type nodeScorer interface {
+	calculateScore(collectionID, nodeID int64) int
+	calculateSegmentScore(s *meta.Segment) int
 }
 
 func NewScoreBasedBalancer(scheduler task.Scheduler,
@@ -44,9 +52,11 @@ func NewScoreBasedBalancer(scheduler task.Scheduler,
 	meta *meta.Meta,
 	targetMgr *meta.TargetManager,
 ) *ScoreBasedBalancer {
-	return &ScoreBasedBalancer{
+	b := &ScoreBasedBalancer{
 		RowCountBasedBalancer: NewRowCountBasedBalancer(scheduler, nodeManager, dist, meta, targetMgr),
 	}
+	b.scorer = b
+	return b
 }
 
 // AssignSegment got a segment list, and try to assign each segment to node's with lowest score
@@ -63,16 +73,17 @@ func (b *ScoreBasedBalancer) AssignSegment(collectionID int64, segments []*meta.
 		queue.push(item)
 	}
 
-	// sort segments by segment row count, if segment has same row count, sort by node's score
+	// sort segments by segment score, if segment has same score, sort by node's score
+	segmentScores := lo.SliceToMap(segments, func(s *meta.Segment) (int64, int) { return s.GetID(), b.scorer.calculateSegmentScore(s) })
 	sort.Slice(segments, func(i, j int) bool {
-		if segments[i].GetNumOfRows() == segments[j].GetNumOfRows() {
+		if segmentScores[segments[i].GetID()] == segmentScores[segments[j].GetID()] {
 			node1 := nodeItemsMap[segments[i].Node]
 			node2 := nodeItemsMap[segments[j].Node]
 			if node1 != nil && node2 != nil {
 				return node1.getPriority() > node2.getPriority()
 			}
 		}
-		return segments[i].GetNumOfRows() > segments[j].GetNumOfRows()
+		return segmentScores[segments[i].GetID()] > segmentScores[segments[j].GetID()]
 	})
 
 	plans := make([]SegmentAssignPlan, 0, len(segments))
@@ -82,7 +93,7 @@ func (b *ScoreBasedBalancer) AssignSegment(collectionID int64, segments []*meta.
 			targetNode := queue.pop().(*nodeItem)
 			// make sure candidate is always push back
 			defer queue.push(targetNode)
-			priorityChange := b.calculateSegmentScore(s)
+			priorityChange := segmentScores[s.GetID()]
 
 			sourceNode := nodeItemsMap[s.Node]
 			// if segment's node exist, which means this segment comes from balancer. we should consider the benefit
@@ -131,7 +142,7 @@ func (b *ScoreBasedBalancer) convertToNodeItems(collectionID int64, nodeIDs []in
 	ret := make([]*nodeItem, 0, len(nodeIDs))
 	for _, nodeInfo := range b.getNodes(nodeIDs) {
 		node := nodeInfo.ID()
-		priority := b.calculateScore(collectionID, node)
+		priority := b.scorer.calculateScore(collectionID, node)
 		nodeItem := newNodeItem(priority, node)
 		ret = append(ret, &nodeItem)
 	}
@@ -184,24 +195,7 @@ func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 		return nil, nil
 	}
 
-	outboundNodes := b.meta.ResourceManager.CheckOutboundNodes(replica)
-	onlineNodes := make([]int64, 0)
-	offlineNodes := make([]int64, 0)
-	for _, nid := range nodes {
-		if isStopping, err := b.nodeManager.IsStoppingNode(nid); err != nil {
-			log.Info("not existed node", zap.Int64("nid", nid), zap.Error(err))
-			continue
-		} else if isStopping {
-			offlineNodes = append(offlineNodes, nid)
-		} else if outboundNodes.Contain(nid) {
-			// if node is stop or transfer to other rg
-			log.RatedInfo(10, "meet outbound node, try to move out all segment/channel", zap.Int64("node", nid))
-			offlineNodes = append(offlineNodes, nid)
-		} else {
-			onlineNodes = append(onlineNodes, nid)
-		}
-	}
-
+	onlineNodes, offlineNodes := b.classifyNodes(replica)
 	if len(nodes) == len(offlineNodes) || len(onlineNodes) == 0 {
 		// no available nodes to balance
 		return nil, nil
@@ -232,6 +226,34 @@ func (b *ScoreBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAss
 	return segmentPlans, channelPlans
 }
 
+// classifyNodes splits the nodes of the replica into the online nodes and the offline nodes,
+// which are stopping or transferred to other resource group.
+func (b *ScoreBasedBalancer) classifyNodes(replica *meta.Replica) ([]int64, []int64) {
+	log := log.With(
+		zap.Int64("collection", replica.CollectionID),
+		zap.Int64("replica id", replica.Replica.GetID()),
+		zap.String("replica group", replica.Replica.GetResourceGroup()),
+	)
+	outboundNodes := b.meta.ResourceManager.CheckOutboundNodes(replica)
+	onlineNodes := make([]int64, 0)
+	offlineNodes := make([]int64, 0)
+	for _, nid := range replica.GetNodes() {
+		if isStopping, err := b.nodeManager.IsStoppingNode(nid); err != nil {
+			log.Info("not existed node", zap.Int64("nid", nid), zap.Error(err))
+			continue
+		} else if isStopping {
+			offlineNodes = append(offlineNodes, nid)
+		} else if outboundNodes.Contain(nid) {
+			// if node is stop or transfer to other rg
+			log.RatedInfo(10, "meet outbound node, try to move out all segment/channel", zap.Int64("node", nid))
+			offlineNodes = append(offlineNodes, nid)
+		} else {
+			onlineNodes = append(onlineNodes, nid)
+		}
+	}
+	return onlineNodes, offlineNodes
+}
+
 func (b *ScoreBasedBalancer) genStoppingSegmentPlan(replica *meta.Replica, onlineNodes []int64, offlineNodes []int64) []SegmentAssignPlan {
 	segmentPlans := make([]SegmentAssignPlan, 0)
 	for _, nodeID := range offlineNodes {
@@ -266,9 +288,9 @@ func (b *ScoreBasedBalancer) genSegmentPlan(replica *meta.Replica, onlineNodes [
 		})
 		segmentDist[node] = segments
 
-		rowCount := b.calculateScore(replica.CollectionID, node)
-		totalScore += rowCount
-		nodeScore[node] = rowCount
+		score := b.scorer.calculateScore(replica.CollectionID, node)
+		totalScore += score
+		nodeScore[node] = score
 	}
 
 	if totalScore == 0 {
@@ -284,12 +306,13 @@ func (b *ScoreBasedBalancer) genSegmentPlan(replica *meta.Replica, onlineNodes [
 			continue
 		}
 
+		segmentScores := lo.SliceToMap(segments, func(s *meta.Segment) (int64, int) { return s.GetID(), b.scorer.calculateSegmentScore(s) })
 		sort.Slice(segments, func(i, j int) bool {
-			return segments[i].GetNumOfRows() < segments[j].GetNumOfRows()
+			return segmentScores[segments[i].GetID()] < segmentScores[segments[j].GetID()]
 		})
 		for _, s := range segments {
 			segmentsToMove = append(segmentsToMove, s)
-			leftScore -= b.calculateSegmentScore(s)
+			leftScore -= segmentScores[s.GetID()]
 			if leftScore <= average {
 				break
 			}
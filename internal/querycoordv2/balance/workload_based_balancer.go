@@ -0,0 +1,232 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package balance
+
+import (
+	"sort"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	"github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// workload based balancer moves segments and delegators to even out the search cost measured on the nodes,
+// rather than the row count, so the hot collections are not stacked on the same nodes.
+// To avoid thrashing, it starts only if a node's cost exceeds the average by the unbalance threshold,
+// moves until the nodes are within the smaller tolerance, and waits for the cooldown before balancing the replica again,
+// as the measured load takes time to converge after moving.
+// The segments are assigned by row count as the score based balancer, as there is no load measured before loading.
+type WorkloadBasedBalancer struct {
+	*ScoreBasedBalancer
+	lastBalanceTime *typeutil.ConcurrentMap[int64, time.Time] // replicaID -> last time of generating plans
+}
+
+func NewWorkloadBasedBalancer(scheduler task.Scheduler,
+	nodeManager *session.NodeManager,
+	dist *meta.DistributionManager,
+	meta *meta.Meta,
+	targetMgr *meta.TargetManager,
+) *WorkloadBasedBalancer {
+	return &WorkloadBasedBalancer{
+		ScoreBasedBalancer: NewScoreBasedBalancer(scheduler, nodeManager, dist, meta, targetMgr),
+		lastBalanceTime:    typeutil.NewConcurrentMap[int64, time.Time](),
+	}
+}
+
+func (b *WorkloadBasedBalancer) BalanceReplica(replica *meta.Replica) ([]SegmentAssignPlan, []ChannelAssignPlan) {
+	log := log.With(
+		zap.Int64("collection", replica.CollectionID),
+		zap.Int64("replica id", replica.Replica.GetID()),
+		zap.String("replica group", replica.Replica.GetResourceGroup()),
+	)
+	nodes := replica.GetNodes()
+	if len(nodes) == 0 {
+		return nil, nil
+	}
+
+	onlineNodes, offlineNodes := b.classifyNodes(replica)
+	if len(onlineNodes) == 0 {
+		return nil, nil
+	}
+	// segments and channels on stopping nodes are moved out as the score based balancer
+	if len(offlineNodes) != 0 {
+		return b.ScoreBasedBalancer.BalanceReplica(replica)
+	}
+
+	if lastBalanceTime, ok := b.lastBalanceTime.Get(replica.GetID()); ok &&
+		time.Since(lastBalanceTime) < params.Params.QueryCoordCfg.WorkloadBalanceCooldown.GetAsDuration(time.Second) {
+		return nil, nil
+	}
+
+	nodeCosts := b.getNodeCosts(onlineNodes)
+	if !isWorkloadUnbalanced(nodeCosts) {
+		return nil, nil
+	}
+
+	segmentPlans, channelPlans := make([]SegmentAssignPlan, 0), make([]ChannelAssignPlan, 0)
+	if paramtable.Get().QueryCoordCfg.AutoBalanceChannel.GetAsBool() {
+		channelPlans = append(channelPlans, b.genWorkloadChannelPlan(replica, nodeCosts)...)
+	}
+	if len(channelPlans) == 0 {
+		segmentPlans = append(segmentPlans, b.genWorkloadSegmentPlan(replica, nodeCosts)...)
+	}
+
+	if len(segmentPlans) != 0 || len(channelPlans) != 0 {
+		log.Info("generate workload balance plans",
+			zap.Any("nodeCosts", nodeCosts),
+			zap.Int("segmentPlans", len(segmentPlans)),
+			zap.Int("channelPlans", len(channelPlans)),
+		)
+		b.lastBalanceTime.Insert(replica.GetID(), time.Now())
+	}
+	return segmentPlans, channelPlans
+}
+
+// getNodeCosts returns the search cost of all collections on each node, as they share the node's CPU
+func (b *WorkloadBasedBalancer) getNodeCosts(nodes []int64) map[int64]float64 {
+	nodeCosts := make(map[int64]float64, len(nodes))
+	for _, node := range nodes {
+		nodeCosts[node] = 0
+		if nodeInfo := b.nodeManager.Get(node); nodeInfo != nil {
+			for _, load := range nodeInfo.SearchLoads() {
+				nodeCosts[node] += load.GetCost()
+			}
+		}
+	}
+	return nodeCosts
+}
+
+func averageCost(nodeCosts map[int64]float64) float64 {
+	total := float64(0)
+	for _, cost := range nodeCosts {
+		total += cost
+	}
+	return total / float64(len(nodeCosts))
+}
+
+// isWorkloadUnbalanced returns whether the cost of any node exceeds the average by the unbalance threshold
+func isWorkloadUnbalanced(nodeCosts map[int64]float64) bool {
+	if len(nodeCosts) < 2 {
+		return false
+	}
+	average := averageCost(nodeCosts)
+	if average == 0 {
+		return false
+	}
+	threshold := average * (1 + params.Params.QueryCoordCfg.WorkloadUnbalanceThreshold.GetAsFloat())
+	return lo.SomeBy(lo.Values(nodeCosts), func(cost float64) bool { return cost > threshold })
+}
+
+// getHotNodes returns the nodes whose cost exceeds the target, sorted by cost in descending order
+func getHotNodes(nodeCosts map[int64]float64, target float64) []int64 {
+	hotNodes := lo.Filter(lo.Keys(nodeCosts), func(node int64, _ int) bool { return nodeCosts[node] > target })
+	sort.Slice(hotNodes, func(i, j int) bool { return nodeCosts[hotNodes[i]] > nodeCosts[hotNodes[j]] })
+	return hotNodes
+}
+
+// getColdestNode returns the node with the least cost
+func getColdestNode(nodeCosts map[int64]float64) int64 {
+	return lo.MinBy(lo.Keys(nodeCosts), func(a, b int64) bool {
+		if nodeCosts[a] == nodeCosts[b] {
+			return a < b
+		}
+		return nodeCosts[a] < nodeCosts[b]
+	})
+}
+
+// genWorkloadSegmentPlan moves the segments of the replica from the hot nodes to the coldest node,
+// until the hot nodes are within the tolerance of the average, the coldest node never exceeds it after moving.
+func (b *WorkloadBasedBalancer) genWorkloadSegmentPlan(replica *meta.Replica, nodeCosts map[int64]float64) []SegmentAssignPlan {
+	target := averageCost(nodeCosts) * (1 + params.Params.QueryCoordCfg.WorkloadBalanceTolerance.GetAsFloat())
+
+	segmentPlans := make([]SegmentAssignPlan, 0)
+	for _, node := range getHotNodes(nodeCosts, target) {
+		dist := b.dist.SegmentDistManager.GetByCollectionAndNode(replica.GetCollectionID(), node)
+		segments := lo.Filter(dist, func(segment *meta.Segment, _ int) bool {
+			return b.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.CurrentTarget) != nil &&
+				b.targetMgr.GetSealedSegment(segment.GetCollectionID(), segment.GetID(), meta.NextTarget) != nil &&
+				segment.GetLevel() != datapb.SegmentLevel_L0 &&
+				segment.SearchLoad.GetCost() > 0 &&
+				// if the segment are redundant in the replica, skip it's balance for now
+				lo.CountBy(b.dist.SegmentDistManager.Get(segment.GetID()), func(s *meta.Segment) bool { return replica.Contains(s.Node) }) == 1
+		})
+		sort.Slice(segments, func(i, j int) bool {
+			return segments[i].SearchLoad.GetCost() > segments[j].SearchLoad.GetCost()
+		})
+
+		for _, s := range segments {
+			if nodeCosts[node] <= target {
+				break
+			}
+			cost := s.SearchLoad.GetCost()
+			coldest := getColdestNode(nodeCosts)
+			if coldest == node || nodeCosts[coldest]+cost > target {
+				continue
+			}
+			segmentPlans = append(segmentPlans, SegmentAssignPlan{
+				Segment:   s,
+				ReplicaID: replica.GetID(),
+				From:      node,
+				To:        coldest,
+			})
+			nodeCosts[node] -= cost
+			nodeCosts[coldest] += cost
+		}
+	}
+	return segmentPlans
+}
+
+// genWorkloadChannelPlan moves at most one delegator of the replica from the hottest node to the coldest node,
+// as moving delegator is much more expensive than moving segment.
+func (b *WorkloadBasedBalancer) genWorkloadChannelPlan(replica *meta.Replica, nodeCosts map[int64]float64) []ChannelAssignPlan {
+	target := averageCost(nodeCosts) * (1 + params.Params.QueryCoordCfg.WorkloadBalanceTolerance.GetAsFloat())
+
+	for _, node := range getHotNodes(nodeCosts, target) {
+		coldest := getColdestNode(nodeCosts)
+		if coldest == node {
+			continue
+		}
+		channels := b.dist.ChannelDistManager.GetByCollectionAndNode(replica.GetCollectionID(), node)
+		for _, channel := range channels {
+			view := b.dist.LeaderViewManager.GetLeaderShardView(node, channel.GetChannelName())
+			if view == nil {
+				continue
+			}
+			cost := view.SearchLoad.GetCost()
+			// the delegator is moved only if the coldest node is still within the tolerance after moving
+			if cost == 0 || nodeCosts[coldest]+cost > target {
+				continue
+			}
+			return []ChannelAssignPlan{{
+				Channel:   channel,
+				ReplicaID: replica.GetID(),
+				From:      node,
+				To:        coldest,
+			}}
+		}
+	}
+	return nil
+}
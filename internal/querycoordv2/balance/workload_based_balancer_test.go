@@ -0,0 +1,191 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//	http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+package balance
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
+	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
+	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/task"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/util/etcd"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+type WorkloadBasedBalancerTestSuite struct {
+	suite.Suite
+	balancer      *WorkloadBasedBalancer
+	kv            kv.MetaKv
+	broker        *meta.MockBroker
+	mockScheduler *task.MockScheduler
+}
+
+func (suite *WorkloadBasedBalancerTestSuite) SetupSuite() {
+	paramtable.Init()
+}
+
+func (suite *WorkloadBasedBalancerTestSuite) SetupTest() {
+	var err error
+	config := GenerateEtcdConfig()
+	cli, err := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	suite.Require().NoError(err)
+	suite.kv = etcdkv.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+	suite.broker = meta.NewMockBroker(suite.T())
+
+	store := querycoord.NewCatalog(suite.kv)
+	idAllocator := RandomIncrementIDAllocator()
+	nodeManager := session.NewNodeManager()
+	testMeta := meta.NewMeta(idAllocator, store, nodeManager)
+	testTarget := meta.NewTargetManager(suite.broker, testMeta)
+
+	distManager := meta.NewDistributionManager()
+	suite.mockScheduler = task.NewMockScheduler(suite.T())
+	suite.balancer = NewWorkloadBasedBalancer(suite.mockScheduler, nodeManager, distManager, testMeta, testTarget)
+
+	// load collection 1 with segment 1~4 on node 1~3
+	balancer := suite.balancer
+	collection := utils.CreateTestCollection(1, 1)
+	collection.Status = querypb.LoadStatus_Loaded
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, NumOfRows: 10, CollectionID: 1, PartitionID: 1},
+		{ID: 2, NumOfRows: 10, CollectionID: 1, PartitionID: 1},
+		{ID: 3, NumOfRows: 10, CollectionID: 1, PartitionID: 1},
+		{ID: 4, NumOfRows: 10, CollectionID: 1, PartitionID: 1},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(nil, segments, nil)
+	suite.broker.EXPECT().GetPartitions(mock.Anything, int64(1)).Return([]int64{1}, nil).Maybe()
+	balancer.meta.CollectionManager.PutCollection(collection)
+	balancer.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	balancer.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2, 3}))
+	balancer.targetMgr.UpdateCollectionNextTarget(1)
+	balancer.targetMgr.UpdateCollectionCurrentTarget(1)
+	balancer.targetMgr.UpdateCollectionNextTarget(1)
+	for _, node := range []int64{1, 2, 3} {
+		nodeInfo := session.NewNodeInfo(node, "127.0.0.1:0")
+		nodeInfo.SetState(session.NodeStateNormal)
+		balancer.nodeManager.Add(nodeInfo)
+		balancer.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, node)
+	}
+}
+
+func (suite *WorkloadBasedBalancerTestSuite) TearDownTest() {
+	suite.kv.Close()
+}
+
+func (suite *WorkloadBasedBalancerTestSuite) setNodeCosts(costs ...float64) {
+	for i, cost := range costs {
+		suite.balancer.nodeManager.Get(int64(i + 1)).UpdateStats(session.WithSearchLoads(map[int64]*querypb.SearchLoad{
+			1: {Cost: cost},
+		}))
+	}
+}
+
+func newLoadedSegment(id int64, node int64, cost float64) *meta.Segment {
+	return &meta.Segment{
+		SegmentInfo: &datapb.SegmentInfo{ID: id, NumOfRows: 10, CollectionID: 1, PartitionID: 1},
+		Node:        node,
+		SearchLoad:  &querypb.SearchLoad{Cost: cost},
+	}
+}
+
+func (suite *WorkloadBasedBalancerTestSuite) TestBalanceSegment() {
+	balancer := suite.balancer
+	balancer.dist.SegmentDistManager.Update(1,
+		newLoadedSegment(1, 1, 30),
+		newLoadedSegment(2, 1, 20),
+		newLoadedSegment(3, 1, 10),
+	)
+	balancer.dist.SegmentDistManager.Update(2, newLoadedSegment(4, 2, 0))
+	replica := balancer.meta.ReplicaManager.Get(1)
+
+	// within the unbalance threshold
+	suite.setNodeCosts(25, 20, 15)
+	segmentPlans, channelPlans := balancer.BalanceReplica(replica)
+	suite.Empty(segmentPlans)
+	suite.Empty(channelPlans)
+
+	// no workload
+	suite.setNodeCosts(0, 0, 0)
+	segmentPlans, channelPlans = balancer.BalanceReplica(replica)
+	suite.Empty(segmentPlans)
+	suite.Empty(channelPlans)
+
+	// average is 20 and target is 22, segment 1 is too hot to move
+	suite.setNodeCosts(60, 0, 0)
+	segmentPlans, channelPlans = balancer.BalanceReplica(replica)
+	suite.Empty(channelPlans)
+	suite.ElementsMatch([]SegmentAssignPlan{
+		{Segment: newLoadedSegment(2, 1, 20), ReplicaID: 1, From: 1, To: 2},
+		{Segment: newLoadedSegment(3, 1, 10), ReplicaID: 1, From: 1, To: 3},
+	}, segmentPlans)
+
+	// cooldown after balance
+	segmentPlans, channelPlans = balancer.BalanceReplica(replica)
+	suite.Empty(segmentPlans)
+	suite.Empty(channelPlans)
+
+	paramtable.Get().Save(Params.QueryCoordCfg.WorkloadBalanceCooldown.Key, "0")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.WorkloadBalanceCooldown.Key)
+	segmentPlans, _ = balancer.BalanceReplica(replica)
+	suite.Len(segmentPlans, 2)
+}
+
+func (suite *WorkloadBasedBalancerTestSuite) TestBalanceChannel() {
+	balancer := suite.balancer
+	paramtable.Get().Save(Params.QueryCoordCfg.AutoBalanceChannel.Key, "true")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.AutoBalanceChannel.Key)
+	balancer.dist.SegmentDistManager.Update(1, newLoadedSegment(1, 1, 5))
+	channels := []*meta.DmChannel{
+		{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "channel-1"}, Node: 1},
+		{VchannelInfo: &datapb.VchannelInfo{CollectionID: 1, ChannelName: "channel-2"}, Node: 1},
+	}
+	balancer.dist.ChannelDistManager.Update(1, channels...)
+	balancer.dist.LeaderViewManager.Update(1,
+		&meta.LeaderView{ID: 1, CollectionID: 1, Channel: "channel-1", SearchLoad: &querypb.SearchLoad{Cost: 40}},
+		&meta.LeaderView{ID: 1, CollectionID: 1, Channel: "channel-2", SearchLoad: &querypb.SearchLoad{Cost: 25}},
+	)
+	replica := balancer.meta.ReplicaManager.Get(1)
+
+	// average is 25 and target is 27.5, channel 1 is too hot to move
+	suite.setNodeCosts(75, 0, 0)
+	segmentPlans, channelPlans := balancer.BalanceReplica(replica)
+	suite.Empty(segmentPlans)
+	suite.Len(channelPlans, 1)
+	suite.Equal("channel-2", channelPlans[0].Channel.GetChannelName())
+	suite.Equal(int64(1), channelPlans[0].From)
+	suite.Equal(int64(2), channelPlans[0].To)
+}
+
+func TestWorkloadBasedBalancerSuite(t *testing.T) {
+	suite.Run(t, new(WorkloadBasedBalancerTestSuite))
+}
@@ -96,6 +96,10 @@ func (c *ChannelChecker) Check(ctx context.Context) []task.Task {
 	releaseTasks := c.createChannelReduceTasks(ctx, released, -1)
 	task.SetReason("collection released", releaseTasks...)
 	tasks = append(tasks, releaseTasks...)
+
+	releaseTasks = c.createStandbyReduceTasks(ctx, c.findStaleStandbys(), -1)
+	task.SetReason("standby delegator out of replica", releaseTasks...)
+	tasks = append(tasks, releaseTasks...)
 	return tasks
 }
 
@@ -103,6 +107,10 @@ func (c *ChannelChecker) checkReplica(ctx context.Context, replica *meta.Replica
 	ret := make([]task.Task, 0)
 
 	lacks, redundancies := c.getDmChannelDiff(replica.GetCollectionID(), replica.GetID())
+	// the lacked channels with standby delegator are promoted by leader checker, rather than subscribed from the checkpoint
+	lacks = lo.Filter(lacks, func(ch *meta.DmChannel, _ int) bool {
+		return getPromotableStandby(c.dist, c.nodeMgr, replica, ch.GetChannelName()) == nil
+	})
 	tasks := c.createChannelLoadTask(c.getTraceCtx(ctx, replica.CollectionID), lacks, replica)
 	task.SetReason("lacks of channel", tasks...)
 	ret = append(ret, tasks...)
@@ -118,6 +126,91 @@ func (c *ChannelChecker) checkReplica(ctx context.Context, replica *meta.Replica
 
 	// All channel related tasks should be with high priority
 	task.SetPriority(task.TaskPriorityHigh, tasks...)
+
+	ret = append(ret, c.checkStandby(c.getTraceCtx(ctx, replica.CollectionID), replica)...)
+	return ret
+}
+
+// checkStandby keeps a standby delegator for each serving shard of the replica if the collection enables it,
+// which is on the other node than the serving delegator, and releases the redundant standby delegators.
+func (c *ChannelChecker) checkStandby(ctx context.Context, replica *meta.Replica) []task.Task {
+	ret := make([]task.Task, 0)
+	collection := c.meta.GetCollection(replica.GetCollectionID())
+	if collection == nil {
+		return ret
+	}
+
+	nextTargetMap := c.targetMgr.GetDmChannelsByCollection(replica.GetCollectionID(), meta.NextTarget)
+	currentTargetMap := c.targetMgr.GetDmChannelsByCollection(replica.GetCollectionID(), meta.CurrentTarget)
+
+	standbys := make(map[string]*meta.LeaderView)
+	redundancies := make([]*meta.LeaderView, 0)
+	for _, node := range replica.GetNodes() {
+		// the standby delegators on stopping node are released, and created on the other nodes
+		stopping, _ := c.nodeMgr.IsStoppingNode(node)
+		for ch, view := range c.dist.StandbyViewManager.GetByCollectionAndNode(replica.GetCollectionID(), node) {
+			_, existOnCurrent := currentTargetMap[ch]
+			_, existOnNext := nextTargetMap[ch]
+			_, repeated := standbys[ch]
+			if !collection.GetStandbyDelegator() || stopping || repeated || (!existOnCurrent && !existOnNext) {
+				redundancies = append(redundancies, view)
+				continue
+			}
+			standbys[ch] = view
+		}
+	}
+	tasks := c.createStandbyReduceTasks(ctx, redundancies, replica.GetID())
+	task.SetReason("redundancies of standby delegator", tasks...)
+	ret = append(ret, tasks...)
+
+	if !collection.GetStandbyDelegator() {
+		return ret
+	}
+	outboundNodes := c.meta.ResourceManager.CheckOutboundNodes(replica)
+	for ch, leader := range c.dist.ChannelDistManager.GetShardLeadersByReplica(replica) {
+		channel, ok := nextTargetMap[ch]
+		if _, exist := standbys[ch]; exist || !ok {
+			continue
+		}
+		availableNodes := lo.Filter(replica.GetNodes(), func(node int64, _ int) bool {
+			stopping, err := c.nodeMgr.IsStoppingNode(node)
+			return node != leader && !outboundNodes.Contain(node) && err == nil && !stopping
+		})
+		if len(availableNodes) == 0 {
+			continue
+		}
+		plans := c.balancer.AssignChannel([]*meta.DmChannel{channel}, availableNodes)
+		for _, plan := range plans {
+			action := task.NewStandbyChannelAction(plan.To, task.ActionTypeGrow, ch)
+			t, err := task.NewChannelTask(ctx, Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond), c.ID(), replica.GetCollectionID(), replica.GetID(), action)
+			if err != nil {
+				log.Warn("create standby channel task failed",
+					zap.Int64("collection", replica.GetCollectionID()),
+					zap.Int64("replica", replica.GetID()),
+					zap.String("channel", ch),
+					zap.Int64("to", plan.To),
+					zap.Error(err),
+				)
+				continue
+			}
+			t.SetReason("lacks of standby delegator")
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+// findStaleStandbys returns the standby delegators whose node is not in any replica of the collection,
+// including the ones of the released collections.
+func (c *ChannelChecker) findStaleStandbys() []*meta.LeaderView {
+	ret := make([]*meta.LeaderView, 0)
+	for _, node := range c.nodeMgr.GetAll() {
+		for _, view := range c.dist.StandbyViewManager.GetLeaderView(node.ID()) {
+			if c.meta.ReplicaManager.GetByCollectionAndNode(view.CollectionID, node.ID()) == nil {
+				ret = append(ret, view)
+			}
+		}
+	}
 	return ret
 }
 
@@ -249,6 +342,26 @@ func (c *ChannelChecker) createChannelReduceTasks(ctx context.Context, channels
 	return ret
 }
 
+func (c *ChannelChecker) createStandbyReduceTasks(ctx context.Context, views []*meta.LeaderView, replicaID int64) []task.Task {
+	ret := make([]task.Task, 0, len(views))
+	for _, view := range views {
+		action := task.NewStandbyChannelAction(view.ID, task.ActionTypeReduce, view.Channel)
+		task, err := task.NewChannelTask(ctx, Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond), c.ID(), view.CollectionID, replicaID, action)
+		if err != nil {
+			log.Warn("create standby channel reduce task failed",
+				zap.Int64("collection", view.CollectionID),
+				zap.Int64("replica", replicaID),
+				zap.String("channel", view.Channel),
+				zap.Int64("from", view.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		ret = append(ret, task)
+	}
+	return ret
+}
+
 func (c *ChannelChecker) getTraceCtx(ctx context.Context, collectionID int64) context.Context {
 	coll := c.meta.GetCollection(collectionID)
 	if coll == nil || coll.LoadSpan == nil {
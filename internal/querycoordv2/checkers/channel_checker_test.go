@@ -219,6 +219,76 @@ func (suite *ChannelCheckerTestSuite) TestRepeatedChannels() {
 	suite.EqualValues("test-insert-channel", action.ChannelName())
 }
 
+func (suite *ChannelCheckerTestSuite) TestStandbyChannel() {
+	checker := suite.checker
+	collection := utils.CreateTestCollection(1, 1)
+	collection.StandbyDelegator = true
+	checker.meta.CollectionManager.PutCollection(collection)
+	checker.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	checker.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2}))
+	suite.setNodeAvailable(1, 2, 3)
+	checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, 1)
+	checker.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, 2)
+
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, nil, nil)
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+	checker.targetMgr.UpdateCollectionCurrentTarget(int64(1))
+	checker.targetMgr.UpdateCollectionNextTarget(int64(1))
+	checker.dist.ChannelDistManager.Update(1, utils.CreateTestChannel(1, 1, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(1, &meta.LeaderView{ID: 1, CollectionID: 1, Channel: "test-insert-channel"})
+
+	// create standby delegator on the other node
+	tasks := checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.EqualValues(1, tasks[0].ReplicaID())
+	action := tasks[0].Actions()[0].(*task.ChannelAction)
+	suite.Equal(task.ActionTypeGrow, action.Type())
+	suite.True(action.IsStandby())
+	suite.EqualValues(2, action.Node())
+	suite.EqualValues("test-insert-channel", action.ChannelName())
+
+	checker.dist.StandbyViewManager.Update(2, &meta.LeaderView{ID: 2, CollectionID: 1, Channel: "test-insert-channel"})
+	tasks = checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+
+	// the lacked channel is left to promote the standby delegator
+	checker.dist.ChannelDistManager.Update(1)
+	checker.dist.LeaderViewManager.Update(1)
+	tasks = checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+
+	// release the standby delegator out of replica
+	checker.dist.ChannelDistManager.Update(1, utils.CreateTestChannel(1, 1, 1, "test-insert-channel"))
+	checker.dist.LeaderViewManager.Update(1, &meta.LeaderView{ID: 1, CollectionID: 1, Channel: "test-insert-channel"})
+	checker.dist.StandbyViewManager.Update(3, &meta.LeaderView{ID: 3, CollectionID: 1, Channel: "test-insert-channel"})
+	tasks = checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.EqualValues(-1, tasks[0].ReplicaID())
+	action = tasks[0].Actions()[0].(*task.ChannelAction)
+	suite.Equal(task.ActionTypeReduce, action.Type())
+	suite.True(action.IsStandby())
+	suite.EqualValues(3, action.Node())
+
+	// release the standby delegator if disabled
+	checker.dist.StandbyViewManager.Update(3)
+	collection = utils.CreateTestCollection(1, 1)
+	checker.meta.CollectionManager.PutCollection(collection)
+	tasks = checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.EqualValues(1, tasks[0].ReplicaID())
+	action = tasks[0].Actions()[0].(*task.ChannelAction)
+	suite.Equal(task.ActionTypeReduce, action.Type())
+	suite.True(action.IsStandby())
+	suite.EqualValues(2, action.Node())
+}
+
 func TestChannelCheckerSuite(t *testing.T) {
 	suite.Run(t, new(ChannelCheckerTestSuite))
 }
@@ -99,18 +99,64 @@ func (c *LeaderChecker) Check(ctx context.Context) []task.Task {
 				}
 
 				leaderViews := c.dist.LeaderViewManager.GetByCollectionAndNode(replica.GetCollectionID(), node)
+				// keep the standby delegators with the same segments as the serving ones, so they could be promoted at any time
+				for ch, standbyView := range c.dist.StandbyViewManager.GetByCollectionAndNode(replica.GetCollectionID(), node) {
+					leaderViews[ch] = standbyView
+				}
 				for ch, leaderView := range leaderViews {
 					dist := c.dist.SegmentDistManager.GetByShardWithReplica(ch, replica)
 					tasks = append(tasks, c.findNeedLoadedSegments(ctx, replica.ID, leaderView, dist)...)
 					tasks = append(tasks, c.findNeedRemovedSegments(ctx, replica.ID, leaderView, dist)...)
 				}
 			}
+			tasks = append(tasks, c.findStandbyToPromote(ctx, replica)...)
 		}
 	}
 
 	return tasks
 }
 
+// findStandbyToPromote promotes the standby delegators of the shards which lack of serving delegator in the replica,
+// the promoted delegator serves at once as it has consumed the channel, rather than subscribing the channel from the checkpoint.
+func (c *LeaderChecker) findStandbyToPromote(ctx context.Context, replica *meta.Replica) []task.Task {
+	ret := make([]task.Task, 0)
+	leaders := c.dist.ChannelDistManager.GetShardLeadersByReplica(replica)
+	for ch := range c.target.GetDmChannelsByCollection(replica.GetCollectionID(), meta.NextTarget) {
+		if _, ok := leaders[ch]; ok {
+			continue
+		}
+		standbyView := getPromotableStandby(c.dist, c.nodeMgr, replica, ch)
+		if standbyView == nil {
+			continue
+		}
+
+		log := log.Ctx(ctx).With(
+			zap.Int64("collectionID", replica.GetCollectionID()),
+			zap.Int64("replica", replica.GetID()),
+			zap.String("channel", ch),
+			zap.Int64("nodeID", standbyView.ID),
+		)
+		action := task.NewChannelAction(standbyView.ID, task.ActionTypeGrow, ch)
+		t, err := task.NewChannelTask(
+			ctx,
+			params.Params.QueryCoordCfg.ChannelTaskTimeout.GetAsDuration(time.Millisecond),
+			c.ID(),
+			replica.GetCollectionID(),
+			replica.GetID(),
+			action,
+		)
+		if err != nil {
+			log.Warn("failed to create task to promote standby delegator", zap.Error(err))
+			continue
+		}
+		log.Info("leader checker promote standby delegator")
+		t.SetPriority(task.TaskPriorityHigh)
+		t.SetReason("promote standby delegator")
+		ret = append(ret, t)
+	}
+	return ret
+}
+
 func (c *LeaderChecker) findNeedLoadedSegments(ctx context.Context, replica int64, leaderView *meta.LeaderView, dist []*meta.Segment) []task.Task {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", leaderView.CollectionID),
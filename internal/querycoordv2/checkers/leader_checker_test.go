@@ -123,6 +123,68 @@ func (suite *LeaderCheckerTestSuite) TestSyncLoadedSegments() {
 	suite.Equal(tasks[0].Priority(), task.TaskPriorityHigh)
 }
 
+func (suite *LeaderCheckerTestSuite) TestStandbyDelegator() {
+	observer := suite.checker
+	observer.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
+	observer.meta.CollectionManager.PutPartition(utils.CreateTestPartition(1, 1))
+	observer.meta.ReplicaManager.Put(utils.CreateTestReplica(1, 1, []int64{1, 2, 3}))
+	for _, node := range []int64{1, 2, 3} {
+		suite.nodeMgr.Add(session.NewNodeInfo(node, "localhost"))
+	}
+	segments := []*datapb.SegmentInfo{
+		{
+			ID:            1,
+			PartitionID:   1,
+			InsertChannel: "test-insert-channel",
+		},
+	}
+	channels := []*datapb.VchannelInfo{
+		{
+			CollectionID: 1,
+			ChannelName:  "test-insert-channel",
+		},
+	}
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, int64(1)).Return(
+		channels, segments, nil)
+	observer.target.UpdateCollectionNextTarget(int64(1))
+	observer.target.UpdateCollectionCurrentTarget(1)
+	observer.target.UpdateCollectionNextTarget(int64(1))
+	observer.dist.SegmentDistManager.Update(1, utils.CreateTestSegment(1, 1, 1, 1, 0, "test-insert-channel"))
+	observer.dist.ChannelDistManager.Update(2, utils.CreateTestChannel(1, 2, 1, "test-insert-channel"))
+	view := utils.CreateTestLeaderView(2, 1, "test-insert-channel", map[int64]int64{1: 1}, map[int64]*meta.Segment{})
+	observer.dist.LeaderViewManager.Update(2, view)
+	standbyView := utils.CreateTestLeaderView(3, 1, "test-insert-channel", map[int64]int64{}, map[int64]*meta.Segment{})
+	observer.dist.StandbyViewManager.Update(3, standbyView)
+
+	// sync the loaded segments to the standby delegator
+	tasks := suite.checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.Equal(tasks[0].Source(), utils.LeaderChecker)
+	suite.Equal(tasks[0].Actions()[0].Type(), task.ActionTypeGrow)
+	suite.Equal(tasks[0].Actions()[0].Node(), int64(1))
+	suite.Equal(tasks[0].Actions()[0].(*task.LeaderAction).SegmentID(), int64(1))
+	suite.Equal(tasks[0].(*task.LeaderTask).Shard(), "test-insert-channel")
+
+	// promote the standby delegator if the serving one lost
+	standbyView = utils.CreateTestLeaderView(3, 1, "test-insert-channel", map[int64]int64{1: 1}, map[int64]*meta.Segment{})
+	observer.dist.StandbyViewManager.Update(3, standbyView)
+	observer.dist.ChannelDistManager.Update(2)
+	observer.dist.LeaderViewManager.Update(2)
+	tasks = suite.checker.Check(context.TODO())
+	suite.Len(tasks, 1)
+	suite.IsType((*task.ChannelTask)(nil), tasks[0])
+	suite.Equal(tasks[0].Priority(), task.TaskPriorityHigh)
+	action := tasks[0].Actions()[0].(*task.ChannelAction)
+	suite.Equal(task.ActionTypeGrow, action.Type())
+	suite.False(action.IsStandby())
+	suite.Equal(int64(3), action.Node())
+
+	// the standby delegator on stopping node could not be promoted
+	suite.nodeMgr.Stopping(3)
+	tasks = suite.checker.Check(context.TODO())
+	suite.Len(tasks, 0)
+}
+
 func (suite *LeaderCheckerTestSuite) TestActivation() {
 	observer := suite.checker
 	observer.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
@@ -76,3 +76,19 @@ func CheckLeaderAvaliable(nodeMgr *session.NodeManager, leader *meta.LeaderView,
 	}
 	return nil
 }
+
+// getPromotableStandby returns the view of a standby delegator of the shard in the replica,
+// which is on a normal node and could be promoted to serve the shard.
+func getPromotableStandby(dist *meta.DistributionManager, nodeMgr *session.NodeManager, replica *meta.Replica, channel string) *meta.LeaderView {
+	for _, node := range replica.GetNodes() {
+		view := dist.StandbyViewManager.GetLeaderShardView(node, channel)
+		if view == nil {
+			continue
+		}
+		if stopping, err := nodeMgr.IsStoppingNode(node); err != nil || stopping {
+			continue
+		}
+		return view
+	}
+	return nil
+}
@@ -90,6 +90,9 @@ func (dh *distHandler) handleDistResp(resp *querypb.GetDataDistributionResponse)
 		node.UpdateStats(
 			session.WithSegmentCnt(len(resp.GetSegments())),
 			session.WithChannelCnt(len(resp.GetChannels())),
+			session.WithMemoryUsed(resp.GetMemoryUsed()),
+			session.WithMemoryTotal(resp.GetMemoryTotal()),
+			session.WithSearchLoads(resp.GetCollectionSearchLoads()),
 		)
 		if time.Since(node.LastHeartbeat()) > paramtable.Get().QueryCoordCfg.HeartBeatWarningLag.GetAsDuration(time.Millisecond) {
 			log.Warn("node last heart beat time lag too behind", zap.Time("now", time.Now()),
@@ -127,6 +130,7 @@ func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistribut
 				Version:            s.GetVersion(),
 				LastDeltaTimestamp: s.GetLastDeltaTimestamp(),
 				IndexInfo:          s.GetIndexInfo(),
+				SearchLoad:         s.GetSearchLoad(),
 			}
 		} else {
 			segment = &meta.Segment{
@@ -135,6 +139,7 @@ func (dh *distHandler) updateSegmentsDistribution(resp *querypb.GetDataDistribut
 				Version:            s.GetVersion(),
 				LastDeltaTimestamp: s.GetLastDeltaTimestamp(),
 				IndexInfo:          s.GetIndexInfo(),
+				SearchLoad:         s.GetSearchLoad(),
 			}
 		}
 		updates = append(updates, segment)
@@ -168,6 +173,7 @@ func (dh *distHandler) updateChannelsDistribution(resp *querypb.GetDataDistribut
 
 func (dh *distHandler) updateLeaderView(resp *querypb.GetDataDistributionResponse) {
 	updates := make([]*meta.LeaderView, 0, len(resp.GetLeaderViews()))
+	standbys := make([]*meta.LeaderView, 0)
 	for _, lview := range resp.GetLeaderViews() {
 		segments := make(map[int64]*meta.Segment)
 
@@ -200,11 +206,17 @@ func (dh *distHandler) updateLeaderView(resp *querypb.GetDataDistributionRespons
 			GrowingSegments:  segments,
 			TargetVersion:    lview.TargetVersion,
 			NumOfGrowingRows: lview.GetNumOfGrowingRows(),
+			SearchLoad:       lview.GetSearchLoad(),
+		}
+		if lview.GetStandby() {
+			standbys = append(standbys, view)
+			continue
 		}
 		updates = append(updates, view)
 	}
 
 	dh.dist.LeaderViewManager.Update(resp.GetNodeID(), updates...)
+	dh.dist.StandbyViewManager.Update(resp.GetNodeID(), standbys...)
 }
 
 func (dh *distHandler) getDistribution(ctx context.Context) (*querypb.GetDataDistributionResponse, error) {
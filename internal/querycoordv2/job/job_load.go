@@ -27,11 +27,12 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
-	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/eventlog"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -43,6 +44,8 @@ type LoadCollectionJob struct {
 	*BaseJob
 	req  *querypb.LoadCollectionRequest
 	undo *UndoList
+	// collectionInfo is described once in PreExecute, nil if it failed
+	collectionInfo *milvuspb.DescribeCollectionResponse
 
 	dist           *meta.DistributionManager
 	meta           *meta.Meta
@@ -82,6 +85,13 @@ func (job *LoadCollectionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
 
+	collectionInfo, err := job.broker.DescribeCollection(job.ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to describe collection, use the default replica number and resource group", zap.Error(err))
+	} else {
+		job.collectionInfo = collectionInfo
+		applyDatabaseLoadDefaults(job.ctx, job.broker, collectionInfo, &req.ReplicaNumber, &req.ResourceGroups)
+	}
 	if req.GetReplicaNumber() <= 0 {
 		log.Info("request doesn't indicate the number of replicas, set it to 1",
 			zap.Int32("replicaNumber", req.GetReplicaNumber()))
@@ -146,10 +156,19 @@ func (job *LoadCollectionJob) Execute() error {
 		}
 	}
 
+	collectionInfo := job.collectionInfo
+	if collectionInfo == nil {
+		collectionInfo, err = job.broker.DescribeCollection(job.ctx, req.GetCollectionID())
+		if err != nil {
+			log.Warn("failed to describe collection", zap.Error(err))
+			return err
+		}
+	}
+
 	// 2. create replica if not exist
 	replicas := job.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
 	if len(replicas) == 0 {
-		replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber())
+		replicas, err = spawnReplicas(job.meta, req.GetCollectionID(), collectionInfo.GetProperties(), req.GetResourceGroups(), req.GetReplicaNumber())
 		if err != nil {
 			msg := "failed to spawn replica for collection"
 			log.Warn(msg, zap.Error(err))
@@ -157,13 +176,14 @@ func (job *LoadCollectionJob) Execute() error {
 		}
 		for _, replica := range replicas {
 			log.Info("replica created", zap.Int64("replicaID", replica.GetID()),
-				zap.Int64s("nodes", replica.GetNodes()), zap.String("resourceGroup", replica.GetResourceGroup()))
+				zap.Int64s("nodes", replica.GetNodes()), zap.String("resourceGroup", replica.GetResourceGroup()),
+				zap.String("tag", replica.GetTag()))
 		}
 		job.undo.IsReplicaCreated = true
 	}
 
 	// 3. loadPartitions on QueryNodes
-	err = loadPartitions(job.ctx, job.meta, job.cluster, job.broker, true, req.GetCollectionID(), lackPartitionIDs...)
+	err = loadPartitions(job.ctx, job.meta, job.cluster, job.broker, collectionInfo.GetSchema(), req.GetCollectionID(), lackPartitionIDs...)
 	if err != nil {
 		return err
 	}
@@ -185,11 +205,12 @@ func (job *LoadCollectionJob) Execute() error {
 	_, sp := otel.Tracer(typeutil.QueryCoordRole).Start(job.ctx, "LoadCollection", trace.WithNewRoot())
 	collection := &meta.Collection{
 		CollectionLoadInfo: &querypb.CollectionLoadInfo{
-			CollectionID:  req.GetCollectionID(),
-			ReplicaNumber: req.GetReplicaNumber(),
-			Status:        querypb.LoadStatus_Loading,
-			FieldIndexID:  req.GetFieldIndexID(),
-			LoadType:      querypb.LoadType_LoadCollection,
+			CollectionID:     req.GetCollectionID(),
+			ReplicaNumber:    req.GetReplicaNumber(),
+			Status:           querypb.LoadStatus_Loading,
+			FieldIndexID:     req.GetFieldIndexID(),
+			LoadType:         querypb.LoadType_LoadCollection,
+			StandbyDelegator: common.IsStandbyDelegatorEnabled(collectionInfo.GetProperties()...),
 		},
 		CreatedAt: time.Now(),
 		LoadSpan:  sp,
@@ -225,6 +246,8 @@ type LoadPartitionJob struct {
 	*BaseJob
 	req  *querypb.LoadPartitionsRequest
 	undo *UndoList
+	// collectionInfo is described once in PreExecute, nil if it failed
+	collectionInfo *milvuspb.DescribeCollectionResponse
 
 	dist           *meta.DistributionManager
 	meta           *meta.Meta
@@ -264,6 +287,13 @@ func (job *LoadPartitionJob) PreExecute() error {
 	req := job.req
 	log := log.Ctx(job.ctx).With(zap.Int64("collectionID", req.GetCollectionID()))
 
+	collectionInfo, err := job.broker.DescribeCollection(job.ctx, req.GetCollectionID())
+	if err != nil {
+		log.Warn("failed to describe collection, use the default replica number and resource group", zap.Error(err))
+	} else {
+		job.collectionInfo = collectionInfo
+		applyDatabaseLoadDefaults(job.ctx, job.broker, collectionInfo, &req.ReplicaNumber, &req.ResourceGroups)
+	}
 	if req.GetReplicaNumber() <= 0 {
 		log.Info("request doesn't indicate the number of replicas, set it to 1",
 			zap.Int32("replicaNumber", req.GetReplicaNumber()))
@@ -323,10 +353,19 @@ func (job *LoadPartitionJob) Execute() error {
 		}
 	}
 
+	collectionInfo := job.collectionInfo
+	if collectionInfo == nil {
+		collectionInfo, err = job.broker.DescribeCollection(job.ctx, req.GetCollectionID())
+		if err != nil {
+			log.Warn("failed to describe collection", zap.Error(err))
+			return err
+		}
+	}
+
 	// 2. create replica if not exist
 	replicas := job.meta.ReplicaManager.GetByCollection(req.GetCollectionID())
 	if len(replicas) == 0 {
-		replicas, err = utils.SpawnReplicasWithRG(job.meta, req.GetCollectionID(), req.GetResourceGroups(), req.GetReplicaNumber())
+		replicas, err = spawnReplicas(job.meta, req.GetCollectionID(), collectionInfo.GetProperties(), req.GetResourceGroups(), req.GetReplicaNumber())
 		if err != nil {
 			msg := "failed to spawn replica for collection"
 			log.Warn(msg, zap.Error(err))
@@ -334,13 +373,14 @@ func (job *LoadPartitionJob) Execute() error {
 		}
 		for _, replica := range replicas {
 			log.Info("replica created", zap.Int64("replicaID", replica.GetID()),
-				zap.Int64s("nodes", replica.GetNodes()), zap.String("resourceGroup", replica.GetResourceGroup()))
+				zap.Int64s("nodes", replica.GetNodes()), zap.String("resourceGroup", replica.GetResourceGroup()),
+				zap.String("tag", replica.GetTag()))
 		}
 		job.undo.IsReplicaCreated = true
 	}
 
 	// 3. loadPartitions on QueryNodes
-	err = loadPartitions(job.ctx, job.meta, job.cluster, job.broker, true, req.GetCollectionID(), lackPartitionIDs...)
+	err = loadPartitions(job.ctx, job.meta, job.cluster, job.broker, collectionInfo.GetSchema(), req.GetCollectionID(), lackPartitionIDs...)
 	if err != nil {
 		return err
 	}
@@ -364,11 +404,12 @@ func (job *LoadPartitionJob) Execute() error {
 		_, sp := otel.Tracer(typeutil.QueryCoordRole).Start(job.ctx, "LoadPartition", trace.WithNewRoot())
 		collection := &meta.Collection{
 			CollectionLoadInfo: &querypb.CollectionLoadInfo{
-				CollectionID:  req.GetCollectionID(),
-				ReplicaNumber: req.GetReplicaNumber(),
-				Status:        querypb.LoadStatus_Loading,
-				FieldIndexID:  req.GetFieldIndexID(),
-				LoadType:      querypb.LoadType_LoadPartition,
+				CollectionID:     req.GetCollectionID(),
+				ReplicaNumber:    req.GetReplicaNumber(),
+				Status:           querypb.LoadStatus_Loading,
+				FieldIndexID:     req.GetFieldIndexID(),
+				LoadType:         querypb.LoadType_LoadPartition,
+				StandbyDelegator: common.IsStandbyDelegatorEnabled(collectionInfo.GetProperties()...),
 			},
 			CreatedAt: time.Now(),
 			LoadSpan:  sp,
@@ -26,18 +26,22 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/observers"
 	. "github.com/milvus-io/milvus/internal/querycoordv2/params"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/util/etcd"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -307,9 +311,9 @@ func (suite *JobSuite) TestLoadCollection() {
 		suite.NoError(err)
 	}
 
-	suite.meta.ResourceManager.AddResourceGroup("rg1")
-	suite.meta.ResourceManager.AddResourceGroup("rg2")
-	suite.meta.ResourceManager.AddResourceGroup("rg3")
+	suite.meta.ResourceManager.AddResourceGroup("rg1", nil)
+	suite.meta.ResourceManager.AddResourceGroup("rg2", nil)
+	suite.meta.ResourceManager.AddResourceGroup("rg3", nil)
 
 	// Load with 3 replica on 1 rg
 	req := &querypb.LoadCollectionRequest{
@@ -384,6 +388,185 @@ func (suite *JobSuite) TestLoadCollectionWithReplicas() {
 	}
 }
 
+func (suite *JobSuite) TestLoadCollectionWithReplicaTags() {
+	ctx := context.Background()
+
+	suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+		return call.Method != "DescribeCollection"
+	})
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionReplicaTagsKey, Value: "online,batch"},
+		},
+	}, nil)
+	defer func() {
+		suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+			return call.Method != "DescribeCollection"
+		})
+		suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(nil, nil)
+	}()
+
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		// the number of replica tags mismatches the replica number
+		req := &querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 1,
+		}
+		job := NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err := job.Wait()
+		suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+		req = &querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 2,
+		}
+		job = NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err = job.Wait()
+		suite.NoError(err)
+		replicas := suite.meta.ReplicaManager.GetByCollection(collection)
+		suite.Len(replicas, 2)
+		suite.ElementsMatch([]string{"online", "batch"}, lo.Map(replicas, func(replica *meta.Replica, _ int) string {
+			return replica.GetTag()
+		}))
+	}
+}
+
+func (suite *JobSuite) TestLoadCollectionWithDatabaseDefaults() {
+	ctx := context.Background()
+
+	suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+		return call.Method != "DescribeCollection"
+	})
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		DbName: "db1",
+	}, nil)
+	suite.broker.EXPECT().DescribeDatabase(mock.Anything, "db1").Return(&internalpb.DescribeDatabaseResponse{
+		DbName: "db1",
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.DatabaseReplicaNumber, Value: "5"},
+		},
+	}, nil)
+	defer func() {
+		suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+			return call.Method != "DescribeCollection" && call.Method != "DescribeDatabase"
+		})
+		suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(nil, nil)
+	}()
+
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		// the replica number of database is applied if not specified
+		req := &querypb.LoadCollectionRequest{
+			CollectionID: collection,
+		}
+		job := NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err := job.Wait()
+		suite.ErrorContains(err, meta.ErrNodeNotEnough.Error())
+
+		// the replica number of request takes precedence
+		req = &querypb.LoadCollectionRequest{
+			CollectionID:  collection,
+			ReplicaNumber: 1,
+		}
+		job = NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err = job.Wait()
+		suite.NoError(err)
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), 1)
+	}
+}
+
+func (suite *JobSuite) TestLoadCollectionWithDatabaseDefaultsFailed() {
+	ctx := context.Background()
+
+	suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+		return call.Method != "DescribeCollection"
+	})
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(&milvuspb.DescribeCollectionResponse{
+		DbName: "db1",
+	}, nil)
+	suite.broker.EXPECT().DescribeDatabase(mock.Anything, "db1").Return(nil, merr.WrapErrDatabaseNotFound("db1"))
+	defer func() {
+		suite.broker.ExpectedCalls = lo.Filter(suite.broker.ExpectedCalls, func(call *mock.Call, _ int) bool {
+			return call.Method != "DescribeCollection" && call.Method != "DescribeDatabase"
+		})
+		suite.broker.EXPECT().DescribeCollection(mock.Anything, mock.Anything).Return(nil, nil)
+	}()
+
+	for _, collection := range suite.collections {
+		if suite.loadTypes[collection] != querypb.LoadType_LoadCollection {
+			continue
+		}
+		// fall back to one replica if the database defaults could not be fetched
+		req := &querypb.LoadCollectionRequest{
+			CollectionID: collection,
+		}
+		job := NewLoadCollectionJob(
+			ctx,
+			req,
+			suite.dist,
+			suite.meta,
+			suite.broker,
+			suite.cluster,
+			suite.targetMgr,
+			suite.targetObserver,
+			suite.nodeMgr,
+		)
+		suite.scheduler.Add(job)
+		err := job.Wait()
+		suite.NoError(err)
+		suite.Len(suite.meta.ReplicaManager.GetByCollection(collection), 1)
+	}
+}
+
 func (suite *JobSuite) TestLoadCollectionWithDiffIndex() {
 	ctx := context.Background()
 
@@ -586,9 +769,9 @@ func (suite *JobSuite) TestLoadPartition() {
 		suite.NoError(err)
 	}
 
-	suite.meta.ResourceManager.AddResourceGroup("rg1")
-	suite.meta.ResourceManager.AddResourceGroup("rg2")
-	suite.meta.ResourceManager.AddResourceGroup("rg3")
+	suite.meta.ResourceManager.AddResourceGroup("rg1", nil)
+	suite.meta.ResourceManager.AddResourceGroup("rg2", nil)
+	suite.meta.ResourceManager.AddResourceGroup("rg3", nil)
 
 	// test load 3 replica in 1 rg, should pass rg check
 	req := &querypb.LoadPartitionsRequest{
@@ -18,17 +18,22 @@ package job
 
 import (
 	"context"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/checkers"
 	"github.com/milvus-io/milvus/internal/querycoordv2/meta"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
+	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -62,23 +67,73 @@ func waitCollectionReleased(dist *meta.DistributionManager, checkerController *c
 	}
 }
 
+// getDatabaseLoadDefaults returns the replica number and resource groups set in the properties
+// of the database, zero and nil if they are not set.
+func getDatabaseLoadDefaults(ctx context.Context, broker meta.Broker, dbName string) (int32, []string, error) {
+	dbInfo, err := broker.DescribeDatabase(ctx, dbName)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	var (
+		replicaNumber  int32
+		resourceGroups []string
+	)
+	for _, prop := range dbInfo.GetProperties() {
+		if prop.GetValue() == "" {
+			continue
+		}
+		switch prop.GetKey() {
+		case common.DatabaseReplicaNumber:
+			num, err := strconv.ParseInt(prop.GetValue(), 10, 32)
+			if err != nil {
+				return 0, nil, merr.WrapErrParameterInvalidMsg("invalid database replica number: %s", prop.GetValue())
+			}
+			replicaNumber = int32(num)
+		case common.DatabaseResourceGroups:
+			resourceGroups = lo.Map(strings.Split(prop.GetValue(), ","), func(rg string, _ int) string {
+				return strings.TrimSpace(rg)
+			})
+		}
+	}
+	return replicaNumber, resourceGroups, nil
+}
+
+// applyDatabaseLoadDefaults fills the replica number and resource groups of the load request
+// with the properties of the database which the collection belongs to if they are not specified.
+// The database defaults are best effort, the request is left as it is if they could not be fetched,
+// then it is loaded with one replica in the default resource group.
+func applyDatabaseLoadDefaults(ctx context.Context, broker meta.Broker, collectionInfo *milvuspb.DescribeCollectionResponse, replicaNumber *int32, resourceGroups *[]string) {
+	if *replicaNumber > 0 && len(*resourceGroups) > 0 {
+		return
+	}
+	if collectionInfo.GetDbName() == "" {
+		return
+	}
+	dbReplicaNumber, dbResourceGroups, err := getDatabaseLoadDefaults(ctx, broker, collectionInfo.GetDbName())
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to get the load defaults of database, use the default replica number and resource group",
+			zap.Int64("collectionID", collectionInfo.GetCollectionID()),
+			zap.String("dbName", collectionInfo.GetDbName()),
+			zap.Error(err))
+		return
+	}
+	if *replicaNumber <= 0 && dbReplicaNumber > 0 {
+		*replicaNumber = dbReplicaNumber
+	}
+	if len(*resourceGroups) == 0 && len(dbResourceGroups) > 0 {
+		*resourceGroups = dbResourceGroups
+	}
+}
+
 func loadPartitions(ctx context.Context,
 	meta *meta.Meta,
 	cluster session.Cluster,
 	broker meta.Broker,
-	withSchema bool,
+	schema *schemapb.CollectionSchema,
 	collection int64,
 	partitions ...int64,
 ) error {
-	var err error
-	var schema *schemapb.CollectionSchema
-	if withSchema {
-		collectionInfo, err := broker.DescribeCollection(ctx, collection)
-		if err != nil {
-			return err
-		}
-		schema = collectionInfo.GetSchema()
-	}
 	indexes, err := broker.DescribeIndex(ctx, collection)
 	if err != nil {
 		return err
@@ -141,3 +196,16 @@ func releasePartitions(ctx context.Context,
 		}
 	}
 }
+
+// spawnReplicas spawns the replicas of the collection,
+// the replicas are tagged and assigned to resource groups by the replica tags in the collection properties if set.
+func spawnReplicas(m *meta.Meta, collection int64, properties []*commonpb.KeyValuePair, resourceGroups []string, replicaNumber int32) ([]*meta.Replica, error) {
+	tags, err := utils.ParseReplicaTags(common.GetReplicaTags(properties...))
+	if err != nil {
+		return nil, err
+	}
+	if len(tags) == 0 {
+		return utils.SpawnReplicasWithRG(m, collection, resourceGroups, replicaNumber)
+	}
+	return utils.SpawnReplicasWithTags(m, collection, tags, resourceGroups, replicaNumber)
+}
@@ -20,6 +20,9 @@ type DistributionManager struct {
 	*SegmentDistManager
 	*ChannelDistManager
 	*LeaderViewManager
+	// StandbyViewManager manages the views of the standby delegators,
+	// which consume the channels but serve no request until promoted
+	StandbyViewManager *LeaderViewManager
 }
 
 func NewDistributionManager() *DistributionManager {
@@ -27,5 +30,14 @@ func NewDistributionManager() *DistributionManager {
 		SegmentDistManager: NewSegmentDistManager(),
 		ChannelDistManager: NewChannelDistManager(),
 		LeaderViewManager:  NewLeaderViewManager(),
+		StandbyViewManager: NewLeaderViewManager(),
 	}
 }
+
+// GetShardView returns the view of the serving or standby delegator of the shard on the given node
+func (m *DistributionManager) GetShardView(id int64, shard string) *LeaderView {
+	if view := m.LeaderViewManager.GetLeaderShardView(id, shard); view != nil {
+		return view
+	}
+	return m.StandbyViewManager.GetLeaderShardView(id, shard)
+}
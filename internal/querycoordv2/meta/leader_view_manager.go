@@ -33,6 +33,7 @@ type LeaderView struct {
 	GrowingSegments  map[int64]*Segment
 	TargetVersion    int64
 	NumOfGrowingRows int64
+	SearchLoad       *querypb.SearchLoad // search load of the delegator, including the growing segments
 }
 
 func (view *LeaderView) Clone() *LeaderView {
@@ -55,6 +56,7 @@ func (view *LeaderView) Clone() *LeaderView {
 		GrowingSegments:  growings,
 		TargetVersion:    view.TargetVersion,
 		NumOfGrowingRows: view.NumOfGrowingRows,
+		SearchLoad:       view.SearchLoad,
 	}
 }
 
@@ -172,6 +172,19 @@ func (m *ReplicaManager) Spawn(collection int64, replicaNumber int32, rgName str
 	return replicas, err
 }
 
+// SpawnWithTag spawns a replica with the given tag for given collection,
+// the exclusive replica serves the requests with the tag only.
+// this doesn't store the replica and assign nodes to it.
+func (m *ReplicaManager) SpawnWithTag(collection int64, rgName string, tag string, exclusive bool) (*Replica, error) {
+	replica, err := m.spawn(collection, rgName)
+	if err != nil {
+		return nil, err
+	}
+	replica.Tag = tag
+	replica.Exclusive = exclusive
+	return replica, nil
+}
+
 func (m *ReplicaManager) Put(replicas ...*Replica) error {
 	m.rwmutex.Lock()
 	defer m.rwmutex.Unlock()
@@ -92,6 +92,21 @@ func (suite *ReplicaManagerSuite) TestSpawn() {
 	}
 }
 
+func (suite *ReplicaManagerSuite) TestSpawnWithTag() {
+	mgr := suite.mgr
+
+	replica, err := mgr.SpawnWithTag(suite.collections[0], DefaultResourceGroupName, "batch", true)
+	suite.NoError(err)
+	suite.Equal("batch", replica.GetTag())
+	suite.True(replica.GetExclusive())
+	suite.Equal(DefaultResourceGroupName, replica.GetResourceGroup())
+	suite.Equal(suite.collections[0], replica.GetCollectionID())
+
+	mgr.idAllocator = ErrorIDAllocator()
+	_, err = mgr.SpawnWithTag(suite.collections[0], DefaultResourceGroupName, "batch", false)
+	suite.Error(err)
+}
+
 func (suite *ReplicaManagerSuite) TestGet() {
 	mgr := suite.mgr
 
@@ -20,9 +20,11 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"github.com/golang/protobuf/proto"
 	"github.com/samber/lo"
 	"go.uber.org/zap"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus/internal/metastore"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querycoordv2/session"
@@ -48,6 +50,7 @@ var (
 	ErrNodeStopped                  = errors.New("node has been stopped")
 	ErrRGLimit                      = errors.New("resource group num reach limit 1024")
 	ErrNodeNotEnough                = errors.New("nodes not enough")
+	ErrRGWithConfig                 = errors.New("nodes of resource group with declarative config can't be transferred manually, update its config instead")
 )
 
 var DefaultResourceGroupName = "__default_resource_group"
@@ -57,6 +60,9 @@ var DefaultResourceGroupCapacity = 1000000
 type ResourceGroup struct {
 	nodes    typeutil.UniqueSet
 	capacity int
+	// config declares the node requests and limits of resource group,
+	// the capacity is ignored if config is set.
+	config *rgpb.ResourceGroupConfig
 }
 
 func NewResourceGroup(capacity int) *ResourceGroup {
@@ -94,8 +100,22 @@ func (rg *ResourceGroup) unassignNode(id int64, deltaCapacity int) error {
 	return nil
 }
 
+func newResourceGroupWithConfig(cfg *rgpb.ResourceGroupConfig) *ResourceGroup {
+	rg := NewResourceGroup(0)
+	rg.config = cfg
+	return rg
+}
+
 func (rg *ResourceGroup) LackOfNodes() int {
-	return rg.capacity - len(rg.nodes)
+	return rg.GetCapacity() - len(rg.nodes)
+}
+
+// RedundantOfNodes returns the num of nodes exceed the limits, always 0 for rg without config.
+func (rg *ResourceGroup) RedundantOfNodes() int {
+	if rg.config == nil {
+		return 0
+	}
+	return len(rg.nodes) - int(rg.config.GetLimits().GetNodeNum())
 }
 
 func (rg *ResourceGroup) containsNode(id int64) bool {
@@ -107,9 +127,16 @@ func (rg *ResourceGroup) GetNodes() []int64 {
 }
 
 func (rg *ResourceGroup) GetCapacity() int {
+	if rg.config != nil {
+		return int(rg.config.GetRequests().GetNodeNum())
+	}
 	return rg.capacity
 }
 
+func (rg *ResourceGroup) GetConfig() *rgpb.ResourceGroupConfig {
+	return rg.config
+}
+
 type ResourceManager struct {
 	groups  map[string]*ResourceGroup
 	catalog metastore.QueryCoordCatalog
@@ -128,7 +155,9 @@ func NewResourceManager(catalog metastore.QueryCoordCatalog, nodeMgr *session.No
 	}
 }
 
-func (rm *ResourceManager) AddResourceGroup(rgName string) error {
+// AddResourceGroup adds a resource group, the nodes of rg are reconciled toward cfg
+// if cfg is not nil, otherwise its capacity is changed by transferring nodes.
+func (rm *ResourceManager) AddResourceGroup(rgName string, cfg *rgpb.ResourceGroupConfig) error {
 	rm.rwmutex.Lock()
 	defer rm.rwmutex.Unlock()
 	if len(rgName) == 0 {
@@ -143,9 +172,18 @@ func (rm *ResourceManager) AddResourceGroup(rgName string) error {
 		return ErrRGLimit
 	}
 
+	if cfg != nil {
+		var err error
+		cfg, err = rm.validateResourceGroupConfig(rgName, cfg)
+		if err != nil {
+			return err
+		}
+	}
+
 	err := rm.catalog.SaveResourceGroup(&querypb.ResourceGroup{
 		Name:     rgName,
-		Capacity: 0,
+		Capacity: cfg.GetRequests().GetNodeNum(),
+		Config:   cfg,
 	})
 	if err != nil {
 		log.Info("failed to add resource group",
@@ -154,14 +192,95 @@ func (rm *ResourceManager) AddResourceGroup(rgName string) error {
 		)
 		return err
 	}
-	rm.groups[rgName] = NewResourceGroup(0)
+	rm.groups[rgName] = newResourceGroupWithConfig(cfg)
 
 	log.Info("add resource group",
 		zap.String("rgName", rgName),
+		zap.Any("config", cfg),
 	)
 	return nil
 }
 
+// UpdateResourceGroups updates the declarative config of resource groups,
+// the nodes are reconciled toward the new config by resource observer.
+func (rm *ResourceManager) UpdateResourceGroups(rgs map[string]*rgpb.ResourceGroupConfig) error {
+	rm.rwmutex.Lock()
+	defer rm.rwmutex.Unlock()
+
+	configs := make(map[string]*rgpb.ResourceGroupConfig, len(rgs))
+	toSave := make([]*querypb.ResourceGroup, 0, len(rgs))
+	for rgName, cfg := range rgs {
+		if rm.groups[rgName] == nil {
+			return merr.WrapErrResourceGroupNotFound(rgName)
+		}
+		if cfg == nil {
+			return merr.WrapErrParameterInvalidMsg("config of resource group %s is empty", rgName)
+		}
+		cfg, err := rm.validateResourceGroupConfig(rgName, cfg)
+		if err != nil {
+			return err
+		}
+		configs[rgName] = cfg
+		toSave = append(toSave, &querypb.ResourceGroup{
+			Name:     rgName,
+			Capacity: cfg.GetRequests().GetNodeNum(),
+			Nodes:    rm.groups[rgName].GetNodes(),
+			Config:   cfg,
+		})
+	}
+
+	err := rm.catalog.SaveResourceGroup(toSave...)
+	if err != nil {
+		log.Warn("failed to update resource groups", zap.Error(err))
+		return err
+	}
+
+	for rgName, cfg := range configs {
+		rm.groups[rgName].config = cfg
+		log.Info("update resource group",
+			zap.String("rgName", rgName),
+			zap.Any("config", cfg),
+		)
+	}
+	return nil
+}
+
+// validateResourceGroupConfig checks the config and returns a normalized copy of it,
+// the limits is set to requests if absent.
+func (rm *ResourceManager) validateResourceGroupConfig(rgName string, cfg *rgpb.ResourceGroupConfig) (*rgpb.ResourceGroupConfig, error) {
+	if rgName == DefaultResourceGroupName {
+		return nil, merr.WrapErrParameterInvalidMsg("config of default resource group can't be changed")
+	}
+
+	cfg = proto.Clone(cfg).(*rgpb.ResourceGroupConfig)
+	if cfg.GetRequests() == nil {
+		cfg.Requests = &rgpb.ResourceGroupLimit{}
+	}
+	if cfg.GetLimits() == nil {
+		cfg.Limits = &rgpb.ResourceGroupLimit{NodeNum: cfg.GetRequests().GetNodeNum()}
+	}
+
+	requests, limits := cfg.GetRequests().GetNodeNum(), cfg.GetLimits().GetNodeNum()
+	if requests < 0 || limits < 0 {
+		return nil, merr.WrapErrParameterInvalidMsg("node num of resource group %s should be non-negative, requests: %d, limits: %d", rgName, requests, limits)
+	}
+	if requests > limits {
+		return nil, merr.WrapErrParameterInvalidMsg("node requests of resource group %s exceed its limits, requests: %d, limits: %d", rgName, requests, limits)
+	}
+
+	transfers := append(lo.Map(cfg.GetFrom(), func(t *rgpb.ResourceGroupTransfer, _ int) string { return t.GetResourceGroup() }),
+		lo.Map(cfg.GetTo(), func(t *rgpb.ResourceGroupTransfer, _ int) string { return t.GetResourceGroup() })...)
+	for _, name := range transfers {
+		if name == rgName {
+			return nil, merr.WrapErrParameterInvalidMsg("resource group %s can't transfer nodes with itself", rgName)
+		}
+		if rm.groups[name] == nil {
+			return nil, merr.WrapErrResourceGroupNotFound(name)
+		}
+	}
+	return cfg, nil
+}
+
 func (rm *ResourceManager) RemoveResourceGroup(rgName string) error {
 	rm.rwmutex.Lock()
 	defer rm.rwmutex.Unlock()
@@ -174,7 +293,8 @@ func (rm *ResourceManager) RemoveResourceGroup(rgName string) error {
 		return nil
 	}
 
-	if rm.groups[rgName].GetCapacity() != 0 {
+	rm.checkRGNodeStatus(rgName)
+	if rm.groups[rgName].GetCapacity() != 0 || len(rm.groups[rgName].GetNodes()) != 0 {
 		return ErrDeleteNonEmptyRG
 	}
 
@@ -225,14 +345,15 @@ func (rm *ResourceManager) assignNode(rgName string, node int64) error {
 	newNodes := rm.groups[rgName].GetNodes()
 	newNodes = append(newNodes, node)
 	deltaCapacity := 1
-	if rgName == DefaultResourceGroupName {
-		// default rg capacity won't be changed
+	if rgName == DefaultResourceGroupName || rm.groups[rgName].GetConfig() != nil {
+		// default rg capacity won't be changed, neither does rg with config
 		deltaCapacity = 0
 	}
 	err := rm.catalog.SaveResourceGroup(&querypb.ResourceGroup{
 		Name:     rgName,
 		Capacity: int32(rm.groups[rgName].GetCapacity() + deltaCapacity),
 		Nodes:    newNodes,
+		Config:   rm.groups[rgName].GetConfig(),
 	})
 	if err != nil {
 		log.Info("failed to add node to resource group",
@@ -278,8 +399,8 @@ func (rm *ResourceManager) unassignNode(rgName string, node int64) error {
 	}
 
 	deltaCapacity := -1
-	if rgName == DefaultResourceGroupName {
-		// default rg capacity won't be changed
+	if rgName == DefaultResourceGroupName || rm.groups[rgName].GetConfig() != nil {
+		// default rg capacity won't be changed, neither does rg with config
 		deltaCapacity = 0
 	}
 
@@ -287,6 +408,7 @@ func (rm *ResourceManager) unassignNode(rgName string, node int64) error {
 		Name:     rgName,
 		Capacity: int32(rm.groups[rgName].GetCapacity() + deltaCapacity),
 		Nodes:    newNodes,
+		Config:   rm.groups[rgName].GetConfig(),
 	})
 	if err != nil {
 		log.Info("remove node from resource group",
@@ -484,6 +606,7 @@ func (rm *ResourceManager) HandleNodeDown(node int64) (string, error) {
 		Name:     rgName,
 		Capacity: int32(rm.groups[rgName].GetCapacity()),
 		Nodes:    newNodes,
+		Config:   rm.groups[rgName].GetConfig(),
 	})
 	if err != nil {
 		log.Info("failed to add node to resource group",
@@ -512,6 +635,10 @@ func (rm *ResourceManager) TransferNode(from string, to string, numNode int) ([]
 		return nil, merr.WrapErrResourceGroupNotFound(to)
 	}
 
+	if rm.groups[from].GetConfig() != nil || rm.groups[to].GetConfig() != nil {
+		return nil, ErrRGWithConfig
+	}
+
 	rm.checkRGNodeStatus(from)
 	rm.checkRGNodeStatus(to)
 	if len(rm.groups[from].nodes) < numNode {
@@ -592,7 +719,8 @@ func (rm *ResourceManager) transferNodeInStore(from string, to string, numNode i
 	return movedNodes, rm.catalog.SaveResourceGroup(fromRG, toRG)
 }
 
-// auto recover rg, return recover used node num
+// auto recover rg, return recover used node num.
+// the spare nodes of the preferred transfer-from groups in config are used first, then the nodes in default rg.
 func (rm *ResourceManager) AutoRecoverResourceGroup(rgName string) ([]int64, error) {
 	rm.rwmutex.Lock()
 	defer rm.rwmutex.Unlock()
@@ -603,37 +731,130 @@ func (rm *ResourceManager) AutoRecoverResourceGroup(rgName string) ([]int64, err
 
 	ret := make([]int64, 0)
 
-	rm.checkRGNodeStatus(DefaultResourceGroupName)
 	rm.checkRGNodeStatus(rgName)
 	lackNodesNum := rm.groups[rgName].LackOfNodes()
-	nodesInDefault := rm.groups[DefaultResourceGroupName].GetNodes()
-	for i := 0; i < len(nodesInDefault) && i < lackNodesNum; i++ {
-		// todo: a better way to choose a node with least balance cost
-		node := nodesInDefault[i]
-		err := rm.unassignNode(DefaultResourceGroupName, node)
-		if err != nil {
-			// interrupt transfer, unreachable logic path
-			return ret, err
+	sources := lo.Map(rm.groups[rgName].GetConfig().GetFrom(), func(t *rgpb.ResourceGroupTransfer, _ int) string {
+		return t.GetResourceGroup()
+	})
+	sources = append(sources, DefaultResourceGroupName)
+	for _, source := range sources {
+		if len(ret) >= lackNodesNum {
+			break
+		}
+		if rm.groups[source] == nil || source == rgName {
+			continue
 		}
 
-		err = rm.groups[rgName].assignNode(node, 0)
-		if err != nil {
-			// roll back, unreachable logic path
-			rm.assignNode(DefaultResourceGroupName, node)
-			return ret, err
+		rm.checkRGNodeStatus(source)
+		nodes := rm.groups[source].GetNodes()
+		spareNum := len(nodes)
+		if source != DefaultResourceGroupName {
+			spareNum = len(nodes) - rm.groups[source].GetCapacity()
 		}
+		for i := 0; i < spareNum && len(ret) < lackNodesNum; i++ {
+			// todo: a better way to choose a node with least balance cost
+			if err := rm.moveNode(source, rgName, nodes[i]); err != nil {
+				// interrupt transfer
+				return ret, err
+			}
 
-		log.Info("move node from default rg to recover",
-			zap.String("targetRG", rgName),
-			zap.Int64("nodeID", node),
-		)
+			log.Info("move node to recover resource group",
+				zap.String("sourceRG", source),
+				zap.String("targetRG", rgName),
+				zap.Int64("nodeID", nodes[i]),
+			)
+			ret = append(ret, nodes[i])
+		}
+	}
+
+	return ret, nil
+}
+
+// AutoReleaseRedundantNodes moves the nodes exceed the limits of rg to the preferred transfer-to groups
+// in config which are short of nodes, the rest goes to default rg. returns the moved nodes of each target rg.
+func (rm *ResourceManager) AutoReleaseRedundantNodes(rgName string) (map[string][]int64, error) {
+	rm.rwmutex.Lock()
+	defer rm.rwmutex.Unlock()
 
-		ret = append(ret, node)
+	if rm.groups[rgName] == nil {
+		return nil, merr.WrapErrResourceGroupNotFound(rgName)
+	}
+
+	ret := make(map[string][]int64)
+
+	rm.checkRGNodeStatus(rgName)
+	redundantNum := rm.groups[rgName].RedundantOfNodes()
+	nodes := rm.groups[rgName].GetNodes()
+	targets := lo.Map(rm.groups[rgName].GetConfig().GetTo(), func(t *rgpb.ResourceGroupTransfer, _ int) string {
+		return t.GetResourceGroup()
+	})
+	targets = append(targets, DefaultResourceGroupName)
+	moved := 0
+	for _, target := range targets {
+		if moved >= redundantNum {
+			break
+		}
+		if rm.groups[target] == nil || target == rgName {
+			continue
+		}
+
+		rm.checkRGNodeStatus(target)
+		roomNum := redundantNum
+		if cfg := rm.groups[target].GetConfig(); cfg != nil {
+			roomNum = int(cfg.GetLimits().GetNodeNum()) - len(rm.groups[target].GetNodes())
+		} else if target != DefaultResourceGroupName {
+			roomNum = rm.groups[target].LackOfNodes()
+		}
+		for i := 0; i < roomNum && moved < redundantNum; i++ {
+			node := nodes[moved]
+			if err := rm.moveNode(rgName, target, node); err != nil {
+				// interrupt transfer
+				return ret, err
+			}
+
+			log.Info("move redundant node out of resource group",
+				zap.String("sourceRG", rgName),
+				zap.String("targetRG", target),
+				zap.Int64("nodeID", node),
+			)
+			ret[target] = append(ret[target], node)
+			moved++
+		}
 	}
 
 	return ret, nil
 }
 
+// moveNode moves node between resource groups without changing their capacity.
+func (rm *ResourceManager) moveNode(from string, to string, node int64) error {
+	fromNodes := lo.Filter(rm.groups[from].GetNodes(), func(nid int64, _ int) bool { return nid != node })
+	toNodes := append(rm.groups[to].GetNodes(), node)
+	err := rm.catalog.SaveResourceGroup(&querypb.ResourceGroup{
+		Name:     from,
+		Capacity: int32(rm.groups[from].GetCapacity()),
+		Nodes:    fromNodes,
+		Config:   rm.groups[from].GetConfig(),
+	}, &querypb.ResourceGroup{
+		Name:     to,
+		Capacity: int32(rm.groups[to].GetCapacity()),
+		Nodes:    toNodes,
+		Config:   rm.groups[to].GetConfig(),
+	})
+	if err != nil {
+		log.Warn("failed to move node between resource groups",
+			zap.String("sourceRG", from),
+			zap.String("targetRG", to),
+			zap.Int64("nodeID", node),
+			zap.Error(err),
+		)
+		return err
+	}
+
+	rm.groups[from].unassignNode(node, 0)
+	rm.groups[to].assignNode(node, 0)
+	return nil
+}
+
 func (rm *ResourceManager) Recover() error {
 	rm.rwmutex.Lock()
 	defer rm.rwmutex.Unlock()
@@ -650,6 +871,7 @@ func (rm *ResourceManager) Recover() error {
 			}
 		} else {
 			rm.groups[rg.GetName()] = NewResourceGroup(int(rg.GetCapacity()))
+			rm.groups[rg.GetName()].config = rg.GetConfig()
 			for _, node := range rg.GetNodes() {
 				rm.groups[rg.GetName()].assignNode(node, 0)
 			}
@@ -691,3 +913,16 @@ func (rm *ResourceManager) CheckLackOfNode(rgName string) int {
 
 	return rm.groups[rgName].LackOfNodes()
 }
+
+// return redundant nodes num
+func (rm *ResourceManager) CheckRedundantOfNode(rgName string) int {
+	rm.rwmutex.Lock()
+	defer rm.rwmutex.Unlock()
+	if rm.groups[rgName] == nil {
+		return 0
+	}
+
+	rm.checkRGNodeStatus(rgName)
+
+	return rm.groups[rgName].RedundantOfNodes()
+}
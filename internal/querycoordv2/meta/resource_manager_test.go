@@ -19,9 +19,11 @@ import (
 	"testing"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/kv/querycoord"
@@ -65,13 +67,13 @@ func (suite *ResourceManagerSuite) SetupTest() {
 
 func (suite *ResourceManagerSuite) TestManipulateResourceGroup() {
 	// test add rg
-	err := suite.manager.AddResourceGroup("rg1")
+	err := suite.manager.AddResourceGroup("rg1", nil)
 	suite.NoError(err)
 	suite.True(suite.manager.ContainResourceGroup("rg1"))
 	suite.Len(suite.manager.ListResourceGroups(), 2)
 
 	// test add duplicate rg
-	err = suite.manager.AddResourceGroup("rg1")
+	err = suite.manager.AddResourceGroup("rg1", nil)
 	suite.Error(err)
 	// test delete rg
 	err = suite.manager.RemoveResourceGroup("rg1")
@@ -87,7 +89,7 @@ func (suite *ResourceManagerSuite) TestManipulateResourceGroup() {
 
 func (suite *ResourceManagerSuite) TestManipulateNode() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
-	err := suite.manager.AddResourceGroup("rg1")
+	err := suite.manager.AddResourceGroup("rg1", nil)
 	suite.NoError(err)
 	// test add node to rg
 	err = suite.manager.AssignNode("rg1", 1)
@@ -114,7 +116,7 @@ func (suite *ResourceManagerSuite) TestManipulateNode() {
 	suite.ErrorIs(err, merr.ErrResourceGroupNotFound)
 
 	// add node which already assign to rg  to another rg
-	err = suite.manager.AddResourceGroup("rg2")
+	err = suite.manager.AddResourceGroup("rg2", nil)
 	suite.NoError(err)
 	err = suite.manager.AssignNode("rg1", 1)
 	suite.NoError(err)
@@ -158,7 +160,7 @@ func (suite *ResourceManagerSuite) TestHandleNodeUp() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(3, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(100, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(101, "localhost"))
-	err := suite.manager.AddResourceGroup("rg1")
+	err := suite.manager.AddResourceGroup("rg1", nil)
 	suite.NoError(err)
 
 	suite.manager.AssignNode("rg1", 1)
@@ -197,9 +199,9 @@ func (suite *ResourceManagerSuite) TestRecover() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(2, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(3, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(4, "localhost"))
-	err := suite.manager.AddResourceGroup("rg1")
+	err := suite.manager.AddResourceGroup("rg1", nil)
 	suite.NoError(err)
-	err = suite.manager.AddResourceGroup("rg2")
+	err = suite.manager.AddResourceGroup("rg2", nil)
 	suite.NoError(err)
 
 	suite.manager.AssignNode(DefaultResourceGroupName, 1)
@@ -239,7 +241,7 @@ func (suite *ResourceManagerSuite) TestCheckOutboundNodes() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(2, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(3, "localhost"))
-	err := suite.manager.AddResourceGroup("rg")
+	err := suite.manager.AddResourceGroup("rg", nil)
 	suite.NoError(err)
 	suite.manager.AssignNode("rg", 1)
 	suite.manager.AssignNode("rg", 2)
@@ -264,7 +266,7 @@ func (suite *ResourceManagerSuite) TestCheckResourceGroup() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(2, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(3, "localhost"))
-	err := suite.manager.AddResourceGroup("rg")
+	err := suite.manager.AddResourceGroup("rg", nil)
 	suite.NoError(err)
 	suite.manager.AssignNode("rg", 1)
 	suite.manager.AssignNode("rg", 2)
@@ -288,8 +290,8 @@ func (suite *ResourceManagerSuite) TestGetOutboundNode() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(2, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(3, "localhost"))
-	suite.manager.AddResourceGroup("rg")
-	suite.manager.AddResourceGroup("rg1")
+	suite.manager.AddResourceGroup("rg", nil)
+	suite.manager.AddResourceGroup("rg1", nil)
 	suite.manager.AssignNode("rg", 1)
 	suite.manager.AssignNode("rg", 2)
 	suite.manager.AssignNode("rg1", 3)
@@ -315,7 +317,7 @@ func (suite *ResourceManagerSuite) TestAutoRecover() {
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(1, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(2, "localhost"))
 	suite.manager.nodeMgr.Add(session.NewNodeInfo(3, "localhost"))
-	err := suite.manager.AddResourceGroup("rg")
+	err := suite.manager.AddResourceGroup("rg", nil)
 	suite.NoError(err)
 	suite.manager.AssignNode(DefaultResourceGroupName, 1)
 	suite.manager.AssignNode(DefaultResourceGroupName, 2)
@@ -346,6 +348,104 @@ func (suite *ResourceManagerSuite) TestAutoRecover() {
 	suite.Len(nodes, 0)
 }
 
+func (suite *ResourceManagerSuite) TestResourceGroupConfig() {
+	newConfig := func(requests, limits int32, from, to []string) *rgpb.ResourceGroupConfig {
+		toTransfers := func(names []string) []*rgpb.ResourceGroupTransfer {
+			return lo.Map(names, func(name string, _ int) *rgpb.ResourceGroupTransfer {
+				return &rgpb.ResourceGroupTransfer{ResourceGroup: name}
+			})
+		}
+		return &rgpb.ResourceGroupConfig{
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: requests},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: limits},
+			From:     toTransfers(from),
+			To:       toTransfers(to),
+		}
+	}
+	for i := 1; i <= 5; i++ {
+		suite.manager.nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
+		suite.manager.HandleNodeUp(int64(i))
+	}
+
+	// test invalid config
+	suite.ErrorIs(suite.manager.AddResourceGroup("rg1", newConfig(2, 1, nil, nil)), merr.ErrParameterInvalid)
+	suite.ErrorIs(suite.manager.AddResourceGroup("rg1", newConfig(-1, 1, nil, nil)), merr.ErrParameterInvalid)
+	suite.ErrorIs(suite.manager.AddResourceGroup("rg1", newConfig(1, 1, []string{"rg1"}, nil)), merr.ErrParameterInvalid)
+	suite.ErrorIs(suite.manager.AddResourceGroup("rg1", newConfig(1, 1, nil, []string{"rg3"})), merr.ErrResourceGroupNotFound)
+	suite.False(suite.manager.ContainResourceGroup("rg1"))
+
+	// limits is set to requests if absent
+	err := suite.manager.AddResourceGroup("rg1", &rgpb.ResourceGroupConfig{Requests: &rgpb.ResourceGroupLimit{NodeNum: 3}})
+	suite.NoError(err)
+	rg, err := suite.manager.GetResourceGroup("rg1")
+	suite.NoError(err)
+	suite.Equal(3, rg.GetCapacity())
+	suite.EqualValues(3, rg.GetConfig().GetLimits().GetNodeNum())
+	err = suite.manager.AddResourceGroup("rg2", newConfig(1, 2, []string{"rg1"}, []string{"rg1"}))
+	suite.NoError(err)
+
+	// recover nodes from default rg
+	suite.Equal(3, suite.manager.CheckLackOfNode("rg1"))
+	nodes, err := suite.manager.AutoRecoverResourceGroup("rg1")
+	suite.NoError(err)
+	suite.Len(nodes, 3)
+	suite.Equal(0, suite.manager.CheckLackOfNode("rg1"))
+
+	// nodes of rg with config can't be transferred manually
+	_, err = suite.manager.TransferNode(DefaultResourceGroupName, "rg1", 1)
+	suite.ErrorIs(err, ErrRGWithConfig)
+
+	// the spare nodes of preferred transfer-from rg are used first
+	err = suite.manager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{
+		"rg1": newConfig(1, 1, nil, []string{"rg2"}),
+		"rg2": newConfig(2, 2, []string{"rg1"}, nil),
+	})
+	suite.NoError(err)
+	suite.Equal(2, suite.manager.CheckRedundantOfNode("rg1"))
+	suite.Equal(2, suite.manager.CheckLackOfNode("rg2"))
+	nodes, err = suite.manager.AutoRecoverResourceGroup("rg2")
+	suite.NoError(err)
+	suite.Len(nodes, 2)
+	suite.Equal(0, suite.manager.CheckRedundantOfNode("rg1"))
+	nodes, err = suite.manager.GetNodes(DefaultResourceGroupName)
+	suite.NoError(err)
+	suite.Len(nodes, 2)
+
+	// redundant nodes go to default rg if preferred transfer-to rg is full
+	err = suite.manager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{
+		"rg2": newConfig(0, 0, nil, []string{"rg1"}),
+	})
+	suite.NoError(err)
+	moved, err := suite.manager.AutoReleaseRedundantNodes("rg2")
+	suite.NoError(err)
+	suite.Len(moved[DefaultResourceGroupName], 2)
+	nodes, err = suite.manager.GetNodes(DefaultResourceGroupName)
+	suite.NoError(err)
+	suite.Len(nodes, 4)
+
+	// test update invalid config
+	err = suite.manager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{"rg3": newConfig(1, 1, nil, nil)})
+	suite.ErrorIs(err, merr.ErrResourceGroupNotFound)
+	err = suite.manager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{"rg1": nil})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+	err = suite.manager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{DefaultResourceGroupName: newConfig(1, 1, nil, nil)})
+	suite.ErrorIs(err, merr.ErrParameterInvalid)
+
+	// test recover config from store
+	delete(suite.manager.groups, "rg1")
+	delete(suite.manager.groups, "rg2")
+	delete(suite.manager.groups, DefaultResourceGroupName)
+	suite.NoError(suite.manager.Recover())
+	rg, err = suite.manager.GetResourceGroup("rg1")
+	suite.NoError(err)
+	suite.EqualValues(1, rg.GetConfig().GetRequests().GetNodeNum())
+	suite.Len(rg.GetNodes(), 1)
+
+	// rg with nodes can't be removed
+	suite.ErrorIs(suite.manager.RemoveResourceGroup("rg1"), ErrDeleteNonEmptyRG)
+	suite.NoError(suite.manager.RemoveResourceGroup("rg2"))
+}
+
 func (suite *ResourceManagerSuite) TestDefaultResourceGroup() {
 	for i := 0; i < 10; i++ {
 		suite.manager.nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
@@ -394,7 +494,7 @@ func (suite *ResourceManagerSuite) TestStoreFailed() {
 	store.EXPECT().SaveResourceGroup(mock.Anything, mock.Anything).Return(storeErr)
 	store.EXPECT().RemoveResourceGroup(mock.Anything).Return(storeErr)
 
-	err := manager.AddResourceGroup("rg")
+	err := manager.AddResourceGroup("rg", nil)
 	suite.ErrorIs(err, storeErr)
 
 	manager.groups["rg"] = &ResourceGroup{
@@ -32,6 +32,7 @@ type Segment struct {
 	Version            int64                             // Version is the timestamp of loading segment
 	LastDeltaTimestamp uint64                            // The timestamp of the last delta record
 	IndexInfo          map[int64]*querypb.FieldIndexInfo // index info of loaded segment
+	SearchLoad         *querypb.SearchLoad               // recent search load of loaded segment
 }
 
 func SegmentFromInfo(info *datapb.SegmentInfo) *Segment {
@@ -82,8 +82,8 @@ func (suite *ReplicaObserverSuite) SetupTest() {
 }
 
 func (suite *ReplicaObserverSuite) TestCheckNodesInReplica() {
-	suite.meta.ResourceManager.AddResourceGroup("rg1")
-	suite.meta.ResourceManager.AddResourceGroup("rg2")
+	suite.meta.ResourceManager.AddResourceGroup("rg1", nil)
+	suite.meta.ResourceManager.AddResourceGroup("rg2", nil)
 	suite.nodeMgr.Add(session.NewNodeInfo(1, "localhost:8080"))
 	suite.nodeMgr.Add(session.NewNodeInfo(2, "localhost:8080"))
 	suite.nodeMgr.Add(session.NewNodeInfo(3, "localhost:8080"))
@@ -89,6 +89,13 @@ func (ob *ResourceObserver) checkResourceGroup() {
 		if rgName == meta.DefaultResourceGroupName {
 			continue
 		}
+		rg, err := manager.GetResourceGroup(rgName)
+		if err != nil {
+			continue
+		}
+		// rg with declarative config is always reconciled toward its config
+		hasConfig := rg.GetConfig() != nil
+
 		lackNodeNum := manager.CheckLackOfNode(rgName)
 		if lackNodeNum > 0 {
 			log.Info("found resource group lack of nodes",
@@ -96,7 +103,7 @@ func (ob *ResourceObserver) checkResourceGroup() {
 				zap.Int("lackNodeNum", lackNodeNum),
 			)
 
-			if enableRGAutoRecover {
+			if enableRGAutoRecover || hasConfig {
 				nodes, err := manager.AutoRecoverResourceGroup(rgName)
 				if err != nil {
 					log.Warn("failed to recover resource group",
@@ -109,5 +116,25 @@ func (ob *ResourceObserver) checkResourceGroup() {
 				utils.AddNodesToCollectionsInRG(ob.meta, rgName, nodes...)
 			}
 		}
+
+		redundantNodeNum := manager.CheckRedundantOfNode(rgName)
+		if redundantNodeNum > 0 {
+			log.Info("found resource group with redundant nodes",
+				zap.String("rgName", rgName),
+				zap.Int("redundantNodeNum", redundantNodeNum),
+			)
+
+			movedNodes, err := manager.AutoReleaseRedundantNodes(rgName)
+			if err != nil {
+				log.Warn("failed to release redundant nodes of resource group",
+					zap.String("rgName", rgName),
+					zap.Error(err),
+				)
+			}
+
+			for target, nodes := range movedNodes {
+				utils.AddNodesToCollectionsInRG(ob.meta, target, nodes...)
+			}
+		}
 	}
 }
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdKV "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore/mocks"
@@ -79,6 +80,8 @@ func (suite *ResourceObserverSuite) SetupTest() {
 	suite.observer.Start()
 
 	suite.store.EXPECT().SaveResourceGroup(mock.Anything).Return(nil)
+	// moving node between resource groups saves both of them
+	suite.store.EXPECT().SaveResourceGroup(mock.Anything, mock.Anything).Return(nil).Maybe()
 	for i := 0; i < 10; i++ {
 		suite.nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
 		suite.meta.ResourceManager.AssignNode(meta.DefaultResourceGroupName, int64(i))
@@ -109,7 +112,7 @@ func (suite *ResourceObserverSuite) TestCheckNodesInReplica() {
 		},
 		typeutil.NewUniqueSet(),
 	))
-	suite.meta.ResourceManager.AddResourceGroup("rg")
+	suite.meta.ResourceManager.AddResourceGroup("rg", nil)
 	suite.nodeMgr.Add(session.NewNodeInfo(int64(100), "localhost"))
 	suite.nodeMgr.Add(session.NewNodeInfo(int64(101), "localhost"))
 	suite.nodeMgr.Add(session.NewNodeInfo(int64(102), "localhost"))
@@ -137,7 +140,7 @@ func (suite *ResourceObserverSuite) TestCheckNodesInReplica() {
 }
 
 func (suite *ResourceObserverSuite) TestRecoverResourceGroupFailed() {
-	suite.meta.ResourceManager.AddResourceGroup("rg")
+	suite.meta.ResourceManager.AddResourceGroup("rg", nil)
 	for i := 100; i < 200; i++ {
 		suite.nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
 		suite.meta.ResourceManager.AssignNode("rg", int64(i))
@@ -176,7 +179,7 @@ func (suite *ResourceObserverSuite) TestRecoverReplicaFailed() {
 	))
 
 	suite.store.EXPECT().SaveReplica(mock.Anything).Return(errors.New("store error"))
-	suite.meta.ResourceManager.AddResourceGroup("rg")
+	suite.meta.ResourceManager.AddResourceGroup("rg", nil)
 	suite.nodeMgr.Add(session.NewNodeInfo(int64(100), "localhost"))
 	suite.nodeMgr.Add(session.NewNodeInfo(int64(101), "localhost"))
 	suite.nodeMgr.Add(session.NewNodeInfo(int64(102), "localhost"))
@@ -203,6 +206,38 @@ func (suite *ResourceObserverSuite) TestRecoverReplicaFailed() {
 	}, 5*time.Second, 1*time.Second)
 }
 
+func (suite *ResourceObserverSuite) TestReconcileResourceGroupConfig() {
+	paramtable.Get().Save(Params.QueryCoordCfg.EnableRGAutoRecover.Key, "false")
+	defer paramtable.Get().Reset(Params.QueryCoordCfg.EnableRGAutoRecover.Key)
+
+	err := suite.meta.ResourceManager.AddResourceGroup("rg", &rgpb.ResourceGroupConfig{
+		Requests: &rgpb.ResourceGroupLimit{NodeNum: 3},
+		Limits:   &rgpb.ResourceGroupLimit{NodeNum: 3},
+	})
+	suite.NoError(err)
+
+	// rg with config is recovered even if auto recover is disabled
+	suite.Eventually(func() bool {
+		return suite.meta.ResourceManager.CheckLackOfNode("rg") == 0
+	}, 5*time.Second, 1*time.Second)
+
+	// redundant nodes are released to default rg
+	err = suite.meta.ResourceManager.UpdateResourceGroups(map[string]*rgpb.ResourceGroupConfig{
+		"rg": {
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 0},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 1},
+		},
+	})
+	suite.NoError(err)
+	suite.Eventually(func() bool {
+		nodes, err := suite.meta.ResourceManager.GetNodes("rg")
+		return err == nil && len(nodes) == 1
+	}, 5*time.Second, 1*time.Second)
+	nodes, err := suite.meta.ResourceManager.GetNodes(meta.DefaultResourceGroupName)
+	suite.NoError(err)
+	suite.Len(nodes, 9)
+}
+
 func (suite *ResourceObserverSuite) TearDownSuite() {
 	suite.kv.Close()
 	suite.observer.Stop()
@@ -355,6 +355,15 @@ func (ob *TargetObserver) shouldUpdateCurrentTarget(ctx context.Context, collect
 				return false
 			}
 		}
+
+		// keep the standby delegators ready to be promoted, it's best effort as they serve no request
+		for _, node := range replica.GetNodes() {
+			for _, standbyView := range ob.distMgr.StandbyViewManager.GetByCollectionAndNode(collectionID, node) {
+				if updateVersionAction := ob.checkNeedUpdateTargetVersion(ctx, standbyView); updateVersionAction != nil {
+					ob.sync(ctx, replica.GetID(), standbyView, []*querypb.SyncAction{updateVersionAction})
+				}
+			}
+		}
 	}
 
 	return true
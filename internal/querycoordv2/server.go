@@ -273,6 +273,10 @@ func (s *Server) initQueryCoord() error {
 		s.nodeMgr, s.dist, s.meta, s.targetMgr)
 	s.balancerMap[balance.ScoreBasedBalancerName] = balance.NewScoreBasedBalancer(s.taskScheduler,
 		s.nodeMgr, s.dist, s.meta, s.targetMgr)
+	s.balancerMap[balance.MemoryBasedBalancerName] = balance.NewMemoryBasedBalancer(s.taskScheduler,
+		s.nodeMgr, s.dist, s.meta, s.targetMgr, s.broker)
+	s.balancerMap[balance.WorkloadBasedBalancerName] = balance.NewWorkloadBasedBalancer(s.taskScheduler,
+		s.nodeMgr, s.dist, s.meta, s.targetMgr)
 	s.balancerMap[balance.MultiTargetBalancerName] = balance.NewMultiTargetBalancer(s.taskScheduler, s.nodeMgr, s.dist, s.meta, s.targetMgr)
 
 	if balancer, ok := s.balancerMap[params.Params.QueryCoordCfg.Balancer.GetValue()]; ok {
@@ -347,6 +351,7 @@ func (s *Server) initMeta() error {
 		SegmentDistManager: meta.NewSegmentDistManager(),
 		ChannelDistManager: meta.NewChannelDistManager(),
 		LeaderViewManager:  meta.NewLeaderViewManager(),
+		StandbyViewManager: meta.NewLeaderViewManager(),
 	}
 	s.targetMgr = meta.NewTargetManager(s.broker, s.meta)
 	log.Info("QueryCoord server initMeta done", zap.Duration("duration", record.ElapseSpan()))
@@ -399,6 +404,7 @@ func (s *Server) startQueryCoord() error {
 	for _, node := range sessions {
 		n := session.NewNodeInfo(node.ServerID, node.Address)
 		n.SetVersion(node.Version)
+		n.SetLabels(node.ServerLabels)
 		s.nodeMgr.Add(n)
 		s.taskScheduler.AddExecutor(node.ServerID)
 
@@ -617,7 +623,9 @@ func (s *Server) watchNodes(revision int64) {
 					zap.Int64("nodeID", nodeID),
 					zap.String("nodeAddr", addr),
 				)
-				s.nodeMgr.Add(session.NewNodeInfo(nodeID, addr))
+				n := session.NewNodeInfo(nodeID, addr)
+				n.SetLabels(event.Session.ServerLabels)
+				s.nodeMgr.Add(n)
 				s.nodeUpEventChan <- nodeID
 				select {
 				case s.notifyNodeUp <- struct{}{}:
@@ -716,6 +724,7 @@ func (s *Server) handleNodeDown(node int64) {
 
 	// Clear dist
 	s.dist.LeaderViewManager.Update(node)
+	s.dist.StandbyViewManager.Update(node)
 	s.dist.ChannelDistManager.Update(node)
 	s.dist.SegmentDistManager.Update(node)
 
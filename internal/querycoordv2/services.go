@@ -899,6 +899,15 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 			readableLeaders[leader.ID] = leader
 		}
 
+		numRows := s.getShardNumRows(req.GetCollectionID(), channel.GetChannelName(), leaders)
+		if len(readableLeaders) == 0 && req.GetWithUnavailableShards() {
+			log.Warn("channel is not available in any replica", zap.Error(channelErr))
+			resp.Shards = append(resp.Shards, &querypb.ShardLeadersList{
+				ChannelName: channel.GetChannelName(),
+				NumRows:     numRows,
+			})
+			continue
+		}
 		if len(readableLeaders) == 0 {
 			msg := fmt.Sprintf("channel %s is not available in any replica", channel.GetChannelName())
 			log.Warn(msg, zap.Error(channelErr))
@@ -911,22 +920,52 @@ func (s *Server) GetShardLeaders(ctx context.Context, req *querypb.GetShardLeade
 		readableLeaders = filterDupLeaders(s.meta.ReplicaManager, readableLeaders)
 		ids := make([]int64, 0, len(leaders))
 		addrs := make([]string, 0, len(leaders))
+		replicaIDs := make([]int64, 0, len(leaders))
+		replicaTags := make([]string, 0, len(leaders))
+		replicaExclusives := make([]bool, 0, len(leaders))
+		labels := make([]*querypb.ServerLabels, 0, len(leaders))
 		for _, leader := range readableLeaders {
 			info := s.nodeMgr.Get(leader.ID)
+			replica := s.meta.ReplicaManager.GetByCollectionAndNode(leader.CollectionID, leader.ID)
 			ids = append(ids, info.ID())
 			addrs = append(addrs, info.Addr())
+			replicaIDs = append(replicaIDs, replica.GetID())
+			replicaTags = append(replicaTags, replica.GetTag())
+			replicaExclusives = append(replicaExclusives, replica.GetExclusive())
+			labels = append(labels, &querypb.ServerLabels{Labels: info.Labels()})
 		}
 
 		resp.Shards = append(resp.Shards, &querypb.ShardLeadersList{
-			ChannelName: channel.GetChannelName(),
-			NodeIds:     ids,
-			NodeAddrs:   addrs,
+			ChannelName:       channel.GetChannelName(),
+			NodeIds:           ids,
+			NodeAddrs:         addrs,
+			ReplicaIds:        replicaIDs,
+			NodeLabels:        labels,
+			ReplicaTags:       replicaTags,
+			ReplicaExclusives: replicaExclusives,
+			NumRows:           numRows,
 		})
 	}
 
 	return resp, nil
 }
 
+// getShardNumRows returns the rows of the sealed segments in current target of the shard,
+// plus the most growing rows reported by the leaders of the shard.
+func (s *Server) getShardNumRows(collectionID int64, channel string, leaders map[int64]*meta.LeaderView) int64 {
+	var numRows int64
+	for _, segment := range s.targetMgr.GetSealedSegmentsByChannel(collectionID, channel, meta.CurrentTarget) {
+		numRows += segment.GetNumOfRows()
+	}
+	var growingRows int64
+	for _, leader := range leaders {
+		if leader.NumOfGrowingRows > growingRows {
+			growingRows = leader.NumOfGrowingRows
+		}
+	}
+	return numRows + growingRows
+}
+
 func (s *Server) CheckHealth(ctx context.Context, req *milvuspb.CheckHealthRequest) (*milvuspb.CheckHealthResponse, error) {
 	if err := merr.CheckHealthy(s.State()); err != nil {
 		return &milvuspb.CheckHealthResponse{Status: merr.Status(err), IsHealthy: false, Reasons: []string{err.Error()}}, nil
@@ -979,7 +1018,7 @@ func (s *Server) CreateResourceGroup(ctx context.Context, req *milvuspb.CreateRe
 		return merr.Status(err), nil
 	}
 
-	err := s.meta.ResourceManager.AddResourceGroup(req.GetResourceGroup())
+	err := s.meta.ResourceManager.AddResourceGroup(req.GetResourceGroup(), req.GetConfig())
 	if err != nil {
 		log.Warn("failed to create resource group", zap.Error(err))
 		return merr.Status(err), nil
@@ -1218,6 +1257,27 @@ func (s *Server) DescribeResourceGroup(ctx context.Context, req *querypb.Describ
 		NumLoadedReplica: loadedReplicas,
 		NumOutgoingNode:  outgoingNodes,
 		NumIncomingNode:  incomingNodes,
+		Config:           rg.GetConfig(),
+		Nodes:            rg.GetNodes(),
 	}
 	return resp, nil
 }
+
+func (s *Server) UpdateResourceGroups(ctx context.Context, req *milvuspb.UpdateResourceGroupsRequest) (*commonpb.Status, error) {
+	log := log.Ctx(ctx).With(
+		zap.Strings("rgNames", lo.Keys(req.GetResourceGroups())),
+	)
+
+	log.Info("update resource groups request received")
+	if err := merr.CheckHealthy(s.State()); err != nil {
+		log.Warn("failed to update resource groups", zap.Error(err))
+		return merr.Status(err), nil
+	}
+
+	err := s.meta.ResourceManager.UpdateResourceGroups(req.GetResourceGroups())
+	if err != nil {
+		log.Warn("failed to update resource groups", zap.Error(err))
+		return merr.Status(err), nil
+	}
+	return merr.Success(), nil
+}
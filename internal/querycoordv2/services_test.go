@@ -30,6 +30,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/rgpb"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/metastore"
@@ -381,10 +382,10 @@ func (suite *ServiceSuite) TestResourceGroup() {
 	server.nodeMgr.Add(session.NewNodeInfo(1012, "localhost"))
 	server.nodeMgr.Add(session.NewNodeInfo(1013, "localhost"))
 	server.nodeMgr.Add(session.NewNodeInfo(1014, "localhost"))
-	server.meta.ResourceManager.AddResourceGroup("rg11")
+	server.meta.ResourceManager.AddResourceGroup("rg11", nil)
 	server.meta.ResourceManager.AssignNode("rg11", 1011)
 	server.meta.ResourceManager.AssignNode("rg11", 1012)
-	server.meta.ResourceManager.AddResourceGroup("rg12")
+	server.meta.ResourceManager.AddResourceGroup("rg12", nil)
 	server.meta.ResourceManager.AssignNode("rg12", 1013)
 	server.meta.ResourceManager.AssignNode("rg12", 1014)
 	server.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 1))
@@ -433,6 +434,56 @@ func (suite *ServiceSuite) TestResourceGroup() {
 	suite.Len(resp4.GetResourceGroups(), 3)
 }
 
+func (suite *ServiceSuite) TestUpdateResourceGroups() {
+	ctx := context.Background()
+	server := suite.server
+
+	resp, err := server.CreateResourceGroup(ctx, &milvuspb.CreateResourceGroupRequest{
+		ResourceGroup: "rg1",
+		Config: &rgpb.ResourceGroupConfig{
+			Requests: &rgpb.ResourceGroupLimit{NodeNum: 1},
+			Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+		},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+
+	resp, err = server.UpdateResourceGroups(ctx, &milvuspb.UpdateResourceGroupsRequest{
+		ResourceGroups: map[string]*rgpb.ResourceGroupConfig{
+			"rg1": {
+				Requests: &rgpb.ResourceGroupLimit{NodeNum: 2},
+				Limits:   &rgpb.ResourceGroupLimit{NodeNum: 3},
+			},
+		},
+	})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp))
+
+	resp2, err := server.DescribeResourceGroup(ctx, &querypb.DescribeResourceGroupRequest{ResourceGroup: "rg1"})
+	suite.NoError(err)
+	suite.True(merr.Ok(resp2.GetStatus()))
+	suite.Equal(int32(2), resp2.GetResourceGroup().GetCapacity())
+	suite.Equal(int32(3), resp2.GetResourceGroup().GetConfig().GetLimits().GetNodeNum())
+
+	// requests exceed limits
+	resp, err = server.UpdateResourceGroups(ctx, &milvuspb.UpdateResourceGroupsRequest{
+		ResourceGroups: map[string]*rgpb.ResourceGroupConfig{
+			"rg1": {
+				Requests: &rgpb.ResourceGroupLimit{NodeNum: 3},
+				Limits:   &rgpb.ResourceGroupLimit{NodeNum: 2},
+			},
+		},
+	})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrParameterInvalid)
+
+	// server unhealthy
+	server.UpdateStateCode(commonpb.StateCode_Abnormal)
+	resp, err = server.UpdateResourceGroups(ctx, &milvuspb.UpdateResourceGroupsRequest{})
+	suite.NoError(err)
+	suite.ErrorIs(merr.Error(resp), merr.ErrServiceNotReady)
+}
+
 func (suite *ServiceSuite) TestResourceGroupFailed() {
 	ctx := context.Background()
 	server := suite.server
@@ -484,9 +535,9 @@ func (suite *ServiceSuite) TestTransferNode() {
 	ctx := context.Background()
 	server := suite.server
 
-	err := server.meta.ResourceManager.AddResourceGroup("rg1")
+	err := server.meta.ResourceManager.AddResourceGroup("rg1", nil)
 	suite.NoError(err)
-	err = server.meta.ResourceManager.AddResourceGroup("rg2")
+	err = server.meta.ResourceManager.AddResourceGroup("rg2", nil)
 	suite.NoError(err)
 	suite.meta.CollectionManager.PutCollection(utils.CreateTestCollection(1, 2))
 	suite.meta.ReplicaManager.Put(meta.NewReplica(
@@ -545,9 +596,9 @@ func (suite *ServiceSuite) TestTransferNode() {
 	suite.NoError(err)
 	suite.Equal(commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
 
-	err = server.meta.ResourceManager.AddResourceGroup("rg3")
+	err = server.meta.ResourceManager.AddResourceGroup("rg3", nil)
 	suite.NoError(err)
-	err = server.meta.ResourceManager.AddResourceGroup("rg4")
+	err = server.meta.ResourceManager.AddResourceGroup("rg4", nil)
 	suite.NoError(err)
 	suite.nodeMgr.Add(session.NewNodeInfo(11, "localhost"))
 	suite.nodeMgr.Add(session.NewNodeInfo(12, "localhost"))
@@ -602,11 +653,11 @@ func (suite *ServiceSuite) TestTransferReplica() {
 	ctx := context.Background()
 	server := suite.server
 
-	err := server.meta.ResourceManager.AddResourceGroup("rg1")
+	err := server.meta.ResourceManager.AddResourceGroup("rg1", nil)
 	suite.NoError(err)
-	err = server.meta.ResourceManager.AddResourceGroup("rg2")
+	err = server.meta.ResourceManager.AddResourceGroup("rg2", nil)
 	suite.NoError(err)
-	err = server.meta.ResourceManager.AddResourceGroup("rg3")
+	err = server.meta.ResourceManager.AddResourceGroup("rg3", nil)
 	suite.NoError(err)
 
 	resp, err := suite.server.TransferReplica(ctx, &querypb.TransferReplicaRequest{
@@ -1484,6 +1535,17 @@ func (suite *ServiceSuite) TestGetShardLeaders() {
 		suite.Len(resp.Shards, len(suite.channels[collection]))
 		for _, shard := range resp.Shards {
 			suite.Len(shard.NodeIds, int(suite.replicaNumber[collection]))
+			suite.Len(shard.NodeLabels, int(suite.replicaNumber[collection]))
+			suite.Len(shard.ReplicaTags, int(suite.replicaNumber[collection]))
+			suite.Len(shard.ReplicaExclusives, int(suite.replicaNumber[collection]))
+			suite.ElementsMatch(shard.ReplicaIds, lo.Map(suite.meta.ReplicaManager.GetByCollection(collection), func(replica *meta.Replica, _ int) int64 {
+				return replica.GetID()
+			}))
+			var numRows int64
+			for _, segment := range suite.targetMgr.GetSealedSegmentsByChannel(collection, shard.GetChannelName(), meta.CurrentTarget) {
+				numRows += segment.GetNumOfRows()
+			}
+			suite.Equal(numRows, shard.GetNumRows())
 		}
 	}
 
@@ -1545,6 +1607,16 @@ func (suite *ServiceSuite) TestGetShardLeadersFailed() {
 		resp, err := server.GetShardLeaders(ctx, req)
 		suite.NoError(err)
 		suite.ErrorIs(merr.Error(resp.GetStatus()), merr.ErrChannelNotAvailable)
+
+		// the unavailable shards are returned with empty leaders
+		req.WithUnavailableShards = true
+		resp, err = server.GetShardLeaders(ctx, req)
+		suite.NoError(err)
+		suite.NoError(merr.Error(resp.GetStatus()))
+		suite.Len(resp.GetShards(), len(suite.channels[collection]))
+		for _, shard := range resp.GetShards() {
+			suite.Empty(shard.GetNodeIds())
+		}
 	}
 
 	// collection not loaded
@@ -1581,7 +1653,7 @@ func (suite *ServiceSuite) TestHandleNodeUp() {
 	log.Info("handleNodeUp")
 
 	// when more rg exist, new node shouldn't be assign to replica in default rg in handleNodeUp
-	suite.server.meta.ResourceManager.AddResourceGroup("rg")
+	suite.server.meta.ResourceManager.AddResourceGroup("rg", nil)
 	suite.nodeMgr.Add(session.NewNodeInfo(222, "localhost"))
 	server.handleNodeUp(222)
 	nodes = suite.server.meta.ReplicaManager.Get(1).GetNodes()
@@ -24,6 +24,7 @@ import (
 	"github.com/blang/semver/v4"
 	"go.uber.org/atomic"
 
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/pkg/metrics"
 )
 
@@ -110,6 +111,7 @@ type NodeInfo struct {
 	state         State
 	lastHeartbeat *atomic.Int64
 	version       semver.Version
+	labels        map[string]string
 }
 
 func (n *NodeInfo) ID() int64 {
@@ -132,6 +134,27 @@ func (n *NodeInfo) ChannelCnt() int {
 	return n.stats.getChannelCnt()
 }
 
+// MemoryUsed returns the used memory reported by the node in the last heartbeat.
+func (n *NodeInfo) MemoryUsed() uint64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getMemoryUsed()
+}
+
+// MemoryTotal returns the total memory reported by the node in the last heartbeat.
+func (n *NodeInfo) MemoryTotal() uint64 {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getMemoryTotal()
+}
+
+// SearchLoads returns the search load of each collection reported by the node in the last heartbeat.
+func (n *NodeInfo) SearchLoads() map[int64]*querypb.SearchLoad {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.stats.getSearchLoads()
+}
+
 func (n *NodeInfo) SetLastHeartbeat(time time.Time) {
 	n.lastHeartbeat.Store(time.UnixNano())
 }
@@ -168,6 +191,15 @@ func (n *NodeInfo) Version() semver.Version {
 	return n.version
 }
 
+// SetLabels sets the server labels registered in the session of the node.
+func (n *NodeInfo) SetLabels(labels map[string]string) {
+	n.labels = labels
+}
+
+func (n *NodeInfo) Labels() map[string]string {
+	return n.labels
+}
+
 func NewNodeInfo(id int64, addr string) *NodeInfo {
 	return &NodeInfo{
 		stats:         newStats(),
@@ -190,3 +222,21 @@ func WithChannelCnt(cnt int) StatsOption {
 		n.setChannelCnt(cnt)
 	}
 }
+
+func WithMemoryUsed(used uint64) StatsOption {
+	return func(n *NodeInfo) {
+		n.setMemoryUsed(used)
+	}
+}
+
+func WithMemoryTotal(total uint64) StatsOption {
+	return func(n *NodeInfo) {
+		n.setMemoryTotal(total)
+	}
+}
+
+func WithSearchLoads(loads map[int64]*querypb.SearchLoad) StatsOption {
+	return func(n *NodeInfo) {
+		n.setSearchLoads(loads)
+	}
+}
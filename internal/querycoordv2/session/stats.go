@@ -16,9 +16,14 @@
 
 package session
 
+import "github.com/milvus-io/milvus/internal/proto/querypb"
+
 type stats struct {
-	segmentCnt int
-	channelCnt int
+	segmentCnt  int
+	channelCnt  int
+	memoryUsed  uint64
+	memoryTotal uint64
+	searchLoads map[int64]*querypb.SearchLoad // collectionID -> search load
 }
 
 func (s *stats) setSegmentCnt(cnt int) {
@@ -37,6 +42,30 @@ func (s *stats) getChannelCnt() int {
 	return s.channelCnt
 }
 
+func (s *stats) setMemoryUsed(used uint64) {
+	s.memoryUsed = used
+}
+
+func (s *stats) getMemoryUsed() uint64 {
+	return s.memoryUsed
+}
+
+func (s *stats) setMemoryTotal(total uint64) {
+	s.memoryTotal = total
+}
+
+func (s *stats) getMemoryTotal() uint64 {
+	return s.memoryTotal
+}
+
+func (s *stats) setSearchLoads(loads map[int64]*querypb.SearchLoad) {
+	s.searchLoads = loads
+}
+
+func (s *stats) getSearchLoads() map[int64]*querypb.SearchLoad {
+	return s.searchLoads
+}
+
 func newStats() stats {
 	return stats{}
 }
@@ -141,6 +141,8 @@ func (action *SegmentAction) IsFinished(distMgr *meta.DistributionManager) bool
 
 type ChannelAction struct {
 	*BaseAction
+
+	standby bool
 }
 
 func NewChannelAction(nodeID typeutil.UniqueID, typ ActionType, channelName string) *ChannelAction {
@@ -149,12 +151,29 @@ func NewChannelAction(nodeID typeutil.UniqueID, typ ActionType, channelName stri
 	}
 }
 
+// NewStandbyChannelAction creates an action to subscribe or unsubscribe a standby delegator of the channel,
+// growing a serving channel on the node of the standby delegator promotes it.
+func NewStandbyChannelAction(nodeID typeutil.UniqueID, typ ActionType, channelName string) *ChannelAction {
+	return &ChannelAction{
+		BaseAction: NewBaseAction(nodeID, typ, channelName),
+		standby:    true,
+	}
+}
+
 func (action *ChannelAction) ChannelName() string {
 	return action.shard
 }
 
+func (action *ChannelAction) IsStandby() bool {
+	return action.standby
+}
+
 func (action *ChannelAction) IsFinished(distMgr *meta.DistributionManager) bool {
-	nodes := distMgr.LeaderViewManager.GetChannelDist(action.ChannelName())
+	viewMgr := distMgr.LeaderViewManager
+	if action.IsStandby() {
+		viewMgr = distMgr.StandbyViewManager
+	}
+	nodes := viewMgr.GetChannelDist(action.ChannelName())
 	hasNode := lo.Contains(nodes, action.Node())
 	isGrow := action.Type() == ActionTypeGrow
 
@@ -186,8 +205,7 @@ func (action *LeaderAction) SegmentID() typeutil.UniqueID {
 }
 
 func (action *LeaderAction) IsFinished(distMgr *meta.DistributionManager) bool {
-	views := distMgr.LeaderViewManager.GetLeaderView(action.leaderID)
-	view := views[action.Shard()]
+	view := distMgr.GetShardView(action.leaderID, action.Shard())
 	if view == nil {
 		return false
 	}
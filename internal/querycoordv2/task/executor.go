@@ -290,6 +290,7 @@ func (ex *Executor) subscribeChannel(task *ChannelTask, step int) error {
 		zap.Int64("replicaID", task.ReplicaID()),
 		zap.String("channel", task.Channel()),
 		zap.Int64("node", action.Node()),
+		zap.Bool("standby", action.IsStandby()),
 		zap.String("source", task.Source().String()),
 	)
 
@@ -382,6 +383,7 @@ func (ex *Executor) unsubscribeChannel(task *ChannelTask, step int) error {
 		zap.Int64("replicaID", task.ReplicaID()),
 		zap.String("channel", task.Channel()),
 		zap.Int64("node", action.Node()),
+		zap.Bool("standby", action.IsStandby()),
 		zap.String("source", task.Source().String()),
 	)
 
@@ -370,7 +370,11 @@ func (scheduler *taskScheduler) preAdd(task Task) error {
 
 		taskType := GetTaskType(task)
 		if taskType == TaskTypeGrow {
-			nodesWithChannel := scheduler.distMgr.LeaderViewManager.GetChannelDist(task.Channel())
+			viewMgr := scheduler.distMgr.LeaderViewManager
+			if task.Actions()[0].(*ChannelAction).IsStandby() {
+				viewMgr = scheduler.distMgr.StandbyViewManager
+			}
+			nodesWithChannel := viewMgr.GetChannelDist(task.Channel())
 			replicaNodeMap := utils.GroupNodesByReplica(scheduler.meta.ReplicaManager, task.CollectionID(), nodesWithChannel)
 			if _, ok := replicaNodeMap[task.ReplicaID()]; ok {
 				return merr.WrapErrServiceInternal("channel subscribed, it can be only balanced")
@@ -933,14 +937,14 @@ func (scheduler *taskScheduler) checkLeaderTaskStale(task *LeaderTask) error {
 				return merr.WrapErrReplicaNotFound(task.CollectionID(), "by collectionID")
 			}
 
-			view := scheduler.distMgr.GetLeaderShardView(task.leaderID, task.Shard())
+			view := scheduler.distMgr.GetShardView(task.leaderID, task.Shard())
 			if view == nil {
 				log.Warn("task stale due to leader not found")
 				return merr.WrapErrChannelNotFound(task.Shard(), "failed to get shard delegator")
 			}
 
 		case ActionTypeReduce:
-			view := scheduler.distMgr.GetLeaderShardView(task.leaderID, task.Shard())
+			view := scheduler.distMgr.GetShardView(task.leaderID, task.Shard())
 			if view == nil {
 				log.Warn("task stale due to leader not found")
 				return merr.WrapErrChannelNotFound(task.Shard(), "failed to get shard delegator")
@@ -161,6 +161,7 @@ func (suite *TaskSuite) BeforeTest(suiteName, testName string) {
 
 	switch testName {
 	case "TestSubscribeChannelTask",
+		"TestSubscribeStandbyChannelTask",
 		"TestUnsubscribeChannelTask",
 		"TestLoadSegmentTask",
 		"TestLoadSegmentTaskNotIndex",
@@ -286,6 +287,80 @@ func (suite *TaskSuite) TestSubscribeChannelTask() {
 	}
 }
 
+func (suite *TaskSuite) TestSubscribeStandbyChannelTask() {
+	ctx := context.Background()
+	timeout := 10 * time.Second
+	targetNode := int64(3)
+	channel := suite.subChannels[0]
+
+	// Expect
+	suite.broker.EXPECT().DescribeCollection(mock.Anything, suite.collection).
+		Return(&milvuspb.DescribeCollectionResponse{
+			Schema: &schemapb.CollectionSchema{
+				Name: "TestSubscribeStandbyChannelTask",
+				Fields: []*schemapb.FieldSchema{
+					{FieldID: 100, Name: "vec", DataType: schemapb.DataType_FloatVector},
+				},
+			},
+		}, nil)
+	suite.broker.EXPECT().DescribeIndex(mock.Anything, suite.collection).Return([]*indexpb.IndexInfo{
+		{
+			CollectionID: suite.collection,
+			FieldID:      100,
+		},
+	}, nil)
+	suite.cluster.EXPECT().WatchDmChannels(mock.Anything, targetNode, mock.MatchedBy(func(req *querypb.WatchDmChannelsRequest) bool {
+		return req.GetStandby()
+	})).Return(merr.Success(), nil)
+	suite.broker.EXPECT().GetRecoveryInfoV2(mock.Anything, suite.collection).Return([]*datapb.VchannelInfo{
+		{
+			CollectionID: suite.collection,
+			ChannelName:  channel,
+		},
+	}, nil, nil)
+	suite.target.UpdateCollectionNextTarget(suite.collection)
+
+	// the channel is served by the other node of the replica
+	suite.dist.LeaderViewManager.Update(1, &meta.LeaderView{
+		ID:           1,
+		CollectionID: suite.collection,
+		Channel:      channel,
+	})
+	defer suite.dist.LeaderViewManager.Update(1)
+
+	task, err := NewChannelTask(
+		ctx,
+		timeout,
+		WrapIDSource(0),
+		suite.collection,
+		suite.replica,
+		NewStandbyChannelAction(targetNode, ActionTypeGrow, channel),
+	)
+	suite.NoError(err)
+	err = suite.scheduler.Add(task)
+	suite.NoError(err)
+	suite.AssertTaskNum(0, 1, 1, 0)
+
+	// Process tasks
+	suite.dispatchAndWait(targetNode)
+	suite.AssertTaskNum(1, 0, 1, 0)
+
+	// Process tasks done
+	// Dist contains the standby delegator
+	suite.dist.StandbyViewManager.Update(targetNode, &meta.LeaderView{
+		ID:           targetNode,
+		CollectionID: suite.collection,
+		Channel:      channel,
+	})
+	defer suite.dist.StandbyViewManager.Update(targetNode)
+	suite.dispatchAndWait(targetNode)
+	suite.AssertTaskNum(0, 0, 0, 0)
+
+	Wait(ctx, timeout, task)
+	suite.Equal(TaskStatusSucceeded, task.Status())
+	suite.NoError(task.Err())
+}
+
 func (suite *TaskSuite) TestSubmitDuplicateSubscribeChannelTask() {
 	ctx := context.Background()
 	timeout := 10 * time.Second
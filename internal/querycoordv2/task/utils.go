@@ -124,6 +124,12 @@ func packLoadSegmentRequest(
 		}
 	}
 
+	// segments loaded by leader checker are already serving, no need to warm up
+	warmupPolicy := common.GetWarmupPolicy(collectionProperties...)
+	if loadScope == querypb.LoadScope_Delta {
+		warmupPolicy = common.WarmupPolicyNone
+	}
+
 	return &querypb.LoadSegmentsRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgType(commonpb.MsgType_LoadSegments),
@@ -140,6 +146,7 @@ func packLoadSegmentRequest(
 		NeedTransfer:   true,
 		IndexInfoList:  indexInfo,
 		LoadScope:      loadScope,
+		WarmupPolicy:   warmupPolicy,
 	}
 }
 
@@ -169,7 +176,7 @@ func packLoadMeta(loadType querypb.LoadType, collectionID int64, partitions ...i
 
 func packSubChannelRequest(
 	task *ChannelTask,
-	action Action,
+	action *ChannelAction,
 	schema *schemapb.CollectionSchema,
 	loadMeta *querypb.LoadMetaInfo,
 	channel *meta.DmChannel,
@@ -188,6 +195,7 @@ func packSubChannelRequest(
 		ReplicaID:     task.ReplicaID(),
 		Version:       time.Now().UnixNano(),
 		IndexInfoList: indexInfo,
+		Standby:       action.IsStandby(),
 	}
 }
 
@@ -222,7 +230,7 @@ func fillSubChannelRequest(
 	return nil
 }
 
-func packUnsubDmChannelRequest(task *ChannelTask, action Action) *querypb.UnsubDmChannelRequest {
+func packUnsubDmChannelRequest(task *ChannelTask, action *ChannelAction) *querypb.UnsubDmChannelRequest {
 	return &querypb.UnsubDmChannelRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgType(commonpb.MsgType_UnsubDmChannel),
@@ -231,6 +239,7 @@ func packUnsubDmChannelRequest(task *ChannelTask, action Action) *querypb.UnsubD
 		NodeID:       action.Node(),
 		CollectionID: task.CollectionID(),
 		ChannelName:  task.Channel(),
+		Standby:      action.IsStandby(),
 	}
 }
 
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querycoordv2/utils"
 	"github.com/milvus-io/milvus/pkg/common"
 )
 
@@ -140,6 +141,45 @@ func (s *UtilsSuite) TestPackLoadSegmentRequestMmap() {
 	}
 }
 
+func (s *UtilsSuite) TestPackLoadSegmentRequestWarmup() {
+	ctx := context.Background()
+
+	action := NewSegmentAction(1, ActionTypeGrow, "test-ch", 100)
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				DataType:     schemapb.DataType_Int64,
+				IsPrimaryKey: true,
+			},
+		},
+	}
+	properties := []*commonpb.KeyValuePair{
+		{
+			Key:   common.CollectionWarmupPolicyKey,
+			Value: common.WarmupPolicyReplay,
+		},
+	}
+	loadMeta := &querypb.LoadMetaInfo{
+		LoadType: querypb.LoadType_LoadCollection,
+	}
+
+	task, err := NewSegmentTask(ctx, time.Second, utils.SegmentChecker, 1, 10, action)
+	s.NoError(err)
+	req := packLoadSegmentRequest(task, action, schema, properties, loadMeta, &querypb.SegmentLoadInfo{}, nil)
+	s.Equal(common.WarmupPolicyReplay, req.GetWarmupPolicy())
+
+	req = packLoadSegmentRequest(task, action, schema, nil, loadMeta, &querypb.SegmentLoadInfo{}, nil)
+	s.Equal(common.WarmupPolicyNone, req.GetWarmupPolicy())
+
+	// the segments loaded by leader checker are serving
+	task, err = NewSegmentTask(ctx, time.Second, utils.LeaderChecker, 1, 10, action)
+	s.NoError(err)
+	req = packLoadSegmentRequest(task, action, schema, properties, loadMeta, &querypb.SegmentLoadInfo{}, nil)
+	s.Equal(querypb.LoadScope_Delta, req.GetLoadScope())
+	s.Equal(common.WarmupPolicyNone, req.GetWarmupPolicy())
+}
+
 func TestUtils(t *testing.T) {
 	suite.Run(t, new(UtilsSuite))
 }
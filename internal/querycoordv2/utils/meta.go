@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+	"strings"
 
 	"github.com/cockroachdb/errors"
 	"github.com/samber/lo"
@@ -239,3 +240,85 @@ func SpawnReplicasWithRG(m *meta.Meta, collection int64, resourceGroups []string
 
 	return replicaSet, m.ReplicaManager.Put(replicaSet...)
 }
+
+// replicaTagExclusive marks the replica tag as exclusive in the replica tags
+const replicaTagExclusive = "exclusive"
+
+// ReplicaTag is the tag of a replica to spawn, with the resource group to create the replica in
+type ReplicaTag struct {
+	Tag           string
+	ResourceGroup string // empty if the resource group is assigned by the load request
+	Exclusive     bool   // the replica serves the requests with the tag only
+}
+
+// ParseReplicaTags parses the replica tags in format of "tag1:rg1,tag2:rg2:exclusive",
+// the resource groups and the exclusive marks are optional
+func ParseReplicaTags(value string) ([]ReplicaTag, error) {
+	if len(strings.TrimSpace(value)) == 0 {
+		return nil, nil
+	}
+
+	entries := strings.Split(value, ",")
+	tags := make([]ReplicaTag, 0, len(entries))
+	for _, entry := range entries {
+		fields := strings.Split(entry, ":")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+		if len(fields[0]) == 0 || len(fields) > 3 || (len(fields) == 3 && fields[2] != replicaTagExclusive) {
+			return nil, merr.WrapErrParameterInvalidMsg("invalid replica tag [%s]", entry)
+		}
+		tag := ReplicaTag{Tag: fields[0], Exclusive: len(fields) == 3}
+		if len(fields) > 1 {
+			tag.ResourceGroup = fields[1]
+		}
+		tags = append(tags, tag)
+	}
+	return tags, nil
+}
+
+// SpawnReplicasWithTags spawns one replica for each tag, the replica is created in the resource group of its tag,
+// or the resource group assigned by the load request if the tag has none.
+func SpawnReplicasWithTags(m *meta.Meta, collection int64, tags []ReplicaTag, resourceGroups []string, replicaNumber int32) ([]*meta.Replica, error) {
+	if len(tags) != int(replicaNumber) {
+		return nil, merr.WrapErrParameterInvalidMsg("the number of replica tags %d doesn't match the replica number %d", len(tags), replicaNumber)
+	}
+	if err := checkResourceGroup(collection, replicaNumber, resourceGroups); err != nil {
+		return nil, err
+	}
+
+	replicaSet := make([]*meta.Replica, 0, len(tags))
+	rgReplicas := make(map[string][]*meta.Replica)
+	for i, tag := range tags {
+		rgName := tag.ResourceGroup
+		if len(rgName) == 0 {
+			switch len(resourceGroups) {
+			case 0:
+				rgName = meta.DefaultResourceGroupName
+			case 1:
+				rgName = resourceGroups[0]
+			default:
+				rgName = resourceGroups[i]
+			}
+		}
+		if !m.ResourceManager.ContainResourceGroup(rgName) {
+			return nil, merr.WrapErrResourceGroupNotFound(rgName)
+		}
+
+		replica, err := m.ReplicaManager.SpawnWithTag(collection, rgName, tag.Tag, tag.Exclusive)
+		if err != nil {
+			return nil, err
+		}
+		rgReplicas[rgName] = append(rgReplicas[rgName], replica)
+		replicaSet = append(replicaSet, replica)
+	}
+
+	for rgName, replicas := range rgReplicas {
+		err := AssignNodesToReplicas(m, rgName, replicas...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return replicaSet, m.ReplicaManager.Put(replicaSet...)
+}
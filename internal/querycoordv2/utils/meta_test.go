@@ -51,9 +51,9 @@ func TestSpawnReplicasWithRG(t *testing.T) {
 	store := querycoord.NewCatalog(kv)
 	nodeMgr := session.NewNodeManager()
 	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
-	m.ResourceManager.AddResourceGroup("rg1")
-	m.ResourceManager.AddResourceGroup("rg2")
-	m.ResourceManager.AddResourceGroup("rg3")
+	m.ResourceManager.AddResourceGroup("rg1", nil)
+	m.ResourceManager.AddResourceGroup("rg2", nil)
+	m.ResourceManager.AddResourceGroup("rg3", nil)
 
 	for i := 1; i < 10; i++ {
 		nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
@@ -118,6 +118,85 @@ func TestSpawnReplicasWithRG(t *testing.T) {
 	}
 }
 
+func TestParseReplicaTags(t *testing.T) {
+	tags, err := ParseReplicaTags("")
+	assert.NoError(t, err)
+	assert.Empty(t, tags)
+
+	tags, err = ParseReplicaTags("online:rg1, batch")
+	assert.NoError(t, err)
+	assert.Equal(t, []ReplicaTag{{Tag: "online", ResourceGroup: "rg1"}, {Tag: "batch"}}, tags)
+
+	tags, err = ParseReplicaTags("online:rg1,batch::exclusive")
+	assert.NoError(t, err)
+	assert.Equal(t, []ReplicaTag{{Tag: "online", ResourceGroup: "rg1"}, {Tag: "batch", Exclusive: true}}, tags)
+
+	_, err = ParseReplicaTags("online,:rg1")
+	assert.Error(t, err)
+
+	_, err = ParseReplicaTags("online:rg1:shared")
+	assert.Error(t, err)
+}
+
+func TestSpawnReplicasWithTags(t *testing.T) {
+	paramtable.Init()
+	config := GenerateEtcdConfig()
+	cli, _ := etcd.GetEtcdClient(
+		config.UseEmbedEtcd.GetAsBool(),
+		config.EtcdUseSSL.GetAsBool(),
+		config.Endpoints.GetAsStrings(),
+		config.EtcdTLSCert.GetValue(),
+		config.EtcdTLSKey.GetValue(),
+		config.EtcdTLSCACert.GetValue(),
+		config.EtcdTLSMinVersion.GetValue())
+	kv := etcdKV.NewEtcdKV(cli, config.MetaRootPath.GetValue())
+
+	store := querycoord.NewCatalog(kv)
+	nodeMgr := session.NewNodeManager()
+	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
+	m.ResourceManager.AddResourceGroup("rg1", nil)
+	m.ResourceManager.AddResourceGroup("rg2", nil)
+	for i := 1; i < 7; i++ {
+		nodeMgr.Add(session.NewNodeInfo(int64(i), "localhost"))
+		if i%2 == 0 {
+			m.ResourceManager.AssignNode("rg1", int64(i))
+		} else {
+			m.ResourceManager.AssignNode("rg2", int64(i))
+		}
+	}
+
+	// the number of tags mismatches the replica number
+	_, err := SpawnReplicasWithTags(m, 1000, []ReplicaTag{{Tag: "online"}}, nil, 2)
+	assert.Error(t, err)
+
+	// resource group not found
+	_, err = SpawnReplicasWithTags(m, 1000, []ReplicaTag{{Tag: "online", ResourceGroup: "rg3"}}, nil, 1)
+	assert.Error(t, err)
+
+	replicas, err := SpawnReplicasWithTags(m, 1000, []ReplicaTag{
+		{Tag: "online"},
+		{Tag: "online"},
+		{Tag: "batch", ResourceGroup: "rg2", Exclusive: true},
+	}, []string{"rg1"}, 3)
+	assert.NoError(t, err)
+	assert.Len(t, replicas, 3)
+	for _, replica := range replicas {
+		assert.NotEmpty(t, replica.GetNodes())
+		for _, node := range replica.GetNodes() {
+			assert.True(t, m.ResourceManager.ContainsNode(replica.GetResourceGroup(), node))
+		}
+	}
+	assert.Equal(t, "online", replicas[0].GetTag())
+	assert.Equal(t, "rg1", replicas[0].GetResourceGroup())
+	assert.Equal(t, "online", replicas[1].GetTag())
+	assert.Equal(t, "rg1", replicas[1].GetResourceGroup())
+	assert.Equal(t, "batch", replicas[2].GetTag())
+	assert.False(t, replicas[0].GetExclusive())
+	assert.True(t, replicas[2].GetExclusive())
+	assert.Equal(t, "rg2", replicas[2].GetResourceGroup())
+	assert.Len(t, replicas[2].GetNodes(), 3)
+}
+
 func TestAddNodesToCollectionsInRGFailed(t *testing.T) {
 	paramtable.Init()
 
@@ -127,7 +206,7 @@ func TestAddNodesToCollectionsInRGFailed(t *testing.T) {
 	store.EXPECT().SaveResourceGroup(mock.Anything).Return(nil)
 	nodeMgr := session.NewNodeManager()
 	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
-	m.ResourceManager.AddResourceGroup("rg")
+	m.ResourceManager.AddResourceGroup("rg", nil)
 	m.CollectionManager.PutCollection(CreateTestCollection(1, 2))
 	m.CollectionManager.PutCollection(CreateTestCollection(2, 2))
 	m.ReplicaManager.Put(meta.NewReplica(
@@ -189,7 +268,7 @@ func TestAddNodesToCollectionsInRG(t *testing.T) {
 	store.EXPECT().SaveResourceGroup(mock.Anything).Return(nil)
 	nodeMgr := session.NewNodeManager()
 	m := meta.NewMeta(RandomIncrementIDAllocator(), store, nodeMgr)
-	m.ResourceManager.AddResourceGroup("rg")
+	m.ResourceManager.AddResourceGroup("rg", nil)
 	m.CollectionManager.PutCollection(CreateTestCollection(1, 2))
 	m.CollectionManager.PutCollection(CreateTestCollection(2, 2))
 	m.ReplicaManager.Put(meta.NewReplica(
@@ -90,6 +90,7 @@ func PackSegmentLoadInfo(segment *datapb.SegmentInfo, channelCheckpoint *msgpb.M
 		DeltaPosition:  checkpoint,
 		Level:          segment.GetLevel(),
 		StorageVersion: segment.GetStorageVersion(),
+		FieldStats:     segment.GetFieldStats(),
 	}
 	loadInfo.SegmentSize = calculateSegmentSize(loadInfo)
 	return loadInfo
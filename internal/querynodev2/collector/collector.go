@@ -30,6 +30,8 @@ var Rate *ratelimitutil.RateCollector
 
 var Counter *counter
 
+var Load *loadCollector
+
 func RateMetrics() []string {
 	return []string{
 		metricsinfo.NQPerSecond,
@@ -65,6 +67,7 @@ func init() {
 	}
 	Average = newAverageCollector()
 	Counter = newCounter()
+	Load = newLoadCollector()
 
 	// init rate Metric
 	for _, label := range RateMetrics() {
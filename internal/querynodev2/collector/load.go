@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+const (
+	// loadDecayWindow is the time constant of the exponential decay,
+	// the load of a constant workload converges after a few windows.
+	loadDecayWindow = time.Minute
+	// the load is dropped if not updated in the duration, which has decayed to almost zero
+	loadStaleDuration = 10 * loadDecayWindow
+)
+
+// decayedLoad is the exponentially decayed count and cost of the searches
+type decayedLoad struct {
+	count      float64
+	cost       float64
+	lastUpdate time.Time
+}
+
+func (l *decayedLoad) decay(now time.Time) {
+	factor := math.Exp(-float64(now.Sub(l.lastUpdate)) / float64(loadDecayWindow))
+	l.count *= factor
+	l.cost *= factor
+	l.lastUpdate = now
+}
+
+// loadCollector collects the search load of the segments and the delegators, which is reported to querycoord
+// for workload balance. The load is exponentially decayed, so it represents the recent workload.
+type loadCollector struct {
+	sync.Mutex
+	loads     map[string]*decayedLoad
+	lastPrune time.Time
+}
+
+// Add records a search on the label, which takes the cost in milliseconds.
+func (c *loadCollector) Add(label string, cost float64) {
+	c.Lock()
+	defer c.Unlock()
+
+	now := time.Now()
+	load, ok := c.loads[label]
+	if !ok {
+		load = &decayedLoad{lastUpdate: now}
+		c.loads[label] = load
+	}
+	load.decay(now)
+	load.count++
+	load.cost += cost
+
+	c.prune(now)
+}
+
+// Get returns the searches per second and the milliseconds spent on searches per second of the label.
+func (c *loadCollector) Get(label string) (qps float64, cost float64) {
+	c.Lock()
+	defer c.Unlock()
+
+	load, ok := c.loads[label]
+	if !ok {
+		return 0, 0
+	}
+	load.decay(time.Now())
+	return load.count / loadDecayWindow.Seconds(), load.cost / loadDecayWindow.Seconds()
+}
+
+// prune drops the loads of the released segments and delegators, which are not updated for a long time.
+func (c *loadCollector) prune(now time.Time) {
+	if now.Sub(c.lastPrune) < loadDecayWindow {
+		return
+	}
+	c.lastPrune = now
+	for label, load := range c.loads {
+		if now.Sub(load.lastUpdate) > loadStaleDuration {
+			delete(c.loads, label)
+		}
+	}
+}
+
+func newLoadCollector() *loadCollector {
+	return &loadCollector{
+		loads:     make(map[string]*decayedLoad),
+		lastPrune: time.Now(),
+	}
+}
+
+func SegmentLoadLabel(segmentID int64) string {
+	return ConstructLabel("segment", fmt.Sprint(segmentID))
+}
+
+func ChannelLoadLabel(channel string) string {
+	return ConstructLabel("channel", channel)
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package collector
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type LoadTestSuite struct {
+	suite.Suite
+	label string
+	load  *loadCollector
+}
+
+func (suite *LoadTestSuite) SetupTest() {
+	suite.load = newLoadCollector()
+	suite.label = SegmentLoadLabel(1)
+}
+
+func (suite *LoadTestSuite) TestBasic() {
+	// get default value(zero)
+	qps, cost := suite.load.Get(suite.label)
+	suite.Zero(qps)
+	suite.Zero(cost)
+
+	for i := 0; i < 60; i++ {
+		suite.load.Add(suite.label, 10)
+	}
+	qps, cost = suite.load.Get(suite.label)
+	suite.InDelta(1, qps, 0.01)
+	suite.InDelta(10, cost, 0.1)
+
+	// decayed over time
+	suite.load.loads[suite.label].lastUpdate = time.Now().Add(-loadDecayWindow)
+	qps, cost = suite.load.Get(suite.label)
+	suite.InDelta(0.368, qps, 0.01)
+	suite.InDelta(3.68, cost, 0.1)
+
+	qps, cost = suite.load.Get(ChannelLoadLabel("dml_0"))
+	suite.Zero(qps)
+	suite.Zero(cost)
+}
+
+func (suite *LoadTestSuite) TestPrune() {
+	suite.load.Add(suite.label, 10)
+	suite.load.loads[suite.label].lastUpdate = time.Now().Add(-loadStaleDuration - time.Second)
+	suite.load.lastPrune = time.Now().Add(-loadDecayWindow)
+
+	other := SegmentLoadLabel(2)
+	suite.load.Add(other, 10)
+	suite.NotContains(suite.load.loads, suite.label)
+	suite.Contains(suite.load.loads, other)
+}
+
+func TestLoadCollector(t *testing.T) {
+	suite.Run(t, new(LoadTestSuite))
+}
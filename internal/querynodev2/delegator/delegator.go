@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querynodev2/cluster"
 	"github.com/milvus-io/milvus/internal/querynodev2/delegator/deletebuffer"
@@ -80,6 +81,10 @@ type ShardDelegator interface {
 	Serviceable() bool
 	Start()
 	Close()
+
+	// standby
+	MarkStandby()
+	Promote()
 }
 
 var _ ShardDelegator = (*shardDelegator)(nil)
@@ -107,6 +112,8 @@ type shardDelegator struct {
 	// stream delete buffer
 	deleteMut    sync.RWMutex
 	deleteBuffer deletebuffer.DeleteBuffer[*deletebuffer.Item]
+	// the standby delegator buffers the deletes of the sealed segments until promoted
+	standby *atomic.Bool
 	// dispatcherClient msgdispatcher.Client
 	factory msgstream.Factory
 
@@ -116,6 +123,10 @@ type shardDelegator struct {
 	latestTsafe *atomic.Uint64
 	// queryHook
 	queryHook optimizers.QueryHook
+	// scalar field stats of sealed segments for segment pruning, segmentID => stats
+	segmentStats *typeutil.ConcurrentMap[int64, segmentFieldStats]
+	// term stats of the analyzer enabled fields of sealed segments for full-text search
+	idfOracle *idfOracle
 }
 
 // getLogger returns the zap logger with pre-defined shard attributes.
@@ -185,12 +196,61 @@ func (sd *shardDelegator) modifyQueryRequest(req *querypb.QueryRequest, scope qu
 	return nodeReq
 }
 
+// pruneSegments skips the sealed segments which could not match the filter of the plan,
+// based on the scalar field stats of segments.
+func (sd *shardDelegator) pruneSegments(ctx context.Context, serializedPlan []byte, sealed []SnapshotItem) []SnapshotItem {
+	if !paramtable.Get().QueryNodeCfg.EnableSegmentPrune.GetAsBool() || len(serializedPlan) == 0 {
+		return sealed
+	}
+
+	log := sd.getLogger(ctx)
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(serializedPlan, plan); err != nil {
+		log.Warn("failed to unmarshal plan, skip segment pruning", zap.Error(err))
+		return sealed
+	}
+
+	result, pruned := PruneSegments(getPlanPredicates(plan), sealed, sd.segmentStats.Get)
+	if pruned > 0 {
+		log.Debug("segments pruned by field stats", zap.Int("prunedNum", pruned))
+	}
+	return result
+}
+
+// getBM25Statistics sums up the term stats of the readable sealed and growing segments in shard,
+// the stats of growing segments exclude the deleted rows.
+func (sd *shardDelegator) getBM25Statistics(req *querypb.GetStatisticsRequest, sealed []SnapshotItem, growing []SegmentEntry) (*internalpb.GetStatisticsResponse, error) {
+	fieldID, terms := req.GetReq().GetBm25FieldID(), req.GetReq().GetBm25Terms()
+	if !sd.idfOracle.IsAnalyzerField(fieldID) {
+		return nil, merr.WrapErrParameterInvalidMsg("analyzer not enabled on field %d", fieldID)
+	}
+
+	sealedIDs := lo.FlatMap(sealed, func(item SnapshotItem, _ int) []int64 {
+		return lo.Map(item.Segments, func(entry SegmentEntry, _ int) int64 { return entry.SegmentID })
+	})
+	stats := sd.idfOracle.SealedStats(fieldID, terms, sealedIDs)
+	for _, entry := range growing {
+		segment := sd.segmentManager.GetGrowing(entry.SegmentID)
+		if segment == nil {
+			continue
+		}
+		if index := segment.GetBM25Index(fieldID); index != nil {
+			stats.Merge(index.TermStats(terms))
+		}
+	}
+	return &internalpb.GetStatisticsResponse{
+		Status:    merr.Success(),
+		Bm25Stats: stats.ToProto(),
+	}, nil
+}
+
 // Search preforms search operation on shard.
 func (sd *shardDelegator) search(ctx context.Context, req *querypb.SearchRequest, sealed []SnapshotItem, growing []SegmentEntry) ([]*internalpb.SearchResults, error) {
 	log := sd.getLogger(ctx)
 	if req.Req.IgnoreGrowing {
 		growing = []SegmentEntry{}
 	}
+	sealed = sd.pruneSegments(ctx, req.GetReq().GetSerializedExprPlan(), sealed)
 
 	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
 	log.Debug("search segments...",
@@ -340,6 +400,7 @@ func (sd *shardDelegator) HybridSearch(ctx context.Context, req *querypb.HybridS
 				results,
 				searchReq.Req.GetNq(),
 				searchReq.Req.GetTopk(),
+				searchReq.Req.GetGroupSize(),
 				searchReq.Req.GetMetricType())
 		})
 		futures[index] = future
@@ -421,6 +482,7 @@ func (sd *shardDelegator) QueryStream(ctx context.Context, req *querypb.QueryReq
 	if req.Req.IgnoreGrowing {
 		growing = []SegmentEntry{}
 	}
+	sealed = sd.pruneSegments(ctx, req.GetReq().GetSerializedExprPlan(), sealed)
 
 	log.Info("query stream segments...",
 		zap.Int("sealedNum", len(sealed)),
@@ -492,6 +554,7 @@ func (sd *shardDelegator) Query(ctx context.Context, req *querypb.QueryRequest)
 	if req.Req.IgnoreGrowing {
 		growing = []SegmentEntry{}
 	}
+	sealed = sd.pruneSegments(ctx, req.GetReq().GetSerializedExprPlan(), sealed)
 
 	sealedNum := lo.SumBy(sealed, func(item SnapshotItem) int { return len(item.Segments) })
 	log.Debug("query segments...",
@@ -546,6 +609,15 @@ func (sd *shardDelegator) GetStatistics(ctx context.Context, req *querypb.GetSta
 	}
 	defer sd.distribution.Unpin(version)
 
+	if req.GetReq().GetBm25FieldID() != 0 {
+		result, err := sd.getBM25Statistics(req, sealed, growing)
+		if err != nil {
+			log.Warn("delegator failed to get bm25 statistics", zap.Error(err))
+			return nil, err
+		}
+		return []*internalpb.GetStatisticsResponse{result}, nil
+	}
+
 	tasks, err := organizeSubTask(ctx, req, sealed, growing, sd, func(req *querypb.GetStatisticsRequest, scope querypb.DataScope, segmentIDs []int64, targetID int64) *querypb.GetStatisticsRequest {
 		nodeReq := proto.Clone(req).(*querypb.GetStatisticsRequest)
 		nodeReq.GetReq().GetBase().TargetID = targetID
@@ -809,9 +881,12 @@ func NewShardDelegator(ctx context.Context, collectionID UniqueID, replicaID Uni
 		pkOracle:        pkoracle.NewPkOracle(),
 		tsafeManager:    tsafeManager,
 		latestTsafe:     atomic.NewUint64(startTs),
+		standby:         atomic.NewBool(false),
 		loader:          loader,
 		factory:         factory,
 		queryHook:       queryHook,
+		segmentStats:    typeutil.NewConcurrentMap[int64, segmentFieldStats](),
+		idfOracle:       newIDFOracle(collection.Schema()),
 	}
 	m := sync.Mutex{}
 	sd.tsCond = sync.NewCond(&m)
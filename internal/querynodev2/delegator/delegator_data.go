@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -157,6 +158,7 @@ func (sd *shardDelegator) ProcessInsert(insertRecords map[int64]*InsertData) {
 // ProcessDelete handles delete data in delegator.
 // delegator puts deleteData into buffer first,
 // then dispatch data to segments acoording to the result of pkOracle.
+// the standby delegator only dispatches data to its growing segments.
 func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 	method := "ProcessDelete"
 	tr := timerecord.NewTimeRecorder(method)
@@ -185,6 +187,20 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 		Data: cacheItems,
 	})
 
+	// the standby delegator applies the deletes to its growing segments only,
+	// the deletes of the sealed segments are forwarded to the workers after promoted
+	scope := querypb.DataScope_All
+	if sd.standby.Load() {
+		scope = querypb.DataScope_Streaming
+	}
+	sd.forwardDelete(log, sd.groupDeleteBySegment(log, deleteData), scope)
+
+	metrics.QueryNodeProcessCost.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).
+		Observe(float64(tr.ElapseSpan().Milliseconds()))
+}
+
+// groupDeleteBySegment groups the delete data by the candidate segments of the primary keys.
+func (sd *shardDelegator) groupDeleteBySegment(log *log.MLogger, deleteData []*DeleteData) map[int64]DeleteData {
 	// segment => delete data
 	delRecords := make(map[int64]DeleteData)
 	for _, data := range deleteData {
@@ -203,10 +219,18 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 			}
 		}
 	}
+	return delRecords
+}
 
+// forwardDelete applies the delete records to the online segments of the scope on the workers,
+// the segments failed to apply are marked offline.
+func (sd *shardDelegator) forwardDelete(log *log.MLogger, delRecords map[int64]DeleteData, scope querypb.DataScope) {
 	offlineSegments := typeutil.NewConcurrentSet[int64]()
 
 	sealed, growing, version := sd.distribution.PinOnlineSegments()
+	if scope == querypb.DataScope_Streaming {
+		sealed = nil
+	}
 
 	eg, ctx := errgroup.WithContext(context.Background())
 	for _, entry := range sealed {
@@ -226,7 +250,7 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 			return nil
 		})
 	}
-	if len(growing) > 0 {
+	if len(growing) > 0 && scope != querypb.DataScope_Historical {
 		eg.Go(func() error {
 			worker, err := sd.workerManager.GetWorker(ctx, paramtable.GetNodeID())
 			if err != nil {
@@ -251,9 +275,36 @@ func (sd *shardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 		log.Warn("failed to apply delete, mark segment offline", zap.Int64s("offlineSegments", offlineSegIDs))
 		sd.markSegmentOffline(offlineSegIDs...)
 	}
+}
 
-	metrics.QueryNodeProcessCost.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()), metrics.DeleteLabel).
-		Observe(float64(tr.ElapseSpan().Milliseconds()))
+// MarkStandby marks the delegator as standby, which consumes the channel but forwards no delete to the workers.
+func (sd *shardDelegator) MarkStandby() {
+	sd.standby.Store(true)
+}
+
+// Promote makes the standby delegator serve,
+// the deletes buffered while standby are forwarded to the sealed segments on the workers.
+func (sd *shardDelegator) Promote() {
+	sd.deleteMut.Lock()
+	defer sd.deleteMut.Unlock()
+	if !sd.standby.CompareAndSwap(true, false) {
+		return
+	}
+
+	log := sd.getLogger(context.Background())
+	var deleteData []*DeleteData
+	for _, item := range sd.deleteBuffer.ListAfter(0) {
+		for _, entry := range item.Data {
+			deleteData = append(deleteData, &DeleteData{
+				PartitionID: entry.PartitionID,
+				PrimaryKeys: entry.DeleteData.Pks,
+				Timestamps:  entry.DeleteData.Tss,
+				RowCount:    entry.DeleteData.RowCount,
+			})
+		}
+	}
+	log.Info("standby delegator promoted, forward the buffered deletes", zap.Int("deleteNum", len(deleteData)))
+	sd.forwardDelete(log, sd.groupDeleteBySegment(log, deleteData), querypb.DataScope_Historical)
 }
 
 // applyDelete handles delete record and apply them to corresponding workers.
@@ -405,6 +456,15 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 		return err
 	}
 
+	var bm25Stats map[int64]map[int64]*bm25.Stats
+	if sd.idfOracle.Enabled() && req.GetLoadScope() != querypb.LoadScope_Index {
+		bm25Stats, err = sd.loader.LoadBM25Stats(ctx, req.GetCollectionID(), req.GetInfos()...)
+		if err != nil {
+			log.Warn("failed to load bm25 stats for segment", zap.Error(err))
+			return err
+		}
+	}
+
 	req.Base.TargetID = req.GetDstNodeID()
 	log.Debug("worker loads segments...")
 
@@ -472,6 +532,15 @@ func (sd *shardDelegator) LoadSegments(ctx context.Context, req *querypb.LoadSeg
 	// alter distribution
 	sd.distribution.AddDistributions(entries...)
 
+	for _, info := range req.GetInfos() {
+		if len(info.GetFieldStats()) > 0 {
+			sd.segmentStats.Insert(info.GetSegmentID(), newSegmentFieldStats(info.GetFieldStats()))
+		}
+	}
+	for segmentID, stats := range bm25Stats {
+		sd.idfOracle.SetSealed(segmentID, stats)
+	}
+
 	return nil
 }
 
@@ -817,6 +886,21 @@ func (sd *shardDelegator) ReleaseSegments(ctx context.Context, req *querypb.Rele
 			pkoracle.WithSegmentType(commonpb.SegmentState_Sealed),
 			pkoracle.WithWorkerID(targetNodeID),
 		)
+
+		// the segment may still be served by other worker
+		current, _ := sd.distribution.PeekSegments(false)
+		served := typeutil.NewSet[int64]()
+		for _, item := range current {
+			for _, entry := range item.Segments {
+				served.Insert(entry.SegmentID)
+			}
+		}
+		for _, entry := range sealed {
+			if !served.Contain(entry.SegmentID) {
+				sd.segmentStats.Remove(entry.SegmentID)
+				sd.idfOracle.RemoveSealed(entry.SegmentID)
+			}
+		}
 	}
 	if len(growing) > 0 {
 		sd.pkOracle.Remove(
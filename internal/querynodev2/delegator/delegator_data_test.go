@@ -25,6 +25,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
+	"go.uber.org/atomic"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/msgpb"
@@ -363,6 +364,85 @@ func (s *DelegatorDataSuite) TestProcessDelete() {
 	s.False(s.delegator.distribution.Serviceable())
 }
 
+func (s *DelegatorDataSuite) TestStandbyProcessDelete() {
+	s.loader.EXPECT().LoadBloomFilterSet(mock.Anything, s.collectionID, mock.AnythingOfType("int64"), mock.Anything).
+		Call.Return(func(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) []*pkoracle.BloomFilterSet {
+		return lo.Map(infos, func(info *querypb.SegmentLoadInfo, _ int) *pkoracle.BloomFilterSet {
+			bfs := pkoracle.NewBloomFilterSet(info.GetSegmentID(), info.GetPartitionID(), commonpb.SegmentState_Sealed)
+			bf := bloom.NewWithEstimates(paramtable.Get().CommonCfg.BloomFilterSize.GetAsUint(),
+				paramtable.Get().CommonCfg.MaxBloomFalsePositive.GetAsFloat())
+			pks := &storage.PkStatistics{
+				PkFilter: bf,
+			}
+			pks.UpdatePKRange(&storage.Int64FieldData{
+				Data: []int64{10, 20, 30},
+			})
+			bfs.AddHistoricalStats(pks)
+			return bfs
+		})
+	}, func(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) error {
+		return nil
+	})
+
+	worker1 := &cluster.MockWorker{}
+	worker1.EXPECT().LoadSegments(mock.Anything, mock.AnythingOfType("*querypb.LoadSegmentsRequest")).
+		Return(nil)
+	deleted := atomic.NewInt64(0)
+	worker1.EXPECT().Delete(mock.Anything, mock.AnythingOfType("*querypb.DeleteRequest")).
+		Run(func(_ context.Context, req *querypb.DeleteRequest) {
+			deleted.Add(int64(len(req.GetPrimaryKeys().GetIntId().GetData())))
+		}).Return(nil)
+	s.workerManager.EXPECT().GetWorker(mock.Anything, int64(1)).Return(worker1, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	err := s.delegator.LoadSegments(ctx, &querypb.LoadSegmentsRequest{
+		Base:         commonpbutil.NewMsgBase(),
+		DstNodeID:    1,
+		CollectionID: s.collectionID,
+		Infos: []*querypb.SegmentLoadInfo{
+			{
+				SegmentID:     1000,
+				CollectionID:  s.collectionID,
+				PartitionID:   500,
+				StartPosition: &msgpb.MsgPosition{Timestamp: 5},
+				DeltaPosition: &msgpb.MsgPosition{Timestamp: 5},
+			},
+		},
+	})
+	s.Require().NoError(err)
+
+	// the standby delegator buffers the deletes of the sealed segments
+	s.delegator.MarkStandby()
+	s.delegator.ProcessDelete([]*DeleteData{
+		{
+			PartitionID: 500,
+			PrimaryKeys: []storage.PrimaryKey{storage.NewInt64PrimaryKey(10)},
+			Timestamps:  []uint64{10},
+			RowCount:    1,
+		},
+	}, 10)
+	s.EqualValues(0, deleted.Load())
+
+	// the buffered deletes are forwarded after promoted
+	s.delegator.Promote()
+	s.EqualValues(1, deleted.Load())
+
+	s.delegator.ProcessDelete([]*DeleteData{
+		{
+			PartitionID: 500,
+			PrimaryKeys: []storage.PrimaryKey{storage.NewInt64PrimaryKey(20)},
+			Timestamps:  []uint64{11},
+			RowCount:    1,
+		},
+	}, 11)
+	s.EqualValues(2, deleted.Load())
+
+	// promote twice takes no effect
+	s.delegator.Promote()
+	s.EqualValues(2, deleted.Load())
+}
+
 func (s *DelegatorDataSuite) TestLoadSegments() {
 	s.Run("normal_run", func() {
 		defer func() {
@@ -0,0 +1,89 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"sync"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/bm25"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// segmentBM25Stats is the term stats of the analyzer enabled fields of a segment, fieldID => stats.
+type segmentBM25Stats map[int64]*bm25.Stats
+
+// idfOracle maintains the term stats of the analyzer enabled fields of the sealed segments in the shard.
+// The stats of growing segments are read from their term frequency indexes, which exclude the deleted rows.
+// Proxy sums up the stats of all shards to compute the query weights of full-text search,
+// so that the scores of different shards are comparable.
+type idfOracle struct {
+	mut    sync.RWMutex
+	fields typeutil.Set[int64]        // analyzer enabled fields
+	sealed map[int64]segmentBM25Stats // segmentID => stats
+}
+
+func newIDFOracle(schema *schemapb.CollectionSchema) *idfOracle {
+	oracle := &idfOracle{
+		fields: typeutil.NewSet[int64](),
+		sealed: make(map[int64]segmentBM25Stats),
+	}
+	for _, field := range bm25.GetAnalyzerFields(schema) {
+		oracle.fields.Insert(field.GetFieldID())
+	}
+	return oracle
+}
+
+// Enabled returns whether any field of the collection has analyzer enabled.
+func (o *idfOracle) Enabled() bool {
+	return o.fields.Len() > 0
+}
+
+// IsAnalyzerField returns whether the field has analyzer enabled.
+func (o *idfOracle) IsAnalyzerField(fieldID int64) bool {
+	return o.fields.Contain(fieldID)
+}
+
+// SetSealed sets the stats of a loaded sealed segment, the previous stats of the segment are replaced.
+func (o *idfOracle) SetSealed(segmentID int64, stats segmentBM25Stats) {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+	o.sealed[segmentID] = stats
+}
+
+func (o *idfOracle) RemoveSealed(segmentIDs ...int64) {
+	o.mut.Lock()
+	defer o.mut.Unlock()
+	for _, segmentID := range segmentIDs {
+		delete(o.sealed, segmentID)
+	}
+}
+
+// SealedStats sums up the stats of the field of the sealed segments,
+// only the document frequencies of the terms are kept.
+func (o *idfOracle) SealedStats(fieldID int64, terms []string, segmentIDs []int64) *bm25.Stats {
+	o.mut.RLock()
+	defer o.mut.RUnlock()
+
+	result := bm25.NewStats()
+	for _, segmentID := range segmentIDs {
+		if stats, ok := o.sealed[segmentID][fieldID]; ok {
+			result.Merge(stats.Subset(terms))
+		}
+	}
+	return result
+}
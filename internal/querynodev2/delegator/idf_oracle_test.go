@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/bm25"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+type IDFOracleSuite struct {
+	suite.Suite
+
+	oracle *idfOracle
+}
+
+func (s *IDFOracleSuite) SetupTest() {
+	s.oracle = newIDFOracle(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.EnableAnalyzerKey, Value: "true"},
+			}},
+		},
+	})
+}
+
+func (s *IDFOracleSuite) genStats(texts ...string) segmentBM25Stats {
+	analyzer, err := bm25.NewAnalyzer("")
+	s.Require().NoError(err)
+	index := bm25.NewIndex(analyzer)
+	pks := make([]int64, len(texts))
+	index.Append(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}, nil, texts)
+	return segmentBM25Stats{101: index.Stats()}
+}
+
+func (s *IDFOracleSuite) TestDisabled() {
+	oracle := newIDFOracle(&schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar}},
+	})
+	s.False(oracle.Enabled())
+	s.False(oracle.IsAnalyzerField(101))
+}
+
+func (s *IDFOracleSuite) TestSealed() {
+	s.True(s.oracle.Enabled())
+	s.True(s.oracle.IsAnalyzerField(101))
+
+	s.oracle.SetSealed(1, s.genStats("milvus vector database", "milvus"))
+	// reloading the same segment replaces its stats
+	s.oracle.SetSealed(1, s.genStats("milvus vector database", "milvus"))
+	s.oracle.SetSealed(2, s.genStats("vector search", "database"))
+
+	stats := s.oracle.SealedStats(101, []string{"milvus", "vector"}, []int64{1, 2, 3})
+	s.EqualValues(4, stats.NumRows)
+	s.EqualValues(7, stats.NumTokens)
+	s.Equal(map[string]int64{"milvus": 2, "vector": 2}, stats.DocFreq)
+
+	// only the stats of the readable segments are summed up
+	stats = s.oracle.SealedStats(101, []string{"milvus", "vector"}, []int64{2})
+	s.EqualValues(2, stats.NumRows)
+	s.Equal(map[string]int64{"vector": 1}, stats.DocFreq)
+
+	s.oracle.RemoveSealed(1, 3)
+	stats = s.oracle.SealedStats(101, []string{"milvus"}, []int64{1, 2})
+	s.EqualValues(2, stats.NumRows)
+	s.Empty(stats.DocFreq)
+	s.Zero(s.oracle.SealedStats(102, []string{"milvus"}, []int64{2}).NumRows)
+}
+func TestIDFOracle(t *testing.T) {
+	suite.Run(t, new(IDFOracleSuite))
+}
@@ -395,6 +395,38 @@ func (_c *MockShardDelegator_LoadSegments_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// MarkStandby provides a mock function with given fields:
+func (_m *MockShardDelegator) MarkStandby() {
+	_m.Called()
+}
+
+// MockShardDelegator_MarkStandby_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MarkStandby'
+type MockShardDelegator_MarkStandby_Call struct {
+	*mock.Call
+}
+
+// MarkStandby is a helper method to define mock.On call
+func (_e *MockShardDelegator_Expecter) MarkStandby() *MockShardDelegator_MarkStandby_Call {
+	return &MockShardDelegator_MarkStandby_Call{Call: _e.mock.On("MarkStandby")}
+}
+
+func (_c *MockShardDelegator_MarkStandby_Call) Run(run func()) *MockShardDelegator_MarkStandby_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_MarkStandby_Call) Return() *MockShardDelegator_MarkStandby_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockShardDelegator_MarkStandby_Call) RunAndReturn(run func()) *MockShardDelegator_MarkStandby_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ProcessDelete provides a mock function with given fields: deleteData, ts
 func (_m *MockShardDelegator) ProcessDelete(deleteData []*DeleteData, ts uint64) {
 	_m.Called(deleteData, ts)
@@ -462,6 +494,38 @@ func (_c *MockShardDelegator_ProcessInsert_Call) RunAndReturn(run func(map[int64
 	return _c
 }
 
+// Promote provides a mock function with given fields:
+func (_m *MockShardDelegator) Promote() {
+	_m.Called()
+}
+
+// MockShardDelegator_Promote_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Promote'
+type MockShardDelegator_Promote_Call struct {
+	*mock.Call
+}
+
+// Promote is a helper method to define mock.On call
+func (_e *MockShardDelegator_Expecter) Promote() *MockShardDelegator_Promote_Call {
+	return &MockShardDelegator_Promote_Call{Call: _e.mock.On("Promote")}
+}
+
+func (_c *MockShardDelegator_Promote_Call) Run(run func()) *MockShardDelegator_Promote_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockShardDelegator_Promote_Call) Return() *MockShardDelegator_Promote_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockShardDelegator_Promote_Call) RunAndReturn(run func()) *MockShardDelegator_Promote_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Query provides a mock function with given fields: ctx, req
 func (_m *MockShardDelegator) Query(ctx context.Context, req *querypb.QueryRequest) ([]*internalpb.RetrieveResults, error) {
 	ret := _m.Called(ctx, req)
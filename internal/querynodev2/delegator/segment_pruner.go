@@ -0,0 +1,305 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// segmentFieldStats is the scalar field stats of a sealed segment, fieldID => stats.
+type segmentFieldStats map[int64]*datapb.FieldStats
+
+func newSegmentFieldStats(stats []*datapb.FieldStats) segmentFieldStats {
+	result := make(segmentFieldStats, len(stats))
+	for _, s := range stats {
+		result[s.GetFieldID()] = s
+	}
+	return result
+}
+
+// getPlanPredicates returns the filter expression of search or query plan.
+func getPlanPredicates(plan *planpb.PlanNode) *planpb.Expr {
+	switch {
+	case plan.GetVectorAnns() != nil:
+		return plan.GetVectorAnns().GetPredicates()
+	case plan.GetQuery() != nil:
+		return plan.GetQuery().GetPredicates()
+	default:
+		return plan.GetPredicates()
+	}
+}
+
+// PruneSegments removes the sealed segments which could not match the filter expression,
+// based on the min/max stats of the scalar fields. Segments without stats are always kept.
+// The input items are not modified, it returns the pruned items and the number of pruned segments.
+func PruneSegments(expr *planpb.Expr, sealed []SnapshotItem, getStats func(segmentID int64) (segmentFieldStats, bool)) ([]SnapshotItem, int) {
+	if expr == nil {
+		return sealed, 0
+	}
+
+	pruned := 0
+	result := make([]SnapshotItem, 0, len(sealed))
+	for _, item := range sealed {
+		segments := make([]SegmentEntry, 0, len(item.Segments))
+		for _, segment := range item.Segments {
+			stats, ok := getStats(segment.SegmentID)
+			if ok && !mayMatch(expr, stats) {
+				pruned++
+				continue
+			}
+			segments = append(segments, segment)
+		}
+		result = append(result, SnapshotItem{
+			NodeID:   item.NodeID,
+			Segments: segments,
+		})
+	}
+	return result, pruned
+}
+
+// mayMatch returns false only if none of the rows described by stats could satisfy the expr.
+func mayMatch(expr *planpb.Expr, stats segmentFieldStats) bool {
+	switch e := expr.GetExpr().(type) {
+	case *planpb.Expr_BinaryExpr:
+		switch e.BinaryExpr.GetOp() {
+		case planpb.BinaryExpr_LogicalAnd:
+			return mayMatch(e.BinaryExpr.GetLeft(), stats) && mayMatch(e.BinaryExpr.GetRight(), stats)
+		case planpb.BinaryExpr_LogicalOr:
+			return mayMatch(e.BinaryExpr.GetLeft(), stats) || mayMatch(e.BinaryExpr.GetRight(), stats)
+		default:
+			return true
+		}
+	case *planpb.Expr_UnaryRangeExpr:
+		return unaryRangeMayMatch(e.UnaryRangeExpr, stats)
+	case *planpb.Expr_BinaryRangeExpr:
+		return binaryRangeMayMatch(e.BinaryRangeExpr, stats)
+	case *planpb.Expr_TermExpr:
+		return termMayMatch(e.TermExpr, stats)
+	default:
+		// NOT, compare between columns, arithmetic, json and so on are not pruned
+		return true
+	}
+}
+
+func unaryRangeMayMatch(expr *planpb.UnaryRangeExpr, stats segmentFieldStats) bool {
+	r, ok := getColumnRange(expr.GetColumnInfo(), stats)
+	if !ok {
+		return true
+	}
+
+	if expr.GetOp() == planpb.OpType_PrefixMatch {
+		return r.prefixMayMatch(expr.GetValue())
+	}
+
+	minCmp, ok := r.compareMin(expr.GetValue())
+	if !ok {
+		return true
+	}
+	maxCmp, ok := r.compareMax(expr.GetValue())
+	if !ok {
+		return true
+	}
+
+	switch expr.GetOp() {
+	case planpb.OpType_GreaterThan:
+		return maxCmp > 0
+	case planpb.OpType_GreaterEqual:
+		return maxCmp >= 0
+	case planpb.OpType_LessThan:
+		return minCmp < 0
+	case planpb.OpType_LessEqual:
+		return minCmp <= 0
+	case planpb.OpType_Equal:
+		return minCmp <= 0 && maxCmp >= 0
+	case planpb.OpType_NotEqual:
+		return !(minCmp == 0 && maxCmp == 0)
+	default:
+		return true
+	}
+}
+
+func binaryRangeMayMatch(expr *planpb.BinaryRangeExpr, stats segmentFieldStats) bool {
+	r, ok := getColumnRange(expr.GetColumnInfo(), stats)
+	if !ok {
+		return true
+	}
+
+	maxCmp, ok := r.compareMax(expr.GetLowerValue())
+	if !ok {
+		return true
+	}
+	minCmp, ok := r.compareMin(expr.GetUpperValue())
+	if !ok {
+		return true
+	}
+
+	lowerOK := maxCmp > 0 || (expr.GetLowerInclusive() && maxCmp == 0)
+	upperOK := minCmp < 0 || (expr.GetUpperInclusive() && minCmp == 0)
+	return lowerOK && upperOK
+}
+
+func termMayMatch(expr *planpb.TermExpr, stats segmentFieldStats) bool {
+	if expr.GetIsInField() {
+		return true
+	}
+	r, ok := getColumnRange(expr.GetColumnInfo(), stats)
+	if !ok {
+		return true
+	}
+
+	for _, value := range expr.GetValues() {
+		minCmp, ok := r.compareMin(value)
+		if !ok {
+			return true
+		}
+		maxCmp, ok := r.compareMax(value)
+		if !ok {
+			return true
+		}
+		if minCmp <= 0 && maxCmp >= 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// columnRange is the value range of a column in a segment.
+type columnRange struct {
+	dataType schemapb.DataType
+	min      *schemapb.ValueField
+	max      *schemapb.ValueField
+}
+
+func getColumnRange(info *planpb.ColumnInfo, stats segmentFieldStats) (*columnRange, bool) {
+	if len(info.GetNestedPath()) > 0 || !storage.IsFieldStatsSupported(info.GetDataType()) {
+		return nil, false
+	}
+	fieldStats, ok := stats[info.GetFieldId()]
+	if !ok || fieldStats.GetType() != info.GetDataType() || fieldStats.GetMin() == nil || fieldStats.GetMax() == nil {
+		return nil, false
+	}
+
+	r := &columnRange{
+		dataType: fieldStats.GetType(),
+		min:      fieldStats.GetMin(),
+		max:      fieldStats.GetMax(),
+	}
+	if r.dataType == schemapb.DataType_Float {
+		// the filter value is a double, which may be compared either as float or double,
+		// widen the range by one ulp so that both of them are covered
+		r.min = &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: math.Nextafter32(r.min.GetFloatData(), float32(math.Inf(-1)))}}
+		r.max = &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: math.Nextafter32(r.max.GetFloatData(), float32(math.Inf(1)))}}
+	}
+	return r, true
+}
+
+// compareMin compares the value with the min of range, returns false if they are not comparable.
+func (r *columnRange) compareMin(value *planpb.GenericValue) (int, bool) {
+	return compareStatsValue(r.dataType, r.min, value)
+}
+
+// compareMax compares the value with the max of range, returns false if they are not comparable.
+func (r *columnRange) compareMax(value *planpb.GenericValue) (int, bool) {
+	return compareStatsValue(r.dataType, r.max, value)
+}
+
+// prefixMayMatch checks whether there may be strings with the prefix in the range,
+// the strings with the same prefix are contiguous in lexicographic order.
+func (r *columnRange) prefixMayMatch(value *planpb.GenericValue) bool {
+	prefix, ok := value.GetVal().(*planpb.GenericValue_StringVal)
+	if !ok || !typeutil.IsStringType(r.dataType) {
+		return true
+	}
+	minStr, maxStr := r.min.GetStringData(), r.max.GetStringData()
+	if maxStr < prefix.StringVal {
+		return false
+	}
+	return minStr <= prefix.StringVal || strings.HasPrefix(minStr, prefix.StringVal)
+}
+
+// compareStatsValue returns the sign of stat - value.
+func compareStatsValue(dataType schemapb.DataType, stat *schemapb.ValueField, value *planpb.GenericValue) (int, bool) {
+	compareInt := func(a, b int64) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	}
+	compareFloat := func(a, b float64) int {
+		switch {
+		case a < b:
+			return -1
+		case a > b:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	switch dataType {
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64:
+		s := int64(stat.GetIntData())
+		if dataType == schemapb.DataType_Int64 {
+			s = stat.GetLongData()
+		}
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return compareInt(s, v.Int64Val), true
+		case *planpb.GenericValue_FloatVal:
+			return compareFloat(float64(s), v.FloatVal), true
+		}
+	case schemapb.DataType_Float, schemapb.DataType_Double:
+		s := stat.GetDoubleData()
+		if dataType == schemapb.DataType_Float {
+			s = float64(stat.GetFloatData())
+		}
+		switch v := value.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			return compareFloat(s, float64(v.Int64Val)), true
+		case *planpb.GenericValue_FloatVal:
+			return compareFloat(s, v.FloatVal), true
+		}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		if v, ok := value.GetVal().(*planpb.GenericValue_StringVal); ok {
+			return strings.Compare(stat.GetStringData(), v.StringVal), true
+		}
+	case schemapb.DataType_Bool:
+		if v, ok := value.GetVal().(*planpb.GenericValue_BoolVal); ok {
+			s := stat.GetBoolData()
+			switch {
+			case s == v.BoolVal:
+				return 0, true
+			case s:
+				return 1, true
+			default:
+				return -1, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,196 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package delegator
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+type SegmentPrunerSuite struct {
+	suite.Suite
+
+	sealed []SnapshotItem
+	stats  map[int64]segmentFieldStats
+}
+
+func (s *SegmentPrunerSuite) SetupTest() {
+	s.sealed = []SnapshotItem{
+		{NodeID: 1, Segments: []SegmentEntry{{SegmentID: 100}, {SegmentID: 101}}},
+		{NodeID: 2, Segments: []SegmentEntry{{SegmentID: 102}, {SegmentID: 103}}},
+	}
+
+	genStats := func(minInt, maxInt int64, minStr, maxStr string) segmentFieldStats {
+		return newSegmentFieldStats([]*datapb.FieldStats{
+			{
+				FieldID: 101,
+				Type:    schemapb.DataType_Int64,
+				Min:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: minInt}},
+				Max:     &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: maxInt}},
+			},
+			{
+				FieldID: 102,
+				Type:    schemapb.DataType_VarChar,
+				Min:     &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: minStr}},
+				Max:     &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: maxStr}},
+			},
+		})
+	}
+	// segment 103 has no stats
+	s.stats = map[int64]segmentFieldStats{
+		100: genStats(0, 99, "a", "c"),
+		101: genStats(100, 199, "d", "f"),
+		102: genStats(200, 299, "g", "i"),
+	}
+}
+
+func (s *SegmentPrunerSuite) getStats(segmentID int64) (segmentFieldStats, bool) {
+	stats, ok := s.stats[segmentID]
+	return stats, ok
+}
+
+func (s *SegmentPrunerSuite) prunedIDs(expr *planpb.Expr) []int64 {
+	result, _ := PruneSegments(expr, s.sealed, s.getStats)
+	ids := make([]int64, 0)
+	for _, item := range result {
+		for _, entry := range item.Segments {
+			ids = append(ids, entry.SegmentID)
+		}
+	}
+	return ids
+}
+
+func intColumn() *planpb.ColumnInfo {
+	return &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_Int64}
+}
+
+func strColumn() *planpb.ColumnInfo {
+	return &planpb.ColumnInfo{FieldId: 102, DataType: schemapb.DataType_VarChar}
+}
+
+func intValue(v int64) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_Int64Val{Int64Val: v}}
+}
+
+func strValue(v string) *planpb.GenericValue {
+	return &planpb.GenericValue{Val: &planpb.GenericValue_StringVal{StringVal: v}}
+}
+
+func unaryRange(column *planpb.ColumnInfo, op planpb.OpType, value *planpb.GenericValue) *planpb.Expr {
+	return &planpb.Expr{Expr: &planpb.Expr_UnaryRangeExpr{UnaryRangeExpr: &planpb.UnaryRangeExpr{
+		ColumnInfo: column,
+		Op:         op,
+		Value:      value,
+	}}}
+}
+
+func (s *SegmentPrunerSuite) TestUnaryRange() {
+	s.ElementsMatch([]int64{102, 103}, s.prunedIDs(unaryRange(intColumn(), planpb.OpType_GreaterThan, intValue(199))))
+	s.ElementsMatch([]int64{101, 102, 103}, s.prunedIDs(unaryRange(intColumn(), planpb.OpType_GreaterEqual, intValue(199))))
+	s.ElementsMatch([]int64{100, 103}, s.prunedIDs(unaryRange(intColumn(), planpb.OpType_LessThan, intValue(100))))
+	s.ElementsMatch([]int64{100, 101, 103}, s.prunedIDs(unaryRange(intColumn(), planpb.OpType_LessEqual, intValue(100))))
+	s.ElementsMatch([]int64{101, 103}, s.prunedIDs(unaryRange(intColumn(), planpb.OpType_Equal, intValue(150))))
+	s.ElementsMatch([]int64{100, 101, 102, 103}, s.prunedIDs(unaryRange(intColumn(), planpb.OpType_NotEqual, intValue(150))))
+	s.ElementsMatch([]int64{101, 103}, s.prunedIDs(unaryRange(strColumn(), planpb.OpType_PrefixMatch, strValue("e"))))
+	s.ElementsMatch([]int64{100, 101, 102, 103}, s.prunedIDs(unaryRange(strColumn(), planpb.OpType_PostfixMatch, strValue("e"))))
+
+	// json path is not pruned
+	column := &planpb.ColumnInfo{FieldId: 101, DataType: schemapb.DataType_JSON, NestedPath: []string{"a"}}
+	s.ElementsMatch([]int64{100, 101, 102, 103}, s.prunedIDs(unaryRange(column, planpb.OpType_Equal, intValue(1000))))
+}
+
+func (s *SegmentPrunerSuite) TestBinaryRange() {
+	expr := &planpb.Expr{Expr: &planpb.Expr_BinaryRangeExpr{BinaryRangeExpr: &planpb.BinaryRangeExpr{
+		ColumnInfo:     intColumn(),
+		LowerInclusive: false,
+		UpperInclusive: true,
+		LowerValue:     intValue(99),
+		UpperValue:     intValue(200),
+	}}}
+	s.ElementsMatch([]int64{101, 102, 103}, s.prunedIDs(expr))
+}
+
+func (s *SegmentPrunerSuite) TestTerm() {
+	expr := &planpb.Expr{Expr: &planpb.Expr_TermExpr{TermExpr: &planpb.TermExpr{
+		ColumnInfo: strColumn(),
+		Values:     []*planpb.GenericValue{strValue("b"), strValue("h")},
+	}}}
+	s.ElementsMatch([]int64{100, 102, 103}, s.prunedIDs(expr))
+}
+
+func (s *SegmentPrunerSuite) TestLogical() {
+	gt := unaryRange(intColumn(), planpb.OpType_GreaterThan, intValue(150))
+	lt := unaryRange(intColumn(), planpb.OpType_LessThan, intValue(50))
+	and := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{Op: planpb.BinaryExpr_LogicalAnd, Left: gt, Right: lt}}}
+	s.ElementsMatch([]int64{103}, s.prunedIDs(and))
+
+	or := &planpb.Expr{Expr: &planpb.Expr_BinaryExpr{BinaryExpr: &planpb.BinaryExpr{Op: planpb.BinaryExpr_LogicalOr, Left: gt, Right: lt}}}
+	s.ElementsMatch([]int64{100, 101, 102, 103}, s.prunedIDs(or))
+
+	not := &planpb.Expr{Expr: &planpb.Expr_UnaryExpr{UnaryExpr: &planpb.UnaryExpr{Op: planpb.UnaryExpr_Not, Child: gt}}}
+	s.ElementsMatch([]int64{100, 101, 102, 103}, s.prunedIDs(not))
+
+	s.ElementsMatch([]int64{100, 101, 102, 103}, s.prunedIDs(nil))
+}
+
+func (s *SegmentPrunerSuite) TestInputNotModified() {
+	_, pruned := PruneSegments(unaryRange(intColumn(), planpb.OpType_GreaterThan, intValue(1000)), s.sealed, s.getStats)
+	s.Equal(3, pruned)
+	s.Len(s.sealed[0].Segments, 2)
+	s.Len(s.sealed[1].Segments, 2)
+}
+
+func (s *SegmentPrunerSuite) TestNaN() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "ts", DataType: schemapb.DataType_Int64},
+			{FieldID: 103, Name: "float", DataType: schemapb.DataType_Float},
+		},
+	}
+	genStats := func(values ...float32) segmentFieldStats {
+		return newSegmentFieldStats(storage.NewFieldStatsFromInsertData(schema, &storage.InsertData{Data: map[storage.FieldID]storage.FieldData{
+			0:   &storage.Int64FieldData{Data: make([]int64, len(values))},
+			1:   &storage.Int64FieldData{Data: make([]int64, len(values))},
+			103: &storage.FloatFieldData{Data: values},
+		}}))
+	}
+	s.stats = map[int64]segmentFieldStats{
+		100: genStats(1, 2),
+		101: genStats(1, float32(math.NaN()), 2),
+		102: genStats(float32(math.NaN()), 5, 3),
+		103: genStats(float32(math.NaN())),
+	}
+
+	// the segments holding NaN are never pruned by the float field
+	column := &planpb.ColumnInfo{FieldId: 103, DataType: schemapb.DataType_Float}
+	value := &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: 10}}
+	s.ElementsMatch([]int64{101, 102, 103}, s.prunedIDs(unaryRange(column, planpb.OpType_GreaterThan, value)))
+	value = &planpb.GenericValue{Val: &planpb.GenericValue_FloatVal{FloatVal: 1}}
+	s.ElementsMatch([]int64{100, 101, 102, 103}, s.prunedIDs(unaryRange(column, planpb.OpType_NotEqual, value)))
+}
+
+func TestSegmentPruner(t *testing.T) {
+	suite.Run(t, new(SegmentPrunerSuite))
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/samber/lo"
 	"go.opentelemetry.io/otel/trace"
@@ -29,9 +30,11 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querynodev2/collector"
 	"github.com/milvus-io/milvus/internal/querynodev2/delegator"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	"github.com/milvus-io/milvus/internal/util/streamrpc"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -40,6 +43,15 @@ import (
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
 )
 
+// getServingDelegator returns the delegator of the channel,
+// the standby delegator only consumes the channel and serves no request until promoted.
+func (node *QueryNode) getServingDelegator(channel string) (delegator.ShardDelegator, bool) {
+	if node.standbyChannels.Contain(channel) {
+		return nil, false
+	}
+	return node.delegators.Get(channel)
+}
+
 func loadL0Segments(ctx context.Context, delegator delegator.ShardDelegator, req *querypb.WatchDmChannelsRequest) error {
 	l0Segments := make([]*querypb.SegmentLoadInfo, 0)
 	for _, channel := range req.GetInfos() {
@@ -201,7 +213,7 @@ func (node *QueryNode) queryChannel(ctx context.Context, req *querypb.QueryReque
 	// From Proxy
 	tr := timerecord.NewTimeRecorder("queryDelegator")
 	// get delegator
-	sd, ok := node.delegators.Get(channel)
+	sd, ok := node.getServingDelegator(channel)
 	if !ok {
 		err := merr.WrapErrChannelNotFound(channel)
 		log.Warn("Query failed, failed to get shard delegator for query", zap.Error(err))
@@ -277,7 +289,7 @@ func (node *QueryNode) queryChannelStream(ctx context.Context, req *querypb.Quer
 	// From Proxy
 	tr := timerecord.NewTimeRecorder("queryDelegator")
 	// get delegator
-	sd, ok := node.delegators.Get(channel)
+	sd, ok := node.getServingDelegator(channel)
 	if !ok {
 		err := merr.WrapErrChannelNotFound(channel)
 		log.Warn("Query failed, failed to get query shard delegator", zap.Error(err))
@@ -360,7 +372,7 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 	// From Proxy
 	tr := timerecord.NewTimeRecorder("searchDelegator")
 	// get delegator
-	sd, ok := node.delegators.Get(channel)
+	sd, ok := node.getServingDelegator(channel)
 	if !ok {
 		err := merr.WrapErrChannelNotFound(channel)
 		log.Warn("Query failed, failed to get shard delegator for search", zap.Error(err))
@@ -381,10 +393,13 @@ func (node *QueryNode) searchChannel(ctx context.Context, req *querypb.SearchReq
 		req.GetSegmentIDs(),
 	))
 
-	resp, err := segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	reduceStart := time.Now()
+	resp, err := segments.ReduceSearchResults(ctx, results, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetGroupSize(), req.Req.GetMetricType())
 	if err != nil {
 		return nil, err
 	}
+	// the load of the delegator is the cost of reducing the results of the workers
+	collector.Load.Add(collector.ChannelLoadLabel(channel), float64(time.Since(reduceStart).Microseconds())/1000)
 
 	tr.CtxElapse(ctx, fmt.Sprintf("do search with channel done , vChannel = %s, segmentIDs = %v",
 		channel,
@@ -428,7 +443,7 @@ func (node *QueryNode) hybridSearchChannel(ctx context.Context, req *querypb.Hyb
 	// From Proxy
 	tr := timerecord.NewTimeRecorder("hybridSearchDelegator")
 	// get delegator
-	sd, ok := node.delegators.Get(channel)
+	sd, ok := node.getServingDelegator(channel)
 	if !ok {
 		err := merr.WrapErrChannelNotFound(channel)
 		log.Warn("Query failed, failed to get shard delegator for search", zap.Error(err))
@@ -488,7 +503,7 @@ func (node *QueryNode) getChannelStatistics(ctx context.Context, req *querypb.Ge
 		return segmentStatsResponse(results), nil
 	}
 
-	sd, ok := node.delegators.Get(channel)
+	sd, ok := node.getServingDelegator(channel)
 	if !ok {
 		err := merr.WrapErrChannelNotFound(channel, "failed to get channel statistics")
 		log.Warn("GetStatistics failed, failed to get query shard delegator", zap.Error(err))
@@ -543,7 +558,14 @@ func reduceStatisticResponse(results []*internalpb.GetStatisticsResponse) (*inte
 		},
 	}
 
+	var bm25Stats *bm25.Stats
 	for _, partialResult := range results {
+		if partialResult.GetBm25Stats() != nil {
+			if bm25Stats == nil {
+				bm25Stats = bm25.NewStats()
+			}
+			bm25Stats.Merge(bm25.NewStatsFromProto(partialResult.GetBm25Stats()))
+		}
 		for _, pair := range partialResult.Stats {
 			fn, ok := fieldMethod[pair.Key]
 			if !ok {
@@ -564,5 +586,8 @@ func reduceStatisticResponse(results []*internalpb.GetStatisticsResponse) (*inte
 		Status: merr.Success(),
 		Stats:  funcutil.Map2KeyValuePair(stringMap),
 	}
+	if bm25Stats != nil {
+		ret.Bm25Stats = bm25Stats.ToProto()
+	}
 	return ret, nil
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/samber/lo"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querynodev2/collector"
 	"github.com/milvus-io/milvus/internal/querynodev2/segments"
 	"github.com/milvus-io/milvus/pkg/metrics"
@@ -51,6 +52,25 @@ func getRateMetric() ([]metricsinfo.RateMetric, error) {
 	return rms, nil
 }
 
+// getSearchLoad returns the recent search load of the label, which is reported to querycoord for workload balance
+func getSearchLoad(label string) *querypb.SearchLoad {
+	qps, cost := collector.Load.Get(label)
+	return &querypb.SearchLoad{
+		Qps:  qps,
+		Cost: cost,
+	}
+}
+
+// mergeSearchLoad adds the load to the total
+func mergeSearchLoad(total *querypb.SearchLoad, load *querypb.SearchLoad) *querypb.SearchLoad {
+	if total == nil {
+		total = &querypb.SearchLoad{}
+	}
+	total.Qps += load.GetQps()
+	total.Cost += load.GetCost()
+	return total
+}
+
 func getSearchNQInQueue() (metricsinfo.ReadInfoInQueue, error) {
 	average, err := collector.Average.Average(metricsinfo.SearchQueueMetric)
 	if err != nil {
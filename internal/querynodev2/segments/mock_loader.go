@@ -5,6 +5,8 @@ package segments
 import (
 	context "context"
 
+	bm25 "github.com/milvus-io/milvus/internal/util/bm25"
+
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 
 	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
@@ -101,6 +103,76 @@ func (_c *MockLoader_Load_Call) RunAndReturn(run func(context.Context, int64, co
 	return _c
 }
 
+// LoadBM25Stats provides a mock function with given fields: ctx, collectionID, infos
+func (_m *MockLoader) LoadBM25Stats(ctx context.Context, collectionID int64, infos ...*querypb.SegmentLoadInfo) (map[int64]map[int64]*bm25.Stats, error) {
+	_va := make([]interface{}, len(infos))
+	for _i := range infos {
+		_va[_i] = infos[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, collectionID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 map[int64]map[int64]*bm25.Stats
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...*querypb.SegmentLoadInfo) (map[int64]map[int64]*bm25.Stats, error)); ok {
+		return rf(ctx, collectionID, infos...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64, ...*querypb.SegmentLoadInfo) map[int64]map[int64]*bm25.Stats); ok {
+		r0 = rf(ctx, collectionID, infos...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[int64]map[int64]*bm25.Stats)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64, ...*querypb.SegmentLoadInfo) error); ok {
+		r1 = rf(ctx, collectionID, infos...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockLoader_LoadBM25Stats_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LoadBM25Stats'
+type MockLoader_LoadBM25Stats_Call struct {
+	*mock.Call
+}
+
+// LoadBM25Stats is a helper method to define mock.On call
+//   - ctx context.Context
+//   - collectionID int64
+//   - infos ...*querypb.SegmentLoadInfo
+func (_e *MockLoader_Expecter) LoadBM25Stats(ctx interface{}, collectionID interface{}, infos ...interface{}) *MockLoader_LoadBM25Stats_Call {
+	return &MockLoader_LoadBM25Stats_Call{Call: _e.mock.On("LoadBM25Stats",
+		append([]interface{}{ctx, collectionID}, infos...)...)}
+}
+
+func (_c *MockLoader_LoadBM25Stats_Call) Run(run func(ctx context.Context, collectionID int64, infos ...*querypb.SegmentLoadInfo)) *MockLoader_LoadBM25Stats_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]*querypb.SegmentLoadInfo, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(*querypb.SegmentLoadInfo)
+			}
+		}
+		run(args[0].(context.Context), args[1].(int64), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *MockLoader_LoadBM25Stats_Call) Return(_a0 map[int64]map[int64]*bm25.Stats, _a1 error) *MockLoader_LoadBM25Stats_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockLoader_LoadBM25Stats_Call) RunAndReturn(run func(context.Context, int64, ...*querypb.SegmentLoadInfo) (map[int64]map[int64]*bm25.Stats, error)) *MockLoader_LoadBM25Stats_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // LoadBloomFilterSet provides a mock function with given fields: ctx, collectionID, version, infos
 func (_m *MockLoader) LoadBloomFilterSet(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) ([]*pkoracle.BloomFilterSet, error) {
 	_va := make([]interface{}, len(infos))
@@ -5,6 +5,8 @@ package segments
 import (
 	context "context"
 
+	bm25 "github.com/milvus-io/milvus/internal/util/bm25"
+
 	commonpb "github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 
 	datapb "github.com/milvus-io/milvus/internal/proto/datapb"
@@ -203,6 +205,50 @@ func (_c *MockSegment_ExistIndex_Call) RunAndReturn(run func(int64) bool) *MockS
 	return _c
 }
 
+// GetBM25Index provides a mock function with given fields: fieldID
+func (_m *MockSegment) GetBM25Index(fieldID int64) *bm25.Index {
+	ret := _m.Called(fieldID)
+
+	var r0 *bm25.Index
+	if rf, ok := ret.Get(0).(func(int64) *bm25.Index); ok {
+		r0 = rf(fieldID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*bm25.Index)
+		}
+	}
+
+	return r0
+}
+
+// MockSegment_GetBM25Index_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBM25Index'
+type MockSegment_GetBM25Index_Call struct {
+	*mock.Call
+}
+
+// GetBM25Index is a helper method to define mock.On call
+//   - fieldID int64
+func (_e *MockSegment_Expecter) GetBM25Index(fieldID interface{}) *MockSegment_GetBM25Index_Call {
+	return &MockSegment_GetBM25Index_Call{Call: _e.mock.On("GetBM25Index", fieldID)}
+}
+
+func (_c *MockSegment_GetBM25Index_Call) Run(run func(fieldID int64)) *MockSegment_GetBM25Index_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(int64))
+	})
+	return _c
+}
+
+func (_c *MockSegment_GetBM25Index_Call) Return(_a0 *bm25.Index) *MockSegment_GetBM25Index_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockSegment_GetBM25Index_Call) RunAndReturn(run func(int64) *bm25.Index) *MockSegment_GetBM25Index_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetIndex provides a mock function with given fields: fieldID
 func (_m *MockSegment) GetIndex(fieldID int64) *IndexedFieldInfo {
 	ret := _m.Called(fieldID)
@@ -40,7 +40,7 @@ var _ typeutil.ResultWithID = &internalpb.RetrieveResults{}
 
 var _ typeutil.ResultWithID = &segcorepb.RetrieveResults{}
 
-func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, metricType string) (*internalpb.SearchResults, error) {
+func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResults, nq int64, topk int64, groupSize int64, metricType string) (*internalpb.SearchResults, error) {
 	results = lo.Filter(results, func(result *internalpb.SearchResults, _ int) bool {
 		return result != nil && result.GetSlicedBlob() != nil
 	})
@@ -75,7 +75,7 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 			zap.Int64("topk", sData.TopK))
 	}
 
-	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk)
+	reducedResultData, err := ReduceSearchResultData(ctx, searchResultData, nq, topk, groupSize)
 	if err != nil {
 		log.Warn("shard leader reduce errors", zap.Error(err))
 		return nil, err
@@ -102,7 +102,9 @@ func ReduceSearchResults(ctx context.Context, results []*internalpb.SearchResult
 	return searchResults, nil
 }
 
-func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64) (*schemapb.SearchResultData, error) {
+// ReduceSearchResultData merges the search results of nq queries, topk is the number of groups
+// if the search is grouped by field, each group holds at most groupSize hits.
+func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.SearchResultData, nq int64, topk int64, groupSize int64) (*schemapb.SearchResultData, error) {
 	log := log.Ctx(ctx)
 
 	if len(searchResultData) == 0 {
@@ -132,6 +134,12 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		}
 	}
 
+	groupSize = lo.Max([]int64{groupSize, 1})
+	limit := topk
+	if searchResultData[0].GetGroupByFieldValue() != nil {
+		limit = topk * groupSize
+	}
+
 	var skipDupCnt int64
 	var retSize int64
 	maxOutputSize := paramtable.Get().QuotaConfig.MaxOutputSize.GetAsInt64()
@@ -139,9 +147,9 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 		offsets := make([]int64, len(searchResultData))
 
 		idSet := make(map[interface{}]struct{})
-		groupByValueSet := make(map[interface{}]struct{})
+		groupByValueCount := make(map[interface{}]int64)
 		var j int64
-		for j = 0; j < topk; {
+		for j = 0; j < limit; {
 			sel := SelectSearchResultData(searchResultData, resultOffsets, offsets, i)
 			if sel == -1 {
 				break
@@ -154,16 +162,17 @@ func ReduceSearchResultData(ctx context.Context, searchResultData []*schemapb.Se
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				groupByValExist := false
+				groupFull := false
 				if groupByVal != nil {
-					_, groupByValExist = groupByValueSet[groupByVal]
+					count, ok := groupByValueCount[groupByVal]
+					groupFull = count >= groupSize || (!ok && int64(len(groupByValueCount)) >= topk)
 				}
-				if !groupByValExist {
+				if !groupFull {
 					retSize += typeutil.AppendFieldData(ret.FieldsData, searchResultData[sel].FieldsData, idx)
 					typeutil.AppendPKs(ret.Ids, id)
 					ret.Scores = append(ret.Scores, score)
 					if groupByVal != nil {
-						groupByValueSet[groupByVal] = struct{}{}
+						groupByValueCount[groupByVal]++
 						if err := typeutil.AppendGroupByValue(ret, groupByVal, searchResultData[sel].GetGroupByFieldValue().GetType()); err != nil {
 							log.Error("Failed to append groupByValues", zap.Error(err))
 							return ret, err
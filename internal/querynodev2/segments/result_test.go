@@ -580,7 +580,7 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, 1)
 		suite.Nil(err)
 		suite.Equal(ids, res.Ids.GetIntId().Data)
 		suite.Equal(scores, res.Scores)
@@ -597,7 +597,7 @@ func (suite *ResultSuite) TestResult_ReduceSearchResultData() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, 1)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 5, 2, 3}, res.Ids.GetIntId().Data)
 	})
@@ -644,7 +644,7 @@ func (suite *ResultSuite) TestResult_SearchGroupByResult() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, 1)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 2, 3, 4}, res.Ids.GetIntId().Data)
 		suite.ElementsMatch([]float32{-1.0, -2.0, -3.0, -4.0}, res.Scores)
@@ -686,7 +686,7 @@ func (suite *ResultSuite) TestResult_SearchGroupByResult() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, 1)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 4}, res.Ids.GetIntId().Data)
 		suite.ElementsMatch([]float32{-1.0, -1.0}, res.Scores)
@@ -728,12 +728,40 @@ func (suite *ResultSuite) TestResult_SearchGroupByResult() {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk)
+		res, err := ReduceSearchResultData(context.TODO(), dataArray, nq, topk, 1)
 		suite.Nil(err)
 		suite.ElementsMatch([]int64{1, 2, 3, 4}, res.Ids.GetIntId().Data)
 		suite.ElementsMatch([]float32{-1.0, -2.0, -3.0, -4.0}, res.Scores)
 		suite.ElementsMatch([]string{"1", "2", "3", "4"}, res.GroupByFieldValue.GetScalars().GetStringData().Data)
 	})
+	suite.Run("reduce_group_by_group_size", func() {
+		genGroupByValue := func(values []int64) *schemapb.FieldData {
+			return &schemapb.FieldData{
+				Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{
+							LongData: &schemapb.LongArray{Data: values},
+						},
+					},
+				},
+			}
+		}
+		ids1 := []int64{1, 2, 3, 4, 5}
+		scores1 := []float32{-1.0, -2.0, -3.0, -4.0, -5.0}
+		ids2 := []int64{6, 7, 8}
+		scores2 := []float32{-1.5, -2.5, -3.5}
+		data1 := genSearchResultData(nq, 2, ids1, scores1, []int64{int64(len(ids1))})
+		data1.GroupByFieldValue = genGroupByValue([]int64{10, 10, 10, 20, 30})
+		data2 := genSearchResultData(nq, 2, ids2, scores2, []int64{int64(len(ids2))})
+		data2.GroupByFieldValue = genGroupByValue([]int64{20, 30, 40})
+		res, err := ReduceSearchResultData(context.TODO(), []*schemapb.SearchResultData{data1, data2}, nq, 2, 2)
+		suite.Nil(err)
+		// 2 groups with at most 2 hits each, group 10 is full after the hit 2
+		suite.Equal([]int64{1, 6, 2, 4}, res.Ids.GetIntId().Data)
+		suite.Equal([]int64{10, 20, 10, 20}, res.GroupByFieldValue.GetScalars().GetLongData().Data)
+		suite.Equal([]int64{4}, res.Topks)
+	})
 }
 
 func (suite *ResultSuite) TestResult_SelectSearchResultData_int() {
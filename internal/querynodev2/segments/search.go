@@ -24,6 +24,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/querynodev2/collector"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/merr"
@@ -79,6 +80,7 @@ func searchSegments(ctx context.Context, mgr *Manager, segments []Segment, segTy
 				metrics.SearchLabel, searchLabel).Observe(float64(elapsed))
 			metrics.QueryNodeSegmentSearchLatencyPerVector.WithLabelValues(fmt.Sprint(paramtable.GetNodeID()),
 				metrics.SearchLabel, searchLabel).Observe(float64(elapsed) / float64(searchReq.getNumOfQuery()))
+			collector.Load.Add(collector.SegmentLoadLabel(seg.ID()), float64(tr.ElapseSpan().Microseconds())/1000)
 		}(segment, i)
 	}
 	wg.Wait()
@@ -0,0 +1,136 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"sort"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/util/bm25"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// SearchBM25 performs full-text search on the target segments of the request.
+// The rows are scored by the term frequency indexes of segments with the query weights
+// which proxy filled by the term stats of collection, the topk results of each query are returned.
+func SearchBM25(ctx context.Context, manager *Manager, collection *Collection, req *querypb.SearchRequest) (*schemapb.SearchResultData, []Segment, error) {
+	info := req.GetReq().GetBm25Info()
+	if field := typeutil.GetField(collection.Schema(), info.GetFieldID()); field == nil || !bm25.IsAnalyzerEnabled(field) {
+		return nil, nil, merr.WrapErrParameterInvalidMsg("analyzer not enabled on field %d", info.GetFieldID())
+	}
+
+	var (
+		segType  SegmentType
+		segments []Segment
+		err      error
+	)
+	collID := req.GetReq().GetCollectionID()
+	if req.GetScope() == querypb.DataScope_Historical {
+		segType = SegmentTypeSealed
+		segments, err = validateOnHistorical(ctx, manager, collID, nil, req.GetSegmentIDs())
+	} else {
+		segType = SegmentTypeGrowing
+		segments, err = validateOnStream(ctx, manager, collID, nil, req.GetSegmentIDs())
+	}
+	if err != nil {
+		return nil, segments, err
+	}
+
+	filter, err := newBM25Filter(ctx, manager, collection, segments, segType, req)
+	if err != nil {
+		return nil, segments, err
+	}
+	return ScoreBM25(segments, info, req.GetReq().GetTopk(), req.GetReq().GetMvccTimestamp(), filter), segments, nil
+}
+
+// newBM25Filter retrieves the primary keys passing the filter expression of the request,
+// nil is returned if no filter expression.
+func newBM25Filter(ctx context.Context, manager *Manager, collection *Collection, segments []Segment, segType SegmentType, req *querypb.SearchRequest) (func(pk any) bool, error) {
+	plan := &planpb.PlanNode{}
+	if err := proto.Unmarshal(req.GetReq().GetSerializedExprPlan(), plan); err != nil {
+		return nil, merr.WrapErrParameterInvalidMsg("failed to unmarshal plan: %v", err)
+	}
+	if plan.GetQuery().GetPredicates() == nil {
+		return nil, nil
+	}
+
+	retrievePlan, err := NewRetrievePlan(ctx, collection, req.GetReq().GetSerializedExprPlan(),
+		req.GetReq().GetMvccTimestamp(), req.GetReq().GetBase().GetMsgID())
+	if err != nil {
+		return nil, err
+	}
+	defer retrievePlan.Delete()
+
+	results, err := retrieveOnSegments(ctx, manager, segments, segType, retrievePlan)
+	if err != nil {
+		return nil, err
+	}
+	pks := typeutil.NewSet[any]()
+	for _, result := range results {
+		for i := 0; i < typeutil.GetSizeOfIDs(result.GetIds()); i++ {
+			pks.Insert(typeutil.GetPK(result.GetIds(), int64(i)))
+		}
+	}
+	return func(pk any) bool {
+		return pks.Contain(pk)
+	}, nil
+}
+
+// ScoreBM25 scores the rows of segments for each query of the search info by the term frequency indexes,
+// and keeps the topk hits with positive score in descending order.
+func ScoreBM25(segments []Segment, info *internalpb.BM25SearchInfo, topk int64, mvccTs uint64, filter func(pk any) bool) *schemapb.SearchResultData {
+	nq := len(info.GetQueries())
+	hits := make([][]bm25.Hit, nq)
+	for _, segment := range segments {
+		index := segment.GetBM25Index(info.GetFieldID())
+		if index == nil {
+			continue
+		}
+		for q, query := range info.GetQueries() {
+			hits[q] = append(hits[q], index.Search(query.GetWeights(), info.GetAvgDocLen(), topk, mvccTs, filter)...)
+		}
+	}
+
+	data := &schemapb.SearchResultData{
+		NumQueries: int64(nq),
+		TopK:       topk,
+		Ids:        &schemapb.IDs{},
+		Scores:     make([]float32, 0),
+		Topks:      make([]int64, 0, nq),
+	}
+	for _, queryHits := range hits {
+		sort.SliceStable(queryHits, func(i, j int) bool {
+			return queryHits[i].Score > queryHits[j].Score
+		})
+		if int64(len(queryHits)) > topk {
+			queryHits = queryHits[:topk]
+		}
+		for _, hit := range queryHits {
+			typeutil.AppendPKs(data.Ids, hit.PK)
+			data.Scores = append(data.Scores, hit.Score)
+		}
+		data.Topks = append(data.Topks, int64(len(queryHits)))
+	}
+	return data
+}
@@ -0,0 +1,79 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/bm25"
+)
+
+func TestScoreBM25(t *testing.T) {
+	analyzer, err := bm25.NewAnalyzer("")
+	assert.NoError(t, err)
+	newSegment := func(pks []int64, texts []string) Segment {
+		index := bm25.NewIndex(analyzer)
+		index.Append(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}, nil, texts)
+		segment := NewMockSegment(t)
+		segment.EXPECT().GetBM25Index(int64(101)).Return(index)
+		return segment
+	}
+	noIndex := NewMockSegment(t)
+	noIndex.EXPECT().GetBM25Index(int64(101)).Return(nil)
+	segments := []Segment{
+		newSegment([]int64{1, 2}, []string{"milvus is a vector database", "a database"}),
+		newSegment([]int64{3}, []string{"milvus milvus"}),
+		noIndex,
+	}
+
+	stats := bm25.NewStats()
+	for _, segment := range segments {
+		if index := segment.GetBM25Index(101); index != nil {
+			stats.Merge(index.Stats())
+		}
+	}
+	info := &internalpb.BM25SearchInfo{
+		FieldID:   101,
+		AvgDocLen: stats.AvgDocLen(),
+		Queries: []*internalpb.BM25QueryWeights{
+			{Weights: stats.QueryWeights(analyzer.Analyze("milvus"))},
+			{Weights: stats.QueryWeights(analyzer.Analyze("search engine"))},
+		},
+	}
+
+	data := ScoreBM25(segments, info, 1, math.MaxUint64, nil)
+	assert.EqualValues(t, 2, data.GetNumQueries())
+	assert.Equal(t, []int64{1, 0}, data.GetTopks())
+	// the shorter document with more matched terms wins
+	assert.Equal(t, []int64{3}, data.GetIds().GetIntId().GetData())
+	assert.Len(t, data.GetScores(), 1)
+
+	data = ScoreBM25(segments, info, 10, math.MaxUint64, nil)
+	assert.Equal(t, []int64{2, 0}, data.GetTopks())
+	assert.Equal(t, []int64{3, 1}, data.GetIds().GetIntId().GetData())
+	assert.Greater(t, data.GetScores()[0], data.GetScores()[1])
+
+	// filtered out
+	data = ScoreBM25(segments, info, 10, math.MaxUint64, func(pk any) bool { return pk.(int64) != 3 })
+	assert.Equal(t, []int64{1, 0}, data.GetTopks())
+	assert.Equal(t, []int64{1}, data.GetIds().GetIntId().GetData())
+}
@@ -51,6 +51,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	typeutil_internal "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -93,6 +94,8 @@ type baseSegment struct {
 	isLazyLoad     bool
 	startPosition  *msgpb.MsgPosition // for growing segment release
 	bloomFilterSet *pkoracle.BloomFilterSet
+	// term frequency indexes of the analyzer enabled fields for full-text search, fieldID => index
+	bm25Indexes *typeutil.ConcurrentMap[int64, *bm25.Index]
 }
 
 func newBaseSegment(id, partitionID, collectionID int64, shard string, typ SegmentType, level datapb.SegmentLevel, version int64, startPosition *msgpb.MsgPosition) baseSegment {
@@ -107,6 +110,7 @@ func newBaseSegment(id, partitionID, collectionID int64, shard string, typ Segme
 		loadStatus:     atomic.NewString(string(LoadStatusMeta)),
 		startPosition:  startPosition,
 		bloomFilterSet: pkoracle.NewBloomFilterSet(id, partitionID, typ),
+		bm25Indexes:    typeutil.NewConcurrentMap[int64, *bm25.Index](),
 	}
 }
 
@@ -115,6 +119,16 @@ func (s *baseSegment) ID() int64 {
 	return s.segmentID
 }
 
+// GetBM25Index returns the term frequency index of the analyzer enabled field, nil if not loaded.
+func (s *baseSegment) GetBM25Index(fieldID int64) *bm25.Index {
+	index, _ := s.bm25Indexes.Get(fieldID)
+	return index
+}
+
+func (s *baseSegment) setBM25Index(fieldID int64, index *bm25.Index) {
+	s.bm25Indexes.Insert(fieldID, index)
+}
+
 func (s *baseSegment) Collection() int64 {
 	return s.collectionID
 }
@@ -193,6 +207,8 @@ type LocalSegment struct {
 	fields             *typeutil.ConcurrentMap[int64, *FieldInfo]
 	fieldIndexes       *typeutil.ConcurrentMap[int64, *IndexedFieldInfo]
 	space              *milvus_storage.Space
+	// the primary key field, to identify the rows appended to bm25 indexes on insert
+	pkFieldID int64
 }
 
 func NewSegment(ctx context.Context,
@@ -261,6 +277,7 @@ func NewSegment(ctx context.Context,
 
 	if segmentType != SegmentTypeSealed {
 		segment.loadStatus.Store(string(LoadStatusInMemory))
+		segment.initBM25Indexes(collection)
 	}
 
 	return segment, nil
@@ -331,11 +348,61 @@ func NewSegmentV2(
 
 	if segmentType != SegmentTypeSealed {
 		segment.loadStatus.Store(string(LoadStatusInMemory))
+		segment.initBM25Indexes(collection)
 	}
 
 	return segment, nil
 }
 
+// initBM25Indexes creates the empty term frequency indexes of the analyzer enabled fields for growing segment,
+// the inserted texts are analyzed and appended.
+func (s *LocalSegment) initBM25Indexes(collection *Collection) {
+	for _, field := range bm25.GetAnalyzerFields(collection.Schema()) {
+		analyzer, err := bm25.NewFieldAnalyzer(field)
+		if err != nil {
+			log.Warn("invalid analyzer of field, full-text search disabled",
+				zap.Int64("segmentID", s.ID()), zap.String("field", field.GetName()), zap.Error(err))
+			continue
+		}
+		s.setBM25Index(field.GetFieldID(), bm25.NewIndex(analyzer))
+	}
+	if s.bm25Indexes.Len() > 0 {
+		s.pkFieldID = GetPkField(collection.Schema()).GetFieldID()
+	}
+}
+
+// appendBM25Indexes appends the inserted texts to the term frequency indexes.
+func (s *LocalSegment) appendBM25Indexes(timestamps []typeutil.Timestamp, record *segcorepb.InsertRecord) {
+	if s.bm25Indexes.Len() == 0 {
+		return
+	}
+	pks := &schemapb.IDs{}
+	for _, fieldData := range record.GetFieldsData() {
+		if fieldData.GetFieldId() != s.pkFieldID {
+			continue
+		}
+		switch fieldData.GetType() {
+		case schemapb.DataType_Int64:
+			pks.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: fieldData.GetScalars().GetLongData().GetData()}}
+		case schemapb.DataType_VarChar:
+			pks.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: fieldData.GetScalars().GetStringData().GetData()}}
+		}
+	}
+	for _, fieldData := range record.GetFieldsData() {
+		if index := s.GetBM25Index(fieldData.GetFieldId()); index != nil {
+			index.Append(pks, timestamps, fieldData.GetScalars().GetStringData().GetData())
+		}
+	}
+}
+
+// deleteBM25Indexes marks the deleted rows in the term frequency indexes.
+func (s *LocalSegment) deleteBM25Indexes(pks *schemapb.IDs, timestamps []typeutil.Timestamp) {
+	s.bm25Indexes.Range(func(_ int64, index *bm25.Index) bool {
+		index.Delete(pks, timestamps)
+		return true
+	})
+}
+
 func (s *LocalSegment) isValid() bool {
 	return s.ptr != nil
 }
@@ -638,6 +705,7 @@ func (s *LocalSegment) Insert(ctx context.Context, rowIDs []int64, timestamps []
 		return err
 	}
 
+	s.appendBM25Indexes(timestamps, record)
 	s.insertCount.Add(int64(numOfRow))
 	s.rowNum.Store(-1)
 	s.memSize.Store(-1)
@@ -716,6 +784,7 @@ func (s *LocalSegment) Delete(ctx context.Context, primaryKeys []storage.Primary
 		return err
 	}
 
+	s.deleteBM25Indexes(ids, timestamps)
 	s.rowNum.Store(-1)
 	s.lastDeltaTimestamp.Store(timestamps[len(timestamps)-1])
 
@@ -1115,6 +1184,7 @@ func (s *LocalSegment) LoadDeltaData(ctx context.Context, deltaData *storage.Del
 		return err
 	}
 
+	s.deleteBM25Indexes(ids, tss)
 	s.rowNum.Store(-1)
 	s.lastDeltaTimestamp.Store(tss[len(tss)-1])
 
@@ -1227,6 +1297,10 @@ func (s *LocalSegment) UpdateIndexInfo(ctx context.Context, indexInfo *querypb.F
 }
 
 func (s *LocalSegment) WarmupChunkCache(ctx context.Context, fieldID int64) {
+	s.warmupChunkCache(ctx, fieldID, strings.ToLower(paramtable.Get().QueryNodeCfg.ChunkCacheWarmingUp.GetValue()))
+}
+
+func (s *LocalSegment) warmupChunkCache(ctx context.Context, fieldID int64, warmingUp string) {
 	log := log.Ctx(ctx).With(
 		zap.Int64("collectionID", s.Collection()),
 		zap.Int64("partitionID", s.Partition()),
@@ -1242,7 +1316,6 @@ func (s *LocalSegment) WarmupChunkCache(ctx context.Context, fieldID int64) {
 
 	var status C.CStatus
 
-	warmingUp := strings.ToLower(paramtable.Get().QueryNodeCfg.ChunkCacheWarmingUp.GetValue())
 	switch warmingUp {
 	case "sync":
 		GetLoadPool().Submit(func() (any, error) {
@@ -48,6 +48,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/querynodev2/pkoracle"
 	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/bm25"
 	typeutil_internal "github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
@@ -75,6 +76,9 @@ type Loader interface {
 	// LoadBloomFilterSet loads needed statslog for RemoteSegment.
 	LoadBloomFilterSet(ctx context.Context, collectionID int64, version int64, infos ...*querypb.SegmentLoadInfo) ([]*pkoracle.BloomFilterSet, error)
 
+	// LoadBM25Stats loads the term stats of the analyzer enabled fields, segmentID => fieldID => stats.
+	LoadBM25Stats(ctx context.Context, collectionID int64, infos ...*querypb.SegmentLoadInfo) (map[int64]map[int64]*bm25.Stats, error)
+
 	// LoadIndex append index for segment and remove vector binlogs.
 	LoadIndex(ctx context.Context, segment *LocalSegment, info *querypb.SegmentLoadInfo, version int64) error
 }
@@ -906,6 +910,77 @@ func (loader *segmentLoader) LoadBloomFilterSet(ctx context.Context, collectionI
 	return loadedBfs.Collect(), nil
 }
 
+func (loader *segmentLoader) LoadBM25Stats(ctx context.Context, collectionID int64, infos ...*querypb.SegmentLoadInfo) (map[int64]map[int64]*bm25.Stats, error) {
+	collection := loader.manager.Collection.Get(collectionID)
+	if collection == nil {
+		return nil, merr.WrapErrCollectionNotFound(collectionID)
+	}
+	if len(bm25.GetAnalyzerFields(collection.Schema())) == 0 || len(infos) == 0 {
+		return nil, nil
+	}
+
+	loaded := typeutil.NewConcurrentMap[int64, map[int64]*bm25.Stats]()
+	loadFunc := func(idx int) error {
+		indexes, err := loader.loadBM25Index(ctx, collection.Schema(), infos[idx])
+		if err != nil {
+			return err
+		}
+		loaded.Insert(infos[idx].GetSegmentID(), lo.MapValues(indexes, func(index *bm25.Index, _ int64) *bm25.Stats {
+			return index.Stats()
+		}))
+		return nil
+	}
+
+	err := funcutil.ProcessFuncParallel(len(infos), len(infos), loadFunc, "loadBM25StatsFunc")
+	if err != nil {
+		log.Ctx(ctx).Warn("failed to load bm25 stats", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return nil, err
+	}
+
+	result := make(map[int64]map[int64]*bm25.Stats, loaded.Len())
+	loaded.Range(func(segmentID int64, stats map[int64]*bm25.Stats) bool {
+		result[segmentID] = stats
+		return true
+	})
+	return result, nil
+}
+
+// loadBM25Index loads the term frequency indexes of the analyzer enabled fields from statslogs, fieldID => index.
+func (loader *segmentLoader) loadBM25Index(ctx context.Context, schema *schemapb.CollectionSchema, loadInfo *querypb.SegmentLoadInfo) (map[int64]*bm25.Index, error) {
+	result := make(map[int64]*bm25.Index)
+	for _, field := range bm25.GetAnalyzerFields(schema) {
+		paths := make([]string, 0)
+		for _, fieldBinlog := range loadInfo.GetStatslogs() {
+			if fieldBinlog.GetFieldID() != field.GetFieldID() {
+				continue
+			}
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, binlog.GetLogPath())
+			}
+		}
+		if len(paths) == 0 {
+			continue
+		}
+
+		analyzer, err := bm25.NewFieldAnalyzer(field)
+		if err != nil {
+			return nil, err
+		}
+		values, err := loader.cm.MultiRead(ctx, paths)
+		if err != nil {
+			return nil, err
+		}
+		index, err := storage.DeserializeBM25Index(analyzer, lo.Map(values, func(value []byte, _ int) *storage.Blob {
+			return &storage.Blob{Value: value}
+		}))
+		if err != nil {
+			return nil, err
+		}
+		result[field.GetFieldID()] = index
+	}
+	return result, nil
+}
+
 func (loader *segmentLoader) loadSegment(ctx context.Context,
 	segment *LocalSegment,
 	loadInfo *querypb.SegmentLoadInfo,
@@ -1024,6 +1099,16 @@ func (loader *segmentLoader) loadSegment(ctx context.Context,
 		strconv.FormatInt(int64(len(segment.Indexes())), 10),
 	).Add(float64(loadInfo.GetNumOfRows()))
 
+	// load bm25 index before delta, the deleted rows are marked in index
+	log.Info("loading bm25 index...")
+	bm25Indexes, err := loader.loadBM25Index(ctx, collection.Schema(), loadInfo)
+	if err != nil {
+		return err
+	}
+	for fieldID, index := range bm25Indexes {
+		segment.setBM25Index(fieldID, index)
+	}
+
 	log.Info("loading delta...")
 	return loader.LoadDeltaLogs(ctx, segment, loadInfo.Deltalogs)
 }
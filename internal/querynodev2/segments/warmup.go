@@ -0,0 +1,142 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// prefetchBufferSize is the size of the buffer to read the local files of the segments into the page cache
+const prefetchBufferSize = 1 << 20
+
+// TouchSegment loads the data of the sealed segment which the first searches would load otherwise,
+// which are the lazy loaded fields, the raw data of the vector indexes without raw data,
+// and the pages of the mmap files and the local index files of the segment.
+func TouchSegment(ctx context.Context, mgr *Manager, segment Segment) error {
+	localSegment, ok := segment.(*LocalSegment)
+	if !ok {
+		return nil
+	}
+
+	if segment.LoadStatus() == LoadStatusMeta {
+		item, ok := mgr.DiskCache.GetAndPin(segment.ID())
+		if !ok {
+			return merr.WrapErrSegmentNotLoaded(segment.ID())
+		}
+		item.Unpin()
+	}
+
+	collection := mgr.Collection.Get(segment.Collection())
+	if collection == nil {
+		return merr.WrapErrCollectionNotLoaded(segment.Collection())
+	}
+	for _, index := range segment.Indexes() {
+		fieldID := index.IndexInfo.GetFieldID()
+		field := typeutil.GetField(collection.Schema(), fieldID)
+		if field == nil || !typeutil.IsVectorType(field.GetDataType()) || segment.HasRawData(fieldID) {
+			continue
+		}
+		localSegment.warmupChunkCache(ctx, fieldID, "sync")
+	}
+	_, err := prefetchFiles(ctx, segmentLocalDirs(segment))
+	return err
+}
+
+// segmentLocalDirs returns the local dirs of the files mapped or read from disk on search by the segment,
+// which are the mmap files of the fields and the files of the disk and mmap indexes.
+func segmentLocalDirs(segment Segment) []string {
+	mmapDir := paramtable.Get().QueryNodeCfg.MmapDirPath.GetValue()
+	localRoot := filepath.Join(paramtable.Get().LocalStorageCfg.Path.GetValue(), typeutil.QueryNodeRole)
+
+	var dirs []string
+	if mmapDir != "" {
+		dirs = append(dirs, filepath.Join(mmapDir, strconv.FormatInt(segment.ID(), 10)))
+	}
+	for _, index := range segment.Indexes() {
+		buildID := strconv.FormatInt(index.IndexInfo.GetBuildID(), 10)
+		dirs = append(dirs, filepath.Join(localRoot, "index_files", buildID))
+		if mmapDir != "" {
+			dirs = append(dirs, filepath.Join(mmapDir, "index_files", buildID))
+		}
+	}
+	return dirs
+}
+
+// prefetchFiles reads the regular files under the dirs sequentially, so their pages are in the page cache
+// before the first searches fault them in one by one. The dirs and files not existing are skipped,
+// returns the number of the read bytes.
+func prefetchFiles(ctx context.Context, dirs []string) (int64, error) {
+	buf := make([]byte, prefetchBufferSize)
+	var total int64
+	for _, dir := range dirs {
+		err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					return nil
+				}
+				return err
+			}
+			if !d.Type().IsRegular() {
+				return nil
+			}
+			n, err := prefetchFile(ctx, path, buf)
+			total += n
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+func prefetchFile(ctx context.Context, path string, buf []byte) (int64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	defer f.Close()
+
+	var total int64
+	for {
+		if err := ctx.Err(); err != nil {
+			return total, err
+		}
+		n, err := f.Read(buf)
+		total += int64(n)
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package segments
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrefetchFiles(t *testing.T) {
+	dir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(dir, "1", "100"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "1", "100", "data"), make([]byte, prefetchBufferSize+10), 0o600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "1", "offsets"), make([]byte, 20), 0o600))
+
+	n, err := prefetchFiles(context.Background(), []string{filepath.Join(dir, "1"), filepath.Join(dir, "not_exist")})
+	assert.NoError(t, err)
+	assert.EqualValues(t, prefetchBufferSize+30, n)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = prefetchFiles(ctx, []string{filepath.Join(dir, "1")})
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -103,6 +103,7 @@ type QueryNode struct {
 	pipelineManager       pipeline.Manager
 	subscribingChannels   *typeutil.ConcurrentSet[string]
 	unsubscribingChannels *typeutil.ConcurrentSet[string]
+	standbyChannels       *typeutil.ConcurrentSet[string] // channels whose delegator is standby
 	delegators            *typeutil.ConcurrentMap[string, delegator.ShardDelegator]
 	serverID              int64
 
@@ -111,6 +112,8 @@ type QueryNode struct {
 
 	// Search/Query
 	scheduler tasks.Scheduler
+	// sample of the recent searches to warm up the loaded segments
+	searchSampler *searchSampler
 
 	// etcd client
 	etcdCli *clientv3.Client
@@ -140,6 +143,8 @@ func NewQueryNode(ctx context.Context, factory dependency.Factory) *QueryNode {
 		cancel:   cancel,
 		factory:  factory,
 		lifetime: lifetime.NewLifetime(commonpb.StateCode_Abnormal),
+
+		searchSampler: newSearchSampler(),
 	}
 
 	node.tSafeManager = tsafe.NewTSafeReplica()
@@ -344,6 +349,7 @@ func (node *QueryNode) Init() error {
 		node.delegators = typeutil.NewConcurrentMap[string, delegator.ShardDelegator]()
 		node.subscribingChannels = typeutil.NewConcurrentSet[string]()
 		node.unsubscribingChannels = typeutil.NewConcurrentSet[string]()
+		node.standbyChannels = typeutil.NewConcurrentSet[string]()
 		node.manager = segments.NewManager()
 		if paramtable.Get().CommonCfg.EnableStorageV2.GetAsBool() {
 			node.loader = segments.NewLoaderV2(node.manager, node.chunkManager)
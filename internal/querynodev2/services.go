@@ -47,6 +47,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
+	"github.com/milvus-io/milvus/pkg/util/hardware"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
@@ -205,6 +206,7 @@ func (node *QueryNode) WatchDmChannels(ctx context.Context, req *querypb.WatchDm
 
 	log.Info("received watch channel request",
 		zap.Int64("version", req.GetVersion()),
+		zap.Bool("standby", req.GetStandby()),
 	)
 
 	// check node healthy
@@ -238,8 +240,14 @@ func (node *QueryNode) WatchDmChannels(ctx context.Context, req *querypb.WatchDm
 		return merr.Status(err), nil
 	}
 
-	_, exist := node.delegators.Get(channel.GetChannelName())
+	sd, exist := node.delegators.Get(channel.GetChannelName())
 	if exist {
+		// the standby delegator has consumed the channel, promote it to serve at once
+		if !req.GetStandby() && node.standbyChannels.TryRemove(channel.GetChannelName()) {
+			sd.Promote()
+			log.Info("standby delegator promoted")
+			return merr.Success(), nil
+		}
 		log.Info("channel already subscribed")
 		return merr.Success(), nil
 	}
@@ -270,10 +278,15 @@ func (node *QueryNode) WatchDmChannels(ctx context.Context, req *querypb.WatchDm
 		log.Warn("failed to create shard delegator", zap.Error(err))
 		return merr.Status(err), nil
 	}
+	if req.GetStandby() {
+		delegator.MarkStandby()
+		node.standbyChannels.Insert(channel.GetChannelName())
+	}
 	node.delegators.Insert(channel.GetChannelName(), delegator)
 	defer func() {
 		if err != nil {
 			node.delegators.GetAndRemove(channel.GetChannelName())
+			node.standbyChannels.Remove(channel.GetChannelName())
 		}
 	}()
 
@@ -362,7 +375,7 @@ func (node *QueryNode) UnsubDmChannel(ctx context.Context, req *querypb.UnsubDmC
 		zap.Int64("currentNodeID", node.GetNodeID()),
 	)
 
-	log.Info("received unsubscribe channel request")
+	log.Info("received unsubscribe channel request", zap.Bool("standby", req.GetStandby()))
 
 	// check node healthy
 	if err := node.lifetime.Add(merr.IsHealthy); err != nil {
@@ -377,7 +390,13 @@ func (node *QueryNode) UnsubDmChannel(ctx context.Context, req *querypb.UnsubDmC
 
 	node.unsubscribingChannels.Insert(req.GetChannelName())
 	defer node.unsubscribingChannels.Remove(req.GetChannelName())
+	// the standby delegator may have been promoted and serving
+	if req.GetStandby() && !node.standbyChannels.Contain(req.GetChannelName()) {
+		log.Info("standby delegator not found, skip unsubscribing")
+		return merr.Success(), nil
+	}
 	delegator, ok := node.delegators.GetAndRemove(req.GetChannelName())
+	node.standbyChannels.Remove(req.GetChannelName())
 	if ok {
 		// close the delegator first to block all coming query/search requests
 		delegator.Close()
@@ -386,7 +405,9 @@ func (node *QueryNode) UnsubDmChannel(ctx context.Context, req *querypb.UnsubDmC
 		node.manager.Segment.RemoveBy(segments.WithChannel(req.GetChannelName()), segments.WithType(segments.SegmentTypeGrowing))
 		node.tSafeManager.Remove(ctx, req.GetChannelName())
 
-		node.manager.Collection.Unref(req.GetCollectionID(), 1)
+		if node.manager.Collection.Unref(req.GetCollectionID(), 1) {
+			node.searchSampler.remove(req.GetCollectionID())
+		}
 	}
 	log.Info("unsubscribed channel")
 
@@ -493,6 +514,9 @@ func (node *QueryNode) LoadSegments(ctx context.Context, req *querypb.LoadSegmen
 
 	node.manager.Collection.Ref(req.GetCollectionID(), uint32(len(loaded)))
 
+	// warm up before the delegator routes traffic to the segments
+	node.warmupSegments(ctx, req.GetWarmupPolicy(), loaded)
+
 	log.Info("load segments done...",
 		zap.Int64s("segments", lo.Map(loaded, func(s segments.Segment, _ int) int64 { return s.ID() })))
 
@@ -595,7 +619,9 @@ func (node *QueryNode) ReleaseSegments(ctx context.Context, req *querypb.Release
 		_, count := node.manager.Segment.Remove(id, req.GetScope())
 		sealedCount += count
 	}
-	node.manager.Collection.Unref(req.GetCollectionID(), uint32(sealedCount))
+	if node.manager.Collection.Unref(req.GetCollectionID(), uint32(sealedCount)) {
+		node.searchSampler.remove(req.GetCollectionID())
+	}
 
 	return merr.Success(), nil
 }
@@ -705,6 +731,7 @@ func (node *QueryNode) SearchSegments(ctx context.Context, req *querypb.SearchRe
 		return resp, nil
 	}
 
+	node.searchSampler.record(req)
 	task := tasks.NewSearchTask(searchCtx, collection, node.manager, req, node.serverID)
 	if err := node.scheduler.Add(task); err != nil {
 		log.Warn("failed to search channel", zap.Error(err))
@@ -812,7 +839,7 @@ func (node *QueryNode) Search(ctx context.Context, req *querypb.SearchRequest) (
 	}
 
 	tr.RecordSpan()
-	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetMetricType())
+	result, err := segments.ReduceSearchResults(ctx, toReduceResults, req.Req.GetNq(), req.Req.GetTopk(), req.Req.GetGroupSize(), req.Req.GetMetricType())
 	if err != nil {
 		log.Warn("failed to reduce search results", zap.Error(err))
 		resp.Status = merr.Status(err)
@@ -914,7 +941,7 @@ func (node *QueryNode) HybridSearch(ctx context.Context, req *querypb.HybridSear
 		for index, hs := range MultipleResults {
 			toReduceResults[index] = hs.Results[i]
 		}
-		result, err := segments.ReduceSearchResults(ctx, toReduceResults, searchReq.GetNq(), searchReq.GetTopk(), searchReq.GetMetricType())
+		result, err := segments.ReduceSearchResults(ctx, toReduceResults, searchReq.GetNq(), searchReq.GetTopk(), searchReq.GetGroupSize(), searchReq.GetMetricType())
 		if err != nil {
 			log.Warn("failed to reduce search results", zap.Error(err))
 			resp.Status = merr.Status(err)
@@ -1339,9 +1366,14 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 		}, nil
 	}
 
+	// the search load of a collection is the sum of its sealed segments and delegators on the node
+	collectionSearchLoads := make(map[int64]*querypb.SearchLoad)
+
 	sealedSegments := node.manager.Segment.GetBy(segments.WithType(commonpb.SegmentState_Sealed))
 	segmentVersionInfos := make([]*querypb.SegmentVersionInfo, 0, len(sealedSegments))
 	for _, s := range sealedSegments {
+		searchLoad := getSearchLoad(collector.SegmentLoadLabel(s.ID()))
+		collectionSearchLoads[s.Collection()] = mergeSearchLoad(collectionSearchLoads[s.Collection()], searchLoad)
 		segmentVersionInfos = append(segmentVersionInfos, &querypb.SegmentVersionInfo{
 			ID:                 s.ID(),
 			Collection:         s.Collection(),
@@ -1352,6 +1384,7 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			IndexInfo: lo.SliceToMap(s.Indexes(), func(info *segments.IndexedFieldInfo) (int64, *querypb.FieldIndexInfo) {
 				return info.IndexInfo.FieldID, info.IndexInfo
 			}),
+			SearchLoad: searchLoad,
 		})
 	}
 
@@ -1362,11 +1395,14 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 		if !delegator.Serviceable() {
 			return true
 		}
-		channelVersionInfos = append(channelVersionInfos, &querypb.ChannelVersionInfo{
-			Channel:    key,
-			Collection: delegator.Collection(),
-			Version:    delegator.Version(),
-		})
+		standby := node.standbyChannels.Contain(key)
+		if !standby {
+			channelVersionInfos = append(channelVersionInfos, &querypb.ChannelVersionInfo{
+				Channel:    key,
+				Collection: delegator.Collection(),
+				Version:    delegator.Version(),
+			})
+		}
 
 		sealed, growing := delegator.GetSegmentInfo(false)
 		sealedSegments := make(map[int64]*querypb.SegmentDist)
@@ -1381,7 +1417,10 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 
 		numOfGrowingRows := int64(0)
 		growingSegments := make(map[int64]*msgpb.MsgPosition)
+		// the growing segments are searched by the delegator, so their load moves with the delegator
+		searchLoad := getSearchLoad(collector.ChannelLoadLabel(key))
 		for _, entry := range growing {
+			searchLoad = mergeSearchLoad(searchLoad, getSearchLoad(collector.SegmentLoadLabel(entry.SegmentID)))
 			segment := node.manager.Segment.GetWithType(entry.SegmentID, segments.SegmentTypeGrowing)
 			if segment == nil {
 				log.Warn("leader view growing not found", zap.String("channel", key), zap.Int64("segmentID", entry.SegmentID))
@@ -1399,16 +1438,22 @@ func (node *QueryNode) GetDataDistribution(ctx context.Context, req *querypb.Get
 			GrowingSegments:  growingSegments,
 			TargetVersion:    delegator.GetTargetVersion(),
 			NumOfGrowingRows: numOfGrowingRows,
+			SearchLoad:       searchLoad,
+			Standby:          standby,
 		})
+		collectionSearchLoads[delegator.Collection()] = mergeSearchLoad(collectionSearchLoads[delegator.Collection()], searchLoad)
 		return true
 	})
 
 	return &querypb.GetDataDistributionResponse{
-		Status:      merr.Success(),
-		NodeID:      node.GetNodeID(),
-		Segments:    segmentVersionInfos,
-		Channels:    channelVersionInfos,
-		LeaderViews: leaderViews,
+		Status:                merr.Success(),
+		NodeID:                node.GetNodeID(),
+		Segments:              segmentVersionInfos,
+		Channels:              channelVersionInfos,
+		LeaderViews:           leaderViews,
+		MemoryUsed:            hardware.GetUsedMemoryCount(),
+		MemoryTotal:           hardware.GetMemoryCount(),
+		CollectionSearchLoads: collectionSearchLoads,
 	}, nil
 }
 
@@ -22,6 +22,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/metrics"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/metric"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 	"github.com/milvus-io/milvus/pkg/util/timerecord"
@@ -136,6 +137,10 @@ func (t *SearchTask) Execute() error {
 	}
 	tr := timerecord.NewTimeRecorderWithTrace(t.ctx, "SearchTask")
 
+	if t.req.GetReq().GetBm25Info() != nil {
+		return t.executeBM25(tr)
+	}
+
 	req := t.req
 	t.combinePlaceHolderGroups()
 	searchReq, err := segments.NewSearchRequest(t.ctx, t.collection, req, t.placeholderGroup)
@@ -259,6 +264,32 @@ func (t *SearchTask) Execute() error {
 	return nil
 }
 
+// executeBM25 scores the analyzed texts of segments for full-text search,
+// BM25 search task is never merged with others.
+func (t *SearchTask) executeBM25(tr *timerecord.TimeRecorder) error {
+	data, searchedSegments, err := segments.SearchBM25(t.ctx, t.segmentManager, t.collection, t.req)
+	defer t.segmentManager.Segment.Unpin(searchedSegments)
+	if err != nil {
+		log.Ctx(t.ctx).Warn("failed to search bm25", zap.Int64("collectionID", t.collection.ID()), zap.Error(err))
+		return err
+	}
+
+	result, err := segments.EncodeSearchResultData(data, t.nq, t.topk, metric.BM25)
+	if err != nil {
+		return err
+	}
+	result.Base = &commonpb.MsgBase{
+		SourceID: t.GetNodeID(),
+	}
+	result.SlicedOffset = 1
+	result.SlicedNumCount = 1
+	result.CostAggregation = &internalpb.CostAggregation{
+		ServiceTime: tr.ElapseSpan().Milliseconds(),
+	}
+	t.result = result
+	return nil
+}
+
 func (t *SearchTask) Merge(other *SearchTask) bool {
 	var (
 		nq        = t.nq
@@ -274,7 +305,8 @@ func (t *SearchTask) Merge(other *SearchTask) bool {
 	ratio := float64(after) / float64(pre)
 
 	// Check mergeable
-	if t.req.GetReq().GetDbID() != other.req.GetReq().GetDbID() ||
+	if t.req.GetReq().GetBm25Info() != nil || other.req.GetReq().GetBm25Info() != nil ||
+		t.req.GetReq().GetDbID() != other.req.GetReq().GetDbID() ||
 		t.req.GetReq().GetCollectionID() != other.req.GetReq().GetCollectionID() ||
 		t.req.GetReq().GetMvccTimestamp() != other.req.GetReq().GetMvccTimestamp() ||
 		t.req.GetReq().GetDslType() != other.req.GetReq().GetDslType() ||
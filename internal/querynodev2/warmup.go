@@ -0,0 +1,177 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/samber/lo"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/querynodev2/segments"
+	"github.com/milvus-io/milvus/internal/querynodev2/tasks"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/log"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// warmupSampleInterval bounds the cost of sampling the searches, at most one search of each collection is sampled per interval
+const warmupSampleInterval = time.Second
+
+// searchSampler keeps a sample of the recent searches on the historical segments of each collection,
+// which are replayed on the newly loaded segments by the replay warmup policy.
+type searchSampler struct {
+	mu         sync.Mutex
+	samples    map[int64][]*querypb.SearchRequest // collectionID -> ring buffer of the sampled searches
+	next       map[int64]int
+	lastSample map[int64]time.Time
+}
+
+func newSearchSampler() *searchSampler {
+	return &searchSampler{
+		samples:    make(map[int64][]*querypb.SearchRequest),
+		next:       make(map[int64]int),
+		lastSample: make(map[int64]time.Time),
+	}
+}
+
+func (s *searchSampler) record(req *querypb.SearchRequest) {
+	if req.GetScope() != querypb.DataScope_Historical {
+		return
+	}
+	size := paramtable.Get().QueryNodeCfg.WarmupReplaySampleSize.GetAsInt()
+	if size <= 0 {
+		return
+	}
+
+	collectionID := req.GetReq().GetCollectionID()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if time.Since(s.lastSample[collectionID]) < warmupSampleInterval {
+		return
+	}
+	s.lastSample[collectionID] = time.Now()
+
+	sample := typeutil.Clone(req)
+	samples := s.samples[collectionID]
+	if len(samples) > size {
+		samples = samples[:size]
+		s.next[collectionID] = 0
+	}
+	if len(samples) < size {
+		s.samples[collectionID] = append(samples, sample)
+		return
+	}
+	samples[s.next[collectionID]] = sample
+	s.next[collectionID] = (s.next[collectionID] + 1) % size
+}
+
+func (s *searchSampler) get(collectionID int64) []*querypb.SearchRequest {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	samples := make([]*querypb.SearchRequest, len(s.samples[collectionID]))
+	copy(samples, s.samples[collectionID])
+	return samples
+}
+
+func (s *searchSampler) remove(collectionID int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.samples, collectionID)
+	delete(s.next, collectionID)
+	delete(s.lastSample, collectionID)
+}
+
+// warmupSegments warms up the loaded segments by the warmup policy of the collection,
+// the delegator routes traffic to the segments only after they are loaded,
+// so the first searches on them are not slowed down by loading the data on demand.
+// Warmup is best effort, the segments are served after timeout or warmup failure.
+func (node *QueryNode) warmupSegments(ctx context.Context, policy string, loaded []segments.Segment) {
+	if policy == "" || policy == common.WarmupPolicyNone || len(loaded) == 0 {
+		return
+	}
+	log := log.Ctx(ctx).With(
+		zap.Int64("collectionID", loaded[0].Collection()),
+		zap.String("policy", policy),
+		zap.Int64s("segments", lo.Map(loaded, func(s segments.Segment, _ int) int64 { return s.ID() })),
+	)
+	if policy != common.WarmupPolicyTouch && policy != common.WarmupPolicyReplay {
+		log.Warn("unknown warmup policy, skip warmup")
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, paramtable.Get().QueryNodeCfg.WarmupTimeout.GetAsDuration(time.Millisecond))
+	defer cancel()
+	start := time.Now()
+
+	for _, segment := range loaded {
+		if err := segments.TouchSegment(ctx, node.manager, segment); err != nil {
+			log.Warn("failed to touch segment", zap.Int64("segmentID", segment.ID()), zap.Error(err))
+		}
+		if ctx.Err() != nil {
+			log.Warn("warmup segments timeout")
+			return
+		}
+	}
+
+	if policy == common.WarmupPolicyReplay {
+		replayed := node.replaySearches(ctx, loaded)
+		log = log.With(zap.Int("replayed", replayed))
+	}
+	log.Info("warmup segments done", zap.Duration("elapsed", time.Since(start)))
+}
+
+// replaySearches replays the sampled searches of the collection on the loaded segments and drops the results,
+// returns the number of the replayed searches.
+func (node *QueryNode) replaySearches(ctx context.Context, loaded []segments.Segment) int {
+	collectionID := loaded[0].Collection()
+	collection := node.manager.Collection.Get(collectionID)
+	if collection == nil {
+		return 0
+	}
+
+	replayed := 0
+	channelSegments := lo.GroupBy(loaded, func(s segments.Segment) string { return s.Shard() })
+	for _, sample := range node.searchSampler.get(collectionID) {
+		for channel, segs := range channelSegments {
+			if ctx.Err() != nil {
+				return replayed
+			}
+			req := typeutil.Clone(sample)
+			req.DmlChannels = []string{channel}
+			req.SegmentIDs = lo.Map(segs, func(s segments.Segment, _ int) int64 { return s.ID() })
+			req.Scope = querypb.DataScope_Historical
+			req.FromShardLeader = true
+
+			task := tasks.NewSearchTask(ctx, collection, node.manager, req, node.serverID)
+			if err := node.scheduler.Add(task); err != nil {
+				log.Ctx(ctx).Warn("failed to replay search for warmup", zap.Error(err))
+				return replayed
+			}
+			if err := task.Wait(); err != nil {
+				log.Ctx(ctx).Warn("failed to replay search for warmup", zap.Error(err))
+				continue
+			}
+			replayed++
+		}
+	}
+	return replayed
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package querynodev2
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+func TestSearchSampler(t *testing.T) {
+	paramtable.Init()
+	paramtable.Get().Save(paramtable.Get().QueryNodeCfg.WarmupReplaySampleSize.Key, "2")
+	defer paramtable.Get().Reset(paramtable.Get().QueryNodeCfg.WarmupReplaySampleSize.Key)
+
+	newReq := func(collectionID int64, msgID int64, scope querypb.DataScope) *querypb.SearchRequest {
+		return &querypb.SearchRequest{
+			Req: &internalpb.SearchRequest{
+				Base:         &commonpb.MsgBase{MsgID: msgID},
+				CollectionID: collectionID,
+			},
+			Scope: scope,
+		}
+	}
+	// sample every search
+	backdate := func(s *searchSampler) {
+		for collectionID := range s.lastSample {
+			s.lastSample[collectionID] = time.Time{}
+		}
+	}
+
+	s := newSearchSampler()
+	s.record(newReq(1, 1, querypb.DataScope_Streaming))
+	assert.Empty(t, s.get(1))
+
+	s.record(newReq(1, 1, querypb.DataScope_Historical))
+	// sampled at most once per interval
+	s.record(newReq(1, 2, querypb.DataScope_Historical))
+	assert.Equal(t, 1, len(s.get(1)))
+
+	for msgID := int64(2); msgID <= 4; msgID++ {
+		backdate(s)
+		s.record(newReq(1, msgID, querypb.DataScope_Historical))
+	}
+	samples := s.get(1)
+	assert.Equal(t, 2, len(samples))
+	msgIDs := []int64{samples[0].GetReq().GetBase().GetMsgID(), samples[1].GetReq().GetBase().GetMsgID()}
+	assert.ElementsMatch(t, []int64{3, 4}, msgIDs)
+	assert.Empty(t, s.get(2))
+
+	s.remove(1)
+	assert.Empty(t, s.get(1))
+}
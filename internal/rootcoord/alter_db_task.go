@@ -0,0 +1,93 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/log"
+)
+
+type alterDatabaseTask struct {
+	baseTask
+	Req *internalpb.AlterDatabaseRequest
+}
+
+func (a *alterDatabaseTask) Prepare(ctx context.Context) error {
+	if a.Req.GetDbName() == "" {
+		return fmt.Errorf("alter database failed, database name does not exists")
+	}
+
+	return nil
+}
+
+func (a *alterDatabaseTask) Execute(ctx context.Context) error {
+	// Now we only support alter properties of database
+	if a.Req.GetProperties() == nil {
+		return errors.New("only support alter database properties, but database properties is empty")
+	}
+
+	oldDB, err := a.core.meta.GetDatabaseByName(ctx, a.Req.GetDbName(), a.ts)
+	if err != nil {
+		log.Ctx(ctx).Warn("get database failed during changing database props",
+			zap.String("databaseName", a.Req.GetDbName()), zap.Uint64("ts", a.ts))
+		return err
+	}
+
+	newDB := oldDB.Clone()
+	newDB.Properties = updateProperties(oldDB.Properties, a.Req.GetProperties())
+
+	return a.core.meta.AlterDatabase(ctx, oldDB, newDB, a.GetTs())
+}
+
+// updateProperties merges the updated properties into the old ones,
+// a property with empty value is removed.
+func updateProperties(oldProps []*commonpb.KeyValuePair, updatedProps []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	props := make(map[string]string)
+	keys := make([]string, 0, len(oldProps)+len(updatedProps))
+	for _, prop := range oldProps {
+		if _, ok := props[prop.GetKey()]; !ok {
+			keys = append(keys, prop.GetKey())
+		}
+		props[prop.GetKey()] = prop.GetValue()
+	}
+
+	for _, prop := range updatedProps {
+		if _, ok := props[prop.GetKey()]; !ok {
+			keys = append(keys, prop.GetKey())
+		}
+		props[prop.GetKey()] = prop.GetValue()
+	}
+
+	propKV := make([]*commonpb.KeyValuePair, 0, len(keys))
+	for _, key := range keys {
+		if props[key] == "" {
+			continue
+		}
+		propKV = append(propKV, &commonpb.KeyValuePair{
+			Key:   key,
+			Value: props[key],
+		})
+	}
+	return propKV
+}
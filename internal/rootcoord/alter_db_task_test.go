@@ -0,0 +1,101 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func Test_alterDatabaseTask_Prepare(t *testing.T) {
+	t.Run("invalid db name", func(t *testing.T) {
+		task := &alterDatabaseTask{Req: &internalpb.AlterDatabaseRequest{}}
+		err := task.Prepare(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		task := &alterDatabaseTask{Req: &internalpb.AlterDatabaseRequest{DbName: "db1"}}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func Test_alterDatabaseTask_Execute(t *testing.T) {
+	properties := []*commonpb.KeyValuePair{
+		{Key: common.DatabaseInsertRateMaxKey, Value: "10"},
+	}
+
+	t.Run("properties is empty", func(t *testing.T) {
+		task := &alterDatabaseTask{Req: &internalpb.AlterDatabaseRequest{DbName: "db1"}}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("failed to get database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(nil, errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.AlterDatabaseRequest{DbName: "db1", Properties: properties},
+		}
+		err := task.Execute(context.Background())
+		assert.Error(t, err)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		oldDB := model.NewDatabase(1, "db1", etcdpb.DatabaseState_DatabaseCreated)
+		oldDB.Properties = []*commonpb.KeyValuePair{
+			{Key: common.DatabaseQueryRateMaxKey, Value: "100"},
+			{Key: common.DatabaseSearchRateMaxKey, Value: "100"},
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(oldDB, nil)
+		meta.EXPECT().AlterDatabase(mock.Anything, oldDB, mock.Anything, mock.Anything).
+			Run(func(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64) {
+				assert.ElementsMatch(t, []*commonpb.KeyValuePair{
+					{Key: common.DatabaseQueryRateMaxKey, Value: "100"},
+					{Key: common.DatabaseInsertRateMaxKey, Value: "10"},
+				}, newDB.Properties)
+			}).Return(nil)
+		core := newTestCore(withMeta(meta))
+		task := &alterDatabaseTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req: &internalpb.AlterDatabaseRequest{
+				DbName: "db1",
+				Properties: append(properties, &commonpb.KeyValuePair{
+					Key: common.DatabaseSearchRateMaxKey, Value: "",
+				}),
+			},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Len(t, oldDB.Properties, 2)
+	})
+}
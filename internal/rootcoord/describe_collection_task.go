@@ -21,6 +21,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
 // describeCollectionTask describe collection request task
@@ -47,5 +48,11 @@ func (t *describeCollectionTask) Execute(ctx context.Context) (err error) {
 	aliases := t.core.meta.ListAliasesByID(coll.CollectionID)
 	t.Rsp = convertModelToDesc(coll, aliases)
 	t.Rsp.DbName = t.Req.GetDbName()
+	if t.Rsp.DbName == "" {
+		// described by id, fill the name of database which the collection belongs to
+		if db, err := t.core.meta.GetDatabaseByID(ctx, coll.DBID, typeutil.MaxTimestamp); err == nil {
+			t.Rsp.DbName = db.Name
+		}
+	}
 	return nil
 }
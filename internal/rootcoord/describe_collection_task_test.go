@@ -101,10 +101,12 @@ func Test_describeCollectionTask_Execute(t *testing.T) {
 		).Return(&model.Collection{
 			CollectionID: 1,
 			Name:         "test coll",
+			DBID:         2,
 		}, nil)
 		meta.On("ListAliasesByID",
 			mock.Anything,
 		).Return([]string{alias1, alias2})
+		meta.EXPECT().GetDatabaseByID(mock.Anything, int64(2), mock.Anything).Return(&model.Database{ID: 2, Name: "db2"}, nil)
 
 		core := newTestCore(withMeta(meta))
 		task := &describeCollectionTask{
@@ -121,5 +123,7 @@ func Test_describeCollectionTask_Execute(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, task.Rsp.GetStatus().GetErrorCode(), commonpb.ErrorCode_Success)
 		assert.ElementsMatch(t, []string{alias1, alias2}, task.Rsp.GetAliases())
+		// the database name is filled if described by id
+		assert.Equal(t, "db2", task.Rsp.GetDbName())
 	})
 }
@@ -0,0 +1,55 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type describeDBTask struct {
+	baseTask
+	Req *internalpb.DescribeDatabaseRequest
+	Rsp *internalpb.DescribeDatabaseResponse
+}
+
+func (t *describeDBTask) Prepare(ctx context.Context) error {
+	return nil
+}
+
+func (t *describeDBTask) Execute(ctx context.Context) error {
+	db, err := t.core.meta.GetDatabaseByName(ctx, t.Req.GetDbName(), typeutil.MaxTimestamp)
+	if err != nil {
+		t.Rsp = &internalpb.DescribeDatabaseResponse{
+			Status: merr.Status(err),
+		}
+		return err
+	}
+
+	t.Rsp = &internalpb.DescribeDatabaseResponse{
+		Status:           merr.Success(),
+		DbName:           db.Name,
+		DbID:             db.ID,
+		CreatedTimestamp: db.CreatedTime,
+		Properties:       common.CloneKeyValuePairs(db.Properties),
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/metastore/model"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func Test_describeDatabaseTask_Execute(t *testing.T) {
+	t.Run("failed to get database", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(nil, errors.New("mock"))
+		core := newTestCore(withMeta(meta))
+		task := &describeDBTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.DescribeDatabaseRequest{DbName: "db1"},
+		}
+		err := task.Prepare(context.Background())
+		assert.NoError(t, err)
+		err = task.Execute(context.Background())
+		assert.Error(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		db := model.NewDatabase(1, "db1", etcdpb.DatabaseState_DatabaseCreated)
+		db.Properties = []*commonpb.KeyValuePair{
+			{Key: common.DatabaseInsertRateMaxKey, Value: "10"},
+		}
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(db, nil)
+		core := newTestCore(withMeta(meta))
+		task := &describeDBTask{
+			baseTask: newBaseTask(context.Background(), core),
+			Req:      &internalpb.DescribeDatabaseRequest{DbName: "db1"},
+		}
+		err := task.Execute(context.Background())
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, task.Rsp.GetStatus().GetErrorCode())
+		assert.Equal(t, "db1", task.Rsp.GetDbName())
+		assert.Equal(t, int64(1), task.Rsp.GetDbID())
+		assert.Equal(t, db.CreatedTime, task.Rsp.GetCreatedTimestamp())
+		assert.Equal(t, db.Properties, task.Rsp.GetProperties())
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"sync"
 
 	"github.com/cockroachdb/errors"
+	"github.com/samber/lo"
 	"go.uber.org/zap"
 	"golang.org/x/exp/maps"
 
@@ -49,6 +50,7 @@ type IMetaTable interface {
 	CreateDatabase(ctx context.Context, db *model.Database, ts typeutil.Timestamp) error
 	DropDatabase(ctx context.Context, dbName string, ts typeutil.Timestamp) error
 	ListDatabases(ctx context.Context, ts typeutil.Timestamp) ([]*model.Database, error)
+	AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts typeutil.Timestamp) error
 
 	AddCollection(ctx context.Context, coll *model.Collection) error
 	ChangeCollectionState(ctx context.Context, collectionID UniqueID, state pb.CollectionState, ts Timestamp) error
@@ -84,6 +86,8 @@ type IMetaTable interface {
 	DeleteCredential(username string) error
 	AlterCredential(credInfo *internalpb.CredentialInfo) error
 	ListCredentialUsernames() (*milvuspb.ListCredUsersResponse, error)
+	AlterUserRateLimits(username string, rates []*internalpb.Rate) error
+	ListUserRateLimits() (map[string][]*internalpb.Rate, error)
 
 	// TODO: better to accept ctx.
 	CreateRole(tenant string, entity *milvuspb.RoleEntity) error
@@ -96,6 +100,8 @@ type IMetaTable interface {
 	DropGrant(tenant string, role *milvuspb.RoleEntity) error
 	ListPolicy(tenant string) ([]string, error)
 	ListUserRole(tenant string) ([]string, error)
+	AlterRoleRateLimits(tenant string, roleName string, rates []*internalpb.Rate) error
+	ListRoleRateLimits(tenant string) (map[string][]*internalpb.Rate, error)
 }
 
 type MetaTable struct {
@@ -111,6 +117,11 @@ type MetaTable struct {
 	names   *nameDb
 	aliases *nameDb
 
+	// rate limits of users and roles, loaded from catalog on first listing and reset when altered,
+	// nil means not loaded, protected by permissionLock
+	userRateLimits map[string][]*internalpb.Rate
+	roleRateLimits map[string]map[string][]*internalpb.Rate // tenant -> role -> rates
+
 	ddLock         sync.RWMutex
 	permissionLock sync.RWMutex
 }
@@ -317,6 +328,23 @@ func (mt *MetaTable) ListDatabases(ctx context.Context, ts typeutil.Timestamp) (
 	return maps.Values(mt.dbName2Meta), nil
 }
 
+func (mt *MetaTable) AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if oldDB.Name != newDB.Name || oldDB.ID != newDB.ID || oldDB.State != newDB.State {
+		return fmt.Errorf("alter database name/id/state is not supported")
+	}
+
+	if err := mt.catalog.AlterDatabase(ctx, newDB, ts); err != nil {
+		return err
+	}
+
+	mt.dbName2Meta[newDB.Name] = newDB
+	log.Ctx(ctx).Info("alter database finished", zap.String("db", newDB.Name), zap.Uint64("ts", ts))
+	return nil
+}
+
 func (mt *MetaTable) GetDatabaseByID(ctx context.Context, dbID int64, ts Timestamp) (*model.Database, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
@@ -1240,8 +1268,13 @@ func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
 	credential := &model.Credential{
 		Username:          credInfo.Username,
 		EncryptedPassword: credInfo.EncryptedPassword,
+		RateLimits:        credInfo.RateLimits,
 	}
-	return mt.catalog.CreateCredential(mt.ctx, credential)
+	if err := mt.catalog.CreateCredential(mt.ctx, credential); err != nil {
+		return err
+	}
+	mt.userRateLimits = nil
+	return nil
 }
 
 // AlterCredential update credential
@@ -1256,8 +1289,19 @@ func (mt *MetaTable) AlterCredential(credInfo *internalpb.CredentialInfo) error
 	credential := &model.Credential{
 		Username:          credInfo.Username,
 		EncryptedPassword: credInfo.EncryptedPassword,
+		RateLimits:        credInfo.RateLimits,
 	}
-	return mt.catalog.AlterCredential(mt.ctx, credential)
+	// the rate limits are altered by AlterUserRateLimits, keep them when updating the password
+	if credential.RateLimits == nil {
+		if origin, _ := mt.catalog.GetCredential(mt.ctx, credInfo.Username); origin != nil {
+			credential.RateLimits = origin.RateLimits
+		}
+	}
+	if err := mt.catalog.AlterCredential(mt.ctx, credential); err != nil {
+		return err
+	}
+	mt.userRateLimits = nil
+	return nil
 }
 
 // GetCredential get credential by username
@@ -1274,7 +1318,11 @@ func (mt *MetaTable) DeleteCredential(username string) error {
 	mt.permissionLock.Lock()
 	defer mt.permissionLock.Unlock()
 
-	return mt.catalog.DropCredential(mt.ctx, username)
+	if err := mt.catalog.DropCredential(mt.ctx, username); err != nil {
+		return err
+	}
+	mt.userRateLimits = nil
+	return nil
 }
 
 // ListCredentialUsernames list credential usernames
@@ -1289,6 +1337,49 @@ func (mt *MetaTable) ListCredentialUsernames() (*milvuspb.ListCredUsersResponse,
 	return &milvuspb.ListCredUsersResponse{Usernames: usernames}, nil
 }
 
+// AlterUserRateLimits replaces the rate limits of the user, empty rates means no limit.
+func (mt *MetaTable) AlterUserRateLimits(username string, rates []*internalpb.Rate) error {
+	if username == "" {
+		return fmt.Errorf("username is empty")
+	}
+
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	credential, err := mt.catalog.GetCredential(mt.ctx, username)
+	if err != nil {
+		return err
+	}
+	credential.RateLimits = rates
+	if err := mt.catalog.AlterCredential(mt.ctx, credential); err != nil {
+		return err
+	}
+	mt.userRateLimits = nil
+	return nil
+}
+
+// ListUserRateLimits returns the rate limits of all the users, users without rate limits are not included.
+// The rate limits are cached, only loaded from catalog after they are altered.
+func (mt *MetaTable) ListUserRateLimits() (map[string][]*internalpb.Rate, error) {
+	mt.permissionLock.RLock()
+	if mt.userRateLimits != nil {
+		defer mt.permissionLock.RUnlock()
+		return lo.Assign(mt.userRateLimits), nil
+	}
+	mt.permissionLock.RUnlock()
+
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+	if mt.userRateLimits == nil {
+		rates, err := mt.catalog.ListUserRateLimits(mt.ctx)
+		if err != nil {
+			return nil, err
+		}
+		mt.userRateLimits = lo.Assign(rates)
+	}
+	return lo.Assign(mt.userRateLimits), nil
+}
+
 // CreateRole create role
 func (mt *MetaTable) CreateRole(tenant string, entity *milvuspb.RoleEntity) error {
 	if funcutil.IsEmptyString(entity.Name) {
@@ -1322,7 +1413,11 @@ func (mt *MetaTable) DropRole(tenant string, roleName string) error {
 	mt.permissionLock.Lock()
 	defer mt.permissionLock.Unlock()
 
-	return mt.catalog.DropRole(mt.ctx, tenant, roleName)
+	if err := mt.catalog.DropRole(mt.ctx, tenant, roleName); err != nil {
+		return err
+	}
+	delete(mt.roleRateLimits, tenant)
+	return nil
 }
 
 // OperateUserRole operate the relationship between a user and a role, including adding a user to a role and removing a user from a role
@@ -1438,3 +1533,47 @@ func (mt *MetaTable) ListUserRole(tenant string) ([]string, error) {
 
 	return mt.catalog.ListUserRole(mt.ctx, tenant)
 }
+
+// AlterRoleRateLimits replaces the rate limits of the role, empty rates means no limit.
+func (mt *MetaTable) AlterRoleRateLimits(tenant string, roleName string, rates []*internalpb.Rate) error {
+	if funcutil.IsEmptyString(roleName) {
+		return fmt.Errorf("the role name in the role info is empty")
+	}
+
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+
+	if _, err := mt.catalog.ListRole(mt.ctx, tenant, &milvuspb.RoleEntity{Name: roleName}, false); err != nil {
+		return err
+	}
+	if err := mt.catalog.AlterRoleRateLimits(mt.ctx, tenant, roleName, rates); err != nil {
+		return err
+	}
+	delete(mt.roleRateLimits, tenant)
+	return nil
+}
+
+// ListRoleRateLimits returns the rate limits of all the roles, roles without rate limits are not included.
+// The rate limits are cached, only loaded from catalog after they are altered.
+func (mt *MetaTable) ListRoleRateLimits(tenant string) (map[string][]*internalpb.Rate, error) {
+	mt.permissionLock.RLock()
+	if rates, ok := mt.roleRateLimits[tenant]; ok {
+		defer mt.permissionLock.RUnlock()
+		return lo.Assign(rates), nil
+	}
+	mt.permissionLock.RUnlock()
+
+	mt.permissionLock.Lock()
+	defer mt.permissionLock.Unlock()
+	if _, ok := mt.roleRateLimits[tenant]; !ok {
+		rates, err := mt.catalog.ListRoleRateLimits(mt.ctx, tenant)
+		if err != nil {
+			return nil, err
+		}
+		if mt.roleRateLimits == nil {
+			mt.roleRateLimits = make(map[string]map[string][]*internalpb.Rate)
+		}
+		mt.roleRateLimits[tenant] = lo.Assign(rates)
+	}
+	return lo.Assign(mt.roleRateLimits[tenant]), nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/metastore/kv/rootcoord"
@@ -447,6 +448,107 @@ func TestRbacListPolicy(t *testing.T) {
 	assert.Equal(t, 0, len(userRoles))
 }
 
+func TestRbacRateLimits(t *testing.T) {
+	mt := generateMetaTable(t)
+	rates := []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 100}}
+
+	t.Run("user rate limits", func(t *testing.T) {
+		err := mt.AlterUserRateLimits("user1", rates)
+		assert.Error(t, err)
+
+		err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", Tenant: util.DefaultTenant})
+		require.NoError(t, err)
+		err = mt.AlterUserRateLimits("user1", rates)
+		assert.NoError(t, err)
+
+		credInfo, err := mt.GetCredential("user1")
+		assert.NoError(t, err)
+		assert.Len(t, credInfo.GetRateLimits(), 1)
+
+		// rate limits are kept when the password is altered
+		err = mt.AlterCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "password"})
+		assert.NoError(t, err)
+		userRates, err := mt.ListUserRateLimits()
+		assert.NoError(t, err)
+		assert.Len(t, userRates["user1"], 1)
+
+		err = mt.AlterUserRateLimits("", rates)
+		assert.Error(t, err)
+	})
+
+	t.Run("role rate limits", func(t *testing.T) {
+		err := mt.AlterRoleRateLimits(util.DefaultTenant, "role1", rates)
+		assert.Error(t, err)
+
+		err = mt.CreateRole(util.DefaultTenant, &milvuspb.RoleEntity{Name: "role1"})
+		require.NoError(t, err)
+		err = mt.AlterRoleRateLimits(util.DefaultTenant, "role1", rates)
+		assert.NoError(t, err)
+
+		roleRates, err := mt.ListRoleRateLimits(util.DefaultTenant)
+		assert.NoError(t, err)
+		assert.Len(t, roleRates["role1"], 1)
+
+		err = mt.DropRole(util.DefaultTenant, "role1")
+		assert.NoError(t, err)
+		roleRates, err = mt.ListRoleRateLimits(util.DefaultTenant)
+		assert.NoError(t, err)
+		assert.Empty(t, roleRates)
+
+		err = mt.AlterRoleRateLimits(util.DefaultTenant, "", rates)
+		assert.Error(t, err)
+	})
+}
+
+func TestRateLimitsCache(t *testing.T) {
+	catalog := mocks.NewRootCoordCatalog(t)
+	mt := &MetaTable{catalog: catalog}
+	rates := []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 100}}
+
+	t.Run("user rate limits", func(t *testing.T) {
+		catalog.EXPECT().ListUserRateLimits(mock.Anything).Return(nil, errors.New("mock error")).Once()
+		_, err := mt.ListUserRateLimits()
+		assert.Error(t, err)
+
+		// loaded once and cached
+		catalog.EXPECT().ListUserRateLimits(mock.Anything).Return(map[string][]*internalpb.Rate{"user1": rates}, nil).Once()
+		for i := 0; i < 2; i++ {
+			userRates, err := mt.ListUserRateLimits()
+			assert.NoError(t, err)
+			assert.Len(t, userRates["user1"], 1)
+		}
+
+		// reloaded after altered
+		catalog.EXPECT().GetCredential(mock.Anything, "user1").Return(&model.Credential{Username: "user1"}, nil).Once()
+		catalog.EXPECT().AlterCredential(mock.Anything, mock.Anything).Return(nil).Once()
+		err = mt.AlterUserRateLimits("user1", nil)
+		assert.NoError(t, err)
+		catalog.EXPECT().ListUserRateLimits(mock.Anything).Return(nil, nil).Once()
+		for i := 0; i < 2; i++ {
+			userRates, err := mt.ListUserRateLimits()
+			assert.NoError(t, err)
+			assert.Empty(t, userRates)
+		}
+	})
+
+	t.Run("role rate limits", func(t *testing.T) {
+		catalog.EXPECT().ListRoleRateLimits(mock.Anything, util.DefaultTenant).Return(map[string][]*internalpb.Rate{"role1": rates}, nil).Once()
+		for i := 0; i < 2; i++ {
+			roleRates, err := mt.ListRoleRateLimits(util.DefaultTenant)
+			assert.NoError(t, err)
+			assert.Len(t, roleRates["role1"], 1)
+		}
+
+		catalog.EXPECT().DropRole(mock.Anything, util.DefaultTenant, "role1").Return(nil).Once()
+		err := mt.DropRole(util.DefaultTenant, "role1")
+		assert.NoError(t, err)
+		catalog.EXPECT().ListRoleRateLimits(mock.Anything, util.DefaultTenant).Return(nil, nil).Once()
+		roleRates, err := mt.ListRoleRateLimits(util.DefaultTenant)
+		assert.NoError(t, err)
+		assert.Empty(t, roleRates)
+	})
+}
+
 func TestMetaTable_getCollectionByIDInternal(t *testing.T) {
 	t.Run("failed to get from catalog", func(t *testing.T) {
 		catalog := mocks.NewRootCoordCatalog(t)
@@ -1722,6 +1824,56 @@ func TestMetaTable_CreateDatabase(t *testing.T) {
 	})
 }
 
+func TestMetaTable_AlterDatabase(t *testing.T) {
+	db := model.NewDatabase(1, "db1", pb.DatabaseState_DatabaseCreated)
+	newDB := db.Clone()
+	newDB.Properties = []*commonpb.KeyValuePair{
+		{Key: common.DatabaseInsertRateMaxKey, Value: "10"},
+	}
+
+	t.Run("alter name not supported", func(t *testing.T) {
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{"db1": db},
+		}
+		renamed := db.Clone()
+		renamed.Name = "db2"
+		err := meta.AlterDatabase(context.TODO(), db, renamed, 10000)
+		assert.Error(t, err)
+	})
+
+	t.Run("database not persistent", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterDatabase",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(errors.New("error mock AlterDatabase"))
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{"db1": db},
+			catalog:     catalog,
+		}
+		err := meta.AlterDatabase(context.TODO(), db, newDB, 10000)
+		assert.Error(t, err)
+		assert.Empty(t, meta.dbName2Meta["db1"].Properties)
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		catalog := mocks.NewRootCoordCatalog(t)
+		catalog.On("AlterDatabase",
+			mock.Anything,
+			mock.Anything,
+			mock.Anything,
+		).Return(nil)
+		meta := &MetaTable{
+			dbName2Meta: map[string]*model.Database{"db1": db},
+			catalog:     catalog,
+		}
+		err := meta.AlterDatabase(context.TODO(), db, newDB, 10000)
+		assert.NoError(t, err)
+		assert.Equal(t, newDB.Properties, meta.dbName2Meta["db1"].Properties)
+	})
+}
+
 func TestMetaTable_EmtpyDatabaseName(t *testing.T) {
 	t.Run("getDatabaseByNameInternal with empty db", func(t *testing.T) {
 		mt := &MetaTable{
@@ -289,6 +289,138 @@ func (_c *IMetaTable_AlterCredential_Call) RunAndReturn(run func(*internalpb.Cre
 	return _c
 }
 
+// AlterDatabase provides a mock function with given fields: ctx, oldDB, newDB, ts
+func (_m *IMetaTable) AlterDatabase(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64) error {
+	ret := _m.Called(ctx, oldDB, newDB, ts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.Database, *model.Database, uint64) error); ok {
+		r0 = rf(ctx, oldDB, newDB, ts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterDatabase_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterDatabase'
+type IMetaTable_AlterDatabase_Call struct {
+	*mock.Call
+}
+
+// AlterDatabase is a helper method to define mock.On call
+//   - ctx context.Context
+//   - oldDB *model.Database
+//   - newDB *model.Database
+//   - ts uint64
+func (_e *IMetaTable_Expecter) AlterDatabase(ctx interface{}, oldDB interface{}, newDB interface{}, ts interface{}) *IMetaTable_AlterDatabase_Call {
+	return &IMetaTable_AlterDatabase_Call{Call: _e.mock.On("AlterDatabase", ctx, oldDB, newDB, ts)}
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) Run(run func(ctx context.Context, oldDB *model.Database, newDB *model.Database, ts uint64)) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*model.Database), args[2].(*model.Database), args[3].(uint64))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) Return(_a0 error) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterDatabase_Call) RunAndReturn(run func(context.Context, *model.Database, *model.Database, uint64) error) *IMetaTable_AlterDatabase_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterRoleRateLimits provides a mock function with given fields: tenant, roleName, rates
+func (_m *IMetaTable) AlterRoleRateLimits(tenant string, roleName string, rates []*internalpb.Rate) error {
+	ret := _m.Called(tenant, roleName, rates)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, []*internalpb.Rate) error); ok {
+		r0 = rf(tenant, roleName, rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterRoleRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterRoleRateLimits'
+type IMetaTable_AlterRoleRateLimits_Call struct {
+	*mock.Call
+}
+
+// AlterRoleRateLimits is a helper method to define mock.On call
+//   - tenant string
+//   - roleName string
+//   - rates []*internalpb.Rate
+func (_e *IMetaTable_Expecter) AlterRoleRateLimits(tenant interface{}, roleName interface{}, rates interface{}) *IMetaTable_AlterRoleRateLimits_Call {
+	return &IMetaTable_AlterRoleRateLimits_Call{Call: _e.mock.On("AlterRoleRateLimits", tenant, roleName, rates)}
+}
+
+func (_c *IMetaTable_AlterRoleRateLimits_Call) Run(run func(tenant string, roleName string, rates []*internalpb.Rate)) *IMetaTable_AlterRoleRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].(string), args[2].([]*internalpb.Rate))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterRoleRateLimits_Call) Return(_a0 error) *IMetaTable_AlterRoleRateLimits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterRoleRateLimits_Call) RunAndReturn(run func(string, string, []*internalpb.Rate) error) *IMetaTable_AlterRoleRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AlterUserRateLimits provides a mock function with given fields: username, rates
+func (_m *IMetaTable) AlterUserRateLimits(username string, rates []*internalpb.Rate) error {
+	ret := _m.Called(username, rates)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, []*internalpb.Rate) error); ok {
+		r0 = rf(username, rates)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// IMetaTable_AlterUserRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AlterUserRateLimits'
+type IMetaTable_AlterUserRateLimits_Call struct {
+	*mock.Call
+}
+
+// AlterUserRateLimits is a helper method to define mock.On call
+//   - username string
+//   - rates []*internalpb.Rate
+func (_e *IMetaTable_Expecter) AlterUserRateLimits(username interface{}, rates interface{}) *IMetaTable_AlterUserRateLimits_Call {
+	return &IMetaTable_AlterUserRateLimits_Call{Call: _e.mock.On("AlterUserRateLimits", username, rates)}
+}
+
+func (_c *IMetaTable_AlterUserRateLimits_Call) Run(run func(username string, rates []*internalpb.Rate)) *IMetaTable_AlterUserRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]*internalpb.Rate))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_AlterUserRateLimits_Call) Return(_a0 error) *IMetaTable_AlterUserRateLimits_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *IMetaTable_AlterUserRateLimits_Call) RunAndReturn(run func(string, []*internalpb.Rate) error) *IMetaTable_AlterUserRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ChangeCollectionState provides a mock function with given fields: ctx, collectionID, state, ts
 func (_m *IMetaTable) ChangeCollectionState(ctx context.Context, collectionID int64, state etcdpb.CollectionState, ts uint64) error {
 	ret := _m.Called(ctx, collectionID, state, ts)
@@ -1668,6 +1800,113 @@ func (_c *IMetaTable_ListPolicy_Call) RunAndReturn(run func(string) ([]string, e
 	return _c
 }
 
+// ListRoleRateLimits provides a mock function with given fields: tenant
+func (_m *IMetaTable) ListRoleRateLimits(tenant string) (map[string][]*internalpb.Rate, error) {
+	ret := _m.Called(tenant)
+
+	var r0 map[string][]*internalpb.Rate
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (map[string][]*internalpb.Rate, error)); ok {
+		return rf(tenant)
+	}
+	if rf, ok := ret.Get(0).(func(string) map[string][]*internalpb.Rate); ok {
+		r0 = rf(tenant)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*internalpb.Rate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(tenant)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListRoleRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleRateLimits'
+type IMetaTable_ListRoleRateLimits_Call struct {
+	*mock.Call
+}
+
+// ListRoleRateLimits is a helper method to define mock.On call
+//   - tenant string
+func (_e *IMetaTable_Expecter) ListRoleRateLimits(tenant interface{}) *IMetaTable_ListRoleRateLimits_Call {
+	return &IMetaTable_ListRoleRateLimits_Call{Call: _e.mock.On("ListRoleRateLimits", tenant)}
+}
+
+func (_c *IMetaTable_ListRoleRateLimits_Call) Run(run func(tenant string)) *IMetaTable_ListRoleRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string))
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListRoleRateLimits_Call) Return(_a0 map[string][]*internalpb.Rate, _a1 error) *IMetaTable_ListRoleRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListRoleRateLimits_Call) RunAndReturn(run func(string) (map[string][]*internalpb.Rate, error)) *IMetaTable_ListRoleRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListUserRateLimits provides a mock function with given fields:
+func (_m *IMetaTable) ListUserRateLimits() (map[string][]*internalpb.Rate, error) {
+	ret := _m.Called()
+
+	var r0 map[string][]*internalpb.Rate
+	var r1 error
+	if rf, ok := ret.Get(0).(func() (map[string][]*internalpb.Rate, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() map[string][]*internalpb.Rate); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*internalpb.Rate)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IMetaTable_ListUserRateLimits_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListUserRateLimits'
+type IMetaTable_ListUserRateLimits_Call struct {
+	*mock.Call
+}
+
+// ListUserRateLimits is a helper method to define mock.On call
+func (_e *IMetaTable_Expecter) ListUserRateLimits() *IMetaTable_ListUserRateLimits_Call {
+	return &IMetaTable_ListUserRateLimits_Call{Call: _e.mock.On("ListUserRateLimits")}
+}
+
+func (_c *IMetaTable_ListUserRateLimits_Call) Run(run func()) *IMetaTable_ListUserRateLimits_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *IMetaTable_ListUserRateLimits_Call) Return(_a0 map[string][]*internalpb.Rate, _a1 error) *IMetaTable_ListUserRateLimits_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *IMetaTable_ListUserRateLimits_Call) RunAndReturn(run func() (map[string][]*internalpb.Rate, error)) *IMetaTable_ListUserRateLimits_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListUserRole provides a mock function with given fields: tenant
 func (_m *IMetaTable) ListUserRole(tenant string) ([]string, error) {
 	ret := _m.Called(tenant)
@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/metrics"
+	"github.com/milvus-io/milvus/pkg/util"
 	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/metricsinfo"
@@ -106,7 +107,18 @@ type QuotaCenter struct {
 
 	currentRates map[int64]collectionRates
 	quotaStates  map[int64]collectionStates
-	tsoAllocator tso.Allocator
+	// database level rates and states, keyed by database name
+	databaseRates  map[string]collectionRates
+	databaseStates map[string]collectionStates
+	// partition level rates and states, collectionID -> partitionID -> rates
+	partitionRates  map[int64]map[int64]collectionRates
+	partitionStates map[int64]map[int64]collectionStates
+	// collectionDatabases maps collectionID to the name of database it belongs to
+	collectionDatabases map[int64]string
+	// the last rate limits of users and roles loaded from meta, kept if failed to load them
+	userRateLimits map[string][]*internalpb.Rate
+	roleRateLimits map[string][]*internalpb.Rate
+	tsoAllocator   tso.Allocator
 
 	rateAllocateStrategy RateAllocateStrategy
 
@@ -122,6 +134,11 @@ func NewQuotaCenter(proxies proxyutil.ProxyClientManagerInterface, queryCoord ty
 		dataCoord:           dataCoord,
 		currentRates:        make(map[int64]map[internalpb.RateType]Limit),
 		quotaStates:         make(map[int64]map[milvuspb.QuotaState]commonpb.ErrorCode),
+		databaseRates:       make(map[string]collectionRates),
+		databaseStates:      make(map[string]collectionStates),
+		partitionRates:      make(map[int64]map[int64]collectionRates),
+		partitionStates:     make(map[int64]map[int64]collectionStates),
+		collectionDatabases: make(map[int64]string),
 		tsoAllocator:        tsoAllocator,
 		meta:                meta,
 		readableCollections: make([]int64, 0),
@@ -297,6 +314,43 @@ func (q *QuotaCenter) forceDenyWriting(errorCode commonpb.ErrorCode, collections
 		zap.String("reason", errorCode.String()))
 }
 
+// forceDenyDatabaseWriting sets dml rates of the database to 0 to reject all dml requests to it.
+func (q *QuotaCenter) forceDenyDatabaseWriting(errorCode commonpb.ErrorCode, dbName string) {
+	if _, ok := q.databaseRates[dbName]; !ok {
+		q.databaseRates[dbName] = make(collectionRates)
+		q.databaseStates[dbName] = make(collectionStates)
+	}
+	q.databaseRates[dbName][internalpb.RateType_DMLInsert] = 0
+	q.databaseRates[dbName][internalpb.RateType_DMLUpsert] = 0
+	q.databaseRates[dbName][internalpb.RateType_DMLDelete] = 0
+	q.databaseRates[dbName][internalpb.RateType_DMLBulkLoad] = 0
+	q.databaseStates[dbName][milvuspb.QuotaState_DenyToWrite] = errorCode
+	log.RatedWarn(10, "QuotaCenter force to deny writing database",
+		zap.String("dbName", dbName),
+		zap.String("reason", errorCode.String()))
+}
+
+// forceDenyPartitionWriting sets dml rates of the partition to 0 to reject all dml requests to it.
+func (q *QuotaCenter) forceDenyPartitionWriting(errorCode commonpb.ErrorCode, collection int64, partition int64) {
+	if _, ok := q.partitionRates[collection]; !ok {
+		q.partitionRates[collection] = make(map[int64]collectionRates)
+		q.partitionStates[collection] = make(map[int64]collectionStates)
+	}
+	if _, ok := q.partitionRates[collection][partition]; !ok {
+		q.partitionRates[collection][partition] = make(collectionRates)
+		q.partitionStates[collection][partition] = make(collectionStates)
+	}
+	q.partitionRates[collection][partition][internalpb.RateType_DMLInsert] = 0
+	q.partitionRates[collection][partition][internalpb.RateType_DMLUpsert] = 0
+	q.partitionRates[collection][partition][internalpb.RateType_DMLDelete] = 0
+	q.partitionRates[collection][partition][internalpb.RateType_DMLBulkLoad] = 0
+	q.partitionStates[collection][partition][milvuspb.QuotaState_DenyToWrite] = errorCode
+	log.RatedWarn(10, "QuotaCenter force to deny writing partition",
+		zap.Int64("collectionID", collection),
+		zap.Int64("partitionID", partition),
+		zap.String("reason", errorCode.String()))
+}
+
 // forceDenyReading sets dql rates to 0 to reject all dql requests.
 func (q *QuotaCenter) forceDenyReading(errorCode commonpb.ErrorCode, collections ...int64) {
 	if len(collections) == 0 {
@@ -708,6 +762,130 @@ func (q *QuotaCenter) resetAllCurrentRates() {
 		q.resetCurrentRate(internalpb.RateType_DQLSearch, collection)
 		q.resetCurrentRate(internalpb.RateType_DQLQuery, collection)
 	}
+
+	q.resetDatabaseAndPartitionRates()
+}
+
+var (
+	dmlRateTypes = []internalpb.RateType{
+		internalpb.RateType_DMLInsert,
+		internalpb.RateType_DMLUpsert,
+		internalpb.RateType_DMLDelete,
+		internalpb.RateType_DMLBulkLoad,
+	}
+	dqlRateTypes = []internalpb.RateType{
+		internalpb.RateType_DQLSearch,
+		internalpb.RateType_DQLQuery,
+	}
+
+	databaseRateLimitKeys = map[internalpb.RateType]string{
+		internalpb.RateType_DMLInsert:   common.DatabaseInsertRateMaxKey,
+		internalpb.RateType_DMLUpsert:   common.DatabaseUpsertRateMaxKey,
+		internalpb.RateType_DMLDelete:   common.DatabaseDeleteRateMaxKey,
+		internalpb.RateType_DMLBulkLoad: common.DatabaseBulkLoadRateMaxKey,
+		internalpb.RateType_DQLSearch:   common.DatabaseSearchRateMaxKey,
+		internalpb.RateType_DQLQuery:    common.DatabaseQueryRateMaxKey,
+	}
+	partitionRateLimitKeys = map[internalpb.RateType]string{
+		internalpb.RateType_DMLInsert:   common.PartitionInsertRateMaxKey,
+		internalpb.RateType_DMLUpsert:   common.PartitionUpsertRateMaxKey,
+		internalpb.RateType_DMLDelete:   common.PartitionDeleteRateMaxKey,
+		internalpb.RateType_DMLBulkLoad: common.PartitionBulkLoadRateMaxKey,
+		internalpb.RateType_DQLSearch:   common.PartitionSearchRateMaxKey,
+		internalpb.RateType_DQLQuery:    common.PartitionQueryRateMaxKey,
+	}
+)
+
+// resetDatabaseAndPartitionRates resets the rates of the databases and partitions
+// which the writable and readable collections belong to.
+func (q *QuotaCenter) resetDatabaseAndPartitionRates() {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+	q.databaseRates = make(map[string]collectionRates)
+	q.databaseStates = make(map[string]collectionStates)
+	q.partitionRates = make(map[int64]map[int64]collectionRates)
+	q.partitionStates = make(map[int64]map[int64]collectionStates)
+	q.collectionDatabases = make(map[int64]string)
+
+	writable := typeutil.NewUniqueSet(q.writableCollections...)
+	readable := typeutil.NewUniqueSet(q.readableCollections...)
+	collections := typeutil.NewUniqueSet(q.writableCollections...)
+	collections.Insert(q.readableCollections...)
+	for _, collection := range collections.Collect() {
+		// dbName can be ignored if ts is max timestamps
+		collectionInfo, err := q.meta.GetCollectionByID(context.TODO(), "", collection, typeutil.MaxTimestamp, false)
+		if err != nil {
+			log.RatedWarn(10, "failed to get collection meta when reset database and partition rates",
+				zap.Int64("collectionID", collection),
+				zap.Error(err))
+			continue
+		}
+
+		rateTypes := make([]internalpb.RateType, 0, len(dmlRateTypes)+len(dqlRateTypes))
+		if writable.Contain(collection) {
+			rateTypes = append(rateTypes, dmlRateTypes...)
+		}
+		if readable.Contain(collection) {
+			rateTypes = append(rateTypes, dqlRateTypes...)
+		}
+
+		db, err := q.meta.GetDatabaseByID(context.TODO(), collectionInfo.DBID, typeutil.MaxTimestamp)
+		if err != nil {
+			log.RatedWarn(10, "failed to get database meta when reset database rates",
+				zap.Int64("collectionID", collection),
+				zap.Int64("dbID", collectionInfo.DBID),
+				zap.Error(err))
+		} else {
+			q.collectionDatabases[collection] = db.Name
+			dbProps := toPropertiesMap(db.Properties)
+			for _, rt := range rateTypes {
+				q.resetDatabaseRate(rt, db.Name, dbProps)
+			}
+		}
+
+		collectionProps := toPropertiesMap(collectionInfo.Properties)
+		for _, partition := range collectionInfo.Partitions {
+			for _, rt := range rateTypes {
+				q.resetPartitionRate(rt, collection, partition.PartitionID, collectionProps)
+			}
+		}
+	}
+}
+
+// resetDatabaseRate resets the rate of database to the configured rate.
+func (q *QuotaCenter) resetDatabaseRate(rt internalpb.RateType, dbName string, dbProps map[string]string) {
+	if q.databaseRates[dbName] == nil {
+		q.databaseRates[dbName] = make(collectionRates)
+		q.databaseStates[dbName] = make(collectionStates)
+	}
+	q.databaseRates[dbName][rt] = Limit(getCollectionRateLimitConfig(dbProps, databaseRateLimitKeys[rt]))
+	if q.databaseRates[dbName][rt] < 0 {
+		q.databaseRates[dbName][rt] = Inf // no limit
+	}
+}
+
+// resetPartitionRate resets the rate of partition to the configured rate,
+// the partition rate limits are set in the properties of collection.
+func (q *QuotaCenter) resetPartitionRate(rt internalpb.RateType, collection int64, partition int64, collectionProps map[string]string) {
+	if q.partitionRates[collection] == nil {
+		q.partitionRates[collection] = make(map[int64]collectionRates)
+		q.partitionStates[collection] = make(map[int64]collectionStates)
+	}
+	if q.partitionRates[collection][partition] == nil {
+		q.partitionRates[collection][partition] = make(collectionRates)
+		q.partitionStates[collection][partition] = make(collectionStates)
+	}
+	q.partitionRates[collection][partition][rt] = Limit(getCollectionRateLimitConfig(collectionProps, partitionRateLimitKeys[rt]))
+	if q.partitionRates[collection][partition][rt] < 0 {
+		q.partitionRates[collection][partition][rt] = Inf // no limit
+	}
+}
+
+func toPropertiesMap(pairs []*commonpb.KeyValuePair) map[string]string {
+	properties := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		properties[pair.GetKey()] = pair.GetValue()
+	}
+	return properties
 }
 
 // resetCurrentRates resets all current rates to configured rates.
@@ -752,12 +930,21 @@ func (q *QuotaCenter) getCollectionLimitProperties(collection int64) map[string]
 		return make(map[string]string)
 	}
 
-	properties := make(map[string]string)
-	for _, pair := range collectionInfo.Properties {
-		properties[pair.GetKey()] = pair.GetValue()
+	return toPropertiesMap(collectionInfo.Properties)
+}
+
+func (q *QuotaCenter) getDatabaseLimitProperties(dbName string) map[string]string {
+	log := log.Ctx(context.Background()).WithRateGroup("rootcoord.QuotaCenter", 1.0, 60.0)
+
+	db, err := q.meta.GetDatabaseByName(context.TODO(), dbName, typeutil.MaxTimestamp)
+	if err != nil {
+		log.RatedWarn(10, "failed to get rate limit properties from database meta",
+			zap.String("dbName", dbName),
+			zap.Error(err))
+		return make(map[string]string)
 	}
 
-	return properties
+	return toPropertiesMap(db.Properties)
 }
 
 // checkDiskQuota checks if disk quota exceeded.
@@ -786,6 +973,39 @@ func (q *QuotaCenter) checkDiskQuota() {
 	if collections.Len() > 0 {
 		q.forceDenyWriting(commonpb.ErrorCode_DiskQuotaExhausted, collections.Collect()...)
 	}
+
+	dbBinlogSize := make(map[string]int64)
+	for collection, binlogSize := range q.dataCoordMetrics.CollectionBinlogSize {
+		if dbName, ok := q.collectionDatabases[collection]; ok {
+			dbBinlogSize[dbName] += binlogSize
+		}
+	}
+	for dbName, binlogSize := range dbBinlogSize {
+		dbDiskQuota := getCollectionRateLimitConfig(q.getDatabaseLimitProperties(dbName), common.DatabaseDiskQuotaKey)
+		if float64(binlogSize) >= dbDiskQuota {
+			log.RatedWarn(10, "database disk quota exceeded",
+				zap.String("database", dbName),
+				zap.Int64("db disk usage", binlogSize),
+				zap.Float64("db disk quota", dbDiskQuota))
+			q.forceDenyDatabaseWriting(commonpb.ErrorCode_DiskQuotaExhausted, dbName)
+		}
+	}
+
+	for collection, partitions := range q.dataCoordMetrics.PartitionsBinlogSize {
+		collectionProps := q.getCollectionLimitProperties(collection)
+		partDiskQuota := getCollectionRateLimitConfig(collectionProps, common.PartitionDiskQuotaKey)
+		for partition, binlogSize := range partitions {
+			if float64(binlogSize) >= partDiskQuota {
+				log.RatedWarn(10, "partition disk quota exceeded",
+					zap.Int64("collection", collection),
+					zap.Int64("partition", partition),
+					zap.Int64("part disk usage", binlogSize),
+					zap.Float64("part disk quota", partDiskQuota))
+				q.forceDenyPartitionWriting(commonpb.ErrorCode_DiskQuotaExhausted, collection, partition)
+			}
+		}
+	}
+
 	total := q.dataCoordMetrics.TotalBinlogSize
 	if float64(total) >= totalDiskQuota {
 		log.RatedWarn(10, "total disk quota exceeded",
@@ -801,8 +1021,8 @@ func (q *QuotaCenter) setRates() error {
 	ctx, cancel := context.WithTimeout(context.Background(), SetRatesTimeout)
 	defer cancel()
 
-	toCollectionRate := func(collection int64, currentRates map[internalpb.RateType]ratelimitutil.Limit) *proxypb.CollectionRate {
-		rates := make([]*internalpb.Rate, 0, len(q.currentRates))
+	toRates := func(currentRates map[internalpb.RateType]ratelimitutil.Limit) []*internalpb.Rate {
+		rates := make([]*internalpb.Rate, 0, len(currentRates))
 		switch q.rateAllocateStrategy {
 		case Average:
 			proxyNum := q.proxies.GetProxyCount()
@@ -820,12 +1040,44 @@ func (q *QuotaCenter) setRates() error {
 		case ByRateWeight:
 			// TODO: support ByRateWeight
 		}
+		return rates
+	}
+
+	// isUnlimited returns true if no limit is set and no state is reported,
+	// it's used to skip the useless partition rates.
+	isUnlimited := func(currentRates collectionRates, states collectionStates) bool {
+		if len(states) > 0 {
+			return false
+		}
+		for _, r := range currentRates {
+			if r != Inf {
+				return false
+			}
+		}
+		return true
+	}
+
+	toCollectionRate := func(collection int64, currentRates map[internalpb.RateType]ratelimitutil.Limit) *proxypb.CollectionRate {
+		partitionRates := make([]*proxypb.PartitionRate, 0)
+		for partition, rates := range q.partitionRates[collection] {
+			states := q.partitionStates[collection][partition]
+			if isUnlimited(rates, states) {
+				continue
+			}
+			partitionRates = append(partitionRates, &proxypb.PartitionRate{
+				Partition: partition,
+				Rates:     toRates(rates),
+				States:    lo.Keys(states),
+				Codes:     lo.Values(states),
+			})
+		}
 
 		return &proxypb.CollectionRate{
-			Collection: collection,
-			Rates:      rates,
-			States:     lo.Keys(q.quotaStates[collection]),
-			Codes:      lo.Values(q.quotaStates[collection]),
+			Collection:     collection,
+			Rates:          toRates(currentRates),
+			States:         lo.Keys(q.quotaStates[collection]),
+			Codes:          lo.Values(q.quotaStates[collection]),
+			PartitionRates: partitionRates,
 		}
 	}
 
@@ -833,28 +1085,84 @@ func (q *QuotaCenter) setRates() error {
 	for collection, rates := range q.currentRates {
 		collectionRates = append(collectionRates, toCollectionRate(collection, rates))
 	}
+	databaseRates := make([]*proxypb.DatabaseRate, 0)
+	for dbName, rates := range q.databaseRates {
+		databaseRates = append(databaseRates, &proxypb.DatabaseRate{
+			DbName: dbName,
+			Rates:  toRates(rates),
+			States: lo.Keys(q.databaseStates[dbName]),
+			Codes:  lo.Values(q.databaseStates[dbName]),
+		})
+	}
+	userRates, roleRates := q.getPrincipalRates(toRates)
 	timestamp := tsoutil.ComposeTSByTime(time.Now(), 0)
 	req := &proxypb.SetRatesRequest{
 		Base: commonpbutil.NewMsgBase(
 			commonpbutil.WithMsgID(int64(timestamp)),
 			commonpbutil.WithTimeStamp(timestamp),
 		),
-		Rates: collectionRates,
+		Rates:         collectionRates,
+		DatabaseRates: databaseRates,
+		UserRates:     userRates,
+		RoleRates:     roleRates,
 	}
 	return q.proxies.SetRates(ctx, req)
 }
 
+// getPrincipalRates loads the rate limits of users and roles from meta, the limits are
+// allocated to Proxies in the same way as the collection rates. The last loaded limits
+// are used if failed to load them, so that the principals are never left unlimited by a meta error.
+func (q *QuotaCenter) getPrincipalRates(toRates func(map[internalpb.RateType]ratelimitutil.Limit) []*internalpb.Rate) ([]*proxypb.PrincipalRate, []*proxypb.PrincipalRate) {
+	convert := func(principalRates map[string][]*internalpb.Rate) []*proxypb.PrincipalRate {
+		result := make([]*proxypb.PrincipalRate, 0, len(principalRates))
+		for name, rates := range principalRates {
+			limits := make(map[internalpb.RateType]ratelimitutil.Limit, len(rates))
+			for _, rate := range rates {
+				limits[rate.GetRt()] = Limit(rate.GetR())
+			}
+			result = append(result, &proxypb.PrincipalRate{
+				Name:  name,
+				Rates: toRates(limits),
+			})
+		}
+		return result
+	}
+
+	if userRates, err := q.meta.ListUserRateLimits(); err != nil {
+		log.RatedWarn(10, "failed to list user rate limits, use the last loaded ones", zap.Error(err))
+	} else {
+		q.userRateLimits = userRates
+	}
+	if roleRates, err := q.meta.ListRoleRateLimits(util.DefaultTenant); err != nil {
+		log.RatedWarn(10, "failed to list role rate limits, use the last loaded ones", zap.Error(err))
+	} else {
+		q.roleRateLimits = roleRates
+	}
+	return convert(q.userRateLimits), convert(q.roleRateLimits)
+}
+
 // recordMetrics records metrics of quota states.
 func (q *QuotaCenter) recordMetrics() {
 	record := func(errorCode commonpb.ErrorCode) {
 		var hasException float64 = 0
-		for _, states := range q.quotaStates {
+		check := func(states collectionStates) {
 			for _, state := range states {
 				if state == errorCode {
 					hasException = 1
 				}
 			}
 		}
+		for _, states := range q.quotaStates {
+			check(states)
+		}
+		for _, states := range q.databaseStates {
+			check(states)
+		}
+		for _, partitions := range q.partitionStates {
+			for _, states := range partitions {
+				check(states)
+			}
+		}
 		metrics.RootCoordQuotaStates.WithLabelValues(errorCode.String()).Set(hasException)
 	}
 	record(commonpb.ErrorCode_MemoryQuotaExhausted)
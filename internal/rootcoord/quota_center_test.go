@@ -34,6 +34,7 @@ import (
 	"github.com/milvus-io/milvus/internal/metastore/model"
 	"github.com/milvus-io/milvus/internal/mocks"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	mockrootcoord "github.com/milvus-io/milvus/internal/rootcoord/mocks"
 	"github.com/milvus-io/milvus/internal/util/proxyutil"
 	"github.com/milvus-io/milvus/pkg/common"
@@ -537,9 +538,27 @@ func TestQuotaCenter(t *testing.T) {
 	t.Run("test setRates", func(t *testing.T) {
 		qc := mocks.NewMockQueryCoordClient(t)
 		pcm.EXPECT().GetProxyCount().Return(1)
-		pcm.EXPECT().SetRates(mock.Anything, mock.Anything).Return(nil)
+		pcm.EXPECT().SetRates(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req *proxypb.SetRatesRequest) error {
+			assert.Len(t, req.GetRates(), 1)
+			assert.Len(t, req.GetRates()[0].GetPartitionRates(), 1)
+			assert.Equal(t, int64(10), req.GetRates()[0].GetPartitionRates()[0].GetPartition())
+			assert.Len(t, req.GetDatabaseRates(), 1)
+			assert.Equal(t, "db1", req.GetDatabaseRates()[0].GetDbName())
+			assert.Len(t, req.GetUserRates(), 1)
+			assert.Equal(t, "user1", req.GetUserRates()[0].GetName())
+			assert.Equal(t, float64(100), req.GetUserRates()[0].GetRates()[0].GetR())
+			assert.Len(t, req.GetRoleRates(), 1)
+			assert.Equal(t, "role1", req.GetRoleRates()[0].GetName())
+			return nil
+		})
 		meta := mockrootcoord.NewIMetaTable(t)
 		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, merr.ErrCollectionNotFound).Maybe()
+		meta.EXPECT().ListUserRateLimits().Return(map[string][]*internalpb.Rate{
+			"user1": {{Rt: internalpb.RateType_DQLSearch, R: 100}},
+		}, nil)
+		meta.EXPECT().ListRoleRateLimits(mock.Anything).Return(map[string][]*internalpb.Rate{
+			"role1": {{Rt: internalpb.RateType_DMLInsert, R: 1024}},
+		}, nil)
 		quotaCenter := NewQuotaCenter(pcm, qc, dc, core.tsoAllocator, meta)
 		quotaCenter.resetAllCurrentRates()
 		collectionID := int64(1)
@@ -548,10 +567,44 @@ func TestQuotaCenter(t *testing.T) {
 		quotaCenter.currentRates[collectionID][internalpb.RateType_DMLInsert] = 100
 		quotaCenter.quotaStates[collectionID][milvuspb.QuotaState_DenyToWrite] = commonpb.ErrorCode_MemoryQuotaExhausted
 		quotaCenter.quotaStates[collectionID][milvuspb.QuotaState_DenyToRead] = commonpb.ErrorCode_ForceDeny
+		quotaCenter.resetDatabaseRate(internalpb.RateType_DMLInsert, "db1", map[string]string{})
+		quotaCenter.resetPartitionRate(internalpb.RateType_DMLInsert, collectionID, 10, map[string]string{})
+		quotaCenter.resetPartitionRate(internalpb.RateType_DMLInsert, collectionID, 11, map[string]string{})
+		quotaCenter.forceDenyPartitionWriting(commonpb.ErrorCode_DiskQuotaExhausted, collectionID, 10)
 		err = quotaCenter.setRates()
 		assert.NoError(t, err)
 	})
 
+	t.Run("test getPrincipalRates keeps the last loaded rates", func(t *testing.T) {
+		qc := mocks.NewMockQueryCoordClient(t)
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().ListUserRateLimits().Return(map[string][]*internalpb.Rate{
+			"user1": {{Rt: internalpb.RateType_DQLSearch, R: 100}},
+		}, nil).Once()
+		meta.EXPECT().ListRoleRateLimits(mock.Anything).Return(map[string][]*internalpb.Rate{
+			"role1": {{Rt: internalpb.RateType_DMLInsert, R: 1024}},
+		}, nil).Once()
+		meta.EXPECT().ListUserRateLimits().Return(nil, errors.New("mock error")).Once()
+		meta.EXPECT().ListRoleRateLimits(mock.Anything).Return(nil, errors.New("mock error")).Once()
+		quotaCenter := NewQuotaCenter(pcm, qc, dc, core.tsoAllocator, meta)
+		toRates := func(limits map[internalpb.RateType]ratelimitutil.Limit) []*internalpb.Rate {
+			rates := make([]*internalpb.Rate, 0, len(limits))
+			for rt, r := range limits {
+				rates = append(rates, &internalpb.Rate{Rt: rt, R: float64(r)})
+			}
+			return rates
+		}
+
+		for i := 0; i < 2; i++ {
+			userRates, roleRates := quotaCenter.getPrincipalRates(toRates)
+			assert.Len(t, userRates, 1)
+			assert.Equal(t, "user1", userRates[0].GetName())
+			assert.Equal(t, float64(100), userRates[0].GetRates()[0].GetR())
+			assert.Len(t, roleRates, 1)
+			assert.Equal(t, "role1", roleRates[0].GetName())
+		}
+	})
+
 	t.Run("test recordMetrics", func(t *testing.T) {
 		qc := mocks.NewMockQueryCoordClient(t)
 		meta := mockrootcoord.NewIMetaTable(t)
@@ -632,8 +685,32 @@ func TestQuotaCenter(t *testing.T) {
 		assert.Equal(t, float64(quotaCenter.currentRates[1][internalpb.RateType_DQLQuery]), Params.QuotaConfig.DQLMaxQueryRatePerCollection.GetAsFloat())
 
 		meta.ExpectedCalls = nil
+		meta.EXPECT().GetDatabaseByID(mock.Anything, int64(1), mock.Anything).Return(&model.Database{
+			ID:   1,
+			Name: "db1",
+			Properties: []*commonpb.KeyValuePair{
+				{
+					Key:   common.DatabaseInsertRateMaxKey,
+					Value: "7",
+				},
+				{
+					Key:   common.DatabaseQueryRateMaxKey,
+					Value: "8",
+				},
+			},
+		}, nil)
 		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Collection{
+			DBID:       1,
+			Partitions: []*model.Partition{{PartitionID: 10}},
 			Properties: []*commonpb.KeyValuePair{
+				{
+					Key:   common.PartitionInsertRateMaxKey,
+					Value: "9",
+				},
+				{
+					Key:   common.PartitionSearchRateMaxKey,
+					Value: "10",
+				},
 				{
 					Key:   common.CollectionInsertRateMaxKey,
 					Value: "1",
@@ -671,6 +748,75 @@ func TestQuotaCenter(t *testing.T) {
 		assert.Equal(t, float64(quotaCenter.currentRates[1][internalpb.RateType_DQLQuery]), float64(4))
 		assert.Equal(t, float64(quotaCenter.currentRates[1][internalpb.RateType_DQLSearch]), float64(5))
 		assert.Equal(t, float64(quotaCenter.currentRates[1][internalpb.RateType_DMLUpsert]), float64(6*1024*1024))
+
+		assert.Equal(t, "db1", quotaCenter.collectionDatabases[1])
+		assert.Equal(t, float64(quotaCenter.databaseRates["db1"][internalpb.RateType_DMLInsert]), float64(7*1024*1024))
+		assert.Equal(t, float64(quotaCenter.databaseRates["db1"][internalpb.RateType_DQLQuery]), float64(8))
+		assert.Equal(t, float64(quotaCenter.databaseRates["db1"][internalpb.RateType_DQLSearch]), Params.QuotaConfig.DQLMaxSearchRatePerDB.GetAsFloat())
+		assert.Equal(t, float64(quotaCenter.partitionRates[1][10][internalpb.RateType_DMLInsert]), float64(9*1024*1024))
+		assert.Equal(t, float64(quotaCenter.partitionRates[1][10][internalpb.RateType_DQLSearch]), float64(10))
+		assert.Equal(t, float64(quotaCenter.partitionRates[1][10][internalpb.RateType_DMLDelete]), Params.QuotaConfig.DMLMaxDeleteRatePerPartition.GetAsFloat())
+	})
+
+	t.Run("test database and partition disk quota", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(1), mock.Anything, mock.Anything).Return(&model.Collection{
+			CollectionID: 1,
+			DBID:         1,
+			Partitions:   []*model.Partition{{PartitionID: 10}, {PartitionID: 11}},
+			Properties: []*commonpb.KeyValuePair{
+				{
+					Key:   common.PartitionDiskQuotaKey,
+					Value: "30",
+				},
+			},
+		}, nil)
+		meta.EXPECT().GetCollectionByID(mock.Anything, mock.Anything, int64(2), mock.Anything, mock.Anything).Return(&model.Collection{
+			CollectionID: 2,
+			DBID:         2,
+			Partitions:   []*model.Partition{{PartitionID: 20}},
+		}, nil)
+		meta.EXPECT().GetDatabaseByID(mock.Anything, int64(1), mock.Anything).Return(&model.Database{ID: 1, Name: "db1"}, nil)
+		meta.EXPECT().GetDatabaseByID(mock.Anything, int64(2), mock.Anything).Return(&model.Database{ID: 2, Name: "db2"}, nil)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db1", mock.Anything).Return(&model.Database{
+			ID:   1,
+			Name: "db1",
+			Properties: []*commonpb.KeyValuePair{
+				{
+					Key:   common.DatabaseDiskQuotaKey,
+					Value: "100",
+				},
+			},
+		}, nil)
+		meta.EXPECT().GetDatabaseByName(mock.Anything, "db2", mock.Anything).Return(&model.Database{ID: 2, Name: "db2"}, nil)
+
+		quotaCenter := NewQuotaCenter(pcm, nil, dc, core.tsoAllocator, meta)
+		quotaCenter.writableCollections = []int64{1, 2}
+		quotaCenter.dataCoordMetrics = &metricsinfo.DataCoordQuotaMetrics{
+			TotalBinlogSize:      200 * 1024 * 1024,
+			CollectionBinlogSize: map[int64]int64{1: 150 * 1024 * 1024, 2: 50 * 1024 * 1024},
+			PartitionsBinlogSize: map[int64]map[int64]int64{
+				1: {10: 140 * 1024 * 1024, 11: 10 * 1024 * 1024},
+				2: {20: 50 * 1024 * 1024},
+			},
+		}
+		quotaCenter.resetAllCurrentRates()
+		quotaCenter.checkDiskQuota()
+
+		// db1 exceeds the database disk quota, db2 has no limit
+		assert.Equal(t, Limit(0), quotaCenter.databaseRates["db1"][internalpb.RateType_DMLInsert])
+		assert.Equal(t, commonpb.ErrorCode_DiskQuotaExhausted, quotaCenter.databaseStates["db1"][milvuspb.QuotaState_DenyToWrite])
+		assert.NotEqual(t, Limit(0), quotaCenter.databaseRates["db2"][internalpb.RateType_DMLInsert])
+		assert.Empty(t, quotaCenter.databaseStates["db2"])
+
+		// partition 10 exceeds the partition disk quota
+		assert.Equal(t, Limit(0), quotaCenter.partitionRates[1][10][internalpb.RateType_DMLInsert])
+		assert.Equal(t, commonpb.ErrorCode_DiskQuotaExhausted, quotaCenter.partitionStates[1][10][milvuspb.QuotaState_DenyToWrite])
+		assert.NotEqual(t, Limit(0), quotaCenter.partitionRates[1][11][internalpb.RateType_DMLInsert])
+		assert.NotEqual(t, Limit(0), quotaCenter.partitionRates[2][20][internalpb.RateType_DMLInsert])
+
+		// collection rates are not affected
+		assert.NotEqual(t, Limit(0), quotaCenter.currentRates[1][internalpb.RateType_DMLInsert])
 	})
 }
 
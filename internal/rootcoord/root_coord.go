@@ -971,6 +971,87 @@ func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequ
 	return t.Resp, nil
 }
 
+func (c *Core) AlterDatabase(ctx context.Context, in *internalpb.AlterDatabaseRequest) (*commonpb.Status, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	method := "AlterDatabase"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log.Ctx(ctx).Info("received request to alter database", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()), zap.Any("props", in.GetProperties()),
+		zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+	t := &alterDatabaseTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Ctx(ctx).Warn("failed to enqueue request to alter database", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("dbName", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Ctx(ctx).Warn("failed to alter database", zap.String("role", typeutil.RootCoordRole),
+			zap.Error(err),
+			zap.String("dbName", in.GetDbName()),
+			zap.Int64("msgID", in.GetBase().GetMsgID()), zap.Uint64("ts", t.GetTs()))
+
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	log.Ctx(ctx).Info("done to alter database", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbName", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()),
+		zap.Uint64("ts", t.GetTs()))
+	return merr.Success(), nil
+}
+
+func (c *Core) DescribeDatabase(ctx context.Context, in *internalpb.DescribeDatabaseRequest) (*internalpb.DescribeDatabaseResponse, error) {
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+
+	method := "DescribeDatabase"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+
+	log := log.Ctx(ctx).With(zap.String("dbName", in.GetDbName()), zap.Int64("msgID", in.GetBase().GetMsgID()))
+	log.Info("received request to describe database")
+
+	t := &describeDBTask{
+		baseTask: newBaseTask(ctx, c),
+		Req:      in,
+		Rsp:      &internalpb.DescribeDatabaseResponse{},
+	}
+
+	if err := c.scheduler.AddTask(t); err != nil {
+		log.Warn("failed to enqueue request to describe database", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+
+	if err := t.WaitToFinish(); err != nil {
+		log.Warn("failed to describe database", zap.Uint64("ts", t.GetTs()), zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return &internalpb.DescribeDatabaseResponse{Status: merr.Status(err)}, nil
+	}
+
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	log.Info("done to describe database", zap.Uint64("ts", t.GetTs()))
+	return t.Rsp, nil
+}
+
 // CreateCollection create collection
 func (c *Core) CreateCollection(ctx context.Context, in *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
@@ -2849,6 +2930,92 @@ func (c *Core) ListPolicy(ctx context.Context, in *internalpb.ListPolicyRequest)
 	}, nil
 }
 
+// AlterRateLimits alters the rate limits of a user or a role, the rate limits are applied by QuotaCenter.
+func (c *Core) AlterRateLimits(ctx context.Context, in *internalpb.AlterRateLimitsRequest) (*commonpb.Status, error) {
+	method := "AlterRateLimits"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("user_name", in.GetUserName()), zap.String("role_name", in.GetRoleName()), zap.Any("rates", in.GetRates()))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkRateLimitsPrincipal(in.GetUserName(), in.GetRoleName()); err != nil {
+		return merr.Status(err), nil
+	}
+	if err := checkPrincipalRates(in.GetRates()); err != nil {
+		return merr.Status(err), nil
+	}
+
+	var err error
+	if in.GetUserName() != "" {
+		err = c.meta.AlterUserRateLimits(in.GetUserName(), in.GetRates())
+	} else {
+		err = c.meta.AlterRoleRateLimits(util.DefaultTenant, in.GetRoleName(), in.GetRates())
+	}
+	if err != nil {
+		ctxLog.Warn("fail to alter rate limits", zap.Error(err))
+		metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+		return merr.Status(err), nil
+	}
+
+	ctxLog.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return merr.Success(), nil
+}
+
+// DescribeRateLimits returns the rate limits of a user or a role.
+func (c *Core) DescribeRateLimits(ctx context.Context, in *internalpb.DescribeRateLimitsRequest) (*internalpb.DescribeRateLimitsResponse, error) {
+	method := "DescribeRateLimits"
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.TotalLabel).Inc()
+	tr := timerecord.NewTimeRecorder(method)
+	ctxLog := log.Ctx(ctx).With(zap.String("role", typeutil.RootCoordRole),
+		zap.String("user_name", in.GetUserName()), zap.String("role_name", in.GetRoleName()))
+	ctxLog.Debug(method)
+
+	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
+		return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+	}
+	if err := checkRateLimitsPrincipal(in.GetUserName(), in.GetRoleName()); err != nil {
+		return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+	}
+
+	var rates []*internalpb.Rate
+	if in.GetUserName() != "" {
+		credInfo, err := c.meta.GetCredential(in.GetUserName())
+		if err != nil {
+			ctxLog.Warn("fail to get the user", zap.Error(err))
+			metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+			return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+		}
+		rates = credInfo.GetRateLimits()
+	} else {
+		if _, err := c.meta.SelectRole(util.DefaultTenant, &milvuspb.RoleEntity{Name: in.GetRoleName()}, false); err != nil {
+			ctxLog.Warn("fail to get the role", zap.Error(err))
+			metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+			return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+		}
+		roleRates, err := c.meta.ListRoleRateLimits(util.DefaultTenant)
+		if err != nil {
+			ctxLog.Warn("fail to list role rate limits", zap.Error(err))
+			metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.FailLabel).Inc()
+			return &internalpb.DescribeRateLimitsResponse{Status: merr.Status(err)}, nil
+		}
+		rates = roleRates[in.GetRoleName()]
+	}
+
+	ctxLog.Debug(method + " success")
+	metrics.RootCoordDDLReqCounter.WithLabelValues(method, metrics.SuccessLabel).Inc()
+	metrics.RootCoordDDLReqLatency.WithLabelValues(method).Observe(float64(tr.ElapseSpan().Milliseconds()))
+	return &internalpb.DescribeRateLimitsResponse{
+		Status: merr.Success(),
+		Rates:  rates,
+	}, nil
+}
+
 func (c *Core) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	if err := merr.CheckHealthy(c.GetStateCode()); err != nil {
 		return merr.Status(err), nil
@@ -149,6 +149,180 @@ func TestRootCoord_DropDatabase(t *testing.T) {
 	})
 }
 
+func TestRootCoord_AlterDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		resp, err := c.AlterDatabase(ctx, &internalpb.AlterDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetErrorCode())
+	})
+}
+
+func TestRootCoord_DescribeDatabase(t *testing.T) {
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		ctx := context.Background()
+		resp, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to add task", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withInvalidScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("failed to execute", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withTaskFailScheduler())
+
+		ctx := context.Background()
+		resp, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("ok", func(t *testing.T) {
+		c := newTestCore(withHealthyCode(),
+			withValidScheduler())
+		ctx := context.Background()
+		_, err := c.DescribeDatabase(ctx, &internalpb.DescribeDatabaseRequest{})
+		assert.NoError(t, err)
+	})
+}
+
+func TestRootCoord_AlterRateLimits(t *testing.T) {
+	rates := []*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: 1024}}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.AlterRateLimits(context.Background(), &internalpb.AlterRateLimitsRequest{UserName: "user"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetErrorCode())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		resp, err := c.AlterRateLimits(context.Background(), &internalpb.AlterRateLimitsRequest{UserName: "user", RoleName: "role"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+
+		resp, err = c.AlterRateLimits(context.Background(), &internalpb.AlterRateLimitsRequest{
+			UserName: "user",
+			Rates:    []*internalpb.Rate{{Rt: internalpb.RateType_DDLCollection, R: 1}},
+		})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+	})
+
+	t.Run("alter user", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		meta.EXPECT().AlterUserRateLimits("user", rates).Return(nil).Once()
+		resp, err := c.AlterRateLimits(context.Background(), &internalpb.AlterRateLimitsRequest{UserName: "user", Rates: rates})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+
+		meta.EXPECT().AlterUserRateLimits("user", rates).Return(errors.New("mock error")).Once()
+		resp, err = c.AlterRateLimits(context.Background(), &internalpb.AlterRateLimitsRequest{UserName: "user", Rates: rates})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp))
+	})
+
+	t.Run("alter role", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		meta.EXPECT().AlterRoleRateLimits(util.DefaultTenant, "role", rates).Return(nil).Once()
+		resp, err := c.AlterRateLimits(context.Background(), &internalpb.AlterRateLimitsRequest{RoleName: "role", Rates: rates})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp))
+	})
+}
+
+func TestRootCoord_DescribeRateLimits(t *testing.T) {
+	rates := []*internalpb.Rate{{Rt: internalpb.RateType_DQLSearch, R: 100}}
+
+	t.Run("not healthy", func(t *testing.T) {
+		c := newTestCore(withAbnormalCode())
+		resp, err := c.DescribeRateLimits(context.Background(), &internalpb.DescribeRateLimitsRequest{UserName: "user"})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_NotReadyServe, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("invalid request", func(t *testing.T) {
+		c := newTestCore(withHealthyCode())
+		resp, err := c.DescribeRateLimits(context.Background(), &internalpb.DescribeRateLimitsRequest{})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("describe user", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		meta.EXPECT().GetCredential("user").Return(&internalpb.CredentialInfo{Username: "user", RateLimits: rates}, nil).Once()
+		resp, err := c.DescribeRateLimits(context.Background(), &internalpb.DescribeRateLimitsRequest{UserName: "user"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, rates, resp.GetRates())
+
+		meta.EXPECT().GetCredential("user").Return(nil, errors.New("mock error")).Once()
+		resp, err = c.DescribeRateLimits(context.Background(), &internalpb.DescribeRateLimitsRequest{UserName: "user"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+
+	t.Run("describe role", func(t *testing.T) {
+		meta := mockrootcoord.NewIMetaTable(t)
+		c := newTestCore(withHealthyCode(), withMeta(meta))
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, nil).Once()
+		meta.EXPECT().ListRoleRateLimits(util.DefaultTenant).Return(map[string][]*internalpb.Rate{"role": rates}, nil).Once()
+		resp, err := c.DescribeRateLimits(context.Background(), &internalpb.DescribeRateLimitsRequest{RoleName: "role"})
+		assert.NoError(t, err)
+		assert.NoError(t, merr.Error(resp.GetStatus()))
+		assert.Equal(t, rates, resp.GetRates())
+
+		meta.EXPECT().SelectRole(util.DefaultTenant, mock.Anything, false).Return(nil, errors.New("mock error")).Once()
+		resp, err = c.DescribeRateLimits(context.Background(), &internalpb.DescribeRateLimitsRequest{RoleName: "role"})
+		assert.NoError(t, err)
+		assert.Error(t, merr.Error(resp.GetStatus()))
+	})
+}
+
 func TestRootCoord_ListDatabases(t *testing.T) {
 	t.Run("not healthy", func(t *testing.T) {
 		c := newTestCore(withAbnormalCode())
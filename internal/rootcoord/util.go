@@ -24,9 +24,11 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/log"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
+	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
 )
 
@@ -138,6 +140,34 @@ func getCollectionRateLimitConfigDefaultValue(configKey string) float64 {
 		return Params.QuotaConfig.DQLMinSearchRatePerCollection.GetAsFloat()
 	case common.CollectionDiskQuotaKey:
 		return Params.QuotaConfig.DiskQuotaPerCollection.GetAsFloat()
+	case common.PartitionInsertRateMaxKey:
+		return Params.QuotaConfig.DMLMaxInsertRatePerPartition.GetAsFloat()
+	case common.PartitionUpsertRateMaxKey:
+		return Params.QuotaConfig.DMLMaxUpsertRatePerPartition.GetAsFloat()
+	case common.PartitionDeleteRateMaxKey:
+		return Params.QuotaConfig.DMLMaxDeleteRatePerPartition.GetAsFloat()
+	case common.PartitionBulkLoadRateMaxKey:
+		return Params.QuotaConfig.DMLMaxBulkLoadRatePerPartition.GetAsFloat()
+	case common.PartitionQueryRateMaxKey:
+		return Params.QuotaConfig.DQLMaxQueryRatePerPartition.GetAsFloat()
+	case common.PartitionSearchRateMaxKey:
+		return Params.QuotaConfig.DQLMaxSearchRatePerPartition.GetAsFloat()
+	case common.PartitionDiskQuotaKey:
+		return Params.QuotaConfig.DiskQuotaPerPartition.GetAsFloat()
+	case common.DatabaseInsertRateMaxKey:
+		return Params.QuotaConfig.DMLMaxInsertRatePerDB.GetAsFloat()
+	case common.DatabaseUpsertRateMaxKey:
+		return Params.QuotaConfig.DMLMaxUpsertRatePerDB.GetAsFloat()
+	case common.DatabaseDeleteRateMaxKey:
+		return Params.QuotaConfig.DMLMaxDeleteRatePerDB.GetAsFloat()
+	case common.DatabaseBulkLoadRateMaxKey:
+		return Params.QuotaConfig.DMLMaxBulkLoadRatePerDB.GetAsFloat()
+	case common.DatabaseQueryRateMaxKey:
+		return Params.QuotaConfig.DQLMaxQueryRatePerDB.GetAsFloat()
+	case common.DatabaseSearchRateMaxKey:
+		return Params.QuotaConfig.DQLMaxSearchRatePerDB.GetAsFloat()
+	case common.DatabaseDiskQuotaKey:
+		return Params.QuotaConfig.DiskQuotaPerDB.GetAsFloat()
 
 	default:
 		return float64(0)
@@ -176,6 +206,18 @@ func getCollectionRateLimitConfig(properties map[string]string, configKey string
 			return rate
 		case common.CollectionDiskQuotaKey:
 			return megaBytes2Bytes(rate)
+		case common.PartitionInsertRateMaxKey, common.PartitionUpsertRateMaxKey,
+			common.PartitionDeleteRateMaxKey, common.PartitionBulkLoadRateMaxKey,
+			common.PartitionDiskQuotaKey:
+			return megaBytes2Bytes(rate)
+		case common.PartitionQueryRateMaxKey, common.PartitionSearchRateMaxKey:
+			return rate
+		case common.DatabaseInsertRateMaxKey, common.DatabaseUpsertRateMaxKey,
+			common.DatabaseDeleteRateMaxKey, common.DatabaseBulkLoadRateMaxKey,
+			common.DatabaseDiskQuotaKey:
+			return megaBytes2Bytes(rate)
+		case common.DatabaseQueryRateMaxKey, common.DatabaseSearchRateMaxKey:
+			return rate
 
 		default:
 			return float64(0)
@@ -186,7 +228,7 @@ func getCollectionRateLimitConfig(properties map[string]string, configKey string
 	if ok {
 		rate, err := strconv.ParseFloat(v, 64)
 		if err != nil {
-			log.Warn("invalid configuration for collection dml rate",
+			log.Warn("invalid configuration for rate limit",
 				zap.String("config item", configKey),
 				zap.String("config value", v))
 			return getCollectionRateLimitConfigDefaultValue(configKey)
@@ -201,3 +243,32 @@ func getCollectionRateLimitConfig(properties map[string]string, configKey string
 
 	return getCollectionRateLimitConfigDefaultValue(configKey)
 }
+
+// checkRateLimitsPrincipal checks that one and only one of the user and the role is specified.
+func checkRateLimitsPrincipal(userName, roleName string) error {
+	if (userName == "") == (roleName == "") {
+		return merr.WrapErrParameterInvalidMsg("one and only one of user name and role name should be specified")
+	}
+	return nil
+}
+
+// checkPrincipalRates checks the rate limits of user or role, only DML and DQL could be limited.
+func checkPrincipalRates(rates []*internalpb.Rate) error {
+	rateTypes := typeutil.NewSet[internalpb.RateType]()
+	for _, rate := range rates {
+		switch rate.GetRt() {
+		case internalpb.RateType_DMLInsert, internalpb.RateType_DMLUpsert, internalpb.RateType_DMLDelete,
+			internalpb.RateType_DMLBulkLoad, internalpb.RateType_DQLSearch, internalpb.RateType_DQLQuery:
+		default:
+			return merr.WrapErrParameterInvalidMsg("rate type %s could not be limited by user or role", rate.GetRt().String())
+		}
+		if rate.GetR() < 0 {
+			return merr.WrapErrParameterInvalidMsg("rate of %s should not be negative, got %f", rate.GetRt().String(), rate.GetR())
+		}
+		if rateTypes.Contain(rate.GetRt()) {
+			return merr.WrapErrParameterInvalidMsg("duplicated rate type %s", rate.GetRt().String())
+		}
+		rateTypes.Insert(rate.GetRt())
+	}
+	return nil
+}
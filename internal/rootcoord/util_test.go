@@ -23,6 +23,7 @@ import (
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
 	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/pkg/common"
 	"github.com/milvus-io/milvus/pkg/mq/msgstream"
 	"github.com/milvus-io/milvus/pkg/util/typeutil"
@@ -153,6 +154,11 @@ func Test_getCollectionRateLimitConfig(t *testing.T) {
 		common.CollectionSearchRateMaxKey:   "5",
 		common.CollectionSearchRateMinKey:   "5",
 		common.CollectionDiskQuotaKey:       "5",
+		common.PartitionInsertRateMaxKey:    "5",
+		common.PartitionSearchRateMaxKey:    "5",
+		common.DatabaseDeleteRateMaxKey:     "5",
+		common.DatabaseQueryRateMaxKey:      "5",
+		common.DatabaseDiskQuotaKey:         "5",
 	}
 
 	tests := []struct {
@@ -256,6 +262,60 @@ func Test_getCollectionRateLimitConfig(t *testing.T) {
 			want: float64(5 * 1024 * 1024),
 		},
 
+		{
+			name: "test PartitionInsertRateMaxKey",
+			args: args{
+				properties: configMap,
+				configKey:  common.PartitionInsertRateMaxKey,
+			},
+			want: float64(5 * 1024 * 1024),
+		},
+
+		{
+			name: "test PartitionSearchRateMaxKey",
+			args: args{
+				properties: configMap,
+				configKey:  common.PartitionSearchRateMaxKey,
+			},
+			want: float64(5),
+		},
+
+		{
+			name: "test DatabaseDeleteRateMaxKey",
+			args: args{
+				properties: configMap,
+				configKey:  common.DatabaseDeleteRateMaxKey,
+			},
+			want: float64(5 * 1024 * 1024),
+		},
+
+		{
+			name: "test DatabaseQueryRateMaxKey",
+			args: args{
+				properties: configMap,
+				configKey:  common.DatabaseQueryRateMaxKey,
+			},
+			want: float64(5),
+		},
+
+		{
+			name: "test DatabaseDiskQuotaKey",
+			args: args{
+				properties: configMap,
+				configKey:  common.DatabaseDiskQuotaKey,
+			},
+			want: float64(5 * 1024 * 1024),
+		},
+
+		{
+			name: "test empty partition config item",
+			args: args{
+				properties: map[string]string{},
+				configKey:  common.PartitionDiskQuotaKey,
+			},
+			want: Params.QuotaConfig.DiskQuotaPerPartition.GetAsFloat(),
+		},
+
 		{
 			name: "test invalid config value",
 			args: args{
@@ -292,3 +352,24 @@ func Test_getCollectionRateLimitConfig(t *testing.T) {
 		})
 	}
 }
+
+func Test_checkRateLimitsPrincipal(t *testing.T) {
+	assert.NoError(t, checkRateLimitsPrincipal("user", ""))
+	assert.NoError(t, checkRateLimitsPrincipal("", "role"))
+	assert.Error(t, checkRateLimitsPrincipal("", ""))
+	assert.Error(t, checkRateLimitsPrincipal("user", "role"))
+}
+
+func Test_checkPrincipalRates(t *testing.T) {
+	assert.NoError(t, checkPrincipalRates(nil))
+	assert.NoError(t, checkPrincipalRates([]*internalpb.Rate{
+		{Rt: internalpb.RateType_DMLInsert, R: 1024},
+		{Rt: internalpb.RateType_DQLSearch, R: 0},
+	}))
+	assert.Error(t, checkPrincipalRates([]*internalpb.Rate{{Rt: internalpb.RateType_DDLFlush, R: 1}}))
+	assert.Error(t, checkPrincipalRates([]*internalpb.Rate{{Rt: internalpb.RateType_DMLInsert, R: -1}}))
+	assert.Error(t, checkPrincipalRates([]*internalpb.Rate{
+		{Rt: internalpb.RateType_DMLInsert, R: 1},
+		{Rt: internalpb.RateType_DMLInsert, R: 2},
+	}))
+}
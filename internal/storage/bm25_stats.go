@@ -0,0 +1,91 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/util/bm25"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// NewBM25IndexFromInsertData builds the term frequency index of the analyzer enabled fields in the insert data.
+func NewBM25IndexFromInsertData(schema *schemapb.CollectionSchema, data *InsertData) (map[int64]*bm25.Index, error) {
+	result := make(map[int64]*bm25.Index)
+	fields := bm25.GetAnalyzerFields(schema)
+	if len(fields) == 0 {
+		return result, nil
+	}
+
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	pks := &schemapb.IDs{}
+	switch pkData := data.Data[pkField.GetFieldID()].(type) {
+	case *Int64FieldData:
+		pks.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pkData.Data}}
+	case *StringFieldData:
+		pks.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: pkData.Data}}
+	default:
+		return nil, merr.WrapErrParameterInvalidMsg("primary key of field %d not found in insert data", pkField.GetFieldID())
+	}
+
+	for _, field := range fields {
+		fieldData, ok := data.Data[field.GetFieldID()].(*StringFieldData)
+		if !ok {
+			continue
+		}
+		analyzer, err := bm25.NewFieldAnalyzer(field)
+		if err != nil {
+			return nil, err
+		}
+		index := bm25.NewIndex(analyzer)
+		index.Append(pks, nil, fieldData.Data)
+		result[field.GetFieldID()] = index
+	}
+	return result, nil
+}
+
+// SerializeBM25Index serializes the term frequency index as a statslog blob of the field.
+func SerializeBM25Index(fieldID int64, index *bm25.Index) (*Blob, error) {
+	value, err := index.Serialize()
+	if err != nil {
+		return nil, err
+	}
+	return &Blob{
+		Key:    fmt.Sprint(fieldID),
+		Value:  value,
+		RowNum: index.NumRows(),
+	}, nil
+}
+
+// DeserializeBM25Index merges the term frequency index blobs of one field in order,
+// the analyzer is used to analyze the texts appended later.
+func DeserializeBM25Index(analyzer bm25.Analyzer, blobs []*Blob) (*bm25.Index, error) {
+	result := bm25.NewIndex(analyzer)
+	for _, blob := range blobs {
+		index, err := bm25.DeserializeIndex(blob.GetValue())
+		if err != nil {
+			return nil, err
+		}
+		result.Merge(index)
+	}
+	return result, nil
+}
@@ -0,0 +1,76 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestBM25Stats(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "text", DataType: schemapb.DataType_VarChar, TypeParams: []*commonpb.KeyValuePair{
+				{Key: common.EnableAnalyzerKey, Value: "true"},
+			}},
+			{FieldID: 102, Name: "str", DataType: schemapb.DataType_VarChar},
+		},
+	}
+	newData := func(texts ...string) *InsertData {
+		return &InsertData{Data: map[FieldID]FieldData{
+			100: &Int64FieldData{Data: make([]int64, len(texts))},
+			101: &StringFieldData{Data: texts},
+			102: &StringFieldData{Data: texts},
+		}}
+	}
+
+	index1, err := NewBM25IndexFromInsertData(schema, newData("Hello world", "hello milvus"))
+	assert.NoError(t, err)
+	assert.Len(t, index1, 1)
+	assert.Equal(t, int64(2), index1[101].Stats().DocFreq["hello"])
+
+	index2, err := NewBM25IndexFromInsertData(schema, newData("milvus"))
+	assert.NoError(t, err)
+
+	blob1, err := SerializeBM25Index(101, index1[101])
+	assert.NoError(t, err)
+	assert.Equal(t, "101", blob1.GetKey())
+	assert.Equal(t, int64(2), blob1.RowNum)
+	blob2, err := SerializeBM25Index(101, index2[101])
+	assert.NoError(t, err)
+
+	merged, err := DeserializeBM25Index(nil, []*Blob{blob1, blob2})
+	assert.NoError(t, err)
+	stats := merged.Stats()
+	assert.Equal(t, int64(3), stats.NumRows)
+	assert.Equal(t, int64(5), stats.NumTokens)
+	assert.Equal(t, int64(2), stats.DocFreq["milvus"])
+
+	_, err = DeserializeBM25Index(nil, []*Blob{{Value: []byte("{")}})
+	assert.Error(t, err)
+
+	// no analyzer enabled field
+	indexes, err := NewBM25IndexFromInsertData(&schemapb.CollectionSchema{Fields: schema.Fields[:1]}, newData("milvus"))
+	assert.NoError(t, err)
+	assert.Empty(t, indexes)
+}
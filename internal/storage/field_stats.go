@@ -0,0 +1,185 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"math"
+	"strings"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+// IsFieldStatsSupported returns whether min/max stats are collected for the data type.
+func IsFieldStatsSupported(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_Bool,
+		schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+		schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_String, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// NewValueField wraps a scalar value of the data type as schemapb.ValueField.
+func NewValueField(dataType schemapb.DataType, value interface{}) *schemapb.ValueField {
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_BoolData{BoolData: value.(bool)}}
+	case schemapb.DataType_Int8:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(value.(int8))}}
+	case schemapb.DataType_Int16:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: int32(value.(int16))}}
+	case schemapb.DataType_Int32:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: value.(int32)}}
+	case schemapb.DataType_Int64:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: value.(int64)}}
+	case schemapb.DataType_Float:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: value.(float32)}}
+	case schemapb.DataType_Double:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_DoubleData{DoubleData: value.(float64)}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: value.(string)}}
+	default:
+		return nil
+	}
+}
+
+// CompareValueField returns -1, 0 or 1 if a is less than, equal to or greater than b,
+// both of them must hold values of the data type.
+func CompareValueField(dataType schemapb.DataType, a, b *schemapb.ValueField) int {
+	compare := func(less, greater bool) int {
+		switch {
+		case less:
+			return -1
+		case greater:
+			return 1
+		default:
+			return 0
+		}
+	}
+
+	switch dataType {
+	case schemapb.DataType_Bool:
+		return compare(!a.GetBoolData() && b.GetBoolData(), a.GetBoolData() && !b.GetBoolData())
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		return compare(a.GetIntData() < b.GetIntData(), a.GetIntData() > b.GetIntData())
+	case schemapb.DataType_Int64:
+		return compare(a.GetLongData() < b.GetLongData(), a.GetLongData() > b.GetLongData())
+	case schemapb.DataType_Float:
+		return compare(a.GetFloatData() < b.GetFloatData(), a.GetFloatData() > b.GetFloatData())
+	case schemapb.DataType_Double:
+		return compare(a.GetDoubleData() < b.GetDoubleData(), a.GetDoubleData() > b.GetDoubleData())
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return strings.Compare(a.GetStringData(), b.GetStringData())
+	default:
+		return 0
+	}
+}
+
+// isNaNValueField returns whether the value is a float or double NaN, which is not ordered with any value.
+func isNaNValueField(dataType schemapb.DataType, value *schemapb.ValueField) bool {
+	switch dataType {
+	case schemapb.DataType_Float:
+		return math.IsNaN(float64(value.GetFloatData()))
+	case schemapb.DataType_Double:
+		return math.IsNaN(value.GetDoubleData())
+	default:
+		return false
+	}
+}
+
+// NewFieldStatsFromInsertData collects the min/max stats of all the scalar user fields
+// of the insert data. Nullable field is not supported yet, so the null count is always 0.
+// Fields holding NaN values have no stats, since the NaN rows could not be described by a value range.
+func NewFieldStatsFromInsertData(schema *schemapb.CollectionSchema, data *InsertData) []*datapb.FieldStats {
+	if data == nil || data.IsEmpty() {
+		return nil
+	}
+
+	result := make([]*datapb.FieldStats, 0)
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID || !IsFieldStatsSupported(field.GetDataType()) {
+			continue
+		}
+		fieldData, ok := data.Data[field.GetFieldID()]
+		if !ok || fieldData.RowNum() == 0 {
+			continue
+		}
+
+		stats := &datapb.FieldStats{
+			FieldID: field.GetFieldID(),
+			Type:    field.GetDataType(),
+		}
+		hasNaN := false
+		for i := 0; i < fieldData.RowNum(); i++ {
+			value := NewValueField(field.GetDataType(), fieldData.GetRow(i))
+			if isNaNValueField(field.GetDataType(), value) {
+				hasNaN = true
+				break
+			}
+			if stats.Min == nil || CompareValueField(field.GetDataType(), value, stats.Min) < 0 {
+				stats.Min = value
+			}
+			if stats.Max == nil || CompareValueField(field.GetDataType(), value, stats.Max) > 0 {
+				stats.Max = value
+			}
+		}
+		if hasNaN {
+			continue
+		}
+		result = append(result, stats)
+	}
+	return result
+}
+
+// MergeFieldStats merges two stats lists of the same segment, the value range of
+// each field is widened to cover both. Fields missing in either list are dropped,
+// since the value range of them are unknown.
+func MergeFieldStats(a, b []*datapb.FieldStats) []*datapb.FieldStats {
+	bStats := make(map[int64]*datapb.FieldStats, len(b))
+	for _, stats := range b {
+		bStats[stats.GetFieldID()] = stats
+	}
+
+	result := make([]*datapb.FieldStats, 0, len(a))
+	for _, stats := range a {
+		other, ok := bStats[stats.GetFieldID()]
+		if !ok || other.GetType() != stats.GetType() {
+			continue
+		}
+
+		merged := &datapb.FieldStats{
+			FieldID:   stats.GetFieldID(),
+			Type:      stats.GetType(),
+			Min:       stats.GetMin(),
+			Max:       stats.GetMax(),
+			NullCount: stats.GetNullCount() + other.GetNullCount(),
+		}
+		if CompareValueField(stats.GetType(), other.GetMin(), merged.Min) < 0 {
+			merged.Min = other.GetMin()
+		}
+		if CompareValueField(stats.GetType(), other.GetMax(), merged.Max) > 0 {
+			merged.Max = other.GetMax()
+		}
+		result = append(result, merged)
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+)
+
+func TestNewFieldStatsFromInsertData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 0, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: 1, Name: "ts", DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "str", DataType: schemapb.DataType_VarChar},
+			{FieldID: 102, Name: "double", DataType: schemapb.DataType_Double},
+			{FieldID: 103, Name: "bool", DataType: schemapb.DataType_Bool},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	data := &InsertData{Data: map[FieldID]FieldData{
+		0:   &Int64FieldData{Data: []int64{1, 2, 3}},
+		1:   &Int64FieldData{Data: []int64{1, 2, 3}},
+		100: &Int64FieldData{Data: []int64{5, -3, 7}},
+		101: &StringFieldData{Data: []string{"b", "c", "a"}},
+		102: &DoubleFieldData{Data: []float64{0.5, 1.5, -1}},
+		103: &BoolFieldData{Data: []bool{true, true, true}},
+		104: &FloatVectorFieldData{Data: []float32{1, 2, 3}, Dim: 1},
+	}}
+
+	stats := NewFieldStatsFromInsertData(schema, data)
+	assert.Len(t, stats, 4)
+	assert.Equal(t, int64(100), stats[0].GetFieldID())
+	assert.Equal(t, int64(-3), stats[0].GetMin().GetLongData())
+	assert.Equal(t, int64(7), stats[0].GetMax().GetLongData())
+	assert.Equal(t, "a", stats[1].GetMin().GetStringData())
+	assert.Equal(t, "c", stats[1].GetMax().GetStringData())
+	assert.Equal(t, float64(-1), stats[2].GetMin().GetDoubleData())
+	assert.Equal(t, 1.5, stats[2].GetMax().GetDoubleData())
+	assert.True(t, stats[3].GetMin().GetBoolData())
+	assert.True(t, stats[3].GetMax().GetBoolData())
+
+	assert.Nil(t, NewFieldStatsFromInsertData(schema, nil))
+
+	// fields holding NaN have no stats
+	data.Data[102] = &DoubleFieldData{Data: []float64{0.5, math.NaN(), -1}}
+	stats = NewFieldStatsFromInsertData(schema, data)
+	assert.Len(t, stats, 3)
+	for _, s := range stats {
+		assert.NotEqual(t, int64(102), s.GetFieldID())
+	}
+}
+
+func TestMergeFieldStats(t *testing.T) {
+	a := []*datapb.FieldStats{
+		{
+			FieldID: 100,
+			Type:    schemapb.DataType_Int64,
+			Min:     NewValueField(schemapb.DataType_Int64, int64(1)),
+			Max:     NewValueField(schemapb.DataType_Int64, int64(10)),
+		},
+		{
+			FieldID: 101,
+			Type:    schemapb.DataType_Int32,
+			Min:     NewValueField(schemapb.DataType_Int32, int32(1)),
+			Max:     NewValueField(schemapb.DataType_Int32, int32(10)),
+		},
+	}
+	b := []*datapb.FieldStats{
+		{
+			FieldID:   100,
+			Type:      schemapb.DataType_Int64,
+			Min:       NewValueField(schemapb.DataType_Int64, int64(-5)),
+			Max:       NewValueField(schemapb.DataType_Int64, int64(5)),
+			NullCount: 2,
+		},
+	}
+
+	merged := MergeFieldStats(a, b)
+	assert.Len(t, merged, 1)
+	assert.Equal(t, int64(100), merged[0].GetFieldID())
+	assert.Equal(t, int64(-5), merged[0].GetMin().GetLongData())
+	assert.Equal(t, int64(10), merged[0].GetMax().GetLongData())
+	assert.Equal(t, int64(2), merged[0].GetNullCount())
+}
+
+func TestCompareValueField(t *testing.T) {
+	assert.Equal(t, -1, CompareValueField(schemapb.DataType_Bool, NewValueField(schemapb.DataType_Bool, false), NewValueField(schemapb.DataType_Bool, true)))
+	assert.Equal(t, 1, CompareValueField(schemapb.DataType_Int8, NewValueField(schemapb.DataType_Int8, int8(2)), NewValueField(schemapb.DataType_Int8, int8(1))))
+	assert.Equal(t, 0, CompareValueField(schemapb.DataType_Float, NewValueField(schemapb.DataType_Float, float32(1)), NewValueField(schemapb.DataType_Float, float32(1))))
+	assert.Equal(t, -1, CompareValueField(schemapb.DataType_VarChar, NewValueField(schemapb.DataType_VarChar, "a"), NewValueField(schemapb.DataType_VarChar, "b")))
+	assert.Nil(t, NewValueField(schemapb.DataType_JSON, []byte("{}")))
+}
@@ -37,7 +37,7 @@ import (
 // If Limit function return true, the request will be rejected.
 // Otherwise, the request will pass. Limit also returns limit of limiter.
 type Limiter interface {
-	Check(collectionIDs []int64, rt internalpb.RateType, n int) error
+	Check(ctx context.Context, dbName string, collectionIDToPartIDs map[int64][]int64, rt internalpb.RateType, n int) error
 }
 
 // Component is the interface all services implement
@@ -219,6 +219,7 @@ type ProxyClient interface {
 type Proxy interface {
 	Component
 	proxypb.ProxyServer
+	proxypb.ProxyStreamServer
 	milvuspb.MilvusServiceServer
 }
 
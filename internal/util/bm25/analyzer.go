@@ -0,0 +1,141 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bm25
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+const (
+	// StandardTokenizer splits text on any character which is neither a letter nor a digit.
+	StandardTokenizer = "standard"
+	// WhitespaceTokenizer splits text on white spaces only.
+	WhitespaceTokenizer = "whitespace"
+)
+
+// Analyzer turns a text into the terms used for full-text search.
+type Analyzer interface {
+	Analyze(text string) []string
+}
+
+// AnalyzerParams is the json form of the `analyzer_params` type param of a VarChar field.
+type AnalyzerParams struct {
+	Tokenizer string   `json:"tokenizer"`
+	Lowercase *bool    `json:"lowercase"`
+	StopWords []string `json:"stop_words"`
+}
+
+type analyzer struct {
+	split     func(text string) []string
+	lowercase bool
+	stopWords map[string]struct{}
+}
+
+func (a *analyzer) Analyze(text string) []string {
+	tokens := a.split(text)
+	terms := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if a.lowercase {
+			token = strings.ToLower(token)
+		}
+		if _, ok := a.stopWords[token]; ok {
+			continue
+		}
+		terms = append(terms, token)
+	}
+	return terms
+}
+
+func splitStandard(text string) []string {
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// NewAnalyzer creates an analyzer by the json encoded analyzer params,
+// the standard tokenizer with lowercase filter is used if params is empty.
+func NewAnalyzer(params string) (Analyzer, error) {
+	p := &AnalyzerParams{}
+	if len(params) > 0 {
+		if err := json.Unmarshal([]byte(params), p); err != nil {
+			return nil, errors.Wrap(err, "invalid analyzer params")
+		}
+	}
+
+	a := &analyzer{
+		lowercase: p.Lowercase == nil || *p.Lowercase,
+		stopWords: make(map[string]struct{}, len(p.StopWords)),
+	}
+	switch p.Tokenizer {
+	case "", StandardTokenizer:
+		a.split = splitStandard
+	case WhitespaceTokenizer:
+		a.split = strings.Fields
+	default:
+		return nil, errors.Newf("unsupported tokenizer %s", p.Tokenizer)
+	}
+	for _, word := range p.StopWords {
+		if a.lowercase {
+			word = strings.ToLower(word)
+		}
+		a.stopWords[word] = struct{}{}
+	}
+	return a, nil
+}
+
+// IsAnalyzerEnabled returns whether the inserted text of the field is analyzed for full-text search.
+func IsAnalyzerEnabled(field *schemapb.FieldSchema) bool {
+	if field.GetDataType() != schemapb.DataType_VarChar {
+		return false
+	}
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.EnableAnalyzerKey {
+			enabled, err := strconv.ParseBool(kv.GetValue())
+			return err == nil && enabled
+		}
+	}
+	return false
+}
+
+// NewFieldAnalyzer creates the analyzer of a field by its type params.
+func NewFieldAnalyzer(field *schemapb.FieldSchema) (Analyzer, error) {
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == common.AnalyzerParamsKey {
+			return NewAnalyzer(kv.GetValue())
+		}
+	}
+	return NewAnalyzer("")
+}
+
+// GetAnalyzerFields returns the fields with analyzer enabled in the schema.
+func GetAnalyzerFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0)
+	for _, field := range schema.GetFields() {
+		if IsAnalyzerEnabled(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
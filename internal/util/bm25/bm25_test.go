@@ -0,0 +1,198 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bm25
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/common"
+)
+
+func TestAnalyzer(t *testing.T) {
+	a, err := NewAnalyzer("")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"hello", "milvus", "2", "4", "world"}, a.Analyze("Hello, Milvus-2.4 world!"))
+
+	a, err = NewAnalyzer(`{"tokenizer": "whitespace", "lowercase": false, "stop_words": ["the"]}`)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"The", "Quick,", "fox"}, a.Analyze("The Quick, the fox"))
+
+	_, err = NewAnalyzer(`{"tokenizer": "jieba"}`)
+	assert.Error(t, err)
+	_, err = NewAnalyzer(`{`)
+	assert.Error(t, err)
+}
+
+func TestIsAnalyzerEnabled(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:  101,
+		DataType: schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{
+			{Key: common.MaxLengthKey, Value: "256"},
+			{Key: common.EnableAnalyzerKey, Value: "true"},
+			{Key: common.AnalyzerParamsKey, Value: `{"stop_words": ["a"]}`},
+		},
+	}
+	assert.True(t, IsAnalyzerEnabled(field))
+	a, err := NewFieldAnalyzer(field)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"cat"}, a.Analyze("A cat"))
+
+	schema := &schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{
+		field,
+		{FieldID: 102, DataType: schemapb.DataType_VarChar},
+		{FieldID: 103, DataType: schemapb.DataType_Int64, TypeParams: []*commonpb.KeyValuePair{{Key: common.EnableAnalyzerKey, Value: "true"}}},
+	}}
+	fields := GetAnalyzerFields(schema)
+	assert.Len(t, fields, 1)
+	assert.Equal(t, int64(101), fields[0].GetFieldID())
+}
+
+func newTestIndex(texts ...string) *Index {
+	a, _ := NewAnalyzer("")
+	idx := NewIndex(a)
+	pks := make([]int64, len(texts))
+	for i := range texts {
+		pks[i] = int64(i + 1)
+	}
+	idx.Append(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}}, nil, texts)
+	return idx
+}
+
+func TestStats(t *testing.T) {
+	s1 := newTestIndex("milvus is a vector database", "milvus milvus").Stats()
+	assert.Equal(t, int64(2), s1.NumRows)
+	assert.Equal(t, int64(7), s1.NumTokens)
+	assert.Equal(t, int64(2), s1.DocFreq["milvus"])
+
+	s2 := newTestIndex("a database").Stats()
+	merged := s1.Clone()
+	merged.Merge(s2)
+	assert.Equal(t, int64(3), merged.NumRows)
+	assert.Equal(t, int64(2), merged.DocFreq["database"])
+	assert.InDelta(t, 3.0, merged.AvgDocLen(), 1e-6)
+
+	subset := merged.Subset([]string{"database", "unknown"})
+	assert.Equal(t, map[string]int64{"database": 2}, subset.DocFreq)
+	assert.Equal(t, merged.NumRows, subset.NumRows)
+
+	merged.Remove(s2)
+	assert.Equal(t, s1, merged)
+	assert.Equal(t, s1, NewStatsFromProto(s1.ToProto()))
+	assert.Equal(t, float64(0), NewStats().AvgDocLen())
+}
+
+func TestIndexSearch(t *testing.T) {
+	docs := []string{"milvus is a vector database", "a database", "vector vector search"}
+	idx := newTestIndex(docs...)
+	s := idx.Stats()
+
+	// rare term has higher weight
+	weights := s.QueryWeights(idx.analyzer.Analyze("milvus database"))
+	assert.Greater(t, weights["milvus"], weights["database"])
+	assert.InDelta(t, math.Log(1+(3-1+0.5)/(1+0.5)), weights["milvus"], 1e-6)
+
+	hits := idx.Search(weights, s.AvgDocLen(), 10, math.MaxUint64, nil)
+	assert.Len(t, hits, 2)
+	assert.Equal(t, int64(1), hits[0].PK)
+	assert.Equal(t, int64(2), hits[1].PK)
+	assert.Greater(t, hits[0].Score, hits[1].Score)
+
+	// topk and filter
+	hits = idx.Search(weights, s.AvgDocLen(), 1, math.MaxUint64, nil)
+	assert.Len(t, hits, 1)
+	hits = idx.Search(weights, s.AvgDocLen(), 10, math.MaxUint64, func(pk any) bool { return pk.(int64) != 1 })
+	assert.Len(t, hits, 1)
+	assert.Equal(t, int64(2), hits[0].PK)
+
+	// term frequency saturates
+	weights = s.QueryWeights([]string{"vector"})
+	one := termScore(weights["vector"], 1, 1, 0, DefaultK1, DefaultB)
+	two := termScore(weights["vector"], 2, 2, 0, DefaultK1, DefaultB)
+	assert.Greater(t, two, one)
+	assert.Less(t, two, 2*one)
+
+	assert.Empty(t, idx.Search(nil, 1, 10, math.MaxUint64, nil))
+}
+
+func TestIndexDelete(t *testing.T) {
+	a, _ := NewAnalyzer("")
+	idx := NewIndex(a)
+	idx.Append(&schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b"}}}},
+		[]uint64{10, 20}, []string{"milvus database", "milvus"})
+	weights := map[string]float32{"milvus": 1}
+
+	// not visible before inserted
+	assert.Len(t, idx.Search(weights, 1, 10, 15, nil), 1)
+
+	// the row inserted after delete is not deleted
+	idx.Delete(&schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b"}}}}, []uint64{30, 15})
+	assert.Equal(t, int64(1), idx.NumRows())
+	stats := idx.Stats()
+	assert.Equal(t, int64(1), stats.NumTokens)
+	assert.Equal(t, map[string]int64{"milvus": 1}, stats.DocFreq)
+	assert.Equal(t, map[string]int64{"milvus": 1}, idx.TermStats([]string{"milvus", "database"}).DocFreq)
+
+	// deleted rows are visible before deleted
+	assert.Len(t, idx.Search(weights, 1, 10, 25, nil), 2)
+	hits := idx.Search(weights, 1, 10, 30, nil)
+	assert.Len(t, hits, 1)
+	assert.Equal(t, "b", hits[0].PK)
+
+	// deleted rows are not serialized
+	_, err := idx.Serialize()
+	assert.Error(t, err)
+	merged := NewIndex(a)
+	merged.Merge(idx)
+	assert.Equal(t, stats, merged.Stats())
+}
+
+func TestIndexSerialize(t *testing.T) {
+	idx := newTestIndex("milvus is a vector database", "milvus milvus")
+	data, err := idx.Serialize()
+	assert.NoError(t, err)
+
+	loaded, err := DeserializeIndex(data)
+	assert.NoError(t, err)
+	assert.Equal(t, idx.Stats(), loaded.Stats())
+
+	// merged into an index with analyzer to append texts
+	merged := NewIndex(idx.analyzer)
+	merged.Merge(loaded)
+	merged.Append(&schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{3}}}}, nil, []string{"milvus"})
+	assert.Equal(t, int64(3), merged.NumRows())
+
+	weights := map[string]float32{"milvus": 1}
+	hits := merged.Search(weights, 0, 10, math.MaxUint64, nil)
+	assert.Len(t, hits, 3)
+	// tf of row 2 is kept after merge
+	assert.Equal(t, int64(2), hits[0].PK)
+
+	_, err = DeserializeIndex([]byte("{"))
+	assert.Error(t, err)
+	_, err = DeserializeIndex([]byte(`{"terms": ["a"], "postings": [], "docLens": []}`))
+	assert.Error(t, err)
+	_, err = DeserializeIndex([]byte(`{"terms": ["a"], "postings": [[{"o": 1, "f": 1}]], "docLens": [1], "intPKs": [1]}`))
+	assert.Error(t, err)
+	_, err = DeserializeIndex([]byte(`{"terms": [], "postings": [], "docLens": [1]}`))
+	assert.Error(t, err)
+}
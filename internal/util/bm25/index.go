@@ -0,0 +1,326 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bm25
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+// Posting is the frequency of a term in the row at offset.
+type Posting struct {
+	Offset int32 `json:"o"`
+	TF     int32 `json:"f"`
+}
+
+// Hit is a row scored by full-text search.
+type Hit struct {
+	PK    any
+	Score float32
+}
+
+// Index is the inverted index of the term frequencies of the analyzed texts of a segment.
+// It is built when the texts are inserted, persisted as the statslog of the field on sync
+// and compaction, and loaded along with the segment. The rows are identified by primary keys,
+// the deleted rows are skipped on search and excluded from the stats.
+type Index struct {
+	mut      sync.RWMutex
+	analyzer Analyzer
+	// stats of the rows not deleted
+	stats *Stats
+
+	termIDs  map[string]int32
+	terms    []string
+	postings [][]Posting // termID => postings
+
+	rowTerms  [][]int32 // offset => distinct termIDs of the row
+	docLens   []int32
+	pks       *schemapb.IDs
+	tss       []uint64 // insert timestamps, 0 if unknown
+	pkOffsets map[any][]int32
+	deleted   map[int32]uint64 // offset => delete timestamp
+}
+
+// NewIndex creates an empty index, the analyzer is used to analyze the appended texts.
+func NewIndex(analyzer Analyzer) *Index {
+	return &Index{
+		analyzer:  analyzer,
+		stats:     NewStats(),
+		termIDs:   make(map[string]int32),
+		pks:       &schemapb.IDs{},
+		pkOffsets: make(map[any][]int32),
+		deleted:   make(map[int32]uint64),
+	}
+}
+
+func (idx *Index) termID(term string) int32 {
+	id, ok := idx.termIDs[term]
+	if !ok {
+		id = int32(len(idx.terms))
+		idx.termIDs[term] = id
+		idx.terms = append(idx.terms, term)
+		idx.postings = append(idx.postings, nil)
+	}
+	return id
+}
+
+// appendRow adds a row by the frequencies of its terms, the caller must hold the write lock.
+func (idx *Index) appendRow(pk any, ts uint64, docLen int32, tfs map[string]int32) {
+	offset := int32(len(idx.docLens))
+	rowTerms := make([]int32, 0, len(tfs))
+	for term, tf := range tfs {
+		id := idx.termID(term)
+		idx.postings[id] = append(idx.postings[id], Posting{Offset: offset, TF: tf})
+		rowTerms = append(rowTerms, id)
+		idx.stats.DocFreq[term]++
+	}
+	idx.stats.NumRows++
+	idx.stats.NumTokens += int64(docLen)
+
+	idx.rowTerms = append(idx.rowTerms, rowTerms)
+	idx.docLens = append(idx.docLens, docLen)
+	typeutil.AppendPKs(idx.pks, pk)
+	idx.tss = append(idx.tss, ts)
+	idx.pkOffsets[pk] = append(idx.pkOffsets[pk], offset)
+}
+
+// Append analyzes the texts and appends them as rows, timestamps could be nil if unknown.
+func (idx *Index) Append(pks *schemapb.IDs, timestamps []uint64, texts []string) {
+	idx.mut.Lock()
+	defer idx.mut.Unlock()
+
+	for i, text := range texts {
+		terms := idx.analyzer.Analyze(text)
+		tfs := make(map[string]int32, len(terms))
+		for _, term := range terms {
+			tfs[term]++
+		}
+		var ts uint64
+		if i < len(timestamps) {
+			ts = timestamps[i]
+		}
+		idx.appendRow(typeutil.GetPK(pks, int64(i)), ts, int32(len(terms)), tfs)
+	}
+}
+
+// Merge appends the rows of the other index which are not deleted.
+func (idx *Index) Merge(other *Index) {
+	other.mut.RLock()
+	defer other.mut.RUnlock()
+	idx.mut.Lock()
+	defer idx.mut.Unlock()
+
+	rowTFs := make([]map[string]int32, len(other.docLens))
+	for id, postings := range other.postings {
+		for _, posting := range postings {
+			if rowTFs[posting.Offset] == nil {
+				rowTFs[posting.Offset] = make(map[string]int32, len(other.rowTerms[posting.Offset]))
+			}
+			rowTFs[posting.Offset][other.terms[id]] = posting.TF
+		}
+	}
+	for offset, tfs := range rowTFs {
+		if _, ok := other.deleted[int32(offset)]; ok {
+			continue
+		}
+		idx.appendRow(typeutil.GetPK(other.pks, int64(offset)), other.tss[offset], other.docLens[offset], tfs)
+	}
+}
+
+// Delete marks the rows of the primary keys inserted before the delete timestamps as deleted.
+func (idx *Index) Delete(pks *schemapb.IDs, timestamps []uint64) {
+	idx.mut.Lock()
+	defer idx.mut.Unlock()
+
+	n := typeutil.GetSizeOfIDs(pks)
+	for i := 0; i < n; i++ {
+		ts := timestamps[i]
+		for _, offset := range idx.pkOffsets[typeutil.GetPK(pks, int64(i))] {
+			if _, ok := idx.deleted[offset]; ok || idx.tss[offset] > ts {
+				continue
+			}
+			idx.deleted[offset] = ts
+			idx.stats.NumRows--
+			idx.stats.NumTokens -= int64(idx.docLens[offset])
+			for _, id := range idx.rowTerms[offset] {
+				term := idx.terms[id]
+				if idx.stats.DocFreq[term] <= 1 {
+					delete(idx.stats.DocFreq, term)
+					continue
+				}
+				idx.stats.DocFreq[term]--
+			}
+		}
+	}
+}
+
+// NumRows returns the number of rows not deleted.
+func (idx *Index) NumRows() int64 {
+	idx.mut.RLock()
+	defer idx.mut.RUnlock()
+	return idx.stats.NumRows
+}
+
+// Stats returns the stats of the rows not deleted.
+func (idx *Index) Stats() *Stats {
+	idx.mut.RLock()
+	defer idx.mut.RUnlock()
+	return idx.stats.Clone()
+}
+
+// TermStats returns the stats of the rows not deleted, only the document frequencies of the terms are kept.
+func (idx *Index) TermStats(terms []string) *Stats {
+	idx.mut.RLock()
+	defer idx.mut.RUnlock()
+	return idx.stats.Subset(terms)
+}
+
+// Search scores the rows containing any of the query terms and returns the topk hits in descending order.
+// Only the rows visible at mvccTs and accepted by filter are scored, filter could be nil.
+func (idx *Index) Search(weights map[string]float32, avgDocLen float64, topk int64, mvccTs uint64, filter func(pk any) bool) []Hit {
+	idx.mut.RLock()
+	defer idx.mut.RUnlock()
+
+	scores := make(map[int32]float64)
+	for term, weight := range weights {
+		id, ok := idx.termIDs[term]
+		if !ok {
+			continue
+		}
+		for _, posting := range idx.postings[id] {
+			scores[posting.Offset] += termScore(weight, posting.TF, idx.docLens[posting.Offset], avgDocLen, DefaultK1, DefaultB)
+		}
+	}
+
+	hits := make([]Hit, 0, len(scores))
+	offsets := make([]int32, 0, len(scores))
+	for offset, score := range scores {
+		if score <= 0 || idx.tss[offset] > mvccTs {
+			continue
+		}
+		if ts, ok := idx.deleted[offset]; ok && ts <= mvccTs {
+			continue
+		}
+		pk := typeutil.GetPK(idx.pks, int64(offset))
+		if filter != nil && !filter(pk) {
+			continue
+		}
+		hits = append(hits, Hit{PK: pk, Score: float32(score)})
+		offsets = append(offsets, offset)
+	}
+	sort.Sort(&hitSorter{hits: hits, offsets: offsets})
+	if int64(len(hits)) > topk {
+		hits = hits[:topk]
+	}
+	return hits
+}
+
+// hitSorter sorts the hits by score in descending order, the ties are broken by offsets.
+type hitSorter struct {
+	hits    []Hit
+	offsets []int32
+}
+
+func (s *hitSorter) Len() int { return len(s.hits) }
+
+func (s *hitSorter) Less(i, j int) bool {
+	if s.hits[i].Score != s.hits[j].Score {
+		return s.hits[i].Score > s.hits[j].Score
+	}
+	return s.offsets[i] < s.offsets[j]
+}
+
+func (s *hitSorter) Swap(i, j int) {
+	s.hits[i], s.hits[j] = s.hits[j], s.hits[i]
+	s.offsets[i], s.offsets[j] = s.offsets[j], s.offsets[i]
+}
+
+// indexData is the json form of the persisted index, deleted rows and timestamps are not persisted.
+type indexData struct {
+	Terms    []string    `json:"terms"`
+	Postings [][]Posting `json:"postings"`
+	DocLens  []int32     `json:"docLens"`
+	IntPKs   []int64     `json:"intPKs,omitempty"`
+	StrPKs   []string    `json:"strPKs,omitempty"`
+}
+
+// Serialize encodes the index to be persisted, only the index without deleted rows could be serialized.
+func (idx *Index) Serialize() ([]byte, error) {
+	idx.mut.RLock()
+	defer idx.mut.RUnlock()
+	if len(idx.deleted) > 0 {
+		return nil, errors.New("serialize bm25 index with deleted rows")
+	}
+	return json.Marshal(&indexData{
+		Terms:    idx.terms,
+		Postings: idx.postings,
+		DocLens:  idx.docLens,
+		IntPKs:   idx.pks.GetIntId().GetData(),
+		StrPKs:   idx.pks.GetStrId().GetData(),
+	})
+}
+
+// DeserializeIndex decodes a persisted index, it must be merged into an index with analyzer before appending texts.
+func DeserializeIndex(data []byte) (*Index, error) {
+	d := &indexData{}
+	if err := json.Unmarshal(data, d); err != nil {
+		return nil, err
+	}
+	if len(d.Terms) != len(d.Postings) {
+		return nil, errors.Newf("bm25 index corrupted, %d terms with %d postings", len(d.Terms), len(d.Postings))
+	}
+
+	idx := NewIndex(nil)
+	switch {
+	case len(d.IntPKs) > 0:
+		idx.pks.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: d.IntPKs}}
+	case len(d.StrPKs) > 0:
+		idx.pks.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: d.StrPKs}}
+	}
+	if typeutil.GetSizeOfIDs(idx.pks) != len(d.DocLens) {
+		return nil, errors.Newf("bm25 index corrupted, %d primary keys of %d rows", typeutil.GetSizeOfIDs(idx.pks), len(d.DocLens))
+	}
+
+	idx.terms = d.Terms
+	idx.postings = d.Postings
+	idx.docLens = d.DocLens
+	idx.tss = make([]uint64, len(d.DocLens))
+	idx.rowTerms = make([][]int32, len(d.DocLens))
+	for id, term := range d.Terms {
+		idx.termIDs[term] = int32(id)
+		idx.stats.DocFreq[term] = int64(len(d.Postings[id]))
+		for _, posting := range d.Postings[id] {
+			if posting.Offset < 0 || int(posting.Offset) >= len(d.DocLens) {
+				return nil, errors.Newf("bm25 index corrupted, posting offset %d out of %d rows", posting.Offset, len(d.DocLens))
+			}
+			idx.rowTerms[posting.Offset] = append(idx.rowTerms[posting.Offset], int32(id))
+		}
+	}
+	for offset, docLen := range d.DocLens {
+		pk := typeutil.GetPK(idx.pks, int64(offset))
+		idx.pkOffsets[pk] = append(idx.pkOffsets[pk], int32(offset))
+		idx.stats.NumTokens += int64(docLen)
+	}
+	idx.stats.NumRows = int64(len(d.DocLens))
+	return idx, nil
+}
@@ -0,0 +1,36 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bm25
+
+const (
+	// DefaultK1 controls the term frequency saturation.
+	DefaultK1 = 1.2
+	// DefaultB controls how much the document length normalizes the term frequency.
+	DefaultB = 0.75
+)
+
+// termScore computes the BM25 score of a query term in a document by the idf weight of the term
+// and its frequency in the document. The document length is normalized by avgDocLen,
+// no normalization if avgDocLen is not positive.
+func termScore(weight float32, tf int32, docLen int32, avgDocLen float64, k1 float64, b float64) float64 {
+	norm := 1.0
+	if avgDocLen > 0 {
+		norm = 1 - b + b*float64(docLen)/avgDocLen
+	}
+	f := float64(tf)
+	return float64(weight) * f * (k1 + 1) / (f + k1*norm)
+}
@@ -0,0 +1,129 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bm25
+
+import (
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+)
+
+// Stats is the term statistics of the analyzed texts of a field. It is collected
+// per segment, summed up by the shard delegators and then by proxy across shards.
+type Stats struct {
+	NumRows   int64            `json:"numRows"`
+	NumTokens int64            `json:"numTokens"`
+	DocFreq   map[string]int64 `json:"docFreq"`
+}
+
+func NewStats() *Stats {
+	return &Stats{
+		DocFreq: make(map[string]int64),
+	}
+}
+
+// Merge adds up the other stats.
+func (s *Stats) Merge(other *Stats) {
+	s.NumRows += other.NumRows
+	s.NumTokens += other.NumTokens
+	for term, df := range other.DocFreq {
+		s.DocFreq[term] += df
+	}
+}
+
+// Remove subtracts the other stats which has been merged before.
+func (s *Stats) Remove(other *Stats) {
+	s.NumRows -= other.NumRows
+	s.NumTokens -= other.NumTokens
+	for term, df := range other.DocFreq {
+		if s.DocFreq[term] <= df {
+			delete(s.DocFreq, term)
+			continue
+		}
+		s.DocFreq[term] -= df
+	}
+}
+
+func (s *Stats) Clone() *Stats {
+	cloned := &Stats{
+		NumRows:   s.NumRows,
+		NumTokens: s.NumTokens,
+		DocFreq:   make(map[string]int64, len(s.DocFreq)),
+	}
+	for term, df := range s.DocFreq {
+		cloned.DocFreq[term] = df
+	}
+	return cloned
+}
+
+// AvgDocLen returns the average number of terms per document.
+func (s *Stats) AvgDocLen() float64 {
+	if s.NumRows <= 0 {
+		return 0
+	}
+	return float64(s.NumTokens) / float64(s.NumRows)
+}
+
+// IDF returns the inverse document frequency of the term.
+func (s *Stats) IDF(term string) float64 {
+	n := float64(s.NumRows)
+	df := float64(s.DocFreq[term])
+	return math.Log(1 + (n-df+0.5)/(df+0.5))
+}
+
+// QueryWeights returns the idf weights of the query terms,
+// a term appears several times in the query is weighted by its count.
+func (s *Stats) QueryWeights(terms []string) map[string]float32 {
+	weights := make(map[string]float32, len(terms))
+	for _, term := range terms {
+		weights[term] += float32(s.IDF(term))
+	}
+	return weights
+}
+
+// Subset returns the stats with the document frequencies of the terms only.
+func (s *Stats) Subset(terms []string) *Stats {
+	subset := &Stats{
+		NumRows:   s.NumRows,
+		NumTokens: s.NumTokens,
+		DocFreq:   make(map[string]int64, len(terms)),
+	}
+	for _, term := range terms {
+		if df, ok := s.DocFreq[term]; ok {
+			subset.DocFreq[term] = df
+		}
+	}
+	return subset
+}
+
+func (s *Stats) ToProto() *internalpb.BM25Stats {
+	return &internalpb.BM25Stats{
+		NumRows:   s.NumRows,
+		NumTokens: s.NumTokens,
+		DocFreq:   s.DocFreq,
+	}
+}
+
+func NewStatsFromProto(stats *internalpb.BM25Stats) *Stats {
+	result := NewStats()
+	result.NumRows = stats.GetNumRows()
+	result.NumTokens = stats.GetNumTokens()
+	for term, df := range stats.GetDocFreq() {
+		result.DocFreq[term] = df
+	}
+	return result
+}
@@ -0,0 +1,138 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type Row = map[storage.FieldID]any
+
+// Options controls how the CSV content is parsed.
+type Options struct {
+	// Delimiter is the field delimiter, ',' for CSV and '\t' for TSV.
+	Delimiter rune
+	// LazyQuotes allows a quote to appear in an unquoted field and
+	// a non-doubled quote to appear in a quoted field.
+	LazyQuotes bool
+	// NullValue is the cell content regarded as null, null is not recognized if it's empty.
+	NullValue string
+	// HeaderMapping maps the column name in the header to the field name,
+	// columns not in the mapping are matched by their own names.
+	HeaderMapping map[string]string
+}
+
+func DefaultOptions() Options {
+	return Options{
+		Delimiter: ',',
+	}
+}
+
+type Reader struct {
+	r      *csv.Reader
+	schema *schemapb.CollectionSchema
+
+	bufferSize int
+	count      int64
+
+	parser RowParser
+}
+
+func NewReader(r io.Reader, schema *schemapb.CollectionSchema, bufferSize int, options Options) (*Reader, error) {
+	count, err := estimateReadCountPerBatch(bufferSize, schema)
+	if err != nil {
+		return nil, err
+	}
+	csvReader := csv.NewReader(r)
+	csvReader.Comma = options.Delimiter
+	csvReader.LazyQuotes = options.LazyQuotes
+	header, err := csvReader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil, merr.WrapErrImportFailed("the CSV file is empty, header is required")
+		}
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read the CSV header, error: %v", err))
+	}
+	parser, err := NewRowParser(schema, header, options)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		r:          csvReader,
+		schema:     schema,
+		bufferSize: bufferSize,
+		count:      count,
+		parser:     parser,
+	}, nil
+}
+
+func (r *Reader) Read() (*storage.InsertData, error) {
+	insertData, err := storage.NewInsertData(r.schema)
+	if err != nil {
+		return nil, err
+	}
+	var cnt int64 = 0
+	for {
+		record, err := r.r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to read the CSV record, error: %v", err))
+		}
+		row, err := r.parser.Parse(record)
+		if err != nil {
+			return nil, err
+		}
+		err = insertData.Append(row)
+		if err != nil {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to append row, err=%s", err.Error()))
+		}
+		cnt++
+		if cnt%r.count == 0 && insertData.GetMemorySize() >= r.bufferSize {
+			break
+		}
+	}
+	if cnt == 0 {
+		return nil, io.EOF
+	}
+	return insertData, nil
+}
+
+func (r *Reader) Close() {}
+
+func estimateReadCountPerBatch(bufferSize int, schema *schemapb.CollectionSchema) (int64, error) {
+	sizePerRecord, err := typeutil.EstimateMaxSizePerRecord(schema)
+	if err != nil {
+		return 0, err
+	}
+	if 1000*sizePerRecord <= bufferSize {
+		return 1000, nil
+	}
+	count := int64(bufferSize) / int64(sizePerRecord)
+	if count <= 0 {
+		count = 1
+	}
+	return count, nil
+}
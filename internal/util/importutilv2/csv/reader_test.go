@@ -0,0 +1,449 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"bytes"
+	rand2 "crypto/rand"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/exp/slices"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/pkg/common"
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type ReaderSuite struct {
+	suite.Suite
+
+	numRows     int
+	pkDataType  schemapb.DataType
+	vecDataType schemapb.DataType
+	delimiter   rune
+}
+
+func (suite *ReaderSuite) SetupSuite() {
+	paramtable.Get().Init(paramtable.NewBaseTable())
+}
+
+func (suite *ReaderSuite) SetupTest() {
+	// default suite params
+	suite.numRows = 100
+	suite.pkDataType = schemapb.DataType_Int64
+	suite.vecDataType = schemapb.DataType_FloatVector
+	suite.delimiter = ','
+}
+
+func createInsertData(t *testing.T, schema *schemapb.CollectionSchema, rowCount int) *storage.InsertData {
+	insertData, err := storage.NewInsertData(schema)
+	assert.NoError(t, err)
+	for _, field := range schema.GetFields() {
+		switch field.GetDataType() {
+		case schemapb.DataType_Bool:
+			boolData := make([]bool, 0)
+			for i := 0; i < rowCount; i++ {
+				boolData = append(boolData, i%3 != 0)
+			}
+			insertData.Data[field.GetFieldID()] = &storage.BoolFieldData{Data: boolData}
+		case schemapb.DataType_Float:
+			floatData := make([]float32, 0)
+			for i := 0; i < rowCount; i++ {
+				floatData = append(floatData, float32(i)*0.1)
+			}
+			insertData.Data[field.GetFieldID()] = &storage.FloatFieldData{Data: floatData}
+		case schemapb.DataType_Double:
+			doubleData := make([]float64, 0)
+			for i := 0; i < rowCount; i++ {
+				doubleData = append(doubleData, float64(i)*0.02)
+			}
+			insertData.Data[field.GetFieldID()] = &storage.DoubleFieldData{Data: doubleData}
+		case schemapb.DataType_Int8:
+			int8Data := make([]int8, 0)
+			for i := 0; i < rowCount; i++ {
+				int8Data = append(int8Data, int8(i%128))
+			}
+			insertData.Data[field.GetFieldID()] = &storage.Int8FieldData{Data: int8Data}
+		case schemapb.DataType_Int16:
+			int16Data := make([]int16, 0)
+			for i := 0; i < rowCount; i++ {
+				int16Data = append(int16Data, int16(i))
+			}
+			insertData.Data[field.GetFieldID()] = &storage.Int16FieldData{Data: int16Data}
+		case schemapb.DataType_Int32:
+			int32Data := make([]int32, 0)
+			for i := 0; i < rowCount; i++ {
+				int32Data = append(int32Data, int32(i%1000))
+			}
+			insertData.Data[field.GetFieldID()] = &storage.Int32FieldData{Data: int32Data}
+		case schemapb.DataType_Int64:
+			int64Data := make([]int64, 0)
+			for i := 0; i < rowCount; i++ {
+				int64Data = append(int64Data, int64(i))
+			}
+			insertData.Data[field.GetFieldID()] = &storage.Int64FieldData{Data: int64Data}
+		case schemapb.DataType_BinaryVector:
+			dim, err := typeutil.GetDim(field)
+			assert.NoError(t, err)
+			binVecData := make([]byte, 0)
+			total := rowCount * int(dim) / 8
+			for i := 0; i < total; i++ {
+				binVecData = append(binVecData, byte(i%256))
+			}
+			insertData.Data[field.GetFieldID()] = &storage.BinaryVectorFieldData{Data: binVecData, Dim: int(dim)}
+		case schemapb.DataType_FloatVector:
+			dim, err := typeutil.GetDim(field)
+			assert.NoError(t, err)
+			floatVecData := make([]float32, 0)
+			total := rowCount * int(dim)
+			for i := 0; i < total; i++ {
+				floatVecData = append(floatVecData, rand.Float32())
+			}
+			insertData.Data[field.GetFieldID()] = &storage.FloatVectorFieldData{Data: floatVecData, Dim: int(dim)}
+		case schemapb.DataType_Float16Vector:
+			dim, err := typeutil.GetDim(field)
+			assert.NoError(t, err)
+			total := int64(rowCount) * dim * 2
+			float16VecData := make([]byte, total)
+			_, err = rand2.Read(float16VecData)
+			assert.NoError(t, err)
+			insertData.Data[field.GetFieldID()] = &storage.Float16VectorFieldData{Data: float16VecData, Dim: int(dim)}
+		case schemapb.DataType_BFloat16Vector:
+			dim, err := typeutil.GetDim(field)
+			assert.NoError(t, err)
+			total := int64(rowCount) * dim * 2
+			bfloat16VecData := make([]byte, total)
+			_, err = rand2.Read(bfloat16VecData)
+			assert.NoError(t, err)
+			insertData.Data[field.GetFieldID()] = &storage.BFloat16VectorFieldData{Data: bfloat16VecData, Dim: int(dim)}
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
+			varcharData := make([]string, 0)
+			for i := 0; i < rowCount; i++ {
+				// contains the delimiters and quote to make sure they are escaped
+				varcharData = append(varcharData, fmt.Sprintf("str,\t\"%d\"", i))
+			}
+			insertData.Data[field.GetFieldID()] = &storage.StringFieldData{Data: varcharData}
+		case schemapb.DataType_JSON:
+			jsonData := make([][]byte, 0)
+			for i := 0; i < rowCount; i++ {
+				jsonData = append(jsonData, []byte(fmt.Sprintf("{\"y\": %d}", i)))
+			}
+			insertData.Data[field.GetFieldID()] = &storage.JSONFieldData{Data: jsonData}
+		case schemapb.DataType_Array:
+			arrayData := make([]*schemapb.ScalarField, 0)
+			for i := 0; i < rowCount; i++ {
+				arrayData = append(arrayData, &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{
+						IntData: &schemapb.IntArray{
+							Data: []int32{int32(i), int32(i + 1), int32(i + 2)},
+						},
+					},
+				})
+			}
+			insertData.Data[field.GetFieldID()] = &storage.ArrayFieldData{Data: arrayData}
+		default:
+			panic(fmt.Sprintf("unexpected data type: %s", field.GetDataType().String()))
+		}
+	}
+	return insertData
+}
+
+// formatCell encodes the row value of insert data as a CSV cell.
+func formatCell(t *testing.T, dataType schemapb.DataType, v any) string {
+	switch dataType {
+	case schemapb.DataType_Float:
+		return strconv.FormatFloat(float64(v.(float32)), 'f', -1, 32)
+	case schemapb.DataType_Double:
+		return strconv.FormatFloat(v.(float64), 'f', -1, 64)
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return v.(string)
+	case schemapb.DataType_JSON:
+		return string(v.([]byte))
+	case schemapb.DataType_Array:
+		bs, err := json.Marshal(v.(*schemapb.ScalarField).GetIntData().GetData())
+		assert.NoError(t, err)
+		return string(bs)
+	case schemapb.DataType_BinaryVector, schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		bytes := v.([]byte)
+		ints := make([]int, 0, len(bytes))
+		for _, b := range bytes {
+			ints = append(ints, int(b))
+		}
+		bs, err := json.Marshal(ints)
+		assert.NoError(t, err)
+		return string(bs)
+	case schemapb.DataType_FloatVector:
+		bs, err := json.Marshal(v)
+		assert.NoError(t, err)
+		return string(bs)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func writeCSV(t *testing.T, schema *schemapb.CollectionSchema, insertData *storage.InsertData, delimiter rune) string {
+	buf := &bytes.Buffer{}
+	w := csv.NewWriter(buf)
+	w.Comma = delimiter
+	header := make([]string, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		header = append(header, field.GetName())
+	}
+	assert.NoError(t, w.Write(header))
+	for i := 0; i < insertData.GetRowNum(); i++ {
+		record := make([]string, 0, len(schema.GetFields()))
+		for _, field := range schema.GetFields() {
+			record = append(record, formatCell(t, field.GetDataType(), insertData.Data[field.GetFieldID()].GetRow(i)))
+		}
+		assert.NoError(t, w.Write(record))
+	}
+	w.Flush()
+	assert.NoError(t, w.Error())
+	return buf.String()
+}
+
+func (suite *ReaderSuite) run(dt schemapb.DataType) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:      100,
+				Name:         "pk",
+				IsPrimaryKey: true,
+				DataType:     suite.pkDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+				},
+			},
+			{
+				FieldID:  101,
+				Name:     "vec",
+				DataType: suite.vecDataType,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.DimKey,
+						Value: "8",
+					},
+				},
+			},
+			{
+				FieldID:     102,
+				Name:        dt.String(),
+				DataType:    dt,
+				ElementType: schemapb.DataType_Int32,
+				TypeParams: []*commonpb.KeyValuePair{
+					{
+						Key:   common.MaxLengthKey,
+						Value: "128",
+					},
+				},
+			},
+		},
+	}
+	insertData := createInsertData(suite.T(), schema, suite.numRows)
+	content := writeCSV(suite.T(), schema, insertData, suite.delimiter)
+
+	options := DefaultOptions()
+	options.Delimiter = suite.delimiter
+	reader, err := NewReader(strings.NewReader(content), schema, math.MaxInt, options)
+	suite.NoError(err)
+
+	checkFn := func(actualInsertData *storage.InsertData, offsetBegin, expectRows int) {
+		expectInsertData := insertData
+		for fieldID, data := range actualInsertData.Data {
+			suite.Equal(expectRows, data.RowNum())
+			fieldDataType := typeutil.GetField(schema, fieldID).GetDataType()
+			for i := 0; i < expectRows; i++ {
+				expect := expectInsertData.Data[fieldID].GetRow(i + offsetBegin)
+				actual := data.GetRow(i)
+				if fieldDataType == schemapb.DataType_Array {
+					suite.True(slices.Equal(expect.(*schemapb.ScalarField).GetIntData().GetData(), actual.(*schemapb.ScalarField).GetIntData().GetData()))
+				} else {
+					suite.Equal(expect, actual)
+				}
+			}
+		}
+	}
+
+	res, err := reader.Read()
+	suite.NoError(err)
+	checkFn(res, 0, suite.numRows)
+
+	_, err = reader.Read()
+	suite.ErrorIs(err, io.EOF)
+}
+
+func (suite *ReaderSuite) TestReadScalarFields() {
+	suite.run(schemapb.DataType_Bool)
+	suite.run(schemapb.DataType_Int8)
+	suite.run(schemapb.DataType_Int16)
+	suite.run(schemapb.DataType_Int32)
+	suite.run(schemapb.DataType_Int64)
+	suite.run(schemapb.DataType_Float)
+	suite.run(schemapb.DataType_Double)
+	suite.run(schemapb.DataType_VarChar)
+	suite.run(schemapb.DataType_Array)
+	suite.run(schemapb.DataType_JSON)
+}
+
+func (suite *ReaderSuite) TestStringPK() {
+	suite.pkDataType = schemapb.DataType_VarChar
+	suite.run(schemapb.DataType_Int32)
+}
+
+func (suite *ReaderSuite) TestBinaryAndFloat16Vector() {
+	suite.vecDataType = schemapb.DataType_BinaryVector
+	suite.run(schemapb.DataType_Int32)
+	suite.vecDataType = schemapb.DataType_Float16Vector
+	suite.run(schemapb.DataType_Int32)
+	suite.vecDataType = schemapb.DataType_BFloat16Vector
+	suite.run(schemapb.DataType_Int32)
+}
+
+func (suite *ReaderSuite) TestTSV() {
+	suite.delimiter = '\t'
+	suite.run(schemapb.DataType_VarChar)
+	suite.run(schemapb.DataType_Array)
+}
+
+func (suite *ReaderSuite) TestDynamicFieldAndOptions() {
+	schema := &schemapb.CollectionSchema{
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+			{
+				FieldID:      102,
+				Name:         "count",
+				DataType:     schemapb.DataType_Int32,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+			},
+			{FieldID: 103, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+	options := DefaultOptions()
+	options.NullValue = "NULL"
+	options.HeaderMapping = map[string]string{"embedding": "vec"}
+
+	content := "embedding,count,a,b\n" +
+		"\"[0.1, 0.2]\",1,8,str\n" +
+		"\"[0.3, 0.4]\",NULL,\"{\"\"x\"\": true}\",NULL\n"
+	reader, err := NewReader(strings.NewReader(content), schema, math.MaxInt, options)
+	suite.NoError(err)
+	res, err := reader.Read()
+	suite.NoError(err)
+	suite.Equal(2, res.Data[101].RowNum())
+	suite.Equal([]float32{0.3, 0.4}, res.Data[101].GetRow(1))
+	suite.Equal(int32(1), res.Data[102].GetRow(0))
+	suite.Equal(int32(7), res.Data[102].GetRow(1))
+	suite.JSONEq(`{"a": 8, "b": "str"}`, string(res.Data[103].GetRow(0).([]byte)))
+	suite.JSONEq(`{"a": {"x": true}}`, string(res.Data[103].GetRow(1).([]byte)))
+
+	// null value of field without default value
+	content = "embedding,count\nNULL,1\n"
+	reader, err = NewReader(strings.NewReader(content), schema, math.MaxInt, options)
+	suite.NoError(err)
+	_, err = reader.Read()
+	suite.Error(err)
+
+	// auto-generated primary key is provided
+	_, err = NewReader(strings.NewReader("pk,embedding,count\n"), schema, math.MaxInt, options)
+	suite.Error(err)
+
+	// explicit dynamic field is not allowed
+	_, err = NewReader(strings.NewReader("embedding,count,$meta\n"), schema, math.MaxInt, options)
+	suite.Error(err)
+
+	// column of field is missed
+	_, err = NewReader(strings.NewReader("count,a\n"), schema, math.MaxInt, options)
+	suite.Error(err)
+
+	// duplicated columns
+	_, err = NewReader(strings.NewReader("embedding,vec,count\n"), schema, math.MaxInt, options)
+	suite.Error(err)
+
+	// empty file
+	_, err = NewReader(strings.NewReader(""), schema, math.MaxInt, options)
+	suite.Error(err)
+}
+
+func (suite *ReaderSuite) TestInvalidValues() {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{
+				FieldID:    101,
+				Name:       "vec",
+				DataType:   schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: common.DimKey, Value: "2"}},
+			},
+		},
+	}
+	cases := []string{
+		// invalid int64
+		"pk,vec\nabc,\"[0.1, 0.2]\"\n",
+		// dim mismatch
+		"pk,vec\n1,\"[0.1, 0.2, 0.3]\"\n",
+		// not a json array
+		"pk,vec\n1,0.1\n",
+		// column count mismatch
+		"pk,vec\n1\n",
+	}
+	for _, content := range cases {
+		reader, err := NewReader(strings.NewReader(content), schema, math.MaxInt, DefaultOptions())
+		suite.NoError(err)
+		_, err = reader.Read()
+		suite.Error(err)
+	}
+
+	// undefined field without dynamic field
+	_, err := NewReader(strings.NewReader("pk,vec,x\n"), schema, math.MaxInt, DefaultOptions())
+	suite.Error(err)
+
+	// odd byte length of float16 vector
+	for _, dataType := range []schemapb.DataType{schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector} {
+		schema.Fields[1].DataType = dataType
+		reader, err := NewReader(strings.NewReader("pk,vec\n1,\"[1, 2, 3, 4, 5]\"\n"), schema, math.MaxInt, DefaultOptions())
+		suite.NoError(err)
+		_, err = reader.Read()
+		suite.ErrorContains(err, "got 5 bytes")
+	}
+}
+
+func TestUtil(t *testing.T) {
+	suite.Run(t, new(ReaderSuite))
+}
@@ -0,0 +1,385 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package csv
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/samber/lo"
+
+	"github.com/milvus-io/milvus-proto/go-api/v2/schemapb"
+	"github.com/milvus-io/milvus/pkg/util/merr"
+	"github.com/milvus-io/milvus/pkg/util/typeutil"
+)
+
+type RowParser interface {
+	Parse(record []string) (Row, error)
+}
+
+type rowParser struct {
+	nullValue    string
+	id2Field     map[int64]*schemapb.FieldSchema
+	id2Dim       map[int64]int
+	dynamicField *schemapb.FieldSchema
+
+	// column index => fieldID, for the columns of schema fields
+	fieldColumns map[int]int64
+	// column index => key, for the columns going into the dynamic field
+	dynamicColumns map[int]string
+}
+
+// NewRowParser matches the header columns with the schema fields. The columns
+// which are not defined in schema go into the dynamic field if it's enabled.
+func NewRowParser(schema *schemapb.CollectionSchema, header []string, options Options) (RowParser, error) {
+	id2Field := lo.KeyBy(schema.GetFields(), func(field *schemapb.FieldSchema) int64 {
+		return field.GetFieldID()
+	})
+	id2Dim := make(map[int64]int)
+	for _, field := range schema.GetFields() {
+		if !typeutil.IsVectorType(field.GetDataType()) {
+			continue
+		}
+		dim, err := typeutil.GetDim(field)
+		if err != nil {
+			return nil, err
+		}
+		id2Dim[field.GetFieldID()] = int(dim)
+	}
+	pkField, err := typeutil.GetPrimaryFieldSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	name2FieldID := lo.SliceToMap(schema.GetFields(),
+		func(field *schemapb.FieldSchema) (string, int64) {
+			return field.GetName(), field.GetFieldID()
+		})
+	if pkField.GetAutoID() {
+		delete(name2FieldID, pkField.GetName())
+	}
+	dynamicField := typeutil.GetDynamicField(schema)
+	if dynamicField != nil {
+		delete(name2FieldID, dynamicField.GetName())
+	}
+
+	fieldColumns := make(map[int]int64)
+	dynamicColumns := make(map[int]string)
+	columnOfField := make(map[int64]string)
+	for i, column := range header {
+		name := column
+		if mapped, ok := options.HeaderMapping[column]; ok {
+			name = mapped
+		}
+		if fieldID, ok := name2FieldID[name]; ok {
+			if _, ok = columnOfField[fieldID]; ok {
+				return nil, merr.WrapErrImportFailed(
+					fmt.Sprintf("duplicated columns '%s' and '%s' for field '%s'", columnOfField[fieldID], column, name))
+			}
+			fieldColumns[i] = fieldID
+			columnOfField[fieldID] = column
+		} else if name == pkField.GetName() && pkField.GetAutoID() {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("the primary key '%s' is auto-generated, no need to provide", name))
+		} else if dynamicField != nil {
+			if name == dynamicField.GetName() {
+				return nil, merr.WrapErrImportFailed(
+					fmt.Sprintf("dynamic field is enabled, explicit specification of '%s' is not allowed", name))
+			}
+			dynamicColumns[i] = name
+		} else {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("the field '%s' is not defined in schema", name))
+		}
+	}
+	for name, fieldID := range name2FieldID {
+		if _, ok := columnOfField[fieldID]; !ok {
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("column of field '%s' is missed", name))
+		}
+	}
+
+	return &rowParser{
+		nullValue:      options.NullValue,
+		id2Field:       id2Field,
+		id2Dim:         id2Dim,
+		dynamicField:   dynamicField,
+		fieldColumns:   fieldColumns,
+		dynamicColumns: dynamicColumns,
+	}, nil
+}
+
+func (r *rowParser) wrapTypeError(v string, fieldID int64) error {
+	field := r.id2Field[fieldID]
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected type '%s' for field '%s', got value '%s'",
+		field.GetDataType().String(), field.GetName(), v))
+}
+
+func (r *rowParser) wrapDimError(actualDim int, fieldID int64) error {
+	field := r.id2Field[fieldID]
+	return merr.WrapErrImportFailed(fmt.Sprintf("expected dim '%d' for field '%s' with type '%s', got dim '%d'",
+		r.id2Dim[fieldID], field.GetName(), field.GetDataType().String(), actualDim))
+}
+
+func (r *rowParser) isNull(value string) bool {
+	return r.nullValue != "" && value == r.nullValue
+}
+
+func (r *rowParser) Parse(record []string) (Row, error) {
+	row := make(Row)
+	dynamicValues := make(map[string]any)
+	for i, value := range record {
+		if fieldID, ok := r.fieldColumns[i]; ok {
+			data, err := r.parseField(fieldID, value)
+			if err != nil {
+				return nil, err
+			}
+			row[fieldID] = data
+		} else if key, ok := r.dynamicColumns[i]; ok {
+			// null values are omitted from the dynamic field
+			if r.isNull(value) {
+				continue
+			}
+			dynamicValues[key] = parseDynamicValue(value)
+		}
+	}
+	if r.dynamicField == nil {
+		return row, nil
+	}
+	bs, err := json.Marshal(dynamicValues)
+	if err != nil {
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("failed to marshal dynamic values, error: %v", err))
+	}
+	row[r.dynamicField.GetFieldID()] = bs
+	return row, nil
+}
+
+// parseDynamicValue keeps the type of the value if it's valid JSON,
+// e.g. number, bool, object and array, otherwise the value is regarded as a string.
+func parseDynamicValue(value string) any {
+	dec := json.NewDecoder(bytes.NewReader([]byte(value)))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		return value
+	}
+	return v
+}
+
+func (r *rowParser) parseField(fieldID int64, value string) (any, error) {
+	field := r.id2Field[fieldID]
+	if r.isNull(value) {
+		if field.GetDefaultValue() == nil {
+			return nil, merr.WrapErrImportFailed(
+				fmt.Sprintf("value of field '%s' is null, but the field has no default value", field.GetName()))
+		}
+		return defaultValueToRowValue(field)
+	}
+
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return b, nil
+	case schemapb.DataType_Int8:
+		num, err := strconv.ParseInt(value, 0, 8)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return int8(num), nil
+	case schemapb.DataType_Int16:
+		num, err := strconv.ParseInt(value, 0, 16)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return int16(num), nil
+	case schemapb.DataType_Int32:
+		num, err := strconv.ParseInt(value, 0, 32)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return int32(num), nil
+	case schemapb.DataType_Int64:
+		num, err := strconv.ParseInt(value, 0, 64)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return num, nil
+	case schemapb.DataType_Float:
+		num, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return float32(num), nil
+	case schemapb.DataType_Double:
+		num, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return num, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return value, nil
+	case schemapb.DataType_JSON:
+		if !json.Valid([]byte(value)) {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		return []byte(value), nil
+	case schemapb.DataType_FloatVector:
+		var vec []float32
+		if err := json.Unmarshal([]byte(value), &vec); err != nil {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		if len(vec) != r.id2Dim[fieldID] {
+			return nil, r.wrapDimError(len(vec), fieldID)
+		}
+		return vec, nil
+	case schemapb.DataType_BinaryVector:
+		vec, err := r.parseByteVector(fieldID, value)
+		if err != nil {
+			return nil, err
+		}
+		if len(vec)*8 != r.id2Dim[fieldID] {
+			return nil, r.wrapDimError(len(vec)*8, fieldID)
+		}
+		return vec, nil
+	case schemapb.DataType_Float16Vector, schemapb.DataType_BFloat16Vector:
+		vec, err := r.parseByteVector(fieldID, value)
+		if err != nil {
+			return nil, err
+		}
+		// each element takes 2 bytes, odd byte length is invalid as well
+		if len(vec) != r.id2Dim[fieldID]*2 {
+			field := r.id2Field[fieldID]
+			return nil, merr.WrapErrImportFailed(fmt.Sprintf("expected dim '%d' (%d bytes) for field '%s' with type '%s', got %d bytes",
+				r.id2Dim[fieldID], r.id2Dim[fieldID]*2, field.GetName(), field.GetDataType().String(), len(vec)))
+		}
+		return vec, nil
+	case schemapb.DataType_Array:
+		return r.parseArray(fieldID, value)
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("parse csv failed, unsupport data type: %s",
+			field.GetDataType().String()))
+	}
+}
+
+// parseByteVector parses a JSON array of uint8 like [1, 2, 255].
+func (r *rowParser) parseByteVector(fieldID int64, value string) ([]byte, error) {
+	var arr []uint16
+	if err := json.Unmarshal([]byte(value), &arr); err != nil {
+		return nil, r.wrapTypeError(value, fieldID)
+	}
+	vec := make([]byte, len(arr))
+	for i, v := range arr {
+		if v > 255 {
+			return nil, r.wrapTypeError(value, fieldID)
+		}
+		vec[i] = byte(v)
+	}
+	return vec, nil
+}
+
+func (r *rowParser) parseArray(fieldID int64, value string) (*schemapb.ScalarField, error) {
+	field := r.id2Field[fieldID]
+	unmarshal := func(v any) error {
+		if err := json.Unmarshal([]byte(value), v); err != nil {
+			return merr.WrapErrImportFailed(fmt.Sprintf("expected element type '%s' in array field '%s', got value '%s'",
+				field.GetElementType().String(), field.GetName(), value))
+		}
+		return nil
+	}
+
+	switch field.GetElementType() {
+	case schemapb.DataType_Bool:
+		values := make([]bool, 0)
+		if err := unmarshal(&values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: values}},
+		}, nil
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		values := make([]int32, 0)
+		if err := unmarshal(&values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: values}},
+		}, nil
+	case schemapb.DataType_Int64:
+		values := make([]int64, 0)
+		if err := unmarshal(&values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: values}},
+		}, nil
+	case schemapb.DataType_Float:
+		values := make([]float32, 0)
+		if err := unmarshal(&values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: values}},
+		}, nil
+	case schemapb.DataType_Double:
+		values := make([]float64, 0)
+		if err := unmarshal(&values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: values}},
+		}, nil
+	case schemapb.DataType_VarChar, schemapb.DataType_String:
+		values := make([]string, 0)
+		if err := unmarshal(&values); err != nil {
+			return nil, err
+		}
+		return &schemapb.ScalarField{
+			Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: values}},
+		}, nil
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("unsupported array data type '%s'", field.GetElementType().String()))
+	}
+}
+
+// defaultValueToRowValue converts the default value of the field to the row value of insert data.
+func defaultValueToRowValue(field *schemapb.FieldSchema) (any, error) {
+	defaultValue := field.GetDefaultValue()
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return defaultValue.GetBoolData(), nil
+	case schemapb.DataType_Int8:
+		return int8(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int16:
+		return int16(defaultValue.GetIntData()), nil
+	case schemapb.DataType_Int32:
+		return defaultValue.GetIntData(), nil
+	case schemapb.DataType_Int64:
+		return defaultValue.GetLongData(), nil
+	case schemapb.DataType_Float:
+		return defaultValue.GetFloatData(), nil
+	case schemapb.DataType_Double:
+		return defaultValue.GetDoubleData(), nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return defaultValue.GetStringData(), nil
+	default:
+		return nil, merr.WrapErrImportFailed(fmt.Sprintf("default value of type '%s' is not supported, field '%s'",
+			field.GetDataType().String(), field.GetName()))
+	}
+}
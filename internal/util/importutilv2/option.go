@@ -17,12 +17,16 @@
 package importutilv2
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"path/filepath"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/milvus-io/milvus-proto/go-api/v2/commonpb"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/csv"
 	"github.com/milvus-io/milvus/pkg/util/funcutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/tsoutil"
@@ -32,6 +36,11 @@ const (
 	StartTs    = "start_ts"
 	EndTs      = "end_ts"
 	BackupFlag = "backup"
+
+	CSVDelimiter     = "csv_delimiter"
+	CSVLazyQuotes    = "csv_lazy_quotes"
+	CSVNullValue     = "csv_null_value"
+	CSVHeaderMapping = "csv_header_mapping"
 )
 
 type Options []*commonpb.KeyValuePair
@@ -70,3 +79,41 @@ func IsBackup(options Options) bool {
 	}
 	return true
 }
+
+// ParseCSVOptions parses the options of CSV reader, the default delimiter is
+// decided by the file extension, '\t' for TSV and ',' for others.
+// The header mapping is a JSON object like {"column name": "field name"}.
+func ParseCSVOptions(options Options, path string) (csv.Options, error) {
+	importOptions := funcutil.KeyValuePair2Map(options)
+	csvOptions := csv.DefaultOptions()
+	if filepath.Ext(path) == TSVFileExt {
+		csvOptions.Delimiter = '\t'
+	}
+	if value, ok := importOptions[CSVDelimiter]; ok {
+		delimiter, size := utf8.DecodeRuneInString(value)
+		if delimiter == utf8.RuneError || size != len(value) ||
+			delimiter == '"' || delimiter == '\r' || delimiter == '\n' {
+			return csv.Options{}, merr.WrapErrImportFailed(
+				fmt.Sprintf("invalid %s '%s', it should be a single character other than quote and line break", CSVDelimiter, value))
+		}
+		csvOptions.Delimiter = delimiter
+	}
+	if value, ok := importOptions[CSVLazyQuotes]; ok {
+		lazyQuotes, err := strconv.ParseBool(value)
+		if err != nil {
+			return csv.Options{}, merr.WrapErrImportFailed(fmt.Sprintf("parse %s failed, value=%s, err=%s", CSVLazyQuotes, value, err))
+		}
+		csvOptions.LazyQuotes = lazyQuotes
+	}
+	if value, ok := importOptions[CSVNullValue]; ok {
+		csvOptions.NullValue = value
+	}
+	if value, ok := importOptions[CSVHeaderMapping]; ok {
+		mapping := make(map[string]string)
+		if err := json.Unmarshal([]byte(value), &mapping); err != nil {
+			return csv.Options{}, merr.WrapErrImportFailed(fmt.Sprintf("parse %s failed, value=%s, err=%s", CSVHeaderMapping, value, err))
+		}
+		csvOptions.HeaderMapping = mapping
+	}
+	return csvOptions, nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/binlog"
+	"github.com/milvus-io/milvus/internal/util/importutilv2/csv"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/json"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/numpy"
 	"github.com/milvus-io/milvus/internal/util/importutilv2/parquet"
@@ -70,6 +71,16 @@ func NewReader(ctx context.Context,
 			return nil, err
 		}
 		return parquet.NewReader(ctx, schema, cmReader, bufferSize)
+	case CSV:
+		csvOptions, err := ParseCSVOptions(options, importFile.GetPaths()[0])
+		if err != nil {
+			return nil, err
+		}
+		reader, err := cm.Reader(ctx, importFile.GetPaths()[0])
+		if err != nil {
+			return nil, WrapReadFileError(importFile.GetPaths()[0], err)
+		}
+		return csv.NewReader(reader, schema, bufferSize, csvOptions)
 	}
 	return nil, merr.WrapErrImportFailed("unexpected import file")
 }
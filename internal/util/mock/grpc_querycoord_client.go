@@ -130,6 +130,10 @@ func (m *GrpcQueryCoordClient) DescribeResourceGroup(ctx context.Context, req *q
 	return &querypb.DescribeResourceGroupResponse{}, m.Err
 }
 
+func (m *GrpcQueryCoordClient) UpdateResourceGroups(ctx context.Context, req *milvuspb.UpdateResourceGroupsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
 func (m *GrpcQueryCoordClient) ListCheckers(ctx context.Context, in *querypb.ListCheckersRequest, opts ...grpc.CallOption) (*querypb.ListCheckersResponse, error) {
 	return &querypb.ListCheckersResponse{}, m.Err
 }
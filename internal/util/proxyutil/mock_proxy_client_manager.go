@@ -422,6 +422,49 @@ func (_c *MockProxyClientManager_InvalidateCredentialCache_Call) RunAndReturn(ru
 	return _c
 }
 
+// InvalidateQueryCache provides a mock function with given fields: ctx, request
+func (_m *MockProxyClientManager) InvalidateQueryCache(ctx context.Context, request *proxypb.InvalidateQueryCacheRequest) error {
+	ret := _m.Called(ctx, request)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *proxypb.InvalidateQueryCacheRequest) error); ok {
+		r0 = rf(ctx, request)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockProxyClientManager_InvalidateQueryCache_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InvalidateQueryCache'
+type MockProxyClientManager_InvalidateQueryCache_Call struct {
+	*mock.Call
+}
+
+// InvalidateQueryCache is a helper method to define mock.On call
+//   - ctx context.Context
+//   - request *proxypb.InvalidateQueryCacheRequest
+func (_e *MockProxyClientManager_Expecter) InvalidateQueryCache(ctx interface{}, request interface{}) *MockProxyClientManager_InvalidateQueryCache_Call {
+	return &MockProxyClientManager_InvalidateQueryCache_Call{Call: _e.mock.On("InvalidateQueryCache", ctx, request)}
+}
+
+func (_c *MockProxyClientManager_InvalidateQueryCache_Call) Run(run func(ctx context.Context, request *proxypb.InvalidateQueryCacheRequest)) *MockProxyClientManager_InvalidateQueryCache_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*proxypb.InvalidateQueryCacheRequest))
+	})
+	return _c
+}
+
+func (_c *MockProxyClientManager_InvalidateQueryCache_Call) Return(_a0 error) *MockProxyClientManager_InvalidateQueryCache_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockProxyClientManager_InvalidateQueryCache_Call) RunAndReturn(run func(context.Context, *proxypb.InvalidateQueryCacheRequest) error) *MockProxyClientManager_InvalidateQueryCache_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RefreshPolicyInfoCache provides a mock function with given fields: ctx, req
 func (_m *MockProxyClientManager) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) error {
 	ret := _m.Called(ctx, req)
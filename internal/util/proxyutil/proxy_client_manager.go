@@ -93,6 +93,7 @@ type ProxyClientManagerInterface interface {
 	RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) error
 	GetProxyMetrics(ctx context.Context) ([]*milvuspb.GetMetricsResponse, error)
 	SetRates(ctx context.Context, request *proxypb.SetRatesRequest) error
+	InvalidateQueryCache(ctx context.Context, request *proxypb.InvalidateQueryCacheRequest) error
 	GetComponentStates(ctx context.Context) (map[int64]*milvuspb.ComponentStates, error)
 }
 
@@ -340,6 +341,30 @@ func (p *ProxyClientManager) SetRates(ctx context.Context, request *proxypb.SetR
 	return group.Wait()
 }
 
+// InvalidateQueryCache notifies the proxies except the source of request to drop the cached results
+// of the collections computed before the dml.
+func (p *ProxyClientManager) InvalidateQueryCache(ctx context.Context, request *proxypb.InvalidateQueryCacheRequest) error {
+	group := &errgroup.Group{}
+	p.proxyClient.Range(func(key int64, value types.ProxyClient) bool {
+		if key == request.GetBase().GetSourceID() {
+			return true
+		}
+		k, v := key, value
+		group.Go(func() error {
+			sta, err := v.InvalidateQueryCache(ctx, request)
+			if err != nil {
+				return fmt.Errorf("InvalidateQueryCache failed, proxyID = %d, err = %s", k, err)
+			}
+			if sta.GetErrorCode() != commonpb.ErrorCode_Success {
+				return fmt.Errorf("InvalidateQueryCache failed, proxyID = %d, err = %s", k, sta.Reason)
+			}
+			return nil
+		})
+		return true
+	})
+	return group.Wait()
+}
+
 func (p *ProxyClientManager) GetComponentStates(ctx context.Context) (map[int64]*milvuspb.ComponentStates, error) {
 	group, ctx := errgroup.WithContext(ctx)
 	states := make(map[int64]*milvuspb.ComponentStates)
@@ -32,6 +32,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/pkg/util/commonpbutil"
 	"github.com/milvus-io/milvus/pkg/util/merr"
 	"github.com/milvus-io/milvus/pkg/util/paramtable"
 )
@@ -424,3 +425,34 @@ func TestProxyClientManager_GetComponentStates(t *testing.T) {
 		assert.NoError(t, err)
 	})
 }
+
+func TestProxyClientManager_InvalidateQueryCache(t *testing.T) {
+	ctx := context.Background()
+	req := &proxypb.InvalidateQueryCacheRequest{
+		Base:          commonpbutil.NewMsgBase(commonpbutil.WithSourceID(1000)),
+		DmlTimestamps: map[int64]uint64{1: 100},
+	}
+
+	t.Run("skip source", func(t *testing.T) {
+		p1 := mocks.NewMockProxyClient(t)
+		pcm := NewProxyClientManager(DefaultProxyCreator)
+		pcm.proxyClient.Insert(1000, p1)
+		assert.NoError(t, pcm.InvalidateQueryCache(ctx, req))
+	})
+
+	t.Run("mock error code", func(t *testing.T) {
+		p1 := mocks.NewMockProxyClient(t)
+		p1.EXPECT().InvalidateQueryCache(mock.Anything, mock.Anything).Return(merr.Status(errors.New("mock error")), nil)
+		pcm := NewProxyClientManager(DefaultProxyCreator)
+		pcm.proxyClient.Insert(1001, p1)
+		assert.Error(t, pcm.InvalidateQueryCache(ctx, req))
+	})
+
+	t.Run("normal case", func(t *testing.T) {
+		p1 := mocks.NewMockProxyClient(t)
+		p1.EXPECT().InvalidateQueryCache(mock.Anything, mock.Anything).Return(merr.Success(), nil)
+		pcm := NewProxyClientManager(DefaultProxyCreator)
+		pcm.proxyClient.Insert(1001, p1)
+		assert.NoError(t, pcm.InvalidateQueryCache(ctx, req))
+	})
+}
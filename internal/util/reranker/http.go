@@ -0,0 +1,88 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reranker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/cockroachdb/errors"
+)
+
+type httpRequest struct {
+	Query     string      `json:"query"`
+	Documents []*Document `json:"documents"`
+}
+
+type httpResponse struct {
+	Scores []float32 `json:"scores"`
+}
+
+// HTTPProvider calls the rerank endpoint of a local model server.
+// The request body is {"query": "...", "documents": [{"id": 1, "fields": {"title": "..."}}]},
+// and the server responds {"scores": [...]} with one score per document.
+type HTTPProvider struct {
+	endpoint string
+	client   *http.Client
+}
+
+func NewHTTPProvider(endpoint string) (*HTTPProvider, error) {
+	if endpoint == "" {
+		return nil, errors.New("reranker http endpoint not set")
+	}
+	return &HTTPProvider{
+		endpoint: endpoint,
+		client:   &http.Client{},
+	}, nil
+}
+
+func (p *HTTPProvider) Rerank(ctx context.Context, query string, documents []*Document) ([]float32, error) {
+	body, err := json.Marshal(&httpRequest{Query: query, Documents: documents})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Newf("reranker responds %d: %s", resp.StatusCode, string(data))
+	}
+
+	ret := &httpResponse{}
+	if err := json.Unmarshal(data, ret); err != nil {
+		return nil, errors.Wrap(err, "invalid reranker response")
+	}
+	if len(ret.Scores) != len(documents) {
+		return nil, errors.Newf("reranker returns %d scores for %d documents", len(ret.Scores), len(documents))
+	}
+	return ret.Scores, nil
+}
@@ -0,0 +1,74 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reranker
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHTTPProvider(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req := &httpRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.Query == "error" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		scores := make([]float32, 0, len(req.Documents))
+		for _, doc := range req.Documents {
+			scores = append(scores, float32(len(doc.Fields["title"])))
+		}
+		if req.Query == "mismatch" {
+			scores = scores[1:]
+		}
+		json.NewEncoder(w).Encode(&httpResponse{Scores: scores})
+	}))
+	defer server.Close()
+
+	_, err := NewHTTPProvider("")
+	assert.Error(t, err)
+
+	provider, err := NewHTTPProvider(server.URL)
+	assert.NoError(t, err)
+	documents := []*Document{
+		{ID: int64(1), Fields: map[string]string{"title": "a"}},
+		{ID: int64(2), Fields: map[string]string{"title": "abc"}},
+	}
+	scores, err := provider.Rerank(context.Background(), "query", documents)
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{1, 3}, scores)
+
+	_, err = provider.Rerank(context.Background(), "error", documents)
+	assert.Error(t, err)
+
+	_, err = provider.Rerank(context.Background(), "mismatch", documents)
+	assert.Error(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = provider.Rerank(ctx, "query", documents)
+	assert.Error(t, err)
+}
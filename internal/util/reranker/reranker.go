@@ -0,0 +1,83 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package reranker
+
+import (
+	"context"
+	"fmt"
+	"plugin"
+
+	"github.com/cockroachdb/errors"
+
+	"github.com/milvus-io/milvus/pkg/util/paramtable"
+)
+
+const (
+	// ProviderHTTP calls a model server by http.
+	ProviderHTTP = "http"
+	// ProviderHook loads the provider from a go plugin.
+	ProviderHook = "hook"
+
+	// HookSymbol is the symbol of the Provider exported by the reranker plugin.
+	HookSymbol = "MilvusReranker"
+)
+
+// Document is a candidate row of the search results to rerank.
+type Document struct {
+	ID     any               `json:"id"`
+	Fields map[string]string `json:"fields"` // field name => text
+}
+
+// Provider scores the candidate documents against the query by a reranking model,
+// such as a cross-encoder. One score is returned for each document in order, higher is better.
+type Provider interface {
+	Rerank(ctx context.Context, query string, documents []*Document) ([]float32, error)
+}
+
+// NewProvider creates the reranker provider by the config, nil if reranking is not enabled.
+func NewProvider(cfg *paramtable.RerankConfig) (Provider, error) {
+	switch provider := cfg.Provider.GetValue(); provider {
+	case "":
+		return nil, nil
+	case ProviderHTTP:
+		return NewHTTPProvider(cfg.HTTPEndpoint.GetValue())
+	case ProviderHook:
+		return LoadHookProvider(cfg.SoPath.GetValue())
+	default:
+		return nil, errors.Newf("unknown reranker provider %s", provider)
+	}
+}
+
+// LoadHookProvider loads the Provider exported as `MilvusReranker` by the go plugin.
+func LoadHookProvider(path string) (Provider, error) {
+	if path == "" {
+		return nil, errors.New("reranker plugin path not set")
+	}
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("fail to open the reranker plugin, error: %s", err.Error())
+	}
+	symbol, err := p.Lookup(HookSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("fail to find the '%s' object in the plugin, error: %s", HookSymbol, err.Error())
+	}
+	provider, ok := symbol.(Provider)
+	if !ok {
+		return nil, fmt.Errorf("fail to convert the `Provider` interface")
+	}
+	return provider, nil
+}
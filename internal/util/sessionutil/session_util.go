@@ -49,6 +49,9 @@ const (
 	DefaultServiceRoot = "session/"
 	// DefaultIDKey default id key for Session
 	DefaultIDKey = "id"
+	// SupportedLabelPrefix is the prefix of the environment variables defining the labels of the server,
+	// e.g. MILVUS_SERVER_LABEL_ZONE=az1 labels the server with zone=az1.
+	SupportedLabelPrefix = "MILVUS_SERVER_LABEL_"
 )
 
 // SessionEventType session event type
@@ -100,8 +103,9 @@ type SessionRaw struct {
 	IndexEngineVersion IndexEngineVersion `json:"IndexEngineVersion,omitempty"`
 	LeaseID            *clientv3.LeaseID  `json:"LeaseID,omitempty"`
 
-	HostName   string `json:"HostName,omitempty"`
-	EnableDisk bool   `json:"EnableDisk,omitempty"`
+	HostName     string            `json:"HostName,omitempty"`
+	EnableDisk   bool              `json:"EnableDisk,omitempty"`
+	ServerLabels map[string]string `json:"ServerLabels,omitempty"`
 }
 
 func (s *SessionRaw) GetAddress() string {
@@ -210,6 +214,20 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 	return json.Marshal(s.SessionRaw)
 }
 
+// GetServerLabelsFromEnv returns the labels of the server defined by the environment variables with SupportedLabelPrefix,
+// the label keys are in lower case.
+func GetServerLabelsFromEnv() map[string]string {
+	labels := make(map[string]string)
+	for _, env := range os.Environ() {
+		key, value, ok := strings.Cut(env, "=")
+		if !ok || !strings.HasPrefix(key, SupportedLabelPrefix) || len(key) == len(SupportedLabelPrefix) {
+			continue
+		}
+		labels[strings.ToLower(strings.TrimPrefix(key, SupportedLabelPrefix))] = value
+	}
+	return labels
+}
+
 // Create a new Session object. Will use global etcd client
 func NewSession(ctx context.Context, opts ...SessionOption) *Session {
 	client, path := kvfactory.GetEtcdAndPath()
@@ -232,7 +250,8 @@ func NewSessionWithEtcd(ctx context.Context, metaRoot string, client *clientv3.C
 		Version:  common.Version,
 
 		SessionRaw: SessionRaw{
-			HostName: hostName,
+			HostName:     hostName,
+			ServerLabels: GetServerLabelsFromEnv(),
 		},
 
 		// options
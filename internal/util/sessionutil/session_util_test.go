@@ -810,6 +810,23 @@ func TestSession_apply(t *testing.T) {
 	assert.Equal(t, int64(200), session.sessionRetryTimes)
 }
 
+func TestGetServerLabelsFromEnv(t *testing.T) {
+	t.Setenv(SupportedLabelPrefix+"ZONE", "az1")
+	t.Setenv(SupportedLabelPrefix+"rack", "r1")
+	t.Setenv(SupportedLabelPrefix, "ignored")
+	labels := GetServerLabelsFromEnv()
+	assert.Equal(t, "az1", labels["zone"])
+	assert.Equal(t, "r1", labels["rack"])
+	assert.Len(t, labels, 2)
+
+	session := &Session{SessionRaw: SessionRaw{ServerLabels: labels}}
+	data, err := json.Marshal(session)
+	assert.NoError(t, err)
+	session2 := &Session{}
+	assert.NoError(t, json.Unmarshal(data, session2))
+	assert.Equal(t, labels, session2.ServerLabels)
+}
+
 func TestIntegrationMode(t *testing.T) {
 	ctx := context.Background()
 	paramtable.Init()
@@ -7,6 +7,7 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/milvus-io/milvus-proto/go-api/v2/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 )
 
@@ -42,6 +43,18 @@ func NewConcurrentQueryStreamServer(srv QueryStreamServer) *ConcurrentQueryStrea
 	}
 }
 
+// QueryResultsStreamServer is the server streaming the query results from proxy to clients.
+type QueryResultsStreamServer interface {
+	Send(*milvuspb.QueryResults) error
+	Context() context.Context
+}
+
+// SearchResultsStreamServer is the server streaming the search results from proxy to clients.
+type SearchResultsStreamServer interface {
+	Send(*milvuspb.SearchResults) error
+	Context() context.Context
+}
+
 // TODO LOCAL SERVER AND CLIENT FOR STANDALONE
 // ONLY FOR TEST
 type LocalQueryServer struct {
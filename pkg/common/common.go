@@ -101,6 +101,9 @@ const (
 	DimKey         = "dim"
 	MaxLengthKey   = "max_length"
 	MaxCapacityKey = "max_capacity"
+
+	EnableAnalyzerKey = "enable_analyzer"
+	AnalyzerParamsKey = "analyzer_params"
 )
 
 //  Collection properties key
@@ -123,6 +126,48 @@ const (
 	CollectionSearchRateMaxKey   = "collection.searchRate.max.vps"
 	CollectionSearchRateMinKey   = "collection.searchRate.min.vps"
 	CollectionDiskQuotaKey       = "collection.diskProtection.diskQuota.mb"
+
+	// cache the search and query results of the collection in proxy, see proxy.queryCache
+	CollectionQueryCacheEnabledKey = "collection.queryCache.enabled"
+
+	// return the results of the available shards if some shards of the collection are unavailable
+	CollectionAllowPartialResultsKey = "collection.allowPartialResults"
+
+	// warm up the segments loaded on querynode before the delegator routes traffic to them
+	CollectionWarmupPolicyKey = "collection.warmup.policy"
+
+	// keep a standby delegator of each shard on another querynode of the replica, applied on loading the collection
+	CollectionStandbyDelegatorKey = "collection.delegator.standby"
+
+	// tag each replica of the collection on loading, in format of "tag1:rg1,tag2:rg2:exclusive", one entry per replica,
+	// the resource group of the entry is optional, the exclusive replica serves the requests with its tag only
+	CollectionReplicaTagsKey = "collection.replica.tags"
+
+	// partition rate limit, set in collection properties and applied to each partition of the collection
+	PartitionInsertRateMaxKey   = "partition.insertRate.max.mb"
+	PartitionUpsertRateMaxKey   = "partition.upsertRate.max.mb"
+	PartitionDeleteRateMaxKey   = "partition.deleteRate.max.mb"
+	PartitionBulkLoadRateMaxKey = "partition.bulkLoadRate.max.mb"
+	PartitionQueryRateMaxKey    = "partition.queryRate.max.qps"
+	PartitionSearchRateMaxKey   = "partition.searchRate.max.vps"
+	PartitionDiskQuotaKey       = "partition.diskProtection.diskQuota.mb"
+)
+
+//  Database properties key
+
+const (
+	// rate limit
+	DatabaseInsertRateMaxKey   = "database.insertRate.max.mb"
+	DatabaseUpsertRateMaxKey   = "database.upsertRate.max.mb"
+	DatabaseDeleteRateMaxKey   = "database.deleteRate.max.mb"
+	DatabaseBulkLoadRateMaxKey = "database.bulkLoadRate.max.mb"
+	DatabaseQueryRateMaxKey    = "database.queryRate.max.qps"
+	DatabaseSearchRateMaxKey   = "database.searchRate.max.vps"
+	DatabaseDiskQuotaKey       = "database.diskProtection.diskQuota.mb"
+
+	// load config, resource groups are separated by comma
+	DatabaseReplicaNumber  = "database.replica.number"
+	DatabaseResourceGroups = "database.resource_groups"
 )
 
 // common properties
@@ -130,6 +175,17 @@ const (
 	MmapEnabledKey = "mmap.enabled"
 )
 
+// warmup policies of the loaded segments
+const (
+	// WarmupPolicyNone routes traffic to the segments once loaded
+	WarmupPolicyNone = "none"
+	// WarmupPolicyTouch loads the lazy loaded data and the raw data of the vector indexes synchronously,
+	// and reads the mmap files and the local index files of the segments into the page cache
+	WarmupPolicyTouch = "touch"
+	// WarmupPolicyReplay touches the segments and replays a sample of the recent searches on them
+	WarmupPolicyReplay = "replay"
+)
+
 const (
 	PropertiesKey string = "properties"
 	TraceIDKey    string = "uber-trace-id"
@@ -148,6 +204,53 @@ func IsMmapEnabled(kvs ...*commonpb.KeyValuePair) bool {
 	return false
 }
 
+func IsQueryCacheEnabled(kvs ...*commonpb.KeyValuePair) bool {
+	for _, kv := range kvs {
+		if kv.Key == CollectionQueryCacheEnabledKey && kv.Value == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func IsAllowPartialResults(kvs ...*commonpb.KeyValuePair) bool {
+	for _, kv := range kvs {
+		if kv.Key == CollectionAllowPartialResultsKey && kv.Value == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+func IsStandbyDelegatorEnabled(kvs ...*commonpb.KeyValuePair) bool {
+	for _, kv := range kvs {
+		if kv.Key == CollectionStandbyDelegatorKey && kv.Value == "true" {
+			return true
+		}
+	}
+	return false
+}
+
+// GetReplicaTags returns the replica tags of the collection, empty if not set.
+func GetReplicaTags(kvs ...*commonpb.KeyValuePair) string {
+	for _, kv := range kvs {
+		if kv.Key == CollectionReplicaTagsKey {
+			return kv.Value
+		}
+	}
+	return ""
+}
+
+// GetWarmupPolicy returns the warmup policy of the collection, none if not set.
+func GetWarmupPolicy(kvs ...*commonpb.KeyValuePair) string {
+	for _, kv := range kvs {
+		if kv.Key == CollectionWarmupPolicyKey {
+			return kv.Value
+		}
+	}
+	return WarmupPolicyNone
+}
+
 func IsFieldMmapEnabled(schema *schemapb.CollectionSchema, fieldID int64) bool {
 	for _, field := range schema.GetFields() {
 		if field.GetFieldID() == fieldID {
@@ -186,6 +186,24 @@ var (
 			Buckets:   buckets, // unit: ms
 		}, []string{nodeIDLabelName, cacheNameLabelName})
 
+	// ProxyQueryCacheCounter record the number of query result cache hits or miss of search and query requests.
+	ProxyQueryCacheCounter = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "query_cache_hit_count",
+			Help:      "count of query result cache hits/miss",
+		}, []string{nodeIDLabelName, queryTypeLabelName, cacheStateLabelName})
+
+	// ProxyQueryCacheSize record the memory size of the cached results of search and query requests.
+	ProxyQueryCacheSize = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "query_cache_size",
+			Help:      "memory size of the query result cache in bytes",
+		}, []string{nodeIDLabelName})
+
 	// ProxySyncTimeTickLag record Proxy synchronization timestamp statistics, differentiated by Channel.
 	ProxySyncTimeTickLag = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -252,6 +270,33 @@ var (
 			Help:      "count of bytes sent back to sdk",
 		}, []string{nodeIDLabelName})
 
+	// ProxyStreamSentBytes record the bytes of the streaming search and query results sent back to sdk.
+	ProxyStreamSentBytes = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "stream_send_bytes_count",
+			Help:      "count of bytes of the streaming results sent back to sdk",
+		}, []string{nodeIDLabelName, queryTypeLabelName})
+
+	// ProxyHedgedRequestCount record the number of the hedged shard requests, and the ones answered first by the hedged replica.
+	ProxyHedgedRequestCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "hedged_request_count",
+			Help:      "count of the hedged shard requests",
+		}, []string{nodeIDLabelName, statusLabelName})
+
+	// ProxyAccessLogWriteFailureCount record the number of the access log records failed to write.
+	ProxyAccessLogWriteFailureCount = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: milvusNamespace,
+			Subsystem: typeutil.ProxyRole,
+			Name:      "access_log_write_failure_count",
+			Help:      "count of the access log records failed to write",
+		}, []string{nodeIDLabelName})
+
 	// ProxyLimiterRate records rates of rateLimiter in Proxy.
 	ProxyLimiterRate = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -333,6 +378,8 @@ func RegisterProxy(registry *prometheus.Registry) {
 
 	registry.MustRegister(ProxyCacheStatsCounter)
 	registry.MustRegister(ProxyUpdateCacheLatency)
+	registry.MustRegister(ProxyQueryCacheCounter)
+	registry.MustRegister(ProxyQueryCacheSize)
 
 	registry.MustRegister(ProxySyncTimeTickLag)
 	registry.MustRegister(ProxyApplyPrimaryKeyLatency)
@@ -343,6 +390,9 @@ func RegisterProxy(registry *prometheus.Registry) {
 
 	registry.MustRegister(ProxyReceiveBytes)
 	registry.MustRegister(ProxyReadReqSendBytes)
+	registry.MustRegister(ProxyStreamSentBytes)
+	registry.MustRegister(ProxyHedgedRequestCount)
+	registry.MustRegister(ProxyAccessLogWriteFailureCount)
 
 	registry.MustRegister(ProxyLimiterRate)
 	registry.MustRegister(ProxyHookFunc)
@@ -36,4 +36,7 @@ const (
 
 	// SUPERSTRUCTURE represents superstructure distance
 	SUPERSTRUCTURE MetricType = "SUPERSTRUCTURE"
+
+	// BM25 represents the BM25 relevance score of full-text search
+	BM25 MetricType = "BM25"
 )
@@ -18,8 +18,8 @@ package metric
 
 import "strings"
 
-// PositivelyRelated return if metricType are "ip" or "IP"
+// PositivelyRelated return if metricType are "ip", "cosine" or "bm25"
 func PositivelyRelated(metricType string) bool {
 	mUpper := strings.ToUpper(metricType)
-	return mUpper == strings.ToUpper(IP) || mUpper == strings.ToUpper(COSINE)
+	return mUpper == strings.ToUpper(IP) || mUpper == strings.ToUpper(COSINE) || mUpper == strings.ToUpper(BM25)
 }
@@ -31,6 +31,10 @@ func TestPositivelyRelated(t *testing.T) {
 			COSINE,
 			true,
 		},
+		{
+			BM25,
+			true,
+		},
 		{
 			L2,
 			false,
@@ -1016,6 +1016,33 @@ type AccessLogConfig struct {
 	RemotePath    ParamItem  `refreshable:"false"`
 	RemoteMaxTime ParamItem  `refreshable:"false"`
 	Formatter     ParamGroup `refreshable:"false"`
+
+	Mode             ParamItem `refreshable:"false"`
+	AuditSecret      ParamItem `refreshable:"false"`
+	RemoteMaxBackups ParamItem `refreshable:"false"`
+}
+
+type RerankConfig struct {
+	Provider         ParamItem `refreshable:"false"`
+	HTTPEndpoint     ParamItem `refreshable:"false"`
+	SoPath           ParamItem `refreshable:"false"`
+	Timeout          ParamItem `refreshable:"true"`
+	MaxCandidateSize ParamItem `refreshable:"true"`
+}
+
+type QueryCacheConfig struct {
+	Enabled              ParamItem `refreshable:"true"`
+	MaxMemorySize        ParamItem `refreshable:"true"`
+	TTL                  ParamItem `refreshable:"true"`
+	TimestampGranularity ParamItem `refreshable:"true"`
+	SyncInterval         ParamItem `refreshable:"false"`
+}
+
+type HedgeConfig struct {
+	Enabled           ParamItem `refreshable:"true"`
+	LatencyPercentile ParamItem `refreshable:"true"`
+	MinDelay          ParamItem `refreshable:"true"`
+	BudgetRatio       ParamItem `refreshable:"true"`
 }
 
 type proxyConfig struct {
@@ -1040,13 +1067,19 @@ type proxyConfig struct {
 	MaxTaskNum                   ParamItem `refreshable:"false"`
 	ShardLeaderCacheInterval     ParamItem `refreshable:"false"`
 	ReplicaSelectionPolicy       ParamItem `refreshable:"false"`
+	ZoneLabelKey                 ParamItem `refreshable:"false"`
 	CheckQueryNodeHealthInterval ParamItem `refreshable:"false"`
 	CostMetricsExpireTime        ParamItem `refreshable:"true"`
 	RetryTimesOnReplica          ParamItem `refreshable:"true"`
 	RetryTimesOnHealthCheck      ParamItem `refreshable:"true"`
 	PartitionNameRegexp          ParamItem `refreshable:"true"`
+	StreamBatchSize              ParamItem `refreshable:"true"`
+	StreamMaxDedupPKs            ParamItem `refreshable:"true"`
 
-	AccessLog AccessLogConfig
+	AccessLog  AccessLogConfig
+	Rerank     RerankConfig
+	QueryCache QueryCacheConfig
+	Hedge      HedgeConfig
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 }
@@ -1300,6 +1333,30 @@ please adjust in embedded Milvus: false`,
 	}
 	p.AccessLog.Formatter.Init(base.mgr)
 
+	p.AccessLog.Mode = ParamItem{
+		Key:          "proxy.accessLog.mode",
+		Version:      "2.4.0",
+		DefaultValue: "text",
+		Doc:          "text to write access log by formatters, json to write audit records of fixed schema chained by hash, without cache",
+	}
+	p.AccessLog.Mode.Init(base.mgr)
+
+	p.AccessLog.AuditSecret = ParamItem{
+		Key:          "proxy.accessLog.auditSecret",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "secret key of the HMAC-SHA256 chaining the audit records, required by json mode",
+	}
+	p.AccessLog.AuditSecret.Init(base.mgr)
+
+	p.AccessLog.RemoteMaxBackups = ParamItem{
+		Key:          "proxy.accessLog.remoteMaxBackups",
+		Version:      "2.4.0",
+		DefaultValue: "0",
+		Doc:          "Max number of log files in minIO, 0 means close count retention",
+	}
+	p.AccessLog.RemoteMaxBackups.Init(base.mgr)
+
 	p.ShardLeaderCacheInterval = ParamItem{
 		Key:          "proxy.shardLeaderCacheInterval",
 		Version:      "2.2.4",
@@ -1312,10 +1369,18 @@ please adjust in embedded Milvus: false`,
 		Key:          "proxy.replicaSelectionPolicy",
 		Version:      "2.3.0",
 		DefaultValue: "look_aside",
-		Doc:          "replica selection policy in multiple replicas load balancing, support round_robin and look_aside",
+		Doc:          "replica selection policy in multiple replicas load balancing, support round_robin, look_aside and zone_aware",
 	}
 	p.ReplicaSelectionPolicy.Init(base.mgr)
 
+	p.ZoneLabelKey = ParamItem{
+		Key:          "proxy.zoneLabelKey",
+		Version:      "2.4.0",
+		DefaultValue: "zone",
+		Doc:          "the server label telling the zone of proxy and query nodes, used by zone_aware replica selection policy",
+	}
+	p.ZoneLabelKey.Init(base.mgr)
+
 	p.CheckQueryNodeHealthInterval = ParamItem{
 		Key:          "proxy.checkQueryNodeHealthInterval",
 		Version:      "2.3.0",
@@ -1356,6 +1421,24 @@ please adjust in embedded Milvus: false`,
 	}
 	p.PartitionNameRegexp.Init(base.mgr)
 
+	p.StreamBatchSize = ParamItem{
+		Key:          "proxy.streamBatchSize",
+		Version:      "2.4.0",
+		DefaultValue: "4",
+		Doc:          "MB, the max size of a batch of the streaming search and query results",
+		Export:       true,
+	}
+	p.StreamBatchSize.Init(base.mgr)
+
+	p.StreamMaxDedupPKs = ParamItem{
+		Key:          "proxy.streamMaxDedupPKs",
+		Version:      "2.4.0",
+		DefaultValue: "1000000",
+		Doc:          "the max number of primary keys kept to skip the duplicated rows of a streaming query, the query fails if more rows are returned",
+		Export:       true,
+	}
+	p.StreamMaxDedupPKs.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "proxy.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -1364,6 +1447,134 @@ please adjust in embedded Milvus: false`,
 		Export:       true,
 	}
 	p.GracefulStopTimeout.Init(base.mgr)
+
+	p.Rerank.Provider = ParamItem{
+		Key:          "proxy.rerank.provider",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "provider of the reranking model for search results, http or hook, reranking is disabled if empty",
+		Export:       true,
+	}
+	p.Rerank.Provider.Init(base.mgr)
+
+	p.Rerank.HTTPEndpoint = ParamItem{
+		Key:          "proxy.rerank.http.endpoint",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "rerank endpoint of the model server for the http provider",
+		Export:       true,
+	}
+	p.Rerank.HTTPEndpoint.Init(base.mgr)
+
+	p.Rerank.SoPath = ParamItem{
+		Key:          "proxy.rerank.soPath",
+		Version:      "2.4.0",
+		DefaultValue: "",
+		Doc:          "path of the reranker plugin for the hook provider",
+		Export:       true,
+	}
+	p.Rerank.SoPath.Init(base.mgr)
+
+	p.Rerank.Timeout = ParamItem{
+		Key:          "proxy.rerank.timeout",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "ms, the default timeout of reranking a search request",
+		Export:       true,
+	}
+	p.Rerank.Timeout.Init(base.mgr)
+
+	p.Rerank.MaxCandidateSize = ParamItem{
+		Key:          "proxy.rerank.maxCandidateSize",
+		Version:      "2.4.0",
+		DefaultValue: "1024",
+		Doc:          "the max number of candidates to rerank of a search request",
+		Export:       true,
+	}
+	p.Rerank.MaxCandidateSize.Init(base.mgr)
+
+	p.QueryCache.Enabled = ParamItem{
+		Key:          "proxy.queryCache.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc: `cache the results of search and query requests at Bounded or Eventually consistency,
+only the collections with collection.queryCache.enabled property are cached`,
+		Export: true,
+	}
+	p.QueryCache.Enabled.Init(base.mgr)
+
+	p.QueryCache.MaxMemorySize = ParamItem{
+		Key:          "proxy.queryCache.maxMemorySize",
+		Version:      "2.4.0",
+		DefaultValue: "256",
+		Doc:          "MB, the max memory size of the cached results",
+		Export:       true,
+	}
+	p.QueryCache.MaxMemorySize.Init(base.mgr)
+
+	p.QueryCache.TTL = ParamItem{
+		Key:          "proxy.queryCache.ttl",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		Doc:          "seconds, the max time a result is cached",
+		Export:       true,
+	}
+	p.QueryCache.TTL.Init(base.mgr)
+
+	p.QueryCache.TimestampGranularity = ParamItem{
+		Key:          "proxy.queryCache.timestampGranularity",
+		Version:      "2.4.0",
+		DefaultValue: "1000",
+		Doc:          "ms, the guarantee timestamps of requests are rounded down to the granularity in the cache key",
+		Export:       true,
+	}
+	p.QueryCache.TimestampGranularity.Init(base.mgr)
+
+	p.QueryCache.SyncInterval = ParamItem{
+		Key:          "proxy.queryCache.syncInterval",
+		Version:      "2.4.0",
+		DefaultValue: "200",
+		Doc:          "ms, the interval to notify the other proxies to drop the cached results of the collections received dml",
+		Export:       true,
+	}
+	p.QueryCache.SyncInterval.Init(base.mgr)
+
+	p.Hedge.Enabled = ParamItem{
+		Key:          "proxy.hedge.enabled",
+		Version:      "2.4.0",
+		DefaultValue: "false",
+		Doc: `send the search and query requests of a shard to another replica if the shard leader has not answered in time,
+the first response wins and the other request is canceled`,
+		Export: true,
+	}
+	p.Hedge.Enabled.Init(base.mgr)
+
+	p.Hedge.LatencyPercentile = ParamItem{
+		Key:          "proxy.hedge.latencyPercentile",
+		Version:      "2.4.0",
+		DefaultValue: "0.95",
+		Doc:          "a request is hedged if not answered within this percentile of the recent response times of shard leaders",
+		Export:       true,
+	}
+	p.Hedge.LatencyPercentile.Init(base.mgr)
+
+	p.Hedge.MinDelay = ParamItem{
+		Key:          "proxy.hedge.minDelay",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		Doc:          "ms, the min time to wait before hedging a request",
+		Export:       true,
+	}
+	p.Hedge.MinDelay.Init(base.mgr)
+
+	p.Hedge.BudgetRatio = ParamItem{
+		Key:          "proxy.hedge.budgetRatio",
+		Version:      "2.4.0",
+		DefaultValue: "0.05",
+		Doc:          "the max ratio of the hedged requests to all requests, which bounds the extra load of hedging",
+		Export:       true,
+	}
+	p.Hedge.BudgetRatio.Init(base.mgr)
 }
 
 // /////////////////////////////////////////////////////////////////////////////
@@ -1399,6 +1610,10 @@ type queryCoordConfig struct {
 	RowCountMaxSteps                    ParamItem `refreshable:"true"`
 	RandomMaxSteps                      ParamItem `refreshable:"true"`
 	GrowingRowCountWeight               ParamItem `refreshable:"true"`
+	DiskUsageWeight                     ParamItem `refreshable:"true"`
+	WorkloadUnbalanceThreshold          ParamItem `refreshable:"true"`
+	WorkloadBalanceTolerance            ParamItem `refreshable:"true"`
+	WorkloadBalanceCooldown             ParamItem `refreshable:"true"`
 	BalanceCostThreshold                ParamItem `refreshable:"true"`
 
 	SegmentCheckInterval       ParamItem `refreshable:"true"`
@@ -1628,6 +1843,46 @@ func (p *queryCoordConfig) init(base *BaseTable) {
 	}
 	p.GrowingRowCountWeight.Init(base.mgr)
 
+	p.DiskUsageWeight = ParamItem{
+		Key:          "queryCoord.diskUsageWeight",
+		Version:      "2.4.0",
+		DefaultValue: "0.1",
+		PanicIfEmpty: true,
+		Doc:          "the weight of the disk usage against the memory usage, only used by MemoryBasedBalancer",
+		Export:       true,
+	}
+	p.DiskUsageWeight.Init(base.mgr)
+
+	p.WorkloadUnbalanceThreshold = ParamItem{
+		Key:          "queryCoord.workloadUnbalanceThreshold",
+		Version:      "2.4.0",
+		DefaultValue: "0.3",
+		PanicIfEmpty: true,
+		Doc:          "the workload balance starts when the search cost of a node exceeds the average by the ratio, only used by WorkloadBasedBalancer",
+		Export:       true,
+	}
+	p.WorkloadUnbalanceThreshold.Init(base.mgr)
+
+	p.WorkloadBalanceTolerance = ParamItem{
+		Key:          "queryCoord.workloadBalanceTolerance",
+		Version:      "2.4.0",
+		DefaultValue: "0.1",
+		PanicIfEmpty: true,
+		Doc:          "the workload balance moves segments until the search cost of the nodes are within the ratio of the average, only used by WorkloadBasedBalancer",
+		Export:       true,
+	}
+	p.WorkloadBalanceTolerance.Init(base.mgr)
+
+	p.WorkloadBalanceCooldown = ParamItem{
+		Key:          "queryCoord.workloadBalanceCooldown",
+		Version:      "2.4.0",
+		DefaultValue: "300",
+		PanicIfEmpty: true,
+		Doc:          "the minimal interval in seconds between two workload balances of a replica, which lets the measured search load converge, only used by WorkloadBasedBalancer",
+		Export:       true,
+	}
+	p.WorkloadBalanceCooldown.Init(base.mgr)
+
 	p.BalanceCostThreshold = ParamItem{
 		Key:          "queryCoord.balanceCostThreshold",
 		Version:      "2.4.0",
@@ -1940,6 +2195,10 @@ type queryNodeConfig struct {
 	ReadAheadPolicy     ParamItem `refreshable:"false"`
 	ChunkCacheWarmingUp ParamItem `refreshable:"true"`
 
+	// segment warmup
+	WarmupReplaySampleSize ParamItem `refreshable:"true"`
+	WarmupTimeout          ParamItem `refreshable:"true"`
+
 	GroupEnabled          ParamItem `refreshable:"true"`
 	MaxReceiveChanSize    ParamItem `refreshable:"false"`
 	MaxUnsolvedQueueSize  ParamItem `refreshable:"true"`
@@ -1975,6 +2234,8 @@ type queryNodeConfig struct {
 
 	EnableWorkerSQCostMetrics ParamItem `refreshable:"true"`
 
+	EnableSegmentPrune ParamItem `refreshable:"true"`
+
 	ExprEvalBatchSize ParamItem `refreshable:"false"`
 
 	// pipeline
@@ -2177,6 +2438,24 @@ for a specific duration post-load, albeit accompanied by a concurrent increase i
 	}
 	p.ChunkCacheWarmingUp.Init(base.mgr)
 
+	p.WarmupReplaySampleSize = ParamItem{
+		Key:          "queryNode.warmup.replaySampleSize",
+		Version:      "2.4.0",
+		DefaultValue: "4",
+		Doc:          "number of the recent searches of each collection replayed on the loaded segments by the replay warmup policy",
+		Export:       true,
+	}
+	p.WarmupReplaySampleSize.Init(base.mgr)
+
+	p.WarmupTimeout = ParamItem{
+		Key:          "queryNode.warmup.timeout",
+		Version:      "2.4.0",
+		DefaultValue: "10000",
+		Doc:          "ms, max time to warm up the loaded segments, the segments serve traffic after timeout even if not warmed up",
+		Export:       true,
+	}
+	p.WarmupTimeout.Init(base.mgr)
+
 	p.GroupEnabled = ParamItem{
 		Key:          "queryNode.grouping.enabled",
 		Version:      "2.0.0",
@@ -2422,6 +2701,15 @@ Max read concurrency must greater than or equal to 1, and less than or equal to
 	}
 	p.EnableWorkerSQCostMetrics.Init(base.mgr)
 
+	p.EnableSegmentPrune = ParamItem{
+		Key:          "queryNode.enableSegmentPrune",
+		Version:      "2.4.0",
+		DefaultValue: "true",
+		Doc:          "use the scalar field min/max stats of sealed segments to skip the segments which could not match the filter in delegator",
+		Export:       true,
+	}
+	p.EnableSegmentPrune.Init(base.mgr)
+
 	p.ExprEvalBatchSize = ParamItem{
 		Key:          "queryNode.segcore.exprEvalBatchSize",
 		Version:      "2.3.4",
@@ -2498,6 +2786,11 @@ type dataCoordConfig struct {
 	LevelZeroCompactionTriggerDeltalogMinNum ParamItem `refreshable:"true"`
 	LevelZeroCompactionTriggerDeltalogMaxNum ParamItem `refreshable:"true"`
 
+	// Clustering Compaction
+	ClusteringCompactionEnable          ParamItem `refreshable:"true"`
+	ClusteringCompactionMinL1SegmentNum ParamItem `refreshable:"true"`
+	ClusteringCompactionMaxInputSize    ParamItem `refreshable:"true"`
+
 	// Garbage Collection
 	EnableGarbageCollection ParamItem `refreshable:"false"`
 	GCInterval              ParamItem `refreshable:"false"`
@@ -2834,6 +3127,33 @@ During compaction, the size of segment # of rows is able to exceed segment max #
 	}
 	p.LevelZeroCompactionTriggerDeltalogMaxNum.Init(base.mgr)
 
+	p.ClusteringCompactionEnable = ParamItem{
+		Key:          "dataCoord.compaction.clustering.enable",
+		Version:      "2.4.0",
+		Doc:          "Whether to enable clustering compaction for collections with clustering key, requires levelzero segment enabled",
+		DefaultValue: "false",
+		Export:       true,
+	}
+	p.ClusteringCompactionEnable.Init(base.mgr)
+
+	p.ClusteringCompactionMinL1SegmentNum = ParamItem{
+		Key:          "dataCoord.compaction.clustering.minL1SegmentNum",
+		Version:      "2.4.0",
+		Doc:          "The minimum number of not yet clustered L1 segments in a partition-channel to trigger a clustering compaction",
+		DefaultValue: "3",
+		Export:       true,
+	}
+	p.ClusteringCompactionMinL1SegmentNum.Init(base.mgr)
+
+	p.ClusteringCompactionMaxInputSize = ParamItem{
+		Key:          "dataCoord.compaction.clustering.maxInputSize",
+		Version:      "2.4.0",
+		Doc:          "The maximum total binlog size in MB of segments in one clustering compaction plan",
+		DefaultValue: "8192",
+		Export:       true,
+	}
+	p.ClusteringCompactionMaxInputSize.Init(base.mgr)
+
 	p.EnableGarbageCollection = ParamItem{
 		Key:          "dataCoord.enableGarbageCollection",
 		Version:      "2.0.0",
@@ -3041,7 +3361,8 @@ type dataNodeConfig struct {
 	MaxConcurrentImportTaskNum ParamItem `refreshable:"true"`
 
 	// Compaction
-	L0BatchMemoryRatio ParamItem `refreshable:"true"`
+	L0BatchMemoryRatio                    ParamItem `refreshable:"true"`
+	ClusteringCompactionMemoryBufferRatio ParamItem `refreshable:"true"`
 
 	GracefulStopTimeout ParamItem `refreshable:"true"`
 }
@@ -3314,6 +3635,15 @@ func (p *dataNodeConfig) init(base *BaseTable) {
 	}
 	p.L0BatchMemoryRatio.Init(base.mgr)
 
+	p.ClusteringCompactionMemoryBufferRatio = ParamItem{
+		Key:          "datanode.compaction.clusteringMemoryBufferRatio",
+		Version:      "2.4.0",
+		Doc:          "The memory ratio of datanode to buffer the rows of clustering compaction, the largest buffers are flushed once exceeded",
+		DefaultValue: "0.1",
+		Export:       true,
+	}
+	p.ClusteringCompactionMemoryBufferRatio.Init(base.mgr)
+
 	p.GracefulStopTimeout = ParamItem{
 		Key:          "datanode.gracefulStopTimeout",
 		Version:      "2.3.7",
@@ -158,6 +158,10 @@ func TestComponentParam(t *testing.T) {
 
 		t.Logf("AccessLog.MaxDays: %d", Params.AccessLog.RotatedTime.GetAsInt64())
 
+		assert.Equal(t, "text", Params.AccessLog.Mode.GetValue())
+		assert.Equal(t, "", Params.AccessLog.AuditSecret.GetValue())
+		assert.Equal(t, 0, Params.AccessLog.RemoteMaxBackups.GetAsInt())
+
 		t.Logf("ShardLeaderCacheInterval: %d", Params.ShardLeaderCacheInterval.GetAsInt64())
 
 		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "look_aside")
@@ -165,6 +169,7 @@ func TestComponentParam(t *testing.T) {
 		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "round_robin")
 		params.Save(Params.ReplicaSelectionPolicy.Key, "look_aside")
 		assert.Equal(t, Params.ReplicaSelectionPolicy.GetValue(), "look_aside")
+		assert.Equal(t, "zone", Params.ZoneLabelKey.GetValue())
 		assert.Equal(t, Params.CheckQueryNodeHealthInterval.GetAsInt(), 1000)
 		assert.Equal(t, Params.CostMetricsExpireTime.GetAsInt(), 1000)
 		assert.Equal(t, Params.RetryTimesOnReplica.GetAsInt(), 2)
@@ -172,6 +177,24 @@ func TestComponentParam(t *testing.T) {
 
 		params.Save("proxy.gracefulStopTimeout", "100")
 		assert.Equal(t, 100*time.Second, Params.GracefulStopTimeout.GetAsDuration(time.Second))
+
+		assert.Equal(t, "", Params.Rerank.Provider.GetValue())
+		assert.Equal(t, time.Second, Params.Rerank.Timeout.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 1024, Params.Rerank.MaxCandidateSize.GetAsInt())
+
+		assert.Equal(t, int64(4), Params.StreamBatchSize.GetAsInt64())
+		assert.Equal(t, 1000000, Params.StreamMaxDedupPKs.GetAsInt())
+
+		assert.False(t, Params.QueryCache.Enabled.GetAsBool())
+		assert.Equal(t, int64(256), Params.QueryCache.MaxMemorySize.GetAsInt64())
+		assert.Equal(t, 10*time.Second, Params.QueryCache.TTL.GetAsDuration(time.Second))
+		assert.Equal(t, time.Second, Params.QueryCache.TimestampGranularity.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 200*time.Millisecond, Params.QueryCache.SyncInterval.GetAsDuration(time.Millisecond))
+
+		assert.False(t, Params.Hedge.Enabled.GetAsBool())
+		assert.Equal(t, 0.95, Params.Hedge.LatencyPercentile.GetAsFloat())
+		assert.Equal(t, 10*time.Millisecond, Params.Hedge.MinDelay.GetAsDuration(time.Millisecond))
+		assert.Equal(t, 0.05, Params.Hedge.BudgetRatio.GetAsFloat())
 	})
 
 	// t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -282,6 +305,14 @@ func TestComponentParam(t *testing.T) {
 		params.Save("queryCoord.reverseUnBalanceTolerationFactor", "1.5")
 		assert.Equal(t, 1.5, Params.ReverseUnbalanceTolerationFactor.GetAsFloat())
 
+		assert.Equal(t, 0.1, Params.DiskUsageWeight.GetAsFloat())
+		params.Save("queryCoord.diskUsageWeight", "0.5")
+		assert.Equal(t, 0.5, Params.DiskUsageWeight.GetAsFloat())
+
+		assert.Equal(t, 0.3, Params.WorkloadUnbalanceThreshold.GetAsFloat())
+		assert.Equal(t, 0.1, Params.WorkloadBalanceTolerance.GetAsFloat())
+		assert.Equal(t, 300*time.Second, Params.WorkloadBalanceCooldown.GetAsDuration(time.Second))
+
 		assert.Equal(t, 1000, Params.SegmentCheckInterval.GetAsInt())
 		assert.Equal(t, 1000, Params.ChannelCheckInterval.GetAsInt())
 		assert.Equal(t, 10000, Params.BalanceCheckInterval.GetAsInt())
@@ -326,6 +357,8 @@ func TestComponentParam(t *testing.T) {
 		// chunk cache
 		assert.Equal(t, "willneed", Params.ReadAheadPolicy.GetValue())
 		assert.Equal(t, "async", Params.ChunkCacheWarmingUp.GetValue())
+		assert.Equal(t, 4, Params.WarmupReplaySampleSize.GetAsInt())
+		assert.Equal(t, 10*time.Second, Params.WarmupTimeout.GetAsDuration(time.Millisecond))
 
 		// test small indexNlist/NProbe default
 		params.Remove("queryNode.segcore.smallIndex.nlist")
@@ -79,6 +79,14 @@ type quotaConfig struct {
 	DMLMinDeleteRatePerCollection   ParamItem `refreshable:"true"`
 	DMLMaxBulkLoadRatePerCollection ParamItem `refreshable:"true"`
 	DMLMinBulkLoadRatePerCollection ParamItem `refreshable:"true"`
+	DMLMaxInsertRatePerDB           ParamItem `refreshable:"true"`
+	DMLMaxUpsertRatePerDB           ParamItem `refreshable:"true"`
+	DMLMaxDeleteRatePerDB           ParamItem `refreshable:"true"`
+	DMLMaxBulkLoadRatePerDB         ParamItem `refreshable:"true"`
+	DMLMaxInsertRatePerPartition    ParamItem `refreshable:"true"`
+	DMLMaxUpsertRatePerPartition    ParamItem `refreshable:"true"`
+	DMLMaxDeleteRatePerPartition    ParamItem `refreshable:"true"`
+	DMLMaxBulkLoadRatePerPartition  ParamItem `refreshable:"true"`
 
 	// dql
 	DQLLimitEnabled               ParamItem `refreshable:"true"`
@@ -90,6 +98,10 @@ type quotaConfig struct {
 	DQLMinSearchRatePerCollection ParamItem `refreshable:"true"`
 	DQLMaxQueryRatePerCollection  ParamItem `refreshable:"true"`
 	DQLMinQueryRatePerCollection  ParamItem `refreshable:"true"`
+	DQLMaxSearchRatePerDB         ParamItem `refreshable:"true"`
+	DQLMaxQueryRatePerDB          ParamItem `refreshable:"true"`
+	DQLMaxSearchRatePerPartition  ParamItem `refreshable:"true"`
+	DQLMaxQueryRatePerPartition   ParamItem `refreshable:"true"`
 
 	// limits
 	MaxCollectionNum      ParamItem `refreshable:"true"`
@@ -98,6 +110,7 @@ type quotaConfig struct {
 	NQLimit               ParamItem `refreshable:"true"`
 	MaxQueryResultWindow  ParamItem `refreshable:"true"`
 	MaxOutputSize         ParamItem `refreshable:"true"`
+	MaxGroupSize          ParamItem `refreshable:"true"`
 
 	// limit writing
 	ForceDenyWriting                     ParamItem `refreshable:"true"`
@@ -115,6 +128,8 @@ type quotaConfig struct {
 	DiskProtectionEnabled                ParamItem `refreshable:"true"`
 	DiskQuota                            ParamItem `refreshable:"true"`
 	DiskQuotaPerCollection               ParamItem `refreshable:"true"`
+	DiskQuotaPerDB                       ParamItem `refreshable:"true"`
+	DiskQuotaPerPartition                ParamItem `refreshable:"true"`
 
 	// limit reading
 	ForceDenyReading        ParamItem `refreshable:"true"`
@@ -403,6 +418,52 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinInsertRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxInsertRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.insertRate.db.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxInsertRate.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxInsertRatePerDB.Init(base.mgr)
+
+	p.DMLMaxInsertRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dml.insertRate.partition.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxInsertRatePerCollection.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxInsertRatePerPartition.Init(base.mgr)
+
 	p.DMLMaxUpsertRate = ParamItem{
 		Key:          "quotaAndLimits.dml.upsertRate.max",
 		Version:      "2.3.0",
@@ -491,6 +552,52 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinUpsertRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxUpsertRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.upsertRate.db.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxUpsertRate.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxUpsertRatePerDB.Init(base.mgr)
+
+	p.DMLMaxUpsertRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dml.upsertRate.partition.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxUpsertRatePerCollection.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxUpsertRatePerPartition.Init(base.mgr)
+
 	p.DMLMaxDeleteRate = ParamItem{
 		Key:          "quotaAndLimits.dml.deleteRate.max",
 		Version:      "2.2.0",
@@ -579,6 +686,52 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinDeleteRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxDeleteRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.deleteRate.db.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxDeleteRate.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxDeleteRatePerDB.Init(base.mgr)
+
+	p.DMLMaxDeleteRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dml.deleteRate.partition.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxDeleteRatePerCollection.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxDeleteRatePerPartition.Init(base.mgr)
+
 	p.DMLMaxBulkLoadRate = ParamItem{
 		Key:          "quotaAndLimits.dml.bulkLoadRate.max",
 		Version:      "2.2.0",
@@ -667,6 +820,52 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DMLMinBulkLoadRatePerCollection.Init(base.mgr)
 
+	p.DMLMaxBulkLoadRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dml.bulkLoadRate.db.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxBulkLoadRate.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxBulkLoadRatePerDB.Init(base.mgr)
+
+	p.DMLMaxBulkLoadRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dml.bulkLoadRate.partition.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DMLLimitEnabled.GetAsBool() {
+				return max
+			}
+			rate := getAsFloat(v)
+			if math.Abs(rate-defaultMax) > 0.001 { // maxRate != defaultMax
+				rate = megaBytes2Bytes(rate)
+			}
+			// [0, inf)
+			if rate < 0 {
+				return p.DMLMaxBulkLoadRatePerCollection.GetValue()
+			}
+			return fmt.Sprintf("%f", rate)
+		},
+		Doc:    "MB/s, default no limit",
+		Export: true,
+	}
+	p.DMLMaxBulkLoadRatePerPartition.Init(base.mgr)
+
 	// dql
 	p.DQLLimitEnabled = ParamItem{
 		Key:          "quotaAndLimits.dql.enabled",
@@ -758,6 +957,44 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DQLMinSearchRatePerCollection.Init(base.mgr)
 
+	p.DQLMaxSearchRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dql.searchRate.db.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return p.DQLMaxSearchRate.GetValue()
+			}
+			return v
+		},
+		Doc:    "vps (vectors per second), default no limit",
+		Export: true,
+	}
+	p.DQLMaxSearchRatePerDB.Init(base.mgr)
+
+	p.DQLMaxSearchRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dql.searchRate.partition.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return p.DQLMaxSearchRatePerCollection.GetValue()
+			}
+			return v
+		},
+		Doc:    "vps (vectors per second), default no limit",
+		Export: true,
+	}
+	p.DQLMaxSearchRatePerPartition.Init(base.mgr)
+
 	p.DQLMaxQueryRate = ParamItem{
 		Key:          "quotaAndLimits.dql.queryRate.max",
 		Version:      "2.2.0",
@@ -838,6 +1075,44 @@ The maximum rate will not be greater than ` + "max" + `.`,
 	}
 	p.DQLMinQueryRatePerCollection.Init(base.mgr)
 
+	p.DQLMaxQueryRatePerDB = ParamItem{
+		Key:          "quotaAndLimits.dql.queryRate.db.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return p.DQLMaxQueryRate.GetValue()
+			}
+			return v
+		},
+		Doc:    "qps, default no limit",
+		Export: true,
+	}
+	p.DQLMaxQueryRatePerDB.Init(base.mgr)
+
+	p.DQLMaxQueryRatePerPartition = ParamItem{
+		Key:          "quotaAndLimits.dql.queryRate.partition.max",
+		Version:      "2.4.0",
+		DefaultValue: max,
+		Formatter: func(v string) string {
+			if !p.DQLLimitEnabled.GetAsBool() {
+				return max
+			}
+			// [0, inf)
+			if getAsFloat(v) < 0 {
+				return p.DQLMaxQueryRatePerCollection.GetValue()
+			}
+			return v
+		},
+		Doc:    "qps, default no limit",
+		Export: true,
+	}
+	p.DQLMaxQueryRatePerPartition.Init(base.mgr)
+
 	// limits
 	p.MaxCollectionNum = ParamItem{
 		Key:          "quotaAndLimits.limits.maxCollectionNum",
@@ -893,6 +1168,15 @@ Check https://milvus.io/docs/limitations.md for more details.`,
 	}
 	p.MaxOutputSize.Init(base.mgr)
 
+	p.MaxGroupSize = ParamItem{
+		Key:          "quotaAndLimits.limits.maxGroupSize",
+		Version:      "2.4.0",
+		DefaultValue: "10",
+		FallbackKeys: []string{},
+		Doc:          `Search limit, which applies on: maximum # of hits in each group of group by search (group_size)`,
+	}
+	p.MaxGroupSize.Init(base.mgr)
+
 	// limit writing
 	p.ForceDenyWriting = ParamItem{
 		Key:          "quotaAndLimits.limitWriting.forceDeny",
@@ -1153,6 +1437,48 @@ but the rate will not be lower than minRateRatio * dmlRate.`,
 	}
 	p.DiskQuotaPerCollection.Init(base.mgr)
 
+	p.DiskQuotaPerDB = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.diskProtection.diskQuotaPerDB",
+		Version:      "2.4.0",
+		DefaultValue: quota,
+		Formatter: func(v string) string {
+			if !p.DiskProtectionEnabled.GetAsBool() {
+				return max
+			}
+			level := getAsFloat(v)
+			// (0, +inf)
+			if level <= 0 {
+				return p.DiskQuota.GetValue()
+			}
+			// megabytes to bytes
+			return fmt.Sprintf("%f", megaBytes2Bytes(level))
+		},
+		Doc:    "MB, (0, +inf), default no limit",
+		Export: true,
+	}
+	p.DiskQuotaPerDB.Init(base.mgr)
+
+	p.DiskQuotaPerPartition = ParamItem{
+		Key:          "quotaAndLimits.limitWriting.diskProtection.diskQuotaPerPartition",
+		Version:      "2.4.0",
+		DefaultValue: quota,
+		Formatter: func(v string) string {
+			if !p.DiskProtectionEnabled.GetAsBool() {
+				return max
+			}
+			level := getAsFloat(v)
+			// (0, +inf)
+			if level <= 0 {
+				return p.DiskQuotaPerCollection.GetValue()
+			}
+			// megabytes to bytes
+			return fmt.Sprintf("%f", megaBytes2Bytes(level))
+		},
+		Doc:    "MB, (0, +inf), default no limit",
+		Export: true,
+	}
+	p.DiskQuotaPerPartition.Init(base.mgr)
+
 	// limit reading
 	p.ForceDenyReading = ParamItem{
 		Key:          "quotaAndLimits.limitReading.forceDeny",
@@ -128,6 +128,35 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, float64(0), params.QuotaConfig.DMLMinBulkLoadRatePerCollection.GetAsFloat())
 	})
 
+	t.Run("test database and partition dml", func(t *testing.T) {
+		params.Init(NewBaseTable(SkipRemote(true)))
+		params.Save(params.QuotaConfig.DMLLimitEnabled.Key, "true")
+		params.Save(params.QuotaConfig.DMLMaxInsertRatePerDB.Key, "10")
+		params.Save(params.QuotaConfig.DMLMaxUpsertRatePerDB.Key, "10")
+		params.Save(params.QuotaConfig.DMLMaxDeleteRatePerDB.Key, "10")
+		params.Save(params.QuotaConfig.DMLMaxBulkLoadRatePerDB.Key, "10")
+		params.Save(params.QuotaConfig.DMLMaxInsertRatePerPartition.Key, "5")
+		params.Save(params.QuotaConfig.DMLMaxUpsertRatePerPartition.Key, "5")
+		params.Save(params.QuotaConfig.DMLMaxDeleteRatePerPartition.Key, "5")
+		params.Save(params.QuotaConfig.DMLMaxBulkLoadRatePerPartition.Key, "5")
+		assert.Equal(t, float64(10)*1024*1024, params.QuotaConfig.DMLMaxInsertRatePerDB.GetAsFloat())
+		assert.Equal(t, float64(10)*1024*1024, params.QuotaConfig.DMLMaxUpsertRatePerDB.GetAsFloat())
+		assert.Equal(t, float64(10)*1024*1024, params.QuotaConfig.DMLMaxDeleteRatePerDB.GetAsFloat())
+		assert.Equal(t, float64(10)*1024*1024, params.QuotaConfig.DMLMaxBulkLoadRatePerDB.GetAsFloat())
+		assert.Equal(t, float64(5)*1024*1024, params.QuotaConfig.DMLMaxInsertRatePerPartition.GetAsFloat())
+		assert.Equal(t, float64(5)*1024*1024, params.QuotaConfig.DMLMaxUpsertRatePerPartition.GetAsFloat())
+		assert.Equal(t, float64(5)*1024*1024, params.QuotaConfig.DMLMaxDeleteRatePerPartition.GetAsFloat())
+		assert.Equal(t, float64(5)*1024*1024, params.QuotaConfig.DMLMaxBulkLoadRatePerPartition.GetAsFloat())
+
+		// database falls back to the global rate, partition falls back to the collection rate
+		params.Save(params.QuotaConfig.DMLMaxInsertRate.Key, "20")
+		params.Save(params.QuotaConfig.DMLMaxInsertRatePerCollection.Key, "15")
+		params.Save(params.QuotaConfig.DMLMaxInsertRatePerDB.Key, "-1")
+		params.Save(params.QuotaConfig.DMLMaxInsertRatePerPartition.Key, "-1")
+		assert.Equal(t, params.QuotaConfig.DMLMaxInsertRate.GetAsFloat(), params.QuotaConfig.DMLMaxInsertRatePerDB.GetAsFloat())
+		assert.Equal(t, params.QuotaConfig.DMLMaxInsertRatePerCollection.GetAsFloat(), params.QuotaConfig.DMLMaxInsertRatePerPartition.GetAsFloat())
+	})
+
 	t.Run("test dql", func(t *testing.T) {
 		params.Init(NewBaseTable(SkipRemote(true)))
 		params.Save(params.QuotaConfig.DQLLimitEnabled.Key, "true")
@@ -174,6 +203,24 @@ func TestQuotaParam(t *testing.T) {
 		assert.Equal(t, float64(0), params.QuotaConfig.DQLMinQueryRatePerCollection.GetAsFloat())
 	})
 
+	t.Run("test database and partition dql", func(t *testing.T) {
+		params.Init(NewBaseTable(SkipRemote(true)))
+		params.Save(params.QuotaConfig.DQLLimitEnabled.Key, "true")
+		params.Save(params.QuotaConfig.DQLMaxSearchRatePerDB.Key, "10")
+		params.Save(params.QuotaConfig.DQLMaxQueryRatePerDB.Key, "10")
+		params.Save(params.QuotaConfig.DQLMaxSearchRatePerPartition.Key, "5")
+		params.Save(params.QuotaConfig.DQLMaxQueryRatePerPartition.Key, "5")
+		assert.Equal(t, float64(10), params.QuotaConfig.DQLMaxSearchRatePerDB.GetAsFloat())
+		assert.Equal(t, float64(10), params.QuotaConfig.DQLMaxQueryRatePerDB.GetAsFloat())
+		assert.Equal(t, float64(5), params.QuotaConfig.DQLMaxSearchRatePerPartition.GetAsFloat())
+		assert.Equal(t, float64(5), params.QuotaConfig.DQLMaxQueryRatePerPartition.GetAsFloat())
+
+		params.Save(params.QuotaConfig.DQLMaxSearchRatePerDB.Key, "-1")
+		params.Save(params.QuotaConfig.DQLMaxQueryRatePerPartition.Key, "-1")
+		assert.Equal(t, params.QuotaConfig.DQLMaxSearchRate.GetAsFloat(), params.QuotaConfig.DQLMaxSearchRatePerDB.GetAsFloat())
+		assert.Equal(t, params.QuotaConfig.DQLMaxQueryRatePerCollection.GetAsFloat(), params.QuotaConfig.DQLMaxQueryRatePerPartition.GetAsFloat())
+	})
+
 	t.Run("test limits", func(t *testing.T) {
 		assert.Equal(t, 65536, qc.MaxCollectionNum.GetAsInt())
 		assert.Equal(t, 65536, qc.MaxCollectionNumPerDB.GetAsInt())
@@ -213,5 +260,14 @@ func TestQuotaParam(t *testing.T) {
 		// test invalid config
 		params.Save(params.QuotaConfig.DiskQuotaPerCollection.Key, "-1")
 		assert.Equal(t, qc.DiskQuota.GetAsFloat(), qc.DiskQuotaPerCollection.GetAsFloat())
+
+		params.Save(params.QuotaConfig.DiskQuotaPerDB.Key, "10")
+		params.Save(params.QuotaConfig.DiskQuotaPerPartition.Key, "5")
+		assert.Equal(t, float64(10*1024*1024), params.QuotaConfig.DiskQuotaPerDB.GetAsFloat())
+		assert.Equal(t, float64(5*1024*1024), params.QuotaConfig.DiskQuotaPerPartition.GetAsFloat())
+		params.Save(params.QuotaConfig.DiskQuotaPerDB.Key, "-1")
+		params.Save(params.QuotaConfig.DiskQuotaPerPartition.Key, "-1")
+		assert.Equal(t, params.QuotaConfig.DiskQuota.GetAsFloat(), params.QuotaConfig.DiskQuotaPerDB.GetAsFloat())
+		assert.Equal(t, params.QuotaConfig.DiskQuotaPerCollection.GetAsFloat(), params.QuotaConfig.DiskQuotaPerPartition.GetAsFloat())
 	})
 }
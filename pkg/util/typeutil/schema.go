@@ -983,6 +983,16 @@ func GetPartitionKeyFieldSchema(schema *schemapb.CollectionSchema) (*schemapb.Fi
 	return nil, errors.New("partition key field is not found")
 }
 
+// GetClusteringKeyField returns the clustering key field if it exists.
+func GetClusteringKeyField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
+	for _, fieldSchema := range schema.GetFields() {
+		if fieldSchema.GetIsClusteringKey() {
+			return fieldSchema
+		}
+	}
+	return nil
+}
+
 // GetDynamicField returns the dynamic field if it exists.
 func GetDynamicField(schema *schemapb.CollectionSchema) *schemapb.FieldSchema {
 	for _, fieldSchema := range schema.GetFields() {